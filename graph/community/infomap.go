@@ -0,0 +1,499 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Infomap returns the communities of g that minimize the two-level map
+// equation, the description length of a random walk on g using a module
+// codebook and one codebook for each community. The minimization uses
+// repeated rounds of local node moves followed by aggregation of the
+// communities into single nodes, as in the Louvain algorithm. If placing
+// all nodes in a single community gives a shorter description, that
+// partition is returned.
+//
+// If g is directed, the random walk follows out edges with probability
+// proportional to their weight and teleports to a uniformly chosen node with
+// probability teleport, or always when at a node without out edges. If g is
+// undirected, the random walk does not teleport and teleport is ignored.
+// Self edges are ignored.
+//
+// The returned ReducedGraph has the communities as its top level. If src is
+// nil, rand.IntN is used as the random generator. Infomap will panic if g
+// has any edge with negative edge weight, or if g is directed and teleport
+// is not in [0, 1].
+//
+// See Rosvall and Bergstrom, "Maps of random walks on complex networks reveal
+// community structure", PNAS 105(4) (2008).
+func Infomap(g graph.Graph, teleport float64, src rand.Source) ReducedGraph {
+	rnd := rand.IntN
+	if src != nil {
+		rnd = rand.New(src).IntN
+	}
+	f := newFlowGraph(g, teleport)
+	units := f
+
+	// label holds the unit of the current level
+	// that each node of g belongs to.
+	label := make([]int, len(f.p))
+	for i := range label {
+		label[i] = i
+	}
+	for {
+		module, moved := units.moveUnits(rnd)
+		if !moved {
+			break
+		}
+		for i, u := range label {
+			label[i] = module[u]
+		}
+		units = units.aggregate(module)
+	}
+
+	// Fall back to the trivial partition when the
+	// search is trapped in a poor local minimum.
+	h := f.nodeEntropy()
+	one := make([]int, len(label))
+	if len(label) != 0 && f.newModules(one, 1).codelength(h) < f.newModules(label, len(units.p)).codelength(h) {
+		label = one
+	}
+	return reducePartition(g, label)
+}
+
+// MapEquation returns the two-level map equation codelength in bits of the
+// random walk on g described in Infomap for the given communities. Each node
+// of g must be in exactly one community. MapEquation will panic if g has
+// any edge with negative edge weight, or if g is directed and teleport is
+// not in [0, 1].
+//
+// The codelength is calculated according to
+//
+//	L = q log q - 2 \sum_i q_i log q_i - \sum_α p_α log p_α + \sum_i (q_i + p_i) log (q_i + p_i),
+//
+// where p_α is the visit rate of node α, q_i and p_i are the exit rate and
+// total visit rate of community i, and q is the sum of the q_i.
+func MapEquation(g graph.Graph, communities [][]graph.Node, teleport float64) float64 {
+	f := newFlowGraph(g, teleport)
+	module := make([]int, len(f.p))
+	for i, c := range communities {
+		for _, n := range c {
+			module[f.indexOf[n.ID()]] = i
+		}
+	}
+	return f.newModules(module, len(communities)).codelength(f.nodeEntropy())
+}
+
+// plogp returns p log_2 p, or zero if p is zero.
+func plogp(p float64) float64 {
+	if p <= 0 {
+		return 0
+	}
+	return p * math.Log2(p)
+}
+
+// maxInfomapMoves limits the number of passes over the units of a
+// level during the local moving phase of Infomap.
+const maxInfomapMoves = 100
+
+// flowGraph is the random walk flow on a graph at one level of the Infomap
+// aggregation. Each unit is a node or a set of nodes of the original graph.
+type flowGraph struct {
+	// indexOf is the index of each node of
+	// the original graph ordered by ID.
+	indexOf map[int64]int
+
+	// nodes is the number of nodes in the
+	// original graph.
+	nodes float64
+
+	// p is the visit rate of each unit.
+	p []float64
+	// tele is the rate of teleportation from
+	// each unit.
+	tele []float64
+	// size is the number of nodes of the
+	// original graph in each unit.
+	size []float64
+
+	// out and in hold the flow along edges
+	// from and to each unit, excluding flow
+	// within a unit.
+	out, in [][]flowArc
+}
+
+// flowArc is a flow f along an edge to or from unit v.
+type flowArc struct {
+	v int
+	f float64
+}
+
+// newFlowGraph returns the stationary random walk flow on g.
+func newFlowGraph(g graph.Graph, teleport float64) *flowGraph {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	n := len(nodes)
+	indexOf := make(map[int64]int, n)
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+	weight := positiveWeightFuncFor(g)
+	_, isDirected := g.(graph.Directed)
+	if isDirected && !(0 <= teleport && teleport <= 1) {
+		panic("community: teleport probability out of range")
+	}
+
+	f := &flowGraph{
+		indexOf: indexOf,
+		nodes:   float64(n),
+		p:       make([]float64, n),
+		tele:    make([]float64, n),
+		size:    make([]float64, n),
+		out:     make([][]flowArc, n),
+		in:      make([][]flowArc, n),
+	}
+	strength := make([]float64, n)
+	for u, x := range nodes {
+		f.size[u] = 1
+		uid := x.ID()
+		for it := g.From(uid); it.Next(); {
+			vid := it.Node().ID()
+			v := indexOf[vid]
+			if v == u {
+				continue
+			}
+			w := weight(uid, vid)
+			f.out[u] = append(f.out[u], flowArc{v: v, f: w})
+			strength[u] += w
+		}
+		slices.SortFunc(f.out[u], func(a, b flowArc) int { return a.v - b.v })
+	}
+	if n == 0 {
+		return f
+	}
+
+	if !isDirected {
+		var total float64
+		for _, s := range strength {
+			total += s
+		}
+		if total == 0 {
+			return f
+		}
+		for u, arcs := range f.out {
+			f.p[u] = strength[u] / total
+			for i := range arcs {
+				arcs[i].f /= total
+			}
+		}
+		f.in = f.out
+		return f
+	}
+
+	// Find the stationary distribution of the walk
+	// by power iteration.
+	for i := range f.p {
+		f.p[i] = 1 / f.nodes
+	}
+	next := make([]float64, n)
+	for iter := 0; iter < 1000; iter++ {
+		var jump float64
+		for u, p := range f.p {
+			if strength[u] == 0 {
+				jump += p
+			} else {
+				jump += teleport * p
+			}
+		}
+		for i := range next {
+			next[i] = jump / f.nodes
+		}
+		for u, arcs := range f.out {
+			for _, e := range arcs {
+				next[e.v] += (1 - teleport) * f.p[u] * e.f / strength[u]
+			}
+		}
+		var delta float64
+		for i, p := range next {
+			delta += math.Abs(p - f.p[i])
+		}
+		f.p, next = next, f.p
+		if delta < 1e-15*f.nodes {
+			break
+		}
+	}
+
+	for u, arcs := range f.out {
+		if strength[u] == 0 {
+			f.tele[u] = f.p[u]
+			continue
+		}
+		f.tele[u] = teleport * f.p[u]
+		for i := range arcs {
+			arcs[i].f *= (1 - teleport) * f.p[u] / strength[u]
+			f.in[arcs[i].v] = append(f.in[arcs[i].v], flowArc{v: u, f: arcs[i].f})
+		}
+	}
+	return f
+}
+
+// nodeEntropy returns the sum of p log p over the units of f.
+func (f *flowGraph) nodeEntropy() float64 {
+	var h float64
+	for _, p := range f.p {
+		h += plogp(p)
+	}
+	return h
+}
+
+// moveUnits assigns the units of f to modules by repeatedly moving single
+// units to the neighboring module that most reduces the map equation. It
+// returns the module of each unit, numbered consecutively from zero, and
+// whether any unit was moved.
+func (f *flowGraph) moveUnits(rnd func(int) int) (module []int, moved bool) {
+	n := len(f.p)
+	module = make([]int, n)
+	for i := range module {
+		module[i] = i
+	}
+	m := f.newModules(module, n)
+
+	visit := make([]int, n)
+	for i := range visit {
+		visit[i] = i
+	}
+	// flows holds the flow out of and into the
+	// unit being moved for each neighboring module.
+	flows := make(map[int][2]float64)
+	var candidates []int
+	for pass := 0; pass < maxInfomapMoves; pass++ {
+		for i := len(visit) - 1; i > 0; i-- {
+			j := rnd(i + 1)
+			visit[i], visit[j] = visit[j], visit[i]
+		}
+		var changed bool
+		for _, u := range visit {
+			clear(flows)
+			candidates = candidates[:0]
+			var out float64
+			for _, e := range f.out[u] {
+				c := module[e.v]
+				if _, ok := flows[c]; !ok {
+					candidates = append(candidates, c)
+				}
+				fl := flows[c]
+				fl[0] += e.f
+				flows[c] = fl
+				out += e.f
+			}
+			for _, e := range f.in[u] {
+				c := module[e.v]
+				if _, ok := flows[c]; !ok {
+					candidates = append(candidates, c)
+				}
+				fl := flows[c]
+				fl[1] += e.f
+				flows[c] = fl
+			}
+
+			src := module[u]
+			best := src
+			var bestDelta float64
+			for _, dst := range candidates {
+				if dst == src {
+					continue
+				}
+				delta := m.deltaMove(u, src, dst, out, flows[src], flows[dst])
+				if delta < bestDelta-1e-12 {
+					best = dst
+					bestDelta = delta
+				}
+			}
+			if best != src {
+				m.move(u, src, best, out, flows[src], flows[best])
+				module[u] = best
+				changed = true
+				moved = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	// Renumber the modules consecutively.
+	index := make(map[int]int)
+	for i, c := range module {
+		j, ok := index[c]
+		if !ok {
+			j = len(index)
+			index[c] = j
+		}
+		module[i] = j
+	}
+	return module, moved
+}
+
+// aggregate returns the flow graph with the units in each module of f
+// merged into a single unit.
+func (f *flowGraph) aggregate(module []int) *flowGraph {
+	var k int
+	for _, c := range module {
+		k = max(k, c+1)
+	}
+	a := &flowGraph{
+		indexOf: f.indexOf,
+		nodes:   f.nodes,
+		p:       make([]float64, k),
+		tele:    make([]float64, k),
+		size:    make([]float64, k),
+		out:     make([][]flowArc, k),
+		in:      make([][]flowArc, k),
+	}
+	out := make([]map[int]float64, k)
+	for i := range out {
+		out[i] = make(map[int]float64)
+	}
+	for u, c := range module {
+		a.p[c] += f.p[u]
+		a.tele[c] += f.tele[u]
+		a.size[c] += f.size[u]
+		for _, e := range f.out[u] {
+			if d := module[e.v]; d != c {
+				out[c][d] += e.f
+			}
+		}
+	}
+	for c, arcs := range out {
+		for d, fl := range arcs {
+			a.out[c] = append(a.out[c], flowArc{v: d, f: fl})
+			a.in[d] = append(a.in[d], flowArc{v: c, f: fl})
+		}
+	}
+	for c := range a.out {
+		slices.SortFunc(a.out[c], func(a, b flowArc) int { return a.v - b.v })
+		slices.SortFunc(a.in[c], func(a, b flowArc) int { return a.v - b.v })
+	}
+	return a
+}
+
+// modules holds the flow statistics of a partition of the units of a
+// flowGraph.
+type modules struct {
+	f     *flowGraph
+	nodes float64
+
+	p, tele, size []float64
+	// outflow is the flow along edges leaving
+	// each module.
+	outflow []float64
+
+	// sumExit is the sum of the exit rates
+	// of all modules.
+	sumExit float64
+}
+
+// newModules returns the statistics of the k modules of f given by module.
+func (f *flowGraph) newModules(module []int, k int) *modules {
+	m := &modules{
+		f:       f,
+		nodes:   f.nodes,
+		p:       make([]float64, k),
+		tele:    make([]float64, k),
+		size:    make([]float64, k),
+		outflow: make([]float64, k),
+	}
+	for u, c := range module {
+		m.p[c] += f.p[u]
+		m.tele[c] += f.tele[u]
+		m.size[c] += f.size[u]
+		for _, e := range f.out[u] {
+			if module[e.v] != c {
+				m.outflow[c] += e.f
+			}
+		}
+	}
+	for c := range m.p {
+		m.sumExit += m.exit(c)
+	}
+	return m
+}
+
+// exit returns the rate at which the random walk leaves module c.
+func (m *modules) exit(c int) float64 {
+	return m.exitOf(m.tele[c], m.size[c], m.outflow[c])
+}
+
+// exitOf returns the exit rate of a module with the given teleportation
+// rate, number of nodes and flow along edges leaving the module.
+func (m *modules) exitOf(tele, size, outflow float64) float64 {
+	return tele*(1-size/m.nodes) + outflow
+}
+
+// codelength returns the map equation for the modules given the sum of
+// p log p over the units.
+func (m *modules) codelength(nodeEntropy float64) float64 {
+	l := plogp(m.sumExit) - nodeEntropy
+	for c, p := range m.p {
+		q := m.exit(c)
+		l += plogp(q+p) - 2*plogp(q)
+	}
+	return l
+}
+
+// deltaMove returns the change in the map equation from moving unit u
+// with total flow out along edges from module src to module dst. The flows
+// between u and the other units of src and between u and the units of dst
+// are srcFlow and dstFlow, each holding the flow out of and into u.
+func (m *modules) deltaMove(u, src, dst int, out float64, srcFlow, dstFlow [2]float64) float64 {
+	s, d := m.after(u, src, dst, out, srcFlow, dstFlow)
+	oldSrc, oldDst := m.exit(src), m.exit(dst)
+	newSrc := m.exitOf(s.tele, s.size, s.outflow)
+	newDst := m.exitOf(d.tele, d.size, d.outflow)
+	sumExit := m.sumExit - oldSrc - oldDst + newSrc + newDst
+
+	return plogp(sumExit) - plogp(m.sumExit) -
+		2*(plogp(newSrc)+plogp(newDst)-plogp(oldSrc)-plogp(oldDst)) +
+		plogp(newSrc+s.p) + plogp(newDst+d.p) - plogp(oldSrc+m.p[src]) - plogp(oldDst+m.p[dst])
+}
+
+// move moves unit u from module src to module dst. The parameters are as
+// for deltaMove.
+func (m *modules) move(u, src, dst int, out float64, srcFlow, dstFlow [2]float64) {
+	s, d := m.after(u, src, dst, out, srcFlow, dstFlow)
+	m.sumExit -= m.exit(src) + m.exit(dst)
+	m.p[src], m.tele[src], m.size[src], m.outflow[src] = s.p, s.tele, s.size, s.outflow
+	m.p[dst], m.tele[dst], m.size[dst], m.outflow[dst] = d.p, d.tele, d.size, d.outflow
+	m.sumExit += m.exit(src) + m.exit(dst)
+}
+
+// moduleState holds the statistics of a single module.
+type moduleState struct {
+	p, tele, size, outflow float64
+}
+
+// after returns the statistics of modules src and dst after moving unit u.
+func (m *modules) after(u, src, dst int, out float64, srcFlow, dstFlow [2]float64) (s, d moduleState) {
+	f := m.f
+	s = moduleState{
+		p:       m.p[src] - f.p[u],
+		tele:    m.tele[src] - f.tele[u],
+		size:    m.size[src] - f.size[u],
+		outflow: m.outflow[src] - (out - srcFlow[0]) + srcFlow[1],
+	}
+	d = moduleState{
+		p:       m.p[dst] + f.p[u],
+		tele:    m.tele[dst] + f.tele[u],
+		size:    m.size[dst] + f.size[u],
+		outflow: m.outflow[dst] + (out - dstFlow[0]) - dstFlow[1],
+	}
+	return s, d
+}
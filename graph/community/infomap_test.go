@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestInfomap(t *testing.T) {
+	t.Parallel()
+	want := [][]int64{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}}
+	for _, directed := range []bool{false, true} {
+		g := graphFrom(twoCliques, directed)
+		if directed {
+			// Make the flow circulate within each clique
+			// and between the cliques.
+			d := g.(*simple.DirectedGraph)
+			for _, e := range [][2]int64{{4, 0}, {9, 5}, {5, 4}} {
+				d.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+		}
+		r := Infomap(g, 0.15, rand.NewPCG(1, 1))
+		got := communityIDs(r)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected communities directed=%t:\ngot: %v\nwant:%v", directed, got, want)
+		}
+
+		one := [][]graph.Node{graph.NodesOf(g.Nodes())}
+		if l, l1 := MapEquation(g, r.Communities(), 0.15), MapEquation(g, one, 0.15); l >= l1 {
+			t.Errorf("codelength not reduced directed=%t: got:%v one module:%v", directed, l, l1)
+		}
+	}
+}
+
+func TestMapEquation(t *testing.T) {
+	t.Parallel()
+	g := graphFrom(twoCliques, false)
+	nodes := graph.NodesOf(g.Nodes())
+
+	// With a single module the codelength is the
+	// entropy of the node visit rates, and with
+	// each node in its own module every step
+	// additionally costs one bit for each of the
+	// exit and entry codewords.
+	var entropy float64
+	var singletons [][]graph.Node
+	for _, n := range nodes {
+		p := float64(g.From(n.ID()).Len()) / 42
+		entropy -= p * math.Log2(p)
+		singletons = append(singletons, []graph.Node{n})
+	}
+	got := MapEquation(g, [][]graph.Node{nodes}, 0)
+	if !scalar.EqualWithinAbs(got, entropy, 1e-12) {
+		t.Errorf("unexpected one module codelength: got:%v want:%v", got, entropy)
+	}
+	got = MapEquation(g, singletons, 0)
+	if !scalar.EqualWithinAbs(got, entropy+2, 1e-12) {
+		t.Errorf("unexpected singleton codelength: got:%v want:%v", got, entropy+2)
+	}
+
+	// Infomap never does worse than the trivial
+	// partition on random graphs.
+	src := rand.NewPCG(1, 1)
+	for trial := 0; trial < 10; trial++ {
+		rnd := rand.New(src)
+		d := simple.NewDirectedGraph()
+		for i := 0; i < 20; i++ {
+			d.AddNode(simple.Node(i))
+		}
+		for i := 0; i < 20; i++ {
+			for j := 0; j < 20; j++ {
+				if i != j && rnd.Float64() < 0.1 {
+					d.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+				}
+			}
+		}
+		r := Infomap(d, 0.15, src)
+		if l, l1 := MapEquation(d, r.Communities(), 0.15), MapEquation(d, [][]graph.Node{graph.NodesOf(d.Nodes())}, 0.15); l > l1+1e-12 {
+			t.Errorf("trial %d: codelength increased: got:%v one module:%v", trial, l, l1)
+		}
+	}
+}
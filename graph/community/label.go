@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// maxLabelPropagationIterations limits the number of label update rounds
+// since label propagation is not guaranteed to converge.
+const maxLabelPropagationIterations = 1000
+
+// LabelPropagation returns the communities of g found by asynchronous label
+// propagation. Each node initially has a unique label, and nodes are visited
+// in random order, adopting the label with the greatest total edge weight
+// among their neighbors, until every node has such a label. Ties are broken
+// at random, retaining the current label if it is among the best. Nodes with
+// the same final label form a community. Edge direction is ignored.
+//
+// If seeds is not nil, label propagation is semi-supervised. The nodes with
+// IDs in seeds have the given labels, which do not change, and the remaining
+// nodes are initially unlabeled and adopt labels from their labeled
+// neighbors. Nodes that are not connected to a seeded node each form their
+// own community. Seed labels must not be negative.
+//
+// The returned ReducedGraph has the communities as its top level. If src is
+// nil, rand.IntN is used as the random generator. LabelPropagation will panic
+// if g has any edge with negative edge weight.
+//
+// See Raghavan, Albert and Kumara, "Near linear time algorithm to detect
+// community structures in large-scale networks", Phys. Rev. E 76 (2007).
+func LabelPropagation(g graph.Graph, seeds map[int64]int, src rand.Source) ReducedGraph {
+	return labelPropagation(g, seeds, false, src)
+}
+
+// SynchronousLabelPropagation returns the communities of g found by
+// synchronous label propagation. It is identical to LabelPropagation except
+// that in each round all nodes simultaneously adopt the best label among
+// their neighbors' labels from the previous round. Synchronous updates may
+// oscillate, for example on bipartite graphs, so propagation stops after
+// a fixed number of rounds if the labels have not converged.
+func SynchronousLabelPropagation(g graph.Graph, seeds map[int64]int, src rand.Source) ReducedGraph {
+	return labelPropagation(g, seeds, true, src)
+}
+
+// unlabeled marks a node without a label during semi-supervised
+// propagation.
+const unlabeled = -1
+
+func labelPropagation(g graph.Graph, seeds map[int64]int, synchronous bool, src rand.Source) ReducedGraph {
+	rnd := rand.IntN
+	if src != nil {
+		rnd = rand.New(src).IntN
+	}
+	wn := newWeightedNeighbors(g)
+	n := len(wn.nodes)
+
+	label := make([]int, n)
+	fixed := make([]bool, n)
+	if seeds == nil {
+		for i := range label {
+			label[i] = i
+		}
+	} else {
+		for i, u := range wn.nodes {
+			l, ok := seeds[u.ID()]
+			if ok {
+				if l < 0 {
+					panic("community: negative seed label")
+				}
+				label[i] = l
+				fixed[i] = true
+			} else {
+				label[i] = unlabeled
+			}
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	next := make([]int, n)
+	weights := make(map[int]float64)
+	var best []int
+	for iter := 0; iter < maxLabelPropagationIterations; iter++ {
+		for i := len(order) - 1; i > 0; i-- {
+			j := rnd(i + 1)
+			order[i], order[j] = order[j], order[i]
+		}
+		copy(next, label)
+		changed := false
+		for _, u := range order {
+			if fixed[u] {
+				continue
+			}
+			// In both modes labels are read from label;
+			// synchronous updates are written to next.
+			clear(weights)
+			for _, e := range wn.neighbors[u] {
+				if l := label[e.v]; l != unlabeled {
+					weights[l] += e.w
+				}
+			}
+			if len(weights) == 0 {
+				continue
+			}
+			best = best[:0]
+			var bestWeight float64
+			for l, w := range weights {
+				switch {
+				case w > bestWeight || len(best) == 0:
+					bestWeight = w
+					best = append(best[:0], l)
+				case w == bestWeight:
+					best = append(best, l)
+				}
+			}
+			if slices.Contains(best, label[u]) {
+				continue
+			}
+			// Sort the candidate labels so that the
+			// choice depends only on rnd.
+			slices.Sort(best)
+			l := best[rnd(len(best))]
+			if synchronous {
+				next[u] = l
+			} else {
+				label[u] = l
+			}
+			changed = true
+		}
+		if synchronous {
+			label, next = next, label
+		}
+		if !changed {
+			break
+		}
+	}
+
+	if seeds != nil {
+		// Give each unreached node its own label.
+		maxLabel := 0
+		for _, l := range label {
+			maxLabel = max(maxLabel, l)
+		}
+		for i, l := range label {
+			if l == unlabeled {
+				maxLabel++
+				label[i] = maxLabel
+			}
+		}
+	}
+	return reducePartition(g, label)
+}
@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// twoCliques is a pair of 5-cliques joined by a single edge.
+var twoCliques = []intset{
+	0: linksTo(1, 2, 3, 4),
+	1: linksTo(2, 3, 4),
+	2: linksTo(3, 4),
+	3: linksTo(4),
+	4: linksTo(5),
+	5: linksTo(6, 7, 8, 9),
+	6: linksTo(7, 8, 9),
+	7: linksTo(8, 9),
+	8: linksTo(9),
+	9: nil,
+}
+
+func TestLabelPropagation(t *testing.T) {
+	t.Parallel()
+	want := [][]int64{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}}
+	for _, directed := range []bool{false, true} {
+		g := graphFrom(twoCliques, directed)
+		for _, fn := range []struct {
+			name string
+			fn   func(graph.Graph, map[int64]int, rand.Source) ReducedGraph
+		}{
+			{name: "asynchronous", fn: LabelPropagation},
+			{name: "synchronous", fn: SynchronousLabelPropagation},
+		} {
+			// The seeds place the bridge nodes in
+			// their own cliques' communities.
+			got := communityIDs(fn.fn(g, map[int64]int{4: 1, 5: 2}, rand.NewPCG(1, 1)))
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("unexpected %s seeded communities directed=%t:\ngot: %v\nwant:%v",
+					fn.name, directed, got, want)
+			}
+
+			r := fn.fn(g, nil, rand.NewPCG(1, 1))
+			if q := Q(r, nil, 1); q <= 0 {
+				t.Errorf("unexpected %s modularity directed=%t: got:%v want:>0", fn.name, directed, q)
+			}
+			again := communityIDs(fn.fn(g, nil, rand.NewPCG(1, 1)))
+			if !reflect.DeepEqual(communityIDs(r), again) {
+				t.Errorf("%s label propagation not reproducible directed=%t", fn.name, directed)
+			}
+		}
+	}
+}
+
+func TestLabelPropagationSeeds(t *testing.T) {
+	t.Parallel()
+	// A path with seeds at either end and an
+	// isolated node that is not reached.
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	g.AddNode(simple.Node(6))
+
+	for _, fn := range []func(graph.Graph, map[int64]int, rand.Source) ReducedGraph{
+		LabelPropagation,
+		SynchronousLabelPropagation,
+	} {
+		got := communityIDs(fn(g, map[int64]int{0: 0, 5: 1}, rand.NewPCG(1, 1)))
+		if len(got) != 3 || !reflect.DeepEqual(got[2], []int64{6}) {
+			t.Errorf("unexpected seeded communities: %v", got)
+			continue
+		}
+		if got[0][0] != 0 || slices.Contains(got[0], 5) {
+			t.Errorf("seeds not respected: %v", got)
+		}
+	}
+}
+
+// graphFrom returns a graph with the edges in g. If directed is true each
+// edge is directed from the lower to the higher indexed node.
+func graphFrom(g []intset, directed bool) graph.Graph {
+	var b interface {
+		graph.Graph
+		graph.Builder
+	}
+	if directed {
+		b = simple.NewDirectedGraph()
+	} else {
+		b = simple.NewUndirectedGraph()
+	}
+	for u, e := range g {
+		if b.Node(int64(u)) == nil {
+			b.AddNode(simple.Node(u))
+		}
+		for v := range e {
+			b.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+		}
+	}
+	return b
+}
+
+// communityIDs returns the node IDs of the communities of r, sorted.
+func communityIDs(r ReducedGraph) [][]int64 {
+	var ids [][]int64
+	for _, c := range r.Communities() {
+		var s []int64
+		for _, n := range c {
+			s = append(s, n.ID())
+		}
+		slices.Sort(s)
+		ids = append(ids, s)
+	}
+	slices.SortFunc(ids, func(a, b []int64) int { return int(a[0] - b[0]) })
+	return ids
+}
+
+func ExampleLabelPropagation() {
+	// Two triangles joined by an edge, with one
+	// seeded node in each triangle.
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	seeds := map[int64]int{0: 0, 5: 1}
+	r := LabelPropagation(g, seeds, rand.NewPCG(1, 1))
+	for _, c := range communityIDs(r) {
+		fmt.Println(c)
+	}
+
+	// Output:
+	// [0 1 2]
+	// [3 4 5]
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"fmt"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// reducePartition returns a ReducedGraph of g with the communities given by
+// the label of each node of g. The nodes of g are ordered by ID and label[i]
+// is the label of the ith node. Nodes with the same label are placed in the
+// same community.
+func reducePartition(g graph.Graph, label []int) ReducedGraph {
+	index := make(map[int]int)
+	var communities [][]graph.Node
+	for i, l := range label {
+		c, ok := index[l]
+		if !ok {
+			c = len(communities)
+			index[l] = c
+			communities = append(communities, nil)
+		}
+		// The nodes of the base reduced graph
+		// have IDs given by the index of the
+		// node in the ID order of g.
+		communities[c] = append(communities[c], node(i))
+	}
+	switch g := g.(type) {
+	case graph.Undirected:
+		return reduceUndirected(reduceUndirected(g, nil), communities)
+	case graph.Directed:
+		return reduceDirected(reduceDirected(g, nil), communities)
+	default:
+		panic(fmt.Sprintf("community: invalid graph type: %T", g))
+	}
+}
+
+// weightedNeighbors holds the nodes of a graph ordered by ID and the
+// weighted neighbors of each node, identified by index, ignoring edge
+// direction and self edges.
+type weightedNeighbors struct {
+	nodes     []graph.Node
+	neighbors [][]weightedNeighbor
+}
+
+// weightedNeighbor is a neighbor of a node joined by edges with total
+// weight w.
+type weightedNeighbor struct {
+	v int
+	w float64
+}
+
+// newWeightedNeighbors returns the weighted neighbors of the nodes of g. The
+// weights of edges in both directions between a pair of nodes of a directed
+// graph are summed. newWeightedNeighbors will panic if g has any edge with
+// negative edge weight.
+func newWeightedNeighbors(g graph.Graph) weightedNeighbors {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	weight := positiveWeightFuncFor(g)
+	_, isDirected := g.(graph.Directed)
+
+	neighbors := make([][]weightedNeighbor, len(nodes))
+	for u, n := range nodes {
+		uid := n.ID()
+		for it := g.From(uid); it.Next(); {
+			vid := it.Node().ID()
+			v := indexOf[vid]
+			if v == u {
+				continue
+			}
+			w := weight(uid, vid)
+			neighbors[u] = append(neighbors[u], weightedNeighbor{v: v, w: w})
+			if isDirected {
+				neighbors[v] = append(neighbors[v], weightedNeighbor{v: u, w: w})
+			}
+		}
+	}
+	for u, nbrs := range neighbors {
+		// Merge the neighbors from edges in both
+		// directions and order them by index for
+		// deterministic iteration.
+		slices.SortFunc(nbrs, func(a, b weightedNeighbor) int { return a.v - b.v })
+		var merged []weightedNeighbor
+		for _, e := range nbrs {
+			if len(merged) != 0 && merged[len(merged)-1].v == e.v {
+				merged[len(merged)-1].w += e.w
+				continue
+			}
+			merged = append(merged, e)
+		}
+		neighbors[u] = merged
+	}
+	return weightedNeighbors{nodes: nodes, neighbors: neighbors}
+}
@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/spectral"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/mat"
+)
+
+// maxKMeansIterations limits the number of Lloyd iterations used by
+// Spectral.
+const maxKMeansIterations = 300
+
+// Spectral returns at most k communities of g found by normalized spectral
+// clustering. Each node is embedded using its elements of the eigenvectors
+// of the k smallest eigenvalues of the symmetric normalized Laplacian of g,
+// with the embedding of each node scaled to unit length, and the embedded
+// nodes are clustered with k-means using k-means++ seeding.
+//
+// The returned ReducedGraph has the communities as its top level. If src is
+// nil, rand.Float64 is used as the random generator. Spectral will panic if
+// k is less than one or greater than the number of nodes in g, or if g has
+// self edges.
+//
+// See Ng, Jordan and Weiss, "On spectral clustering: analysis and an
+// algorithm", NIPS 14 (2001).
+func Spectral(g graph.Undirected, k int, src rand.Source) ReducedGraph {
+	rnd := rand.Float64
+	if src != nil {
+		rnd = rand.New(src).Float64
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	if k < 1 || len(nodes) < k {
+		panic("community: invalid number of clusters")
+	}
+	order.ByID(nodes)
+
+	l := spectral.NewSymNormLaplacian(g)
+	var eig mat.EigenSym
+	ok := eig.Factorize(l.Matrix.(mat.Symmetric), true)
+	if !ok {
+		panic("community: eigendecomposition failed")
+	}
+	var vecs mat.Dense
+	eig.VectorsTo(&vecs)
+
+	// Place the embedding of the nodes in ID order
+	// so the clustering depends only on rnd.
+	x := mat.NewDense(len(nodes), k, nil)
+	for i, n := range nodes {
+		row := x.RawRowView(i)
+		mat.Row(row, l.Index[n.ID()], vecs.Slice(0, len(nodes), 0, k))
+		if norm := floats.Norm(row, 2); norm != 0 {
+			floats.Scale(1/norm, row)
+		}
+	}
+	return reducePartition(g, kMeans(x, k, rnd))
+}
+
+// kMeans returns the cluster labels of the rows of x found by Lloyd's
+// algorithm with k-means++ seeding.
+func kMeans(x *mat.Dense, k int, rnd func() float64) []int {
+	n, _ := x.Dims()
+	centers := mat.NewDense(k, x.RawMatrix().Cols, nil)
+
+	// Choose each center with probability proportional
+	// to the squared distance to the nearest chosen center.
+	dist := make([]float64, n)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	c := min(int(rnd()*float64(n)), n-1)
+	for j := 0; j < k; j++ {
+		centers.SetRow(j, x.RawRowView(c))
+		var total float64
+		for i := range dist {
+			dist[i] = min(dist[i], sqDist(x.RawRowView(i), centers.RawRowView(j)))
+			total += dist[i]
+		}
+		if total == 0 {
+			c = min(int(rnd()*float64(n)), n-1)
+			continue
+		}
+		r := rnd() * total
+		for c = 0; c < n-1; c++ {
+			r -= dist[c]
+			if r < 0 {
+				break
+			}
+		}
+	}
+
+	label := make([]int, n)
+	for i := range label {
+		label[i] = -1
+	}
+	count := make([]int, k)
+	for iter := 0; iter < maxKMeansIterations; iter++ {
+		changed := false
+		for i := range label {
+			best := 0
+			bestDist := math.Inf(1)
+			for j := 0; j < k; j++ {
+				if d := sqDist(x.RawRowView(i), centers.RawRowView(j)); d < bestDist {
+					best = j
+					bestDist = d
+				}
+			}
+			if label[i] != best {
+				label[i] = best
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		// Move each center to the mean of its cluster,
+		// leaving the centers of empty clusters in place.
+		clear(count)
+		for _, j := range label {
+			count[j]++
+		}
+		for j, c := range count {
+			if c != 0 {
+				floats.Scale(0, centers.RawRowView(j))
+			}
+		}
+		for i, j := range label {
+			floats.AddScaled(centers.RawRowView(j), 1/float64(count[j]), x.RawRowView(i))
+		}
+	}
+	return label
+}
+
+// sqDist returns the squared Euclidean distance between a and b.
+func sqDist(a, b []float64) float64 {
+	d := floats.Distance(a, b, 2)
+	return d * d
+}
@@ -0,0 +1,45 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+func TestSpectral(t *testing.T) {
+	t.Parallel()
+	g := graphFrom(twoCliques, false).(graph.Undirected)
+	for _, test := range []struct {
+		k    int
+		want [][]int64
+	}{
+		{k: 1, want: [][]int64{{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}}},
+		{k: 2, want: [][]int64{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}}},
+	} {
+		got := communityIDs(Spectral(g, test.k, rand.NewPCG(1, 1)))
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected communities for k=%d:\ngot: %v\nwant:%v", test.k, got, test.want)
+		}
+	}
+
+	// Nodes with identical embeddings may share a
+	// community, so there are at most k communities.
+	if got := communityIDs(Spectral(g, 10, rand.NewPCG(1, 1))); len(got) > 10 || len(got) < 2 {
+		t.Errorf("unexpected number of communities for k=10: %v", got)
+	}
+
+	panicked := func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		Spectral(g, 11, nil)
+		return false
+	}()
+	if !panicked {
+		t.Error("expected panic for too many clusters")
+	}
+}
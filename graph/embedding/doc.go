@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package embedding provides graph node embedding functions.
+//
+// Node embeddings are vector representations of the nodes of a graph
+// such that nodes that are close in the graph are close in the vector
+// space. The package provides random walk embeddings, generating walks
+// with DeepWalk or Node2Vec and training a SkipGram model on the walks,
+// and spectral embeddings with LaplacianEigenmap.
+package embedding // import "gonum.org/v1/gonum/graph/embedding"
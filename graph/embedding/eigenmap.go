@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embedding
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/spectral"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/mat"
+)
+
+// signTol is the relative tolerance for ties between the magnitudes of
+// elements when choosing the sign of an embedding dimension.
+const signTol = 1e-8
+
+// LaplacianEigenmap returns the Laplacian eigenmap embedding of the nodes
+// of g in dims dimensions. The embedding of the nodes is given by the
+// solutions, f, of the generalized eigenproblem Lf = λDf with the dims
+// smallest non-trivial eigenvalues, where L is the Laplacian of g and D
+// is the diagonal matrix of node degrees. The solutions are found from
+// the eigenvectors of the symmetric normalized Laplacian of g. The sign of
+// each dimension is chosen so that its element of largest magnitude, the
+// first in row order if several are equal, is positive. The rows of the
+// returned Embedding are in order of node ID.
+//
+// Only the eigenvector of the smallest eigenvalue is considered trivial,
+// so if g has more than one connected component the leading dimensions of
+// the embedding separate the components. Isolated nodes are embedded at
+// the origin. LaplacianEigenmap will panic if dims is less than one or not
+// less than the number of nodes in g, or if g has self edges.
+//
+// See Belkin and Niyogi, doi:10.1162/089976603321780317 for details.
+func LaplacianEigenmap(g graph.Undirected, dims int) Embedding {
+	nodes := graph.NodesOf(g.Nodes())
+	if dims < 1 || len(nodes) <= dims {
+		panic("embedding: invalid number of dimensions")
+	}
+	order.ByID(nodes)
+
+	l := spectral.NewSymNormLaplacian(g)
+	var eig mat.EigenSym
+	ok := eig.Factorize(l.Matrix.(mat.Symmetric), true)
+	if !ok {
+		panic("embedding: eigendecomposition failed")
+	}
+	var vecs mat.Dense
+	eig.VectorsTo(&vecs)
+
+	// The generalized eigenvectors are the eigenvectors
+	// of the symmetric normalized Laplacian scaled by
+	// the inverse square root of the node degrees.
+	emb := newEmbedding(nodes, dims)
+	for i, n := range nodes {
+		to := g.From(n.ID())
+		deg := to.Len()
+		if deg < 0 {
+			deg = len(graph.NodesOf(to))
+		}
+		if deg == 0 {
+			continue
+		}
+		row := emb.Vectors.RawRowView(i)
+		mat.Row(row, l.Index[n.ID()], vecs.Slice(0, len(nodes), 1, dims+1))
+		for j := range row {
+			row[j] /= math.Sqrt(float64(deg))
+		}
+	}
+	for j := 0; j < dims; j++ {
+		var max float64
+		for i := range nodes {
+			max = math.Max(max, math.Abs(emb.Vectors.At(i, j)))
+		}
+		var largest float64
+		for i := range nodes {
+			// Break near ties by row order so symmetric
+			// embeddings are stable under rounding error.
+			if v := emb.Vectors.At(i, j); math.Abs(v) >= max*(1-signTol) {
+				largest = v
+				break
+			}
+		}
+		if largest < 0 {
+			for i := range nodes {
+				emb.Vectors.Set(i, j, -emb.Vectors.At(i, j))
+			}
+		}
+	}
+	return emb
+}
@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embedding
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/spectral"
+	"gonum.org/v1/gonum/mat"
+)
+
+var eigenmapTests = []struct {
+	name string
+	g    graph.Undirected
+	dims int
+}{
+	{
+		name: "path",
+		g: func() graph.Undirected {
+			g := simple.NewUndirectedGraph()
+			for i := 0; i < 5; i++ {
+				g.SetEdge(g.NewEdge(simple.Node(i), simple.Node(i+1)))
+			}
+			return g
+		}(),
+		dims: 3,
+	},
+	{
+		name: "barbell",
+		g:    barbell(5),
+		dims: 4,
+	},
+	{
+		name: "irregular",
+		g: func() graph.Undirected {
+			g := simple.NewUndirectedGraph()
+			for _, e := range [][2]int64{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {3, 4}, {4, 5}, {5, 6}, {6, 3}, {2, 7}} {
+				g.SetEdge(g.NewEdge(simple.Node(e[0]), simple.Node(e[1])))
+			}
+			return g
+		}(),
+		dims: 5,
+	},
+}
+
+func TestLaplacianEigenmap(t *testing.T) {
+	t.Parallel()
+	for _, test := range eigenmapTests {
+		emb := LaplacianEigenmap(test.g, test.dims)
+		n := len(emb.Nodes)
+		if r, c := emb.Vectors.Dims(); r != n || c != test.dims {
+			t.Fatalf("unexpected embedding dimensions for %q: got:%d×%d want:%d×%d", test.name, r, c, n, test.dims)
+		}
+
+		// Each dimension must solve Lf = λDf and be
+		// D-orthogonal to the trivial solution.
+		l := spectral.NewLaplacian(test.g)
+		deg := make([]float64, n)
+		for i, u := range emb.Nodes {
+			deg[i] = float64(test.g.From(u.ID()).Len())
+		}
+		lap := mat.NewSymDense(n, nil)
+		for i, u := range emb.Nodes {
+			for j, v := range emb.Nodes {
+				lap.SetSym(i, j, l.At(l.Index[u.ID()], l.Index[v.ID()]))
+			}
+		}
+		prev := 0.0
+		for j := 0; j < test.dims; j++ {
+			f := mat.Col(nil, j, emb.Vectors)
+			df := make([]float64, n)
+			floats.MulTo(df, deg, f)
+			var lf mat.VecDense
+			lf.MulVec(lap, mat.NewVecDense(n, f))
+			lambda := floats.Dot(f, lf.RawVector().Data) / floats.Dot(f, df)
+			for i := range f {
+				if !scalar.EqualWithinAbs(lf.AtVec(i), lambda*df[i], 1e-10) {
+					t.Errorf("dimension %d of %q is not a generalized eigenvector", j, test.name)
+					break
+				}
+			}
+			if lambda < prev-1e-10 {
+				t.Errorf("eigenvalues of %q not in ascending order: %v after %v", test.name, lambda, prev)
+			}
+			prev = lambda
+			if sum := floats.Sum(df); !scalar.EqualWithinAbs(sum, 0, 1e-10) {
+				t.Errorf("dimension %d of %q is not orthogonal to the trivial solution: %v", j, test.name, sum)
+			}
+			max := math.Max(floats.Max(f), -floats.Min(f))
+			for _, v := range f {
+				if math.Abs(v) >= max*(1-signTol) {
+					if v < 0 {
+						t.Errorf("dimension %d of %q has a negative largest element", j, test.name)
+					}
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestLaplacianEigenmapPath(t *testing.T) {
+	t.Parallel()
+	// The first dimension of the embedding of a
+	// path graph orders the nodes along the path.
+	emb := LaplacianEigenmap(eigenmapTests[0].g, 1)
+	x := mat.Col(nil, 0, emb.Vectors)
+	increasing := true
+	decreasing := true
+	for i := 1; i < len(x); i++ {
+		increasing = increasing && x[i] > x[i-1]
+		decreasing = decreasing && x[i] < x[i-1]
+	}
+	if !increasing && !decreasing {
+		t.Errorf("path embedding is not monotonic: %v", x)
+	}
+}
+
+func TestLaplacianEigenmapIsolated(t *testing.T) {
+	t.Parallel()
+	g := barbell(3)
+	g.AddNode(simple.Node(10))
+	emb := LaplacianEigenmap(g, 2)
+	if v := emb.Vector(10); floats.Norm(v, 2) != 0 {
+		t.Errorf("isolated node not at origin: %v", v)
+	}
+}
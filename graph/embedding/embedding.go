@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embedding
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Embedding is a set of node embedding vectors.
+type Embedding struct {
+	// Vectors holds the embedding vector
+	// of each node as a row.
+	Vectors *mat.Dense
+
+	// Nodes holds the embedded nodes
+	// in row order.
+	Nodes []graph.Node
+
+	// Index is a mapping from the node
+	// IDs to rows of Vectors.
+	Index map[int64]int
+}
+
+// Vector returns the embedding vector of the node with the given ID, or nil
+// if the node is not in the embedding. The returned slice shares its backing
+// data with Vectors.
+func (e Embedding) Vector(id int64) []float64 {
+	i, ok := e.Index[id]
+	if !ok {
+		return nil
+	}
+	return e.Vectors.RawRowView(i)
+}
+
+// newEmbedding returns an Embedding of the given nodes with dims
+// dimensions and all vectors zero.
+func newEmbedding(nodes []graph.Node, dims int) Embedding {
+	index := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		index[n.ID()] = i
+	}
+	var vecs *mat.Dense
+	if len(nodes) != 0 {
+		vecs = mat.NewDense(len(nodes), dims, nil)
+	}
+	return Embedding{Vectors: vecs, Nodes: nodes, Index: index}
+}
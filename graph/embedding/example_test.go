@@ -0,0 +1,61 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embedding_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph/embedding"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func ExampleSkipGram() {
+	// Construct two triangles joined by a single edge.
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+
+	// Generate node2vec walks biased toward staying
+	// within the local neighbourhood and train a
+	// skip-gram model on them.
+	walks := embedding.Node2Vec(g, 1, 2, 50, 10, rand.NewPCG(1, 1))
+	emb := embedding.SkipGram{Dims: 4, Window: 2, Epochs: 5, Src: rand.NewPCG(1, 1)}.Embed(walks)
+
+	similarity := func(u, v int64) float64 {
+		a, b := emb.Vector(u), emb.Vector(v)
+		return floats.Dot(a, b) / (floats.Norm(a, 2) * floats.Norm(b, 2))
+	}
+	fmt.Printf("node 0 is more similar to node 1 than to node 5: %t\n", similarity(0, 1) > similarity(0, 5))
+
+	// Output:
+	//
+	// node 0 is more similar to node 1 than to node 5: true
+}
+
+func ExampleLaplacianEigenmap() {
+	// Construct a path graph, 0-1-2-3-4.
+	g := simple.NewUndirectedGraph()
+	for i := int64(0); i < 4; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 1)})
+	}
+
+	// The one dimensional embedding places
+	// the nodes in order along a line.
+	emb := embedding.LaplacianEigenmap(g, 1)
+	nodes := slices.Clone(emb.Nodes)
+	sort.Slice(nodes, func(i, j int) bool {
+		return emb.Vector(nodes[i].ID())[0] < emb.Vector(nodes[j].ID())[0]
+	})
+	fmt.Println(nodes)
+
+	// Output:
+	//
+	// [4 3 2 1 0]
+}
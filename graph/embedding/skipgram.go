@@ -0,0 +1,186 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embedding
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// SkipGram is a skip-gram model with negative sampling for training node
+// embeddings from random walks. Each node in a walk is trained to predict
+// the nodes within Window steps of it in the walk and to not predict
+// Negative nodes drawn from the frequency distribution of nodes in the
+// walks raised to the power 3/4.
+//
+// See Mikolov et al. arXiv:1310.4546 [cs.CL] for details.
+type SkipGram struct {
+	// Dims is the number of dimensions
+	// of the embedding.
+	Dims int
+
+	// Window is the maximum distance
+	// between a node and its context
+	// nodes in a walk. If Window is
+	// zero, a window of 5 is used.
+	Window int
+
+	// Negative is the number of negative
+	// samples for each context node. If
+	// Negative is zero, 5 negative
+	// samples are used.
+	Negative int
+
+	// Epochs is the number of passes
+	// over the walks. If Epochs is zero,
+	// one pass is made.
+	Epochs int
+
+	// Rate is the initial learning rate.
+	// The learning rate decreases linearly
+	// during training to 1e-4 of the initial
+	// rate. If Rate is zero, an initial
+	// rate of 0.025 is used.
+	Rate float64
+
+	// Src is the source of randomness used
+	// to initialize the embedding and to
+	// draw negative samples. If Src is nil,
+	// the global random number generator
+	// is used.
+	Src rand.Source
+}
+
+// Embed returns the embedding of the nodes in the walks trained by the
+// skip-gram model. The rows of the returned Embedding are in order of node
+// ID. Embed will panic if s.Dims is less than one or if any of the other
+// parameters of s is negative.
+func (s SkipGram) Embed(walks [][]graph.Node) Embedding {
+	if s.Dims < 1 {
+		panic("embedding: invalid number of dimensions")
+	}
+	if s.Window < 0 || s.Negative < 0 || s.Epochs < 0 || s.Rate < 0 {
+		panic("embedding: negative skip-gram parameter")
+	}
+	window := s.Window
+	if window == 0 {
+		window = 5
+	}
+	negative := s.Negative
+	if negative == 0 {
+		negative = 5
+	}
+	epochs := s.Epochs
+	if epochs == 0 {
+		epochs = 1
+	}
+	rate := s.Rate
+	if rate == 0 {
+		rate = 0.025
+	}
+	rnd := rand.Float64
+	if s.Src != nil {
+		rnd = rand.New(s.Src).Float64
+	}
+
+	// Collect the nodes and their frequencies
+	// and convert the walks to node indices.
+	seen := make(map[int64]graph.Node)
+	for _, walk := range walks {
+		for _, n := range walk {
+			seen[n.ID()] = n
+		}
+	}
+	nodes := make([]graph.Node, 0, len(seen))
+	for _, n := range seen {
+		nodes = append(nodes, n)
+	}
+	order.ByID(nodes)
+	emb := newEmbedding(nodes, s.Dims)
+	if len(nodes) == 0 {
+		return emb
+	}
+	counts := make([]float64, len(nodes))
+	indices := make([][]int, len(walks))
+	var total int
+	for i, walk := range walks {
+		indices[i] = make([]int, len(walk))
+		for j, n := range walk {
+			k := emb.Index[n.ID()]
+			indices[i][j] = k
+			counts[k]++
+		}
+		total += len(walk)
+	}
+
+	// Negative samples are drawn from the unigram
+	// distribution raised to the power 3/4.
+	noise := make([]float64, len(nodes))
+	var sum float64
+	for i, c := range counts {
+		sum += math.Pow(c, 0.75)
+		noise[i] = sum
+	}
+	sample := func() int {
+		r := rnd() * sum
+		i := sort.Search(len(noise), func(i int) bool { return noise[i] > r })
+		return min(i, len(noise)-1)
+	}
+
+	// The input vectors are initialized uniformly in
+	// [-0.5/dims, 0.5/dims) and the output vectors are
+	// initialized to zero.
+	in := emb.Vectors.RawMatrix().Data
+	for i := range in {
+		in[i] = (rnd() - 0.5) / float64(s.Dims)
+	}
+	out := make([]float64, len(in))
+	grad := make([]float64, s.Dims)
+
+	steps := float64(epochs * total)
+	var step int
+	for range epochs {
+		for _, walk := range indices {
+			for i, u := range walk {
+				alpha := math.Max(rate*(1-float64(step)/steps), rate*1e-4)
+				step++
+				for j := max(0, i-window); j < min(len(walk), i+window+1); j++ {
+					if j == i {
+						continue
+					}
+					// Train the context node to predict
+					// u and not the negative samples.
+					vec := in[walk[j]*s.Dims : (walk[j]+1)*s.Dims]
+					clear(grad)
+					for d := 0; d <= negative; d++ {
+						target, label := u, 1.0
+						if d != 0 {
+							target, label = sample(), 0
+							if target == u {
+								continue
+							}
+						}
+						o := out[target*s.Dims : (target+1)*s.Dims]
+						g := alpha * (label - sigmoid(floats.Dot(vec, o)))
+						floats.AddScaled(grad, g, o)
+						floats.AddScaled(o, g, vec)
+					}
+					floats.Add(vec, grad)
+				}
+			}
+		}
+	}
+	return emb
+}
+
+// sigmoid returns the logistic function of x.
+func sigmoid(x float64) float64 {
+	return 1 / (1 + math.Exp(-x))
+}
@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embedding
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// barbell returns an undirected graph of two n-cliques joined by a single
+// edge. The nodes of the first clique have IDs less than n.
+func barbell(n int) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	for c := 0; c < 2; c++ {
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				g.SetEdge(g.NewEdge(simple.Node(c*n+i), simple.Node(c*n+j)))
+			}
+		}
+	}
+	g.SetEdge(g.NewEdge(simple.Node(n-1), simple.Node(n)))
+	return g
+}
+
+func TestSkipGram(t *testing.T) {
+	t.Parallel()
+	const n = 6
+	g := barbell(n)
+	for _, p := range []struct{ p, q float64 }{{1, 1}, {1, 0.5}} {
+		walks := Node2Vec(g, p.p, p.q, 20, 20, rand.NewPCG(1, 1))
+		emb := SkipGram{Dims: 8, Window: 3, Epochs: 5, Src: rand.NewPCG(2, 2)}.Embed(walks)
+
+		if r, c := emb.Vectors.Dims(); r != 2*n || c != 8 {
+			t.Fatalf("unexpected embedding dimensions: got:%d×%d want:%d×8", r, c, 2*n)
+		}
+		for i, u := range emb.Nodes {
+			if u.ID() != int64(i) {
+				t.Errorf("unexpected node order: got:%d want:%d", u.ID(), i)
+			}
+		}
+
+		// Nodes should be closer to the nodes of
+		// their own clique than to the other clique.
+		for _, u := range emb.Nodes {
+			var same, other float64
+			for _, v := range emb.Nodes {
+				if u.ID() == v.ID() {
+					continue
+				}
+				sim := cosine(emb.Vector(u.ID()), emb.Vector(v.ID()))
+				if u.ID()/n == v.ID()/n {
+					same += sim / (n - 1)
+				} else {
+					other += sim / n
+				}
+			}
+			if same <= other {
+				t.Errorf("node %d not closer to own clique for p=%v q=%v: same:%.3f other:%.3f",
+					u.ID(), p.p, p.q, same, other)
+			}
+		}
+	}
+}
+
+func TestSkipGramEmpty(t *testing.T) {
+	t.Parallel()
+	emb := SkipGram{Dims: 2}.Embed(nil)
+	if len(emb.Nodes) != 0 {
+		t.Errorf("unexpected nodes in empty embedding: %v", emb.Nodes)
+	}
+	if v := emb.Vector(0); v != nil {
+		t.Errorf("unexpected vector for absent node: %v", v)
+	}
+
+	// A walk of a single node has no context.
+	emb = SkipGram{Dims: 2, Src: rand.NewPCG(1, 1)}.Embed([][]graph.Node{{simple.Node(3)}})
+	if len(emb.Nodes) != 1 || emb.Vector(3) == nil {
+		t.Errorf("unexpected embedding of single node walk: %v", emb.Nodes)
+	}
+}
+
+func cosine(a, b []float64) float64 {
+	return floats.Dot(a, b) / (floats.Norm(a, 2) * floats.Norm(b, 2))
+}
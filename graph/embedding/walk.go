@@ -0,0 +1,207 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embedding
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// DeepWalk returns n random walks of at most the given length starting from
+// each node of g. Each step of a walk moves to a node adjacent to the current
+// node, chosen with probability proportional to the weight of the joining
+// edge if g is a graph.Weighted, or uniformly otherwise. A walk ends early
+// if it reaches a node with no outgoing edges.
+//
+// The walks are generated in rounds, each of which starts one walk from each
+// node in a random order. If src is nil, the global random number generator
+// is used. DeepWalk will panic if n or length is less than one or if g has a
+// negative edge weight.
+//
+// See Perozzi, Al-Rfou and Skiena, doi:10.1145/2623330.2623732 for details.
+func DeepWalk(g graph.Graph, n, length int, src rand.Source) [][]graph.Node {
+	return Node2Vec(g, 1, 1, n, length, src)
+}
+
+// Node2Vec returns n second order biased random walks of at most the given
+// length starting from each node of g. After a step from t to v, the next
+// step moves to a node x adjacent to v with probability proportional to
+// α⋅w(v, x), where w(v, x) is the weight of the edge joining v and x if g is
+// a graph.Weighted and 1 otherwise, and α is 1/p if x is t, 1 if x and t are
+// joined by an edge in either direction and 1/q otherwise. The return
+// parameter p controls the likelihood of immediately revisiting a node and
+// the in-out parameter q controls whether the walk stays close to t or moves
+// outward. When p and q are both 1, the walks are DeepWalk walks. A walk
+// ends early if it reaches a node with no outgoing edges.
+//
+// The walks are generated in rounds, each of which starts one walk from each
+// node in a random order. If src is nil, the global random number generator
+// is used. Node2Vec will panic if p or q is not positive, if n or length is
+// less than one or if g has a negative edge weight.
+//
+// See Grover and Leskovec, doi:10.1145/2939672.2939754 for details.
+func Node2Vec(g graph.Graph, p, q float64, n, length int, src rand.Source) [][]graph.Node {
+	if !(p > 0) || !(q > 0) {
+		panic("embedding: non-positive node2vec parameter")
+	}
+	if n < 1 {
+		panic("embedding: invalid number of walks")
+	}
+	if length < 1 {
+		panic("embedding: invalid walk length")
+	}
+	var (
+		rnd     = rand.Float64
+		shuffle = rand.Shuffle
+	)
+	if src != nil {
+		r := rand.New(src)
+		rnd, shuffle = r.Float64, r.Shuffle
+	}
+
+	w := newWalker(g)
+	starts := make([]int, len(w.nodes))
+	for i := range starts {
+		starts[i] = i
+	}
+	walks := make([][]graph.Node, 0, n*len(starts))
+	weights := make([]float64, 0, w.maxDegree)
+	for range n {
+		shuffle(len(starts), func(i, j int) { starts[i], starts[j] = starts[j], starts[i] })
+		for _, u := range starts {
+			walk := make([]graph.Node, 1, length)
+			walk[0] = w.nodes[u]
+			prev, curr := -1, u
+			for len(walk) < length {
+				to := w.to[curr]
+				if len(to) == 0 {
+					break
+				}
+				var next int
+				if prev < 0 || (p == 1 && q == 1) {
+					next = w.sample(w.cumulative[curr], rnd)
+				} else {
+					weights = weights[:0]
+					var sum float64
+					for k, x := range to {
+						alpha := 1 / q
+						switch {
+						case x == prev:
+							alpha = 1 / p
+						case w.adjacent(prev, x):
+							alpha = 1
+						}
+						sum += alpha * w.weight(curr, k)
+						weights = append(weights, sum)
+					}
+					next = w.sample(weights, rnd)
+				}
+				prev, curr = curr, to[next]
+				walk = append(walk, w.nodes[curr])
+			}
+			walks = append(walks, walk)
+		}
+	}
+	return walks
+}
+
+// walker holds the adjacency of a graph for generating random walks.
+type walker struct {
+	nodes []graph.Node
+
+	// to holds the indices of the nodes
+	// adjacent from each node in ascending
+	// order and cumulative holds the
+	// cumulative sums of the edge weights.
+	to         [][]int
+	cumulative [][]float64
+
+	maxDegree int
+}
+
+func newWalker(g graph.Graph) *walker {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+
+	weight := func(uid, vid int64) float64 { return 1 }
+	if wg, ok := g.(graph.Weighted); ok {
+		weight = func(uid, vid int64) float64 {
+			w, ok := wg.Weight(uid, vid)
+			if !ok {
+				panic("embedding: unexpected invalid weight")
+			}
+			return w
+		}
+	}
+
+	w := &walker{
+		nodes:      nodes,
+		to:         make([][]int, len(nodes)),
+		cumulative: make([][]float64, len(nodes)),
+	}
+	for i, u := range nodes {
+		uid := u.ID()
+		var to []int
+		for it := g.From(uid); it.Next(); {
+			to = append(to, indexOf[it.Node().ID()])
+		}
+		slices.Sort(to)
+		cumulative := make([]float64, len(to))
+		var sum float64
+		for k, j := range to {
+			wt := weight(uid, nodes[j].ID())
+			if wt < 0 {
+				panic("embedding: negative edge weight")
+			}
+			sum += wt
+			cumulative[k] = sum
+		}
+		w.to[i] = to
+		w.cumulative[i] = cumulative
+		w.maxDegree = max(w.maxDegree, len(to))
+	}
+	return w
+}
+
+// weight returns the weight of the kth edge from the node u.
+func (w *walker) weight(u, k int) float64 {
+	c := w.cumulative[u]
+	if k == 0 {
+		return c[0]
+	}
+	return c[k] - c[k-1]
+}
+
+// adjacent returns whether the nodes u and v are joined by an edge in
+// either direction.
+func (w *walker) adjacent(u, v int) bool {
+	_, ok := slices.BinarySearch(w.to[u], v)
+	if ok {
+		return true
+	}
+	_, ok = slices.BinarySearch(w.to[v], u)
+	return ok
+}
+
+// sample returns an index into cumulative chosen with probability
+// proportional to the increments of cumulative. If all the increments
+// are zero, the index is chosen uniformly.
+func (w *walker) sample(cumulative []float64, rnd func() float64) int {
+	total := cumulative[len(cumulative)-1]
+	if total == 0 {
+		return min(int(rnd()*float64(len(cumulative))), len(cumulative)-1)
+	}
+	r := rnd() * total
+	i := sort.Search(len(cumulative), func(i int) bool { return cumulative[i] > r })
+	return min(i, len(cumulative)-1)
+}
@@ -0,0 +1,185 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embedding
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// torus returns an undirected r×c toroidal lattice. The lattice has no
+// triangles when r and c are greater than three.
+func torus(r, c int) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			u := simple.Node(i*c + j)
+			g.SetEdge(g.NewEdge(u, simple.Node(i*c+(j+1)%c)))
+			g.SetEdge(g.NewEdge(u, simple.Node(((i+1)%r)*c+j)))
+		}
+	}
+	return g
+}
+
+var walkTests = []struct {
+	name string
+	g    graph.Graph
+}{
+	{
+		name: "torus",
+		g:    torus(5, 6),
+	},
+	{
+		name: "directed with sink",
+		g: func() graph.Graph {
+			g := simple.NewDirectedGraph()
+			for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}} {
+				g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+			return g
+		}(),
+	},
+	{
+		name: "weighted",
+		g: func() graph.Graph {
+			g := simple.NewWeightedUndirectedGraph(0, 0)
+			for _, e := range []simple.WeightedEdge{
+				{F: simple.Node(0), T: simple.Node(1), W: 2},
+				{F: simple.Node(1), T: simple.Node(2), W: 0.5},
+				{F: simple.Node(2), T: simple.Node(0), W: 1},
+				{F: simple.Node(3), T: simple.Node(0), W: 0},
+			} {
+				g.SetWeightedEdge(e)
+			}
+			g.AddNode(simple.Node(4))
+			return g
+		}(),
+	},
+}
+
+func TestNode2VecWalks(t *testing.T) {
+	t.Parallel()
+	const (
+		n      = 3
+		length = 10
+	)
+	for _, test := range walkTests {
+		for _, param := range []struct{ p, q float64 }{{1, 1}, {0.5, 2}, {2, 0.5}} {
+			walks := Node2Vec(test.g, param.p, param.q, n, length, rand.NewPCG(1, 1))
+			nodes := graph.NodesOf(test.g.Nodes())
+			if len(walks) != n*len(nodes) {
+				t.Errorf("unexpected number of walks for %q p=%v q=%v: got:%d want:%d",
+					test.name, param.p, param.q, len(walks), n*len(nodes))
+			}
+			starts := make(map[int64]int)
+			for _, w := range walks {
+				starts[w[0].ID()]++
+				if len(w) > length {
+					t.Errorf("walk too long for %q p=%v q=%v: %v", test.name, param.p, param.q, w)
+				}
+				for i := 1; i < len(w); i++ {
+					if !test.g.HasEdgeBetween(w[i-1].ID(), w[i].ID()) || test.g.Edge(w[i-1].ID(), w[i].ID()) == nil {
+						t.Errorf("walk is not a path for %q p=%v q=%v: %v", test.name, param.p, param.q, w)
+						break
+					}
+				}
+				if last := w[len(w)-1]; len(w) < length && test.g.From(last.ID()).Len() != 0 {
+					t.Errorf("walk ended early for %q p=%v q=%v: %v", test.name, param.p, param.q, w)
+				}
+			}
+			for _, u := range nodes {
+				if starts[u.ID()] != n {
+					t.Errorf("unexpected number of walks starting at %d for %q p=%v q=%v: got:%d want:%d",
+						u.ID(), test.name, param.p, param.q, starts[u.ID()], n)
+				}
+			}
+		}
+	}
+}
+
+func TestNode2VecBias(t *testing.T) {
+	t.Parallel()
+	// In a triangle free 4-regular graph each step other than
+	// the first returns to the previous node with probability
+	// (1/p)/(1/p + 3/q).
+	g := torus(10, 10)
+	for _, test := range []struct {
+		p, q float64
+	}{
+		{p: 1, q: 1},
+		{p: 0.25, q: 1},
+		{p: 4, q: 1},
+		{p: 1, q: 0.25},
+		{p: 1, q: 4},
+	} {
+		walks := Node2Vec(g, test.p, test.q, 10, 20, rand.NewPCG(1, 1))
+		var returns, steps int
+		for _, w := range walks {
+			for i := 2; i < len(w); i++ {
+				if w[i].ID() == w[i-2].ID() {
+					returns++
+				}
+				steps++
+			}
+		}
+		got := float64(returns) / float64(steps)
+		want := (1 / test.p) / (1/test.p + 3/test.q)
+		if math.Abs(got-want) > 0.02 {
+			t.Errorf("unexpected return probability for p=%v q=%v: got:%.3f want:%.3f", test.p, test.q, got, want)
+		}
+	}
+}
+
+func TestDeepWalkWeighted(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 9})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(2), W: 1})
+
+	walks := DeepWalk(g, 2000, 2, rand.NewPCG(1, 1))
+	var heavy, total int
+	for _, w := range walks {
+		if w[0].ID() != 0 {
+			continue
+		}
+		if w[1].ID() == 1 {
+			heavy++
+		}
+		total++
+	}
+	got := float64(heavy) / float64(total)
+	if math.Abs(got-0.9) > 0.02 {
+		t.Errorf("unexpected probability of heavy edge: got:%.3f want:0.9", got)
+	}
+}
+
+func TestNode2VecPanics(t *testing.T) {
+	t.Parallel()
+	g := torus(4, 4)
+	for _, test := range []struct {
+		name      string
+		p, q      float64
+		n, length int
+	}{
+		{name: "zero p", p: 0, q: 1, n: 1, length: 1},
+		{name: "negative q", p: 1, q: -1, n: 1, length: 1},
+		{name: "NaN p", p: math.NaN(), q: 1, n: 1, length: 1},
+		{name: "zero walks", p: 1, q: 1, n: 0, length: 1},
+		{name: "zero length", p: 1, q: 1, n: 1, length: 0},
+	} {
+		panicked := func() (panicked bool) {
+			defer func() { panicked = recover() != nil }()
+			Node2Vec(g, test.p, test.q, test.n, test.length, nil)
+			return false
+		}()
+		if !panicked {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
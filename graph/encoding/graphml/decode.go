@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+// IDSetter is implemented by types that can set a GraphML ID.
+type IDSetter interface {
+	SetGraphMLID(id string)
+}
+
+// KeySetter is implemented by graph values that can record the GraphML key
+// declarations of an unmarshaled document.
+type KeySetter interface {
+	SetGraphMLKey(Key) error
+}
+
+// Unmarshal parses the GraphML-encoded data and stores the result in dst.
+// If the number of graphs encoded in data is not one, an error is returned.
+//
+// Nodes and edges are created with dst's NewNode and NewEdge methods. If
+// the created values implement IDSetter or encoding.AttributeSetter, their
+// GraphML IDs and attributes are set. Edges without an ID do not have their
+// GraphML ID set. Attributes of the graph are set if dst
+// implements encoding.AttributeSetter, and key declarations are passed to
+// dst if it implements KeySetter. Attribute values are checked against the
+// type of their key, and keys with non-empty default values set the
+// attribute on elements without a value for the key. An empty default
+// element is treated as no default.
+//
+// Unmarshal returns an error if the document contains hyperedges or nested
+// graphs, or if its edges are not all directed or all undirected.
+func Unmarshal(data []byte, dst encoding.Builder) error {
+	gen, g, err := newGenerator(data, dst)
+	if err != nil {
+		return err
+	}
+	return gen.addEdges(g, func(u, v graph.Node) (basicEdge, error) {
+		return setEdge(dst, u, v)
+	})
+}
+
+// UnmarshalMulti parses the GraphML-encoded data as a multigraph and stores
+// the result in dst. Parallel edges in data are stored as distinct lines.
+// If the number of graphs encoded in data is not one, an error is returned.
+//
+// Nodes, lines, attributes and keys are handled as described for Unmarshal.
+func UnmarshalMulti(data []byte, dst encoding.MultiBuilder) error {
+	gen, g, err := newGenerator(data, dst)
+	if err != nil {
+		return err
+	}
+	return gen.addEdges(g, func(u, v graph.Node) (basicEdge, error) {
+		l := dst.NewLine(u, v)
+		dst.SetLine(l)
+		return l, nil
+	})
+}
+
+// setEdge sets a new edge from u to v in dst, returning any panic from
+// the destination graph as an error.
+func setEdge(dst encoding.Builder, u, v graph.Node) (e basicEdge, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("graphml: unable to set edge from %d to %d: %v", u.ID(), v.ID(), r)
+		}
+	}()
+	edge := dst.NewEdge(u, v)
+	dst.SetEdge(edge)
+	return edge, nil
+}
+
+// generator holds the state required for generating a Gonum graph from a
+// GraphML document.
+type generator struct {
+	// keys maps key IDs to their declarations.
+	keys map[string]Key
+	// defaults holds the keys with default
+	// values in declaration order.
+	defaults []Key
+
+	// ids maps GraphML node IDs to nodes
+	// and edgeIDs holds the GraphML edge
+	// IDs already read.
+	ids     map[string]graph.Node
+	edgeIDs map[string]bool
+
+	directed bool
+}
+
+// newGenerator parses data and adds the graph attributes and nodes of the
+// document to dst, returning the generator and the parsed graph.
+func newGenerator(data []byte, dst graph.NodeAdder) (*generator, *graphElement, error) {
+	var doc document
+	err := xml.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(doc.Graphs) != 1 {
+		return nil, nil, fmt.Errorf("graphml: invalid number of graphs; expected 1, got %d", len(doc.Graphs))
+	}
+	g := &doc.Graphs[0]
+	if len(g.Hyperedges) != 0 {
+		return nil, nil, errors.New("graphml: hyperedges are not supported")
+	}
+
+	gen := &generator{
+		keys:    make(map[string]Key, len(doc.Keys)),
+		ids:     make(map[string]graph.Node, len(g.Nodes)),
+		edgeIDs: make(map[string]bool),
+	}
+	switch g.EdgeDefault {
+	case "directed":
+		gen.directed = true
+	case "undirected":
+	default:
+		return nil, nil, fmt.Errorf("graphml: invalid edgedefault %q", g.EdgeDefault)
+	}
+
+	for _, k := range doc.Keys {
+		key := Key{ID: k.ID, Name: k.Name, For: Domain(k.For), Type: Type(k.Type)}
+		if key.Name == "" {
+			key.Name = key.ID
+		}
+		switch key.For {
+		case "", ForAll, ForGraph, ForNode, ForEdge:
+		default:
+			// Keys for ports, hyperedges and
+			// graphml elements are not used.
+			continue
+		}
+		if _, exists := gen.keys[key.ID]; exists {
+			return nil, nil, fmt.Errorf("graphml: duplicate key ID %q", key.ID)
+		}
+		if k.Default != nil && *k.Default != "" {
+			key.Default = *k.Default
+			if err := key.check(key.Default); err != nil {
+				return nil, nil, err
+			}
+			gen.defaults = append(gen.defaults, key)
+		}
+		gen.keys[key.ID] = key
+		if ks, ok := dst.(KeySetter); ok {
+			if err := ks.SetGraphMLKey(key); err != nil {
+				return nil, nil, fmt.Errorf("graphml: unable to set key %q: %w", key.ID, err)
+			}
+		}
+	}
+
+	if dst, ok := dst.(IDSetter); ok && g.ID != "" {
+		dst.SetGraphMLID(g.ID)
+	}
+	if a, ok := dst.(encoding.AttributeSetter); ok {
+		if err := gen.setAttributes(a, ForGraph, g.Data); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, n := range g.Nodes {
+		if len(n.Graphs) != 0 {
+			return nil, nil, fmt.Errorf("graphml: nested graph in node %q is not supported", n.ID)
+		}
+		if _, exists := gen.ids[n.ID]; exists {
+			return nil, nil, fmt.Errorf("graphml: duplicate node ID %q", n.ID)
+		}
+		u := dst.NewNode()
+		if u, ok := u.(IDSetter); ok {
+			u.SetGraphMLID(n.ID)
+		}
+		if a, ok := u.(encoding.AttributeSetter); ok {
+			if err := gen.setAttributes(a, ForNode, n.Data); err != nil {
+				return nil, nil, err
+			}
+		}
+		dst.AddNode(u)
+		gen.ids[n.ID] = u
+	}
+	return gen, g, nil
+}
+
+// addEdges adds the edges of g using the provided function.
+func (gen *generator) addEdges(g *graphElement, add func(u, v graph.Node) (basicEdge, error)) error {
+	for _, e := range g.Edges {
+		if len(e.Graphs) != 0 {
+			return fmt.Errorf("graphml: nested graph in edge from %q to %q is not supported", e.Source, e.Target)
+		}
+		switch e.Directed {
+		case "":
+		case "true", "false":
+			if (e.Directed == "true") != gen.directed {
+				return errors.New("graphml: mixed directed and undirected edges are not supported")
+			}
+		default:
+			return fmt.Errorf("graphml: invalid directed value %q", e.Directed)
+		}
+		if e.ID != "" {
+			if gen.edgeIDs[e.ID] {
+				return fmt.Errorf("graphml: duplicate edge ID %q", e.ID)
+			}
+			gen.edgeIDs[e.ID] = true
+		}
+		u, ok := gen.ids[e.Source]
+		if !ok {
+			return fmt.Errorf("graphml: edge source %q is not a node", e.Source)
+		}
+		v, ok := gen.ids[e.Target]
+		if !ok {
+			return fmt.Errorf("graphml: edge target %q is not a node", e.Target)
+		}
+		edge, err := add(u, v)
+		if err != nil {
+			return err
+		}
+		if edge, ok := edge.(IDSetter); ok && e.ID != "" {
+			edge.SetGraphMLID(e.ID)
+		}
+		if a, ok := edge.(encoding.AttributeSetter); ok {
+			if err := gen.setAttributes(a, ForEdge, e.Data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setAttributes sets the attributes of an element in domain d from its data
+// and the default values of keys without data.
+func (gen *generator) setAttributes(dst encoding.AttributeSetter, d Domain, data []dataElement) error {
+	seen := make(map[string]bool, len(data))
+	for _, v := range data {
+		key, ok := gen.keys[v.Key]
+		if !ok {
+			return fmt.Errorf("graphml: undeclared key %q", v.Key)
+		}
+		if !key.appliesTo(d) {
+			return fmt.Errorf("graphml: key %q for %s used in %s", key.ID, key.For, d)
+		}
+		if err := key.check(v.Value); err != nil {
+			return err
+		}
+		seen[key.ID] = true
+		err := dst.SetAttribute(encoding.Attribute{Key: key.Name, Value: v.Value})
+		if err != nil {
+			return fmt.Errorf("graphml: unable to unmarshal %s attribute (%s=%s): %w", d, key.Name, v.Value, err)
+		}
+	}
+	for _, key := range gen.defaults {
+		if seen[key.ID] || !key.appliesTo(d) {
+			continue
+		}
+		err := dst.SetAttribute(encoding.Attribute{Key: key.Name, Value: key.Default})
+		if err != nil {
+			return fmt.Errorf("graphml: unable to unmarshal %s attribute (%s=%s): %w", d, key.Name, key.Default, err)
+		}
+	}
+	return nil
+}
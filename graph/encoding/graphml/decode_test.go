@@ -0,0 +1,240 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		want string
+		dst  func() encoding.Builder
+	}{
+		{name: "directed", want: directed, dst: func() encoding.Builder { return newDirectedGraph() }},
+		{name: "undirected", want: undirected, dst: func() encoding.Builder { return newUndirectedGraph() }},
+	} {
+		dst := test.dst()
+		err := Unmarshal([]byte(test.want), dst)
+		if err != nil {
+			t.Errorf("%s: unable to unmarshal GraphML: %v", test.name, err)
+			continue
+		}
+		buf, err := Marshal(dst, "", "", "\t")
+		if err != nil {
+			t.Errorf("%s: unable to marshal graph: %v", test.name, err)
+			continue
+		}
+		if got := string(buf); got != test.want {
+			t.Errorf("%s: graph content mismatch; want:\n%s\n\ngot:\n%s", test.name, test.want, got)
+		}
+	}
+}
+
+func TestRoundTripMulti(t *testing.T) {
+	t.Parallel()
+	dst := newMultigraph()
+	err := UnmarshalMulti([]byte(multiDirected), dst)
+	if err != nil {
+		t.Fatalf("unable to unmarshal GraphML: %v", err)
+	}
+	if n := dst.Lines(0, 1).Len(); n != 2 {
+		t.Errorf("unexpected number of parallel lines: got:%d want:2", n)
+	}
+	ids := make(map[string]bool)
+	for it := dst.Lines(0, 1); it.Next(); {
+		ids[it.Line().(*line).id] = true
+	}
+	if !ids["e0"] || !ids["e1"] {
+		t.Errorf("unexpected parallel line IDs: got:%v want:[e0 e1]", ids)
+	}
+	buf, err := MarshalMulti(dst, "", "", "\t")
+	if err != nil {
+		t.Fatalf("unable to marshal graph: %v", err)
+	}
+	if got := string(buf); got != multiDirected {
+		t.Errorf("graph content mismatch; want:\n%s\n\ngot:\n%s", multiDirected, got)
+	}
+}
+
+func TestUnmarshalDefaults(t *testing.T) {
+	t.Parallel()
+	dst := newUndirectedGraph()
+	err := Unmarshal([]byte(`<graphml>
+	<key id="color" for="node" attr.name="color" attr.type="string"><default>yellow</default></key>
+	<key id="w" for="edge" attr.name="weight" attr.type="double"><default>1</default></key>
+	<key id="note" for="node" attr.name="note" attr.type="string"><default></default></key>
+	<graph edgedefault="undirected">
+		<node id="a"><data key="color">green</data></node>
+		<node id="b"/>
+		<edge source="a" target="b"/>
+	</graph>
+</graphml>`), dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"a": "green", "b": "yellow"}
+	for it := dst.Nodes(); it.Next(); {
+		n := it.Node().(*node)
+		if got := n.Attributes(); len(got) != 1 || got[0].Value != want[n.id] {
+			t.Errorf("unexpected attributes for node %q: got:%v want color=%s", n.id, got, want[n.id])
+		}
+	}
+	e := dst.Edge(0, 1).(*edge)
+	if got := e.Attributes(); len(got) != 1 || got[0] != (encoding.Attribute{Key: "weight", Value: "1"}) {
+		t.Errorf("unexpected edge attributes: %v", got)
+	}
+	if len(dst.keys) != 3 || dst.keys[1] != (Key{ID: "w", Name: "weight", For: ForEdge, Type: Double, Default: "1"}) {
+		t.Errorf("unexpected keys: %+v", dst.keys)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "no graph",
+			data: `<graphml></graphml>`,
+			want: "graphml: invalid number of graphs; expected 1, got 0",
+		},
+		{
+			name: "hyperedge",
+			data: `<graphml><graph edgedefault="undirected">
+	<node id="a"/><node id="b"/><node id="c"/>
+	<hyperedge><endpoint node="a"/><endpoint node="b"/><endpoint node="c"/></hyperedge>
+</graph></graphml>`,
+			want: "graphml: hyperedges are not supported",
+		},
+		{
+			name: "nested graph",
+			data: `<graphml><graph edgedefault="undirected">
+	<node id="a"><graph edgedefault="undirected"><node id="a::b"/></graph></node>
+</graph></graphml>`,
+			want: `graphml: nested graph in node "a" is not supported`,
+		},
+		{
+			name: "mixed edges",
+			data: `<graphml><graph edgedefault="undirected">
+	<node id="a"/><node id="b"/>
+	<edge source="a" target="b" directed="true"/>
+</graph></graphml>`,
+			want: "graphml: mixed directed and undirected edges are not supported",
+		},
+		{
+			name: "missing node",
+			data: `<graphml><graph edgedefault="undirected">
+	<node id="a"/>
+	<edge source="a" target="b"/>
+</graph></graphml>`,
+			want: `graphml: edge target "b" is not a node`,
+		},
+		{
+			name: "invalid value",
+			data: `<graphml>
+	<key id="d0" for="node" attr.name="size" attr.type="int"/>
+	<graph edgedefault="undirected"><node id="a"><data key="d0">big</data></node></graph>
+</graphml>`,
+			want: `graphml: invalid int value "big" for key "size"`,
+		},
+		{
+			name: "undeclared key",
+			data: `<graphml><graph edgedefault="undirected"><node id="a"><data key="d0">1</data></node></graph></graphml>`,
+			want: `graphml: undeclared key "d0"`,
+		},
+		{
+			name: "key domain",
+			data: `<graphml>
+	<key id="d0" for="edge" attr.name="weight" attr.type="double"/>
+	<graph edgedefault="undirected"><node id="a"><data key="d0">1</data></node></graph>
+</graphml>`,
+			want: `graphml: key "d0" for edge used in node`,
+		},
+		{
+			name: "self edge",
+			data: `<graphml><graph edgedefault="undirected">
+	<node id="a"/>
+	<edge source="a" target="a"/>
+</graph></graphml>`,
+			want: "graphml: unable to set edge from 0 to 0: simple: adding self edge",
+		},
+		{
+			name: "duplicate edge ID",
+			data: `<graphml><graph edgedefault="undirected">
+	<node id="a"/><node id="b"/><node id="c"/>
+	<edge id="e" source="a" target="b"/>
+	<edge id="e" source="b" target="c"/>
+</graph></graphml>`,
+			want: `graphml: duplicate edge ID "e"`,
+		},
+	} {
+		err := Unmarshal([]byte(test.data), newUndirectedGraph())
+		if err == nil || err.Error() != test.want {
+			t.Errorf("%s: unexpected error: got:%v want:%s", test.name, err, test.want)
+		}
+	}
+}
+
+const directed = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+	<key id="w" for="edge" attr.name="weight" attr.type="double">
+		<default>1</default>
+	</key>
+	<key id="d1" for="node" attr.name="size" attr.type="int"></key>
+	<key id="d2" for="graph" attr.name="title" attr.type="string"></key>
+	<key id="d3" for="node" attr.name="label" attr.type="string"></key>
+	<graph id="G" edgedefault="directed">
+		<data key="d2">A &amp; B</data>
+		<node id="a">
+			<data key="d1">3</data>
+			<data key="d3">&lt;first&gt;</data>
+		</node>
+		<node id="b"></node>
+		<node id="c"></node>
+		<edge source="a" target="b">
+			<data key="w">2.5</data>
+		</edge>
+		<edge source="b" target="c">
+			<data key="w">1</data>
+		</edge>
+		<edge source="c" target="a">
+			<data key="w">1</data>
+		</edge>
+	</graph>
+</graphml>`
+
+const undirected = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+	<graph edgedefault="undirected">
+		<node id="0"></node>
+		<node id="1"></node>
+		<node id="2"></node>
+		<edge source="0" target="1"></edge>
+		<edge source="1" target="2"></edge>
+	</graph>
+</graphml>`
+
+const multiDirected = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+	<key id="d0" for="edge" attr.name="label" attr.type="string"></key>
+	<graph edgedefault="directed">
+		<node id="n0"></node>
+		<node id="n1"></node>
+		<edge id="e0" source="n0" target="n1">
+			<data key="d0">first</data>
+		</edge>
+		<edge id="e1" source="n0" target="n1">
+			<data key="d0">second</data>
+		</edge>
+		<edge source="n1" target="n1"></edge>
+	</graph>
+</graphml>`
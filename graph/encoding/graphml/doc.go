@@ -0,0 +1,19 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphml implements GraphML marshaling and unmarshaling of graphs.
+//
+// GraphML is an XML-based graph exchange format used by tools such as yEd,
+// Gephi and NetworkX. Node, edge and graph attributes are represented by
+// GraphML data elements, each of which refers to a key declaration that
+// gives the attribute's name, the kind of element it applies to and the
+// type of its value.
+//
+// The package supports a single graph per document. Hyperedges and nested
+// graphs are rejected when unmarshaling, and ports are ignored.
+//
+// See the GraphML Primer for more information on the format:
+//
+// GraphML Primer: http://graphml.graphdrawing.org/primer/graphml-primer.html
+package graphml // import "gonum.org/v1/gonum/graph/encoding/graphml"
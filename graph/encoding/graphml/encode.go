@@ -0,0 +1,321 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Node is a GraphML graph node.
+type Node interface {
+	// GraphMLID returns a GraphML node ID.
+	GraphMLID() string
+}
+
+// Edge is a GraphML graph edge or line.
+type Edge interface {
+	// GraphMLID returns a GraphML edge ID.
+	GraphMLID() string
+}
+
+// Graph wraps named graph.Graph values.
+type Graph interface {
+	graph.Graph
+	GraphMLID() string
+}
+
+// Multigraph wraps named graph.Multigraph values.
+type Multigraph interface {
+	graph.Multigraph
+	GraphMLID() string
+}
+
+// Keyer is implemented by graph values that declare the GraphML keys used
+// by their attributes.
+type Keyer interface {
+	// GraphMLKeys returns the key declarations
+	// for the graph's attributes.
+	GraphMLKeys() []Key
+}
+
+// Marshal returns the GraphML encoding for the graph g, applying the prefix
+// and indent to the encoding. ID is used to specify the graph ID. If id is
+// empty and g implements Graph, the returned string from GraphMLID will be
+// used.
+//
+// Graph serialization will work for a graph.Graph without modification,
+// however, node IDs and attributes provided by Marshal depend on
+// implementation of the Node, encoding.Attributer, Keyer and Graph
+// interfaces. Edges implementing Edge are written with their GraphML ID.
+// Attributes of g are written as graph data. Attributes without a key
+// declared by g are written with a string key. Marshal will return an error
+// if an attribute value is not valid for the type of its key, or if two nodes
+// or two edges have the same GraphML ID.
+func Marshal(g graph.Graph, id, prefix, indent string) ([]byte, error) {
+	if id == "" {
+		if g, ok := g.(Graph); ok {
+			id = g.GraphMLID()
+		}
+	}
+	_, isDirected := g.(graph.Directed)
+	p, err := newPrinter(g, id, isDirected)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range p.nodes {
+		to := graph.NodesOf(g.From(n.ID()))
+		order.ByID(to)
+		for _, v := range to {
+			if !isDirected && v.ID() < n.ID() {
+				continue
+			}
+			err := p.addEdge(g.Edge(n.ID(), v.ID()))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return p.marshal(prefix, indent)
+}
+
+// MarshalMulti returns the GraphML encoding for the multigraph g, applying
+// the prefix and indent to the encoding. ID is used to specify the graph ID.
+// If id is empty and g implements Multigraph, the returned string from
+// GraphMLID will be used.
+//
+// Multigraph serialization will work for a graph.Multigraph without
+// modification, however, node IDs and attributes provided by MarshalMulti
+// depend on implementation of the Node, encoding.Attributer, Keyer and
+// Multigraph interfaces. Attributes are handled as described for Marshal.
+func MarshalMulti(g graph.Multigraph, id, prefix, indent string) ([]byte, error) {
+	if id == "" {
+		if g, ok := g.(Multigraph); ok {
+			id = g.GraphMLID()
+		}
+	}
+	_, isDirected := g.(graph.Directed)
+	p, err := newPrinter(g, id, isDirected)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range p.nodes {
+		to := graph.NodesOf(g.From(n.ID()))
+		order.ByID(to)
+		for _, v := range to {
+			if !isDirected && v.ID() < n.ID() {
+				continue
+			}
+			lines := graph.LinesOf(g.Lines(n.ID(), v.ID()))
+			order.LinesByIDs(lines)
+			for _, l := range lines {
+				err := p.addEdge(l)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return p.marshal(prefix, indent)
+}
+
+// printer builds a GraphML document.
+type printer struct {
+	nodes []graph.Node
+	graph graphElement
+
+	// keys holds the key declarations in
+	// output order and keyFor maps a domain
+	// and attribute name to its key ID.
+	keys   []Key
+	keyFor map[[2]string]string
+	keyIDs map[string]bool
+	byID   map[string]Key
+
+	// nodeIDs and edgeIDs hold the
+	// GraphML IDs already written.
+	nodeIDs map[string]bool
+	edgeIDs map[string]bool
+}
+
+// newPrinter returns a printer holding the keys, graph data and nodes of g.
+func newPrinter(g interface{ Nodes() graph.Nodes }, id string, isDirected bool) (*printer, error) {
+	p := &printer{
+		keyFor:  make(map[[2]string]string),
+		keyIDs:  make(map[string]bool),
+		byID:    make(map[string]Key),
+		nodeIDs: make(map[string]bool),
+		edgeIDs: make(map[string]bool),
+	}
+	p.graph.ID = id
+	if isDirected {
+		p.graph.EdgeDefault = "directed"
+	} else {
+		p.graph.EdgeDefault = "undirected"
+	}
+
+	if k, ok := g.(Keyer); ok {
+		for _, key := range k.GraphMLKeys() {
+			if key.Default != "" {
+				if err := key.check(key.Default); err != nil {
+					return nil, err
+				}
+			}
+			if key.ID != "" {
+				if p.keyIDs[key.ID] {
+					return nil, fmt.Errorf("graphml: duplicate key ID %q", key.ID)
+				}
+				p.keyIDs[key.ID] = true
+			}
+			p.keys = append(p.keys, key)
+		}
+		for i, key := range p.keys {
+			if key.ID == "" {
+				p.keys[i].ID = p.newKeyID()
+			}
+			key = p.keys[i]
+			p.byID[key.ID] = key
+			for _, d := range []Domain{ForGraph, ForNode, ForEdge} {
+				if !key.appliesTo(d) {
+					continue
+				}
+				if _, exists := p.keyFor[[2]string{string(d), key.Name}]; !exists {
+					p.keyFor[[2]string{string(d), key.Name}] = key.ID
+				}
+			}
+		}
+	}
+
+	var err error
+	if a, ok := g.(encoding.Attributer); ok {
+		p.graph.Data, err = p.data(ForGraph, a)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p.nodes = graph.NodesOf(g.Nodes())
+	order.ByID(p.nodes)
+	for _, n := range p.nodes {
+		e := nodeElement{ID: nodeID(n)}
+		if p.nodeIDs[e.ID] {
+			return nil, fmt.Errorf("graphml: duplicate node ID %q", e.ID)
+		}
+		p.nodeIDs[e.ID] = true
+		if a, ok := n.(encoding.Attributer); ok {
+			e.Data, err = p.data(ForNode, a)
+			if err != nil {
+				return nil, err
+			}
+		}
+		p.graph.Nodes = append(p.graph.Nodes, e)
+	}
+	return p, nil
+}
+
+// newKeyID returns an unused key ID.
+func (p *printer) newKeyID() string {
+	for i := len(p.keyIDs); ; i++ {
+		id := fmt.Sprintf("d%d", i)
+		if !p.keyIDs[id] {
+			p.keyIDs[id] = true
+			return id
+		}
+	}
+}
+
+// basicEdge is an edge without the Reverse method to
+// allow satisfaction by both graph.Edge and graph.Line.
+type basicEdge interface {
+	From() graph.Node
+	To() graph.Node
+}
+
+// addEdge adds the edge e to the document.
+func (p *printer) addEdge(e basicEdge) error {
+	elem := edgeElement{Source: nodeID(e.From()), Target: nodeID(e.To())}
+	if e, ok := e.(Edge); ok {
+		elem.ID = e.GraphMLID()
+	}
+	if elem.ID != "" {
+		if p.edgeIDs[elem.ID] {
+			return fmt.Errorf("graphml: duplicate edge ID %q", elem.ID)
+		}
+		p.edgeIDs[elem.ID] = true
+	}
+	if a, ok := e.(encoding.Attributer); ok {
+		var err error
+		elem.Data, err = p.data(ForEdge, a)
+		if err != nil {
+			return err
+		}
+	}
+	p.graph.Edges = append(p.graph.Edges, elem)
+	return nil
+}
+
+// data returns the data elements for the attributes of an element in
+// domain d, declaring string keys for attributes without a key.
+func (p *printer) data(d Domain, a encoding.Attributer) ([]dataElement, error) {
+	var data []dataElement
+	for _, attr := range a.Attributes() {
+		id, ok := p.keyFor[[2]string{string(d), attr.Key}]
+		if !ok {
+			id = p.newKeyID()
+			key := Key{ID: id, Name: attr.Key, For: d, Type: String}
+			p.keys = append(p.keys, key)
+			p.byID[id] = key
+			p.keyFor[[2]string{string(d), attr.Key}] = id
+		}
+		if err := p.byID[id].check(attr.Value); err != nil {
+			return nil, err
+		}
+		data = append(data, dataElement{Key: id, Value: attr.Value})
+	}
+	return data, nil
+}
+
+// marshal returns the XML encoding of the document.
+func (p *printer) marshal(prefix, indent string) ([]byte, error) {
+	doc := document{Xmlns: namespace, Graphs: []graphElement{p.graph}}
+	for _, k := range p.keys {
+		elem := keyElement{ID: k.ID, For: string(k.For), Name: k.Name, Type: string(k.Type)}
+		if k.Default != "" {
+			elem.Default = &k.Default
+		}
+		if elem.For == "" {
+			elem.For = string(ForAll)
+		}
+		if elem.Type == "" {
+			elem.Type = string(String)
+		}
+		doc.Keys = append(doc.Keys, elem)
+	}
+	b, err := xml.MarshalIndent(doc, prefix, indent)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(prefix)
+	buf.WriteString(xml.Header)
+	buf.Write(b)
+	return buf.Bytes(), nil
+}
+
+// nodeID returns the GraphML ID of n, falling back
+// to the graph node ID if n has no GraphML ID.
+func nodeID(n graph.Node) string {
+	if n, ok := n.(Node); ok {
+		if id := n.GraphMLID(); id != "" {
+			return id
+		}
+	}
+	return fmt.Sprint(n.ID())
+}
@@ -0,0 +1,113 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestMarshalSimple(t *testing.T) {
+	t.Parallel()
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(2), T: simple.Node(0)})
+	g.AddNode(simple.Node(1))
+
+	const want = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <graph id="G" edgedefault="undirected">
+    <node id="0"></node>
+    <node id="1"></node>
+    <node id="2"></node>
+    <edge source="0" target="2"></edge>
+  </graph>
+</graphml>`
+
+	buf, err := Marshal(g, "G", "", "  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := string(buf); got != want {
+		t.Errorf("graph content mismatch; want:\n%s\n\ngot:\n%s", want, got)
+	}
+}
+
+func TestMarshalErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		keys  []Key
+		attrs []encoding.Attribute
+		want  string
+	}{
+		{
+			name:  "invalid value",
+			keys:  []Key{{Name: "size", For: ForNode, Type: Int}},
+			attrs: []encoding.Attribute{{Key: "size", Value: "1.5"}},
+			want:  `graphml: invalid int value "1.5" for key "size"`,
+		},
+		{
+			name: "invalid default",
+			keys: []Key{{Name: "visible", For: ForNode, Type: Boolean, Default: "yes"}},
+			want: `graphml: invalid boolean value "yes" for key "visible"`,
+		},
+		{
+			name: "duplicate key",
+			keys: []Key{{ID: "k", Name: "a"}, {ID: "k", Name: "b"}},
+			want: `graphml: duplicate key ID "k"`,
+		},
+	} {
+		g := newDirectedGraph()
+		g.keys = test.keys
+		n := g.NewNode().(*node)
+		for _, a := range test.attrs {
+			n.SetAttribute(a)
+		}
+		g.AddNode(n)
+		_, err := Marshal(g, "", "", "\t")
+		if err == nil || err.Error() != test.want {
+			t.Errorf("%s: unexpected error: got:%v want:%s", test.name, err, test.want)
+		}
+	}
+}
+
+func TestMarshalDuplicateNodeID(t *testing.T) {
+	t.Parallel()
+	// A node with GraphML ID "1" and a node without a
+	// GraphML ID whose graph node ID is 1.
+	g := newUndirectedGraph()
+	n := g.NewNode().(*node)
+	n.id = "1"
+	g.AddNode(n)
+	g.SetEdge(g.NewEdge(n, simple.Node(1)))
+
+	_, err := Marshal(g, "", "", "\t")
+	const want = `graphml: duplicate node ID "1"`
+	if err == nil || err.Error() != want {
+		t.Errorf("unexpected error: got:%v want:%s", err, want)
+	}
+}
+
+func TestMarshalDuplicateEdgeID(t *testing.T) {
+	t.Parallel()
+	g := newMultigraph()
+	u := g.NewNode()
+	g.AddNode(u)
+	v := g.NewNode()
+	g.AddNode(v)
+	for range 2 {
+		l := g.NewLine(u, v).(*line)
+		l.id = "e"
+		g.SetLine(l)
+	}
+
+	_, err := MarshalMulti(g, "", "", "\t")
+	const want = `graphml: duplicate edge ID "e"`
+	if err == nil || err.Error() != want {
+		t.Errorf("unexpected error: got:%v want:%s", err, want)
+	}
+}
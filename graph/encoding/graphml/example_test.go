@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/graph/encoding/graphml"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func ExampleMarshal() {
+	g := simple.NewDirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	g.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(2)})
+
+	b, err := graphml.Marshal(g, "G", "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(b))
+
+	// Output:
+	// <?xml version="1.0" encoding="UTF-8"?>
+	// <graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+	//   <graph id="G" edgedefault="directed">
+	//     <node id="0"></node>
+	//     <node id="1"></node>
+	//     <node id="2"></node>
+	//     <edge source="0" target="1"></edge>
+	//     <edge source="1" target="2"></edge>
+	//   </graph>
+	// </graphml>
+}
+
+func ExampleUnmarshal() {
+	const data = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <graph edgedefault="undirected">
+    <node id="n0"/>
+    <node id="n1"/>
+    <node id="n2"/>
+    <edge source="n0" target="n1"/>
+    <edge source="n0" target="n2"/>
+  </graph>
+</graphml>`
+
+	g := simple.NewUndirectedGraph()
+	err := graphml.Unmarshal([]byte(data), g)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("nodes: %d edges: %d\n", g.Nodes().Len(), g.Edges().Len())
+
+	// Output:
+	// nodes: 3 edges: 2
+}
@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+// namespace is the GraphML XML namespace.
+const namespace = "http://graphml.graphdrawing.org/xmlns"
+
+// Domain is the kind of GraphML element a key applies to.
+type Domain string
+
+// Key domains.
+const (
+	ForAll   Domain = "all"
+	ForGraph Domain = "graph"
+	ForNode  Domain = "node"
+	ForEdge  Domain = "edge"
+)
+
+// Type is the type of the value of a GraphML attribute.
+type Type string
+
+// Attribute value types.
+const (
+	Boolean Type = "boolean"
+	Int     Type = "int"
+	Long    Type = "long"
+	Float   Type = "float"
+	Double  Type = "double"
+	String  Type = "string"
+)
+
+// Key is a GraphML key declaration.
+type Key struct {
+	// ID is the identifier of the key used by
+	// data elements. If ID is empty when
+	// marshaling, an ID is generated.
+	ID string
+
+	// Name is the attribute name.
+	Name string
+
+	// For is the kind of element the key
+	// applies to. An empty For is treated
+	// as ForAll.
+	For Domain
+
+	// Type is the attribute value type. An
+	// empty Type is treated as String.
+	Type Type
+
+	// Default is the value of the attribute
+	// for elements without a data element for
+	// the key. An empty Default indicates
+	// that the key has no default value, so
+	// an empty default element is ignored
+	// when unmarshaling.
+	Default string
+}
+
+// appliesTo returns whether the key applies to elements of domain d.
+func (k Key) appliesTo(d Domain) bool {
+	return k.For == d || k.For == ForAll || k.For == ""
+}
+
+// check returns an error if value is not valid for the key's type.
+func (k Key) check(value string) error {
+	var err error
+	switch k.Type {
+	case "", String:
+	case Boolean:
+		switch value {
+		case "true", "false":
+		default:
+			err = fmt.Errorf("graphml: invalid boolean value %q for key %q", value, k.Name)
+		}
+	case Int:
+		_, err = strconv.ParseInt(value, 10, 32)
+	case Long:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case Float:
+		_, err = strconv.ParseFloat(value, 32)
+	case Double:
+		_, err = strconv.ParseFloat(value, 64)
+	default:
+		return fmt.Errorf("graphml: invalid type %q for key %q", k.Type, k.Name)
+	}
+	if _, ok := err.(*strconv.NumError); ok {
+		err = fmt.Errorf("graphml: invalid %s value %q for key %q", k.Type, value, k.Name)
+	}
+	return err
+}
+
+// document is the XML representation of a GraphML document.
+type document struct {
+	XMLName xml.Name       `xml:"graphml"`
+	Xmlns   string         `xml:"xmlns,attr,omitempty"`
+	Keys    []keyElement   `xml:"key"`
+	Graphs  []graphElement `xml:"graph"`
+}
+
+type keyElement struct {
+	ID      string  `xml:"id,attr"`
+	For     string  `xml:"for,attr,omitempty"`
+	Name    string  `xml:"attr.name,attr,omitempty"`
+	Type    string  `xml:"attr.type,attr,omitempty"`
+	Default *string `xml:"default"`
+}
+
+type graphElement struct {
+	ID          string        `xml:"id,attr,omitempty"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Data        []dataElement `xml:"data"`
+	Nodes       []nodeElement `xml:"node"`
+	Edges       []edgeElement `xml:"edge"`
+
+	// Hyperedges is only used to detect
+	// unsupported hyperedges when decoding.
+	Hyperedges []struct{} `xml:"hyperedge"`
+}
+
+type nodeElement struct {
+	ID   string        `xml:"id,attr"`
+	Data []dataElement `xml:"data"`
+
+	// Graphs is only used to detect unsupported
+	// nested graphs when decoding.
+	Graphs []struct{} `xml:"graph"`
+}
+
+type edgeElement struct {
+	ID       string        `xml:"id,attr,omitempty"`
+	Directed string        `xml:"directed,attr,omitempty"`
+	Source   string        `xml:"source,attr"`
+	Target   string        `xml:"target,attr"`
+	Data     []dataElement `xml:"data"`
+
+	// Graphs is only used to detect unsupported
+	// nested graphs when decoding.
+	Graphs []struct{} `xml:"graph"`
+}
+
+type dataElement struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
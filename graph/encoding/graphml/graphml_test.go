@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// attributes is embedded to provide the attribute
+// methods without a field named Attributes.
+type attributes = encoding.Attributes
+
+// meta holds the GraphML ID, keys and attributes of a test graph.
+type meta struct {
+	id   string
+	keys []Key
+	attributes
+}
+
+func (m *meta) GraphMLID() string      { return m.id }
+func (m *meta) SetGraphMLID(id string) { m.id = id }
+func (m *meta) GraphMLKeys() []Key     { return m.keys }
+func (m *meta) SetGraphMLKey(k Key) error {
+	m.keys = append(m.keys, k)
+	return nil
+}
+
+type directedGraph struct {
+	*simple.DirectedGraph
+	meta
+}
+
+func newDirectedGraph() *directedGraph {
+	return &directedGraph{DirectedGraph: simple.NewDirectedGraph()}
+}
+
+func (g *directedGraph) NewNode() graph.Node {
+	return &node{Node: g.DirectedGraph.NewNode()}
+}
+
+func (g *directedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &edge{Edge: g.DirectedGraph.NewEdge(from, to)}
+}
+
+type undirectedGraph struct {
+	*simple.UndirectedGraph
+	meta
+}
+
+func newUndirectedGraph() *undirectedGraph {
+	return &undirectedGraph{UndirectedGraph: simple.NewUndirectedGraph()}
+}
+
+func (g *undirectedGraph) NewNode() graph.Node {
+	return &node{Node: g.UndirectedGraph.NewNode()}
+}
+
+func (g *undirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &edge{Edge: g.UndirectedGraph.NewEdge(from, to)}
+}
+
+type multigraph struct {
+	*multi.DirectedGraph
+	meta
+}
+
+func newMultigraph() *multigraph {
+	return &multigraph{DirectedGraph: multi.NewDirectedGraph()}
+}
+
+func (g *multigraph) NewNode() graph.Node {
+	return &node{Node: g.DirectedGraph.NewNode()}
+}
+
+func (g *multigraph) NewLine(from, to graph.Node) graph.Line {
+	return &line{Line: g.DirectedGraph.NewLine(from, to)}
+}
+
+type node struct {
+	graph.Node
+	id string
+	attributes
+}
+
+func (n *node) GraphMLID() string      { return n.id }
+func (n *node) SetGraphMLID(id string) { n.id = id }
+
+type edge struct {
+	graph.Edge
+	id string
+	attributes
+}
+
+func (e *edge) GraphMLID() string      { return e.id }
+func (e *edge) SetGraphMLID(id string) { e.id = id }
+
+type line struct {
+	graph.Line
+	id string
+	attributes
+}
+
+func (l *line) GraphMLID() string      { return l.id }
+func (l *line) SetGraphMLID(id string) { l.id = id }
@@ -5,4 +5,12 @@
 // Package rdf implements decoding the RDF 1.1 N-Quads line-based plain text
 // format for encoding an RDF dataset.
 // N-Quad parsing is performed as defined by http://www.w3.org/TR/n-quads/
+//
+// The package also provides decoding and encoding of the RDF 1.1 Turtle
+// format as defined by https://www.w3.org/TR/turtle/, encoding of the
+// N-Triples format, and evaluation of a subset of SPARQL 1.1 SELECT
+// queries, https://www.w3.org/TR/sparql11-query/, over a Graph.
+//
+// A Reasoner materializes the RDFS entailments and a subset of the OWL 2 RL
+// entailments, https://www.w3.org/TR/owl2-profiles/#OWL_2_RL, of a Graph.
 package rdf // import "gonum.org/v1/gonum/graph/formats/rdf"
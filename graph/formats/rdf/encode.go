@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// errLabeled is returned when a statement with a graph label is written
+// in a format that cannot represent graph labels.
+var errLabeled = errors.New("rdf: cannot write labeled statement as a triple")
+
+// WriteNTriples writes the statements to w in the N-Triples format, one
+// statement per line. WriteNTriples returns an error if any statement has
+// a graph label.
+func WriteNTriples(w io.Writer, statements []*Statement) error {
+	bw := bufio.NewWriter(w)
+	for _, s := range statements {
+		if s.Label.Value != "" {
+			return errLabeled
+		}
+		bw.WriteString(s.String())
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// WriteTurtle writes the statements to w in the Turtle format. The
+// prefixes map prefix names to namespace IRIs and are used to abbreviate
+// IRIs where possible. WriteTurtle returns an error if any statement has a
+// graph label or an invalid term.
+//
+// Statements are grouped by subject in order of the first statement of
+// each subject, and by predicate within each subject. Blank nodes that are
+// the object of exactly one statement are written as nested blank node
+// property lists. Collections are written in their expanded form.
+func WriteTurtle(w io.Writer, statements []*Statement, prefixes map[string]string) error {
+	tw := turtleWriter{
+		w:         bufio.NewWriter(w),
+		bySubject: make(map[string][]*Statement),
+		refs:      make(map[string]int),
+		written:   make(map[string]bool),
+	}
+	for name := range prefixes {
+		tw.prefixes = append(tw.prefixes, name)
+	}
+	sort.Strings(tw.prefixes)
+	tw.namespaces = prefixes
+
+	for _, s := range statements {
+		if s.Label.Value != "" {
+			return errLabeled
+		}
+		if _, ok := tw.bySubject[s.Subject.Value]; !ok {
+			tw.subjects = append(tw.subjects, s.Subject.Value)
+		}
+		tw.bySubject[s.Subject.Value] = append(tw.bySubject[s.Subject.Value], s)
+		if isBlank(s.Object.Value) {
+			tw.refs[s.Object.Value]++
+		}
+	}
+
+	for _, name := range tw.prefixes {
+		fmt.Fprintf(tw.w, "@prefix %s: %s .\n", name, escape("<", prefixes[name], ">"))
+	}
+	if len(tw.prefixes) != 0 && len(statements) != 0 {
+		tw.w.WriteByte('\n')
+	}
+
+	// Write subjects that are not nested first, and then
+	// any remaining subjects which are only reachable via
+	// cycles of nested blank nodes.
+	first := true
+	for _, inline := range []bool{false, true} {
+		for _, s := range tw.subjects {
+			if tw.written[s] || (!inline && tw.isNested(s)) {
+				continue
+			}
+			if !first {
+				tw.w.WriteByte('\n')
+			}
+			first = false
+			err := tw.writeSubject(s)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return tw.w.Flush()
+}
+
+// turtleWriter holds the state required for writing Turtle.
+type turtleWriter struct {
+	w *bufio.Writer
+
+	prefixes   []string
+	namespaces map[string]string
+
+	subjects  []string
+	bySubject map[string][]*Statement
+
+	// refs holds the number of statements
+	// with each blank node as the object.
+	refs    map[string]int
+	written map[string]bool
+}
+
+// isNested returns whether the term t is written as a nested blank node.
+func (tw *turtleWriter) isNested(t string) bool {
+	return isBlank(t) && tw.refs[t] == 1
+}
+
+// writeSubject writes the statements of the subject s.
+func (tw *turtleWriter) writeSubject(s string) error {
+	tw.written[s] = true
+	text, err := tw.term(Term{Value: s}, false)
+	if err != nil {
+		return err
+	}
+	tw.w.WriteString(text)
+	tw.w.WriteByte(' ')
+	err = tw.writePredicates(s, 1)
+	if err != nil {
+		return err
+	}
+	tw.w.WriteString(" .\n")
+	return nil
+}
+
+// writePredicates writes the predicates and objects of the subject s at
+// the given indentation depth.
+func (tw *turtleWriter) writePredicates(s string, depth int) error {
+	var (
+		preds    []string
+		byPred   = make(map[string][]Term)
+		subjects = tw.bySubject[s]
+	)
+	for _, st := range subjects {
+		if _, ok := byPred[st.Predicate.Value]; !ok {
+			preds = append(preds, st.Predicate.Value)
+		}
+		byPred[st.Predicate.Value] = append(byPred[st.Predicate.Value], st.Object)
+	}
+	for i, pred := range preds {
+		if i != 0 {
+			tw.w.WriteString(" ;\n")
+			tw.w.WriteString(strings.Repeat("\t", depth))
+		}
+		text, err := tw.term(Term{Value: pred}, true)
+		if err != nil {
+			return err
+		}
+		tw.w.WriteString(text)
+		tw.w.WriteByte(' ')
+		for j, o := range byPred[pred] {
+			if j != 0 {
+				tw.w.WriteString(", ")
+			}
+			err = tw.writeObject(o, depth)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeObject writes the object o at the given indentation depth.
+func (tw *turtleWriter) writeObject(o Term, depth int) error {
+	if !tw.isNested(o.Value) || tw.written[o.Value] {
+		text, err := tw.term(o, false)
+		if err != nil {
+			return err
+		}
+		tw.w.WriteString(text)
+		return nil
+	}
+	tw.written[o.Value] = true
+	if len(tw.bySubject[o.Value]) == 0 {
+		tw.w.WriteString("[]")
+		return nil
+	}
+	tw.w.WriteString("[\n")
+	tw.w.WriteString(strings.Repeat("\t", depth+1))
+	err := tw.writePredicates(o.Value, depth+1)
+	if err != nil {
+		return err
+	}
+	tw.w.WriteByte('\n')
+	tw.w.WriteString(strings.Repeat("\t", depth))
+	tw.w.WriteByte(']')
+	return nil
+}
+
+var (
+	turtleLocal   = regexp.MustCompile(`^([A-Za-z0-9_]([A-Za-z0-9_.-]*[A-Za-z0-9_-])?)?$`)
+	turtleInteger = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	turtleDecimal = regexp.MustCompile(`^[+-]?[0-9]*\.[0-9]+$`)
+	turtleDouble  = regexp.MustCompile(`^[+-]?([0-9]+\.[0-9]*|\.[0-9]+|[0-9]+)[eE][+-]?[0-9]+$`)
+)
+
+// term returns the Turtle text for t. If predicate is true, rdf:type is
+// written as "a".
+func (tw *turtleWriter) term(t Term, predicate bool) (string, error) {
+	text, qual, kind, err := t.Parts()
+	if err != nil {
+		return "", fmt.Errorf("rdf: invalid term %s: %w", t.Value, err)
+	}
+	switch kind {
+	case IRI:
+		if predicate && t.Value == rdfType.Value {
+			return "a", nil
+		}
+		return tw.iri(text), nil
+	case Blank:
+		return t.Value, nil
+	case Literal:
+		switch {
+		case qual == xsdInteger && turtleInteger.MatchString(text),
+			qual == xsdDecimal && turtleDecimal.MatchString(text),
+			qual == xsdDouble && turtleDouble.MatchString(text),
+			qual == xsdBoolean && (text == "true" || text == "false"):
+			return text, nil
+		}
+		lit := escape(`"`, text, `"`)
+		switch {
+		case qual == "":
+			return lit, nil
+		case strings.HasPrefix(qual, "@"):
+			return lit + qual, nil
+		default:
+			return lit + "^^" + tw.iri(qual), nil
+		}
+	default:
+		return "", fmt.Errorf("rdf: invalid term %s", t.Value)
+	}
+}
+
+// iri returns the Turtle text for the IRI, using the longest matching
+// prefix if the remainder of the IRI is a valid local name.
+func (tw *turtleWriter) iri(iri string) string {
+	var best string
+	found := false
+	for _, name := range tw.prefixes {
+		ns := tw.namespaces[name]
+		if !strings.HasPrefix(iri, ns) || !turtleLocal.MatchString(iri[len(ns):]) {
+			continue
+		}
+		if !found || len(ns) > len(tw.namespaces[best]) {
+			best = name
+			found = true
+		}
+	}
+	if found {
+		return best + ":" + iri[len(tw.namespaces[best]):]
+	}
+	return escape("<", iri, ">")
+}
@@ -0,0 +1,438 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// tokenKind is the kind of a Turtle or SPARQL lexical token.
+type tokenKind int
+
+const (
+	tokEOF     tokenKind = iota
+	tokIRI               // <iri>; text is the unescaped IRI.
+	tokPName             // prefix:local; text is the prefix and local the unescaped local part.
+	tokBlank             // _:label; text is the label.
+	tokString            // Quoted string; text is the unescaped string.
+	tokLang              // @tag; text is the tag without the @.
+	tokInteger           // text is the lexical form.
+	tokDecimal           // text is the lexical form.
+	tokDouble            // text is the lexical form.
+	tokVar               // ?name or $name; text is the name.
+	tokWord              // Bare word such as a keyword or function name.
+	tokPunct             // Punctuation or operator.
+)
+
+// token is a Turtle or SPARQL lexical token.
+type token struct {
+	kind  tokenKind
+	text  string
+	local string
+	pos   int
+}
+
+// lexer splits Turtle and SPARQL text into tokens.
+type lexer struct {
+	src []rune
+	pos int
+
+	// sparql indicates that a '<' which does
+	// not start an IRI is an operator.
+	sparql bool
+
+	// err is the sentinel error wrapped by
+	// errors returned by the lexer.
+	err error
+
+	peeked *token
+}
+
+func newLexer(src string, sparql bool, err error) *lexer {
+	return &lexer{src: []rune(src), sparql: sparql, err: err}
+}
+
+// errorf returns an error for the text at the given rune offset.
+func (l *lexer) errorf(pos int, format string, args ...any) error {
+	line, col := 1, 1
+	for _, r := range l.src[:min(pos, len(l.src))] {
+		if r == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	return fmt.Errorf("rdf: %w: line %d column %d: %s", l.err, line, col, fmt.Sprintf(format, args...))
+}
+
+// unexpected returns an error for an unexpected token.
+func (l *lexer) unexpected(t token) error {
+	if t.kind == tokEOF {
+		return l.errorf(t.pos, "unexpected end of input")
+	}
+	return l.errorf(t.pos, "unexpected %s", string(l.src[t.pos:l.end(t)]))
+}
+
+// end returns an approximate end offset of t for error reporting.
+func (l *lexer) end(t token) int {
+	end := t.pos + 1
+	for end < len(l.src) && !unicode.IsSpace(l.src[end]) && end-t.pos < 40 {
+		end++
+	}
+	return end
+}
+
+// peek returns the next token without consuming it.
+func (l *lexer) peek() (token, error) {
+	if l.peeked != nil {
+		return *l.peeked, nil
+	}
+	t, err := l.scan()
+	if err != nil {
+		return t, err
+	}
+	l.peeked = &t
+	return t, nil
+}
+
+// next returns and consumes the next token.
+func (l *lexer) next() (token, error) {
+	if l.peeked != nil {
+		t := *l.peeked
+		l.peeked = nil
+		return t, nil
+	}
+	return l.scan()
+}
+
+// isPunct returns whether t is the punctuation p.
+func (t token) isPunct(p string) bool {
+	return t.kind == tokPunct && t.text == p
+}
+
+// isKeyword returns whether t is the case-insensitive keyword w.
+func (t token) isKeyword(w string) bool {
+	return t.kind == tokWord && strings.EqualFold(t.text, w)
+}
+
+func (l *lexer) at(i int) rune {
+	if i < len(l.src) {
+		return l.src[i]
+	}
+	return 0
+}
+
+// scan returns the next token in the source.
+func (l *lexer) scan() (token, error) {
+	for l.pos < len(l.src) {
+		r := l.src[l.pos]
+		if r == '#' {
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' && l.src[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		if !unicode.IsSpace(r) {
+			break
+		}
+		l.pos++
+	}
+	start := l.pos
+	if start == len(l.src) {
+		return token{kind: tokEOF, pos: start}, nil
+	}
+
+	r := l.src[start]
+	switch {
+	case r == '<':
+		t, ok, err := l.scanIRI()
+		if ok || err != nil {
+			return t, err
+		}
+		if l.at(start+1) == '=' {
+			l.pos += 2
+			return token{kind: tokPunct, text: "<=", pos: start}, nil
+		}
+		l.pos++
+		return token{kind: tokPunct, text: "<", pos: start}, nil
+	case r == '"' || r == '\'':
+		return l.scanString()
+	case r == '_' && l.at(start+1) == ':':
+		return l.scanBlank()
+	case r == '?' || r == '$':
+		l.pos++
+		for l.pos < len(l.src) && isNameChar(l.src[l.pos]) && l.src[l.pos] != '-' {
+			l.pos++
+		}
+		if l.pos == start+1 {
+			return token{}, l.errorf(start, "empty variable name")
+		}
+		return token{kind: tokVar, text: string(l.src[start+1 : l.pos]), pos: start}, nil
+	case r == '@':
+		l.pos++
+		for isASCIILetter(l.at(l.pos)) {
+			l.pos++
+		}
+		if l.pos == start+1 {
+			return token{}, l.errorf(start, "invalid language tag")
+		}
+		for l.at(l.pos) == '-' && (isASCIILetter(l.at(l.pos+1)) || isDigit(l.at(l.pos+1))) {
+			l.pos++
+			for isASCIILetter(l.at(l.pos)) || isDigit(l.at(l.pos)) {
+				l.pos++
+			}
+		}
+		return token{kind: tokLang, text: string(l.src[start+1 : l.pos]), pos: start}, nil
+	case isDigit(r) || (r == '.' && isDigit(l.at(start+1))):
+		return l.scanNumber(), nil
+	case isNameStart(r) || r == ':':
+		return l.scanName()
+	}
+
+	for _, op := range []string{"^^", "&&", "||", "!=", ">=", "<=", "!", ">", "=", ".", ";", ",", "[", "]", "(", ")", "{", "}", "+", "-", "*", "/"} {
+		if strings.HasPrefix(string(l.src[start:min(start+2, len(l.src))]), op) {
+			l.pos += len(op)
+			return token{kind: tokPunct, text: op, pos: start}, nil
+		}
+	}
+	return token{}, l.errorf(start, "unexpected character %q", r)
+}
+
+// scanIRI scans an IRI reference. If the text at the current position is
+// not an IRI and the lexer is lexing SPARQL, ok is false and the position
+// is unchanged.
+func (l *lexer) scanIRI() (t token, ok bool, err error) {
+	start := l.pos
+	var buf strings.Builder
+	for i := start + 1; i < len(l.src); i++ {
+		r := l.src[i]
+		switch {
+		case r == '>':
+			l.pos = i + 1
+			return token{kind: tokIRI, text: buf.String(), pos: start}, true, nil
+		case r == '\\':
+			u, n, ok := l.uchar(i)
+			if !ok {
+				return token{}, false, l.errorf(i, "invalid escape in IRI")
+			}
+			buf.WriteRune(u)
+			i += n - 1
+		case r <= ' ' || strings.ContainsRune("<\"{}|^`", r):
+			if l.sparql {
+				return token{}, false, nil
+			}
+			return token{}, false, l.errorf(i, "invalid character %q in IRI", r)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	if l.sparql {
+		return token{}, false, nil
+	}
+	return token{}, false, l.errorf(start, "unterminated IRI")
+}
+
+// uchar returns the rune encoded by the \u or \U escape at position i
+// and the length of the escape.
+func (l *lexer) uchar(i int) (r rune, n int, ok bool) {
+	switch l.at(i + 1) {
+	case 'u':
+		n = 6
+	case 'U':
+		n = 10
+	default:
+		return 0, 0, false
+	}
+	if i+n > len(l.src) {
+		return 0, 0, false
+	}
+	v, err := strconv.ParseUint(string(l.src[i+2:i+n]), 16, 32)
+	if err != nil {
+		return 0, 0, false
+	}
+	return rune(v), n, true
+}
+
+// scanString scans a single, double or long quoted string.
+func (l *lexer) scanString() (token, error) {
+	start := l.pos
+	q := l.src[start]
+	long := l.at(start+1) == q && l.at(start+2) == q
+	if long {
+		l.pos += 3
+	} else {
+		l.pos++
+	}
+	var buf strings.Builder
+	for l.pos < len(l.src) {
+		r := l.src[l.pos]
+		switch {
+		case r == q && !long:
+			l.pos++
+			return token{kind: tokString, text: buf.String(), pos: start}, nil
+		case r == q && l.at(l.pos+1) == q && l.at(l.pos+2) == q:
+			// A long string may end with up to
+			// two unescaped quotes.
+			for l.at(l.pos+3) == q {
+				buf.WriteRune(q)
+				l.pos++
+			}
+			l.pos += 3
+			return token{kind: tokString, text: buf.String(), pos: start}, nil
+		case (r == '\n' || r == '\r') && !long:
+			return token{}, l.errorf(l.pos, "newline in string")
+		case r == '\\':
+			if u, n, ok := l.uchar(l.pos); ok {
+				buf.WriteRune(u)
+				l.pos += n
+				continue
+			}
+			c, ok := echar[l.at(l.pos+1)]
+			if !ok {
+				return token{}, l.errorf(l.pos, "invalid escape in string")
+			}
+			buf.WriteRune(c)
+			l.pos += 2
+		default:
+			buf.WriteRune(r)
+			l.pos++
+		}
+	}
+	return token{}, l.errorf(start, "unterminated string")
+}
+
+// echar maps string escape characters to their values.
+var echar = map[rune]rune{
+	't': '\t', 'b': '\b', 'n': '\n', 'r': '\r', 'f': '\f',
+	'"': '"', '\'': '\'', '\\': '\\',
+}
+
+// scanBlank scans a blank node label.
+func (l *lexer) scanBlank() (token, error) {
+	start := l.pos
+	l.pos += 2
+	if r := l.at(l.pos); !isNameStart(r) && !isDigit(r) {
+		return token{}, l.errorf(start, "invalid blank node label")
+	}
+	for isNameChar(l.at(l.pos)) || l.at(l.pos) == '.' {
+		l.pos++
+	}
+	for l.src[l.pos-1] == '.' {
+		l.pos--
+	}
+	return token{kind: tokBlank, text: string(l.src[start+2 : l.pos]), pos: start}, nil
+}
+
+// scanNumber scans an integer, decimal or double.
+func (l *lexer) scanNumber() token {
+	start := l.pos
+	kind := tokInteger
+	for isDigit(l.at(l.pos)) {
+		l.pos++
+	}
+	if l.at(l.pos) == '.' && (isDigit(l.at(l.pos+1)) || (l.pos > start && l.isExponent(l.pos+1))) {
+		kind = tokDecimal
+		l.pos++
+		for isDigit(l.at(l.pos)) {
+			l.pos++
+		}
+	}
+	if l.isExponent(l.pos) {
+		kind = tokDouble
+		l.pos++
+		if r := l.at(l.pos); r == '+' || r == '-' {
+			l.pos++
+		}
+		for isDigit(l.at(l.pos)) {
+			l.pos++
+		}
+	}
+	return token{kind: kind, text: string(l.src[start:l.pos]), pos: start}
+}
+
+// isExponent returns whether an exponent starts at position i.
+func (l *lexer) isExponent(i int) bool {
+	if r := l.at(i); r != 'e' && r != 'E' {
+		return false
+	}
+	if r := l.at(i + 1); r == '+' || r == '-' {
+		i++
+	}
+	return isDigit(l.at(i + 1))
+}
+
+// scanName scans a bare word or a prefixed name.
+func (l *lexer) scanName() (token, error) {
+	start := l.pos
+	for isNameChar(l.at(l.pos)) || (l.at(l.pos) == '.' && l.pos > start) {
+		l.pos++
+	}
+	if l.at(l.pos) != ':' {
+		for l.src[l.pos-1] == '.' {
+			l.pos--
+		}
+		return token{kind: tokWord, text: string(l.src[start:l.pos]), pos: start}, nil
+	}
+	prefix := string(l.src[start:l.pos])
+	if strings.HasSuffix(prefix, ".") {
+		return token{}, l.errorf(start, "invalid prefix %q", prefix)
+	}
+	l.pos++
+
+	var (
+		buf   strings.Builder
+		trail int // Number of trailing '.' in buf.
+	)
+	for l.pos < len(l.src) {
+		r := l.src[l.pos]
+		switch {
+		case isNameChar(r) || r == ':':
+			buf.WriteRune(r)
+			l.pos++
+			trail = 0
+			continue
+		case r == '.' && buf.Len() != 0:
+			buf.WriteRune(r)
+			l.pos++
+			trail++
+			continue
+		case r == '%' && isHex(l.at(l.pos+1)) && isHex(l.at(l.pos+2)):
+			buf.WriteString(string(l.src[l.pos : l.pos+3]))
+			l.pos += 3
+			trail = 0
+			continue
+		case r == '\\' && strings.ContainsRune("_~.-!$&'()*+,;=/?#@%", l.at(l.pos+1)):
+			buf.WriteRune(l.src[l.pos+1])
+			l.pos += 2
+			trail = 0
+			continue
+		}
+		break
+	}
+	local := buf.String()
+	l.pos -= trail
+	local = local[:len(local)-trail]
+	return token{kind: tokPName, text: prefix, local: local, pos: start}, nil
+}
+
+func isDigit(r rune) bool       { return '0' <= r && r <= '9' }
+func isASCIILetter(r rune) bool { return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') }
+func isHex(r rune) bool {
+	return isDigit(r) || ('a' <= r && r <= 'f') || ('A' <= r && r <= 'F')
+}
+
+// isNameStart returns whether r may start a name.
+func isNameStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+// isNameChar returns whether r may appear within a name.
+func isNameChar(r rune) bool {
+	return isNameStart(r) || isDigit(r) || r == '-' || r == 0xb7 || unicode.Is(unicode.Mn, r)
+}
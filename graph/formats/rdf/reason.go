@@ -0,0 +1,292 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf
+
+import "fmt"
+
+// Profile is a set of entailment rules applied by a Reasoner.
+type Profile int
+
+const (
+	// RDFS is the set of RDFS entailment rules that
+	// derive statements from the vocabulary of a graph:
+	// rdfs2, rdfs3, rdfs5, rdfs7, rdfs9 and rdfs11.
+	// The axiomatic and reflexive rules are not applied.
+	RDFS Profile = iota + 1
+
+	// OWLRL is the RDFS profile extended with a subset
+	// of the OWL 2 RL rules covering equality, property
+	// characteristics, equivalence and property
+	// restrictions: eq-sym, eq-trans, eq-rep-s,
+	// eq-rep-p, eq-rep-o, prp-fp, prp-ifp, prp-symp,
+	// prp-trp, prp-eqp1, prp-eqp2, prp-inv1, prp-inv2,
+	// cax-eqc1, cax-eqc2, scm-eqc1, scm-eqp1, cls-hv1,
+	// cls-hv2, cls-svf1, cls-svf2 and cls-avf. Rules
+	// detecting inconsistencies are not applied.
+	OWLRL
+)
+
+// Inference is the provenance of an inferred statement.
+type Inference struct {
+	// Rule is the name of the rule that
+	// produced the statement.
+	Rule string
+
+	// Premises are the statements that
+	// matched the premises of the rule.
+	Premises []*Statement
+}
+
+// Reasoner materializes the entailments of the statements in a Graph by
+// forward chaining the rules of a Profile to a fixpoint. Inferred
+// statements are added to the graph.
+//
+// Only entailments that are valid RDF statements are added; statements
+// with a literal subject or a predicate that is not an IRI are not
+// inferred.
+type Reasoner struct {
+	g     *Graph
+	rules []rule
+
+	inferred map[*Statement]Inference
+	order    []*Statement
+}
+
+// NewReasoner returns a new Reasoner for g using the rules of the profile,
+// adding the entailments of the statements already in g to g. NewReasoner
+// will panic if the profile is not valid.
+func NewReasoner(g *Graph, profile Profile) *Reasoner {
+	r := &Reasoner{g: g, inferred: make(map[*Statement]Inference)}
+	switch profile {
+	case RDFS:
+		r.rules = rdfsRules
+	case OWLRL:
+		r.rules = owlRLRules
+	default:
+		panic(fmt.Sprintf("rdf: invalid profile: %d", profile))
+	}
+
+	var queue []*Statement
+	for it := g.AllStatements(); it.Next(); {
+		queue = append(queue, it.Statement())
+	}
+	sortByUIDs(queue)
+	r.run(queue)
+	return r
+}
+
+// AddStatement adds s to the reasoner's graph and adds the entailments of
+// s to the graph. The requirements for s are the same as for the Graph
+// AddStatement method. If the statement is already in the graph it is not
+// added, and if the existing statement was inferred it is marked as
+// asserted.
+func (r *Reasoner) AddStatement(s *Statement) {
+	if t := r.statement(s.Subject, s.Predicate, s.Object); t != nil {
+		if _, ok := r.inferred[t]; ok {
+			delete(r.inferred, t)
+			for i, u := range r.order {
+				if u == t {
+					r.order = append(r.order[:i], r.order[i+1:]...)
+					break
+				}
+			}
+		}
+		return
+	}
+	r.g.AddStatement(s)
+	r.run([]*Statement{s})
+}
+
+// Inference returns the provenance of s and whether s was inferred by the
+// reasoner.
+func (r *Reasoner) Inference(s *Statement) (Inference, bool) {
+	inf, ok := r.inferred[s]
+	return inf, ok
+}
+
+// Inferred returns the statements inferred by the reasoner in the order
+// they were inferred.
+func (r *Reasoner) Inferred() []*Statement {
+	return append([]*Statement(nil), r.order...)
+}
+
+// run applies the rules to the queued statements and the statements they
+// entail until no new statements are inferred.
+func (r *Reasoner) run(queue []*Statement) {
+	for len(queue) != 0 {
+		s := queue[0]
+		queue = queue[1:]
+
+		// Find all the conclusions from s before adding
+		// any to the graph so the graph is not modified
+		// during matching.
+		type conclusion struct {
+			s    *Statement
+			from Inference
+		}
+		var found []conclusion
+		e := evaluator{g: r.g, ids: make(map[string]int64)}
+		for _, rl := range r.rules {
+			for i, prem := range rl.premises {
+				mu, ok := unify(prem, s)
+				if !ok {
+					continue
+				}
+				rest := make([]triplePattern, 0, len(rl.premises)-1)
+				rest = append(rest, rl.premises[:i]...)
+				rest = append(rest, rl.premises[i+1:]...)
+				e.match(rest, make([]bool, len(rest)), mu, func(mu Solution) {
+					for _, d := range rl.distinct {
+						if mu[d[0]].Value == mu[d[1]].Value {
+							return
+						}
+					}
+					from := Inference{Rule: rl.name}
+					for _, p := range rl.premises {
+						from.Premises = append(from.Premises, r.statement(instantiate(p, mu)))
+					}
+					for _, c := range rl.conclusions {
+						sub, pred, obj := instantiate(c, mu)
+						if kindOf(sub) == Literal || kindOf(pred) != IRI {
+							continue
+						}
+						found = append(found, conclusion{
+							s:    &Statement{Subject: Term{Value: sub.Value}, Predicate: Term{Value: pred.Value}, Object: Term{Value: obj.Value}},
+							from: from,
+						})
+					}
+				})
+			}
+		}
+
+		for _, c := range found {
+			if r.statement(c.s.Subject, c.s.Predicate, c.s.Object) != nil {
+				continue
+			}
+			r.g.AddStatement(c.s)
+			r.inferred[c.s] = c.from
+			r.order = append(r.order, c.s)
+			queue = append(queue, c.s)
+		}
+	}
+}
+
+// statement returns the statement in the graph with the given terms, or nil
+// if no such statement exists.
+func (r *Reasoner) statement(s, p, o Term) *Statement {
+	sid, ok := r.g.termIDs[s.Value]
+	if !ok {
+		return nil
+	}
+	pid, ok := r.g.termIDs[p.Value]
+	if !ok {
+		return nil
+	}
+	oid, ok := r.g.termIDs[o.Value]
+	if !ok {
+		return nil
+	}
+	l, ok := r.g.from[sid][oid][pid]
+	if !ok {
+		return nil
+	}
+	return l.(*Statement)
+}
+
+// unify returns the solution binding the variables of tp to the terms of s
+// and whether the constant terms of tp match s.
+func unify(tp triplePattern, s *Statement) (Solution, bool) {
+	for _, b := range [...]struct {
+		n node
+		t Term
+	}{{tp.s, s.Subject}, {tp.p, s.Predicate}, {tp.o, s.Object}} {
+		if b.n.variable == "" && b.n.Value != b.t.Value {
+			return nil, false
+		}
+	}
+	return bind(Solution{}, tp, s)
+}
+
+// instantiate returns the terms of tp with its variables replaced by their
+// bindings in mu.
+func instantiate(tp triplePattern, mu Solution) (s, p, o Term) {
+	term := func(n node) Term {
+		if n.variable != "" {
+			return mu[n.variable]
+		}
+		return n.Term
+	}
+	return term(tp.s), term(tp.p), term(tp.o)
+}
+
+// rule is a forward chaining entailment rule.
+type rule struct {
+	name        string
+	premises    []triplePattern
+	conclusions []triplePattern
+
+	// distinct holds pairs of variables
+	// that must be bound to distinct terms.
+	distinct [][2]string
+}
+
+// newRule returns a rule with premises and conclusions written as SPARQL
+// triple patterns. It panics if the patterns are not valid.
+func newRule(name, premises, conclusions string, distinct ...[2]string) rule {
+	patterns := func(text string) []triplePattern {
+		q, err := ParseSelect(`
+PREFIX rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#>
+PREFIX rdfs: <http://www.w3.org/2000/01/rdf-schema#>
+PREFIX owl: <http://www.w3.org/2002/07/owl#>
+SELECT * { ` + text + ` }`)
+		if err != nil {
+			panic(fmt.Sprintf("rdf: invalid rule %s: %v", name, err))
+		}
+		return q.where.elems[0].(*bgp).patterns
+	}
+	return rule{
+		name:        name,
+		premises:    patterns(premises),
+		conclusions: patterns(conclusions),
+		distinct:    distinct,
+	}
+}
+
+var rdfsRules = []rule{
+	newRule("rdfs2", "?p rdfs:domain ?c . ?x ?p ?y", "?x a ?c"),
+	newRule("rdfs3", "?p rdfs:range ?c . ?x ?p ?y", "?y a ?c"),
+	newRule("rdfs5", "?p rdfs:subPropertyOf ?q . ?q rdfs:subPropertyOf ?r", "?p rdfs:subPropertyOf ?r"),
+	newRule("rdfs7", "?p rdfs:subPropertyOf ?q . ?x ?p ?y", "?x ?q ?y"),
+	newRule("rdfs9", "?c rdfs:subClassOf ?d . ?x a ?c", "?x a ?d"),
+	newRule("rdfs11", "?c rdfs:subClassOf ?d . ?d rdfs:subClassOf ?e", "?c rdfs:subClassOf ?e"),
+}
+
+var owlRLRules = append(rdfsRules[:len(rdfsRules):len(rdfsRules)],
+	newRule("eq-sym", "?x owl:sameAs ?y", "?y owl:sameAs ?x"),
+	newRule("eq-trans", "?x owl:sameAs ?y . ?y owl:sameAs ?z", "?x owl:sameAs ?z", [2]string{"x", "z"}),
+	newRule("eq-rep-s", "?s owl:sameAs ?t . ?s ?p ?o", "?t ?p ?o"),
+	newRule("eq-rep-p", "?p owl:sameAs ?q . ?s ?p ?o", "?s ?q ?o"),
+	newRule("eq-rep-o", "?o owl:sameAs ?t . ?s ?p ?o", "?s ?p ?t"),
+
+	newRule("prp-fp", "?p a owl:FunctionalProperty . ?x ?p ?y1 . ?x ?p ?y2", "?y1 owl:sameAs ?y2", [2]string{"y1", "y2"}),
+	newRule("prp-ifp", "?p a owl:InverseFunctionalProperty . ?x1 ?p ?y . ?x2 ?p ?y", "?x1 owl:sameAs ?x2", [2]string{"x1", "x2"}),
+	newRule("prp-symp", "?p a owl:SymmetricProperty . ?x ?p ?y", "?y ?p ?x"),
+	newRule("prp-trp", "?p a owl:TransitiveProperty . ?x ?p ?y . ?y ?p ?z", "?x ?p ?z"),
+	newRule("prp-eqp1", "?p1 owl:equivalentProperty ?p2 . ?x ?p1 ?y", "?x ?p2 ?y"),
+	newRule("prp-eqp2", "?p1 owl:equivalentProperty ?p2 . ?x ?p2 ?y", "?x ?p1 ?y"),
+	newRule("prp-inv1", "?p1 owl:inverseOf ?p2 . ?x ?p1 ?y", "?y ?p2 ?x"),
+	newRule("prp-inv2", "?p1 owl:inverseOf ?p2 . ?x ?p2 ?y", "?y ?p1 ?x"),
+
+	newRule("cax-eqc1", "?c1 owl:equivalentClass ?c2 . ?x a ?c1", "?x a ?c2"),
+	newRule("cax-eqc2", "?c1 owl:equivalentClass ?c2 . ?x a ?c2", "?x a ?c1"),
+	newRule("scm-eqc1", "?c1 owl:equivalentClass ?c2", "?c1 rdfs:subClassOf ?c2 . ?c2 rdfs:subClassOf ?c1"),
+	newRule("scm-eqp1", "?p1 owl:equivalentProperty ?p2", "?p1 rdfs:subPropertyOf ?p2 . ?p2 rdfs:subPropertyOf ?p1"),
+
+	newRule("cls-hv1", "?x owl:hasValue ?y . ?x owl:onProperty ?p . ?u a ?x", "?u ?p ?y"),
+	newRule("cls-hv2", "?x owl:hasValue ?y . ?x owl:onProperty ?p . ?u ?p ?y", "?u a ?x"),
+	newRule("cls-svf1", "?x owl:someValuesFrom ?y . ?x owl:onProperty ?p . ?u ?p ?v . ?v a ?y", "?u a ?x"),
+	newRule("cls-svf2", "?x owl:someValuesFrom owl:Thing . ?x owl:onProperty ?p . ?u ?p ?v", "?u a ?x"),
+	newRule("cls-avf", "?x owl:allValuesFrom ?y . ?x owl:onProperty ?p . ?u a ?x . ?u ?p ?v", "?v a ?y"),
+)
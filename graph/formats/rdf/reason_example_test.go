@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf_test
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"gonum.org/v1/gonum/graph/formats/rdf"
+)
+
+func ExampleReasoner() {
+	const data = `@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+@prefix ex: <http://example.org/> .
+
+ex:Dog rdfs:subClassOf ex:Mammal .
+ex:Mammal rdfs:subClassOf ex:Animal .
+ex:owns rdfs:range ex:Pet .
+`
+
+	g := rdf.NewGraph()
+	dec := rdf.NewTurtleDecoder(strings.NewReader(data), "")
+	for {
+		s, err := dec.Unmarshal()
+		if err != nil {
+			if err != io.EOF {
+				log.Fatalf("error during decoding: %v", err)
+			}
+			break
+		}
+		g.AddStatement(s)
+	}
+
+	r := rdf.NewReasoner(g, rdf.RDFS)
+
+	// Add statements about individuals after the
+	// ontology has been loaded.
+	r.AddStatement(&rdf.Statement{
+		Subject:   rdf.Term{Value: "<http://example.org/rex>"},
+		Predicate: rdf.Term{Value: "<http://www.w3.org/1999/02/22-rdf-syntax-ns#type>"},
+		Object:    rdf.Term{Value: "<http://example.org/Dog>"},
+	})
+	r.AddStatement(&rdf.Statement{
+		Subject:   rdf.Term{Value: "<http://example.org/alice>"},
+		Predicate: rdf.Term{Value: "<http://example.org/owns>"},
+		Object:    rdf.Term{Value: "<http://example.org/rex>"},
+	})
+
+	for _, s := range r.Inferred() {
+		inf, _ := r.Inference(s)
+		fmt.Printf("%s by %s from %d statements\n", s, inf.Rule, len(inf.Premises))
+	}
+
+	// Output:
+	//
+	// <http://example.org/Dog> <http://www.w3.org/2000/01/rdf-schema#subClassOf> <http://example.org/Animal> . by rdfs11 from 2 statements
+	// <http://example.org/rex> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://example.org/Mammal> . by rdfs9 from 2 statements
+	// <http://example.org/rex> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://example.org/Animal> . by rdfs9 from 2 statements
+	// <http://example.org/rex> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://example.org/Pet> . by rdfs3 from 2 statements
+}
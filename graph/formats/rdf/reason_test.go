@@ -0,0 +1,286 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+const reasonPrefixes = `@prefix : <http://example.org/> .
+@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+@prefix owl: <http://www.w3.org/2002/07/owl#> .
+`
+
+var reasonerTests = []struct {
+	name    string
+	profile Profile
+	data    string
+	want    string // Inferred statements.
+}{
+	{
+		name:    "subclass",
+		profile: RDFS,
+		data: `:Dog rdfs:subClassOf :Mammal . :Mammal rdfs:subClassOf :Animal .
+:rex a :Dog .`,
+		want: `:Dog rdfs:subClassOf :Animal .
+:rex a :Mammal, :Animal .`,
+	},
+	{
+		name:    "subproperty",
+		profile: RDFS,
+		data: `:hasMother rdfs:subPropertyOf :hasParent . :hasParent rdfs:subPropertyOf :hasAncestor .
+:bob :hasMother :alice .`,
+		want: `:hasMother rdfs:subPropertyOf :hasAncestor .
+:bob :hasParent :alice ; :hasAncestor :alice .`,
+	},
+	{
+		name:    "domain and range",
+		profile: RDFS,
+		data: `:teaches rdfs:domain :Teacher ; rdfs:range :Course . :Teacher rdfs:subClassOf :Person .
+:alice :teaches :algebra .
+:alice :age 42 .
+:age rdfs:range :Number .`,
+		want: `:alice a :Teacher, :Person .
+:algebra a :Course .`,
+	},
+	{
+		name:    "rdfs ignores owl",
+		profile: RDFS,
+		data: `:knows a owl:SymmetricProperty .
+:alice :knows :bob .`,
+		want: ``,
+	},
+	{
+		name:    "same as",
+		profile: OWLRL,
+		data: `:a owl:sameAs :b . :b owl:sameAs :c .
+:a :name "A" .`,
+		want: `:b owl:sameAs :a ; :name "A" .
+:c owl:sameAs :b, :a ; :name "A" .
+:a owl:sameAs :c .
+:a owl:sameAs :a .
+:b owl:sameAs :b .
+:c owl:sameAs :c .`,
+	},
+	{
+		name:    "symmetric transitive inverse",
+		profile: OWLRL,
+		data: `:knows a owl:SymmetricProperty .
+:ancestor a owl:TransitiveProperty .
+:parent owl:inverseOf :child .
+:alice :knows :bob .
+:alice :ancestor :bob . :bob :ancestor :carol .
+:alice :parent :bob .`,
+		want: `:bob :knows :alice ; :child :alice .
+:alice :ancestor :carol .`,
+	},
+	{
+		name:    "functional property",
+		profile: OWLRL,
+		data: `:mother a owl:FunctionalProperty .
+:bob :mother :alice, :alicia .`,
+		want: `:alice owl:sameAs :alicia, :alice .
+:alicia owl:sameAs :alice, :alicia .`,
+	},
+	{
+		name:    "equivalence",
+		profile: OWLRL,
+		data: `:Human owl:equivalentClass :Person .
+:name owl:equivalentProperty :label .
+:alice a :Human ; :name "Alice" .`,
+		want: `:Human rdfs:subClassOf :Person .
+:Person rdfs:subClassOf :Human .
+:name rdfs:subPropertyOf :label .
+:label rdfs:subPropertyOf :name .
+:Human rdfs:subClassOf :Human .
+:Person rdfs:subClassOf :Person .
+:name rdfs:subPropertyOf :name .
+:label rdfs:subPropertyOf :label .
+:alice a :Person ; :label "Alice" .`,
+	},
+	{
+		name:    "restrictions",
+		profile: OWLRL,
+		data: `:Parent owl:onProperty :hasChild ; owl:someValuesFrom :Person .
+:Red owl:onProperty :colour ; owl:hasValue :red .
+:Vegan owl:onProperty :eats ; owl:allValuesFrom :Plant .
+:alice :hasChild :bob . :bob a :Person .
+:car :colour :red .
+:apple a :Red .
+:eve a :Vegan ; :eats :kale .`,
+		want: `:alice a :Parent .
+:car a :Red .
+:apple :colour :red .
+:kale a :Plant .`,
+	},
+	{
+		name:    "no literal subjects",
+		profile: OWLRL,
+		data: `:name owl:inverseOf :nameOf .
+:alice :name "Alice" .`,
+		want: ``,
+	},
+}
+
+func TestReasoner(t *testing.T) {
+	for _, test := range reasonerTests {
+		g := reasonGraph(t, test.data)
+		r := NewReasoner(g, test.profile)
+
+		var got []string
+		for _, s := range r.Inferred() {
+			got = append(got, s.String())
+		}
+		slices.Sort(got)
+		want := reasonStrings(t, test.want)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected inferred statements for %q:\ngot: %q\nwant:%q", test.name, got, want)
+		}
+
+		for _, s := range r.Inferred() {
+			inf, ok := r.Inference(s)
+			if !ok {
+				t.Errorf("missing inference for %s in %q", s, test.name)
+				continue
+			}
+			for _, p := range inf.Premises {
+				if p == nil {
+					t.Errorf("missing premise for %s by %s in %q", s, inf.Rule, test.name)
+				}
+			}
+		}
+	}
+}
+
+func TestReasonerIncremental(t *testing.T) {
+	for _, test := range reasonerTests {
+		statements, err := decodeTurtle(reasonPrefixes+test.data, "")
+		if err != nil {
+			t.Fatalf("unexpected error decoding %q: %v", test.name, err)
+		}
+
+		// Add the statements in reverse to reach the
+		// fixpoint by a different route to the batch.
+		g := NewGraph()
+		r := NewReasoner(g, test.profile)
+		for i := len(statements) - 1; i >= 0; i-- {
+			r.AddStatement(statements[i])
+		}
+
+		batch := NewReasoner(reasonGraph(t, test.data), test.profile)
+		got := graphStrings(g)
+		want := graphStrings(batch.g)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected incremental result for %q:\ngot: %q\nwant:%q", test.name, got, want)
+		}
+	}
+}
+
+func TestReasonerProvenance(t *testing.T) {
+	g := reasonGraph(t, `:Dog rdfs:subClassOf :Mammal .
+:rex a :Dog .`)
+	r := NewReasoner(g, RDFS)
+
+	inferred := r.Inferred()
+	if len(inferred) != 1 {
+		t.Fatalf("unexpected number of inferred statements: got:%d want:1", len(inferred))
+	}
+	s := inferred[0]
+	inf, ok := r.Inference(s)
+	if !ok {
+		t.Fatal("missing inference")
+	}
+	if inf.Rule != "rdfs9" {
+		t.Errorf("unexpected rule: got:%s want:rdfs9", inf.Rule)
+	}
+	var premises []string
+	for _, p := range inf.Premises {
+		premises = append(premises, p.String())
+	}
+	want := []string{
+		"<http://example.org/Dog> <http://www.w3.org/2000/01/rdf-schema#subClassOf> <http://example.org/Mammal> .",
+		"<http://example.org/rex> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://example.org/Dog> .",
+	}
+	if !reflect.DeepEqual(premises, want) {
+		t.Errorf("unexpected premises:\ngot: %q\nwant:%q", premises, want)
+	}
+	for _, p := range inf.Premises {
+		if _, ok := r.Inference(p); ok {
+			t.Errorf("asserted premise %s reported as inferred", p)
+		}
+	}
+
+	// Asserting an inferred statement removes its provenance.
+	n := g.Edges().Len()
+	r.AddStatement(&Statement{Subject: Term{Value: s.Subject.Value}, Predicate: Term{Value: s.Predicate.Value}, Object: Term{Value: s.Object.Value}})
+	if _, ok := r.Inference(s); ok {
+		t.Error("asserted statement still reported as inferred")
+	}
+	if len(r.Inferred()) != 0 {
+		t.Errorf("unexpected inferred statements after assertion: %v", r.Inferred())
+	}
+	if g.Edges().Len() != n {
+		t.Errorf("unexpected number of edges after assertion: got:%d want:%d", g.Edges().Len(), n)
+	}
+
+	// New statements are inferred from existing statements.
+	r.AddStatement(&Statement{
+		Subject:   Term{Value: "<http://example.org/Mammal>"},
+		Predicate: Term{Value: "<http://www.w3.org/2000/01/rdf-schema#subClassOf>"},
+		Object:    Term{Value: "<http://example.org/Animal>"},
+	})
+	var got []string
+	for _, s := range r.Inferred() {
+		inf, _ := r.Inference(s)
+		got = append(got, inf.Rule+": "+s.String())
+	}
+	slices.Sort(got)
+	wantInferred := []string{
+		"rdfs11: <http://example.org/Dog> <http://www.w3.org/2000/01/rdf-schema#subClassOf> <http://example.org/Animal> .",
+		"rdfs9: <http://example.org/rex> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://example.org/Animal> .",
+	}
+	if !reflect.DeepEqual(got, wantInferred) {
+		t.Errorf("unexpected inferred statements:\ngot: %q\nwant:%q", got, wantInferred)
+	}
+}
+
+func reasonGraph(t *testing.T, data string) *Graph {
+	t.Helper()
+	statements, err := decodeTurtle(reasonPrefixes+data, "")
+	if err != nil {
+		t.Fatalf("unexpected error decoding graph: %v", err)
+	}
+	g := NewGraph()
+	for _, s := range statements {
+		g.AddStatement(s)
+	}
+	return g
+}
+
+func reasonStrings(t *testing.T, data string) []string {
+	t.Helper()
+	statements, err := decodeTurtle(reasonPrefixes+data, "")
+	if err != nil {
+		t.Fatalf("unexpected error decoding statements: %v", err)
+	}
+	var s []string
+	for _, st := range statements {
+		s = append(s, st.String())
+	}
+	slices.Sort(s)
+	return s
+}
+
+func graphStrings(g *Graph) []string {
+	var s []string
+	for it := g.AllStatements(); it.Next(); {
+		s = append(s, it.Statement().String())
+	}
+	slices.Sort(s)
+	return s
+}
@@ -0,0 +1,639 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// ErrInvalidSPARQL is returned when a SPARQL query is not valid or uses
+// features that are not supported.
+var ErrInvalidSPARQL = errors.New("invalid SPARQL query")
+
+// Solution is a SPARQL query solution. It maps variable names, without the
+// leading ? or $, to their bound terms.
+type Solution map[string]Term
+
+// SelectQuery is a parsed SPARQL SELECT query.
+type SelectQuery struct {
+	vars     []string
+	distinct bool
+	where    *group
+	limit    int
+	offset   int
+}
+
+// ParseSelect parses a SPARQL SELECT query. The supported subset of SPARQL
+// 1.1 consists of PREFIX and BASE declarations, SELECT with an optional
+// DISTINCT or REDUCED modifier and either a list of variables or *, and a
+// WHERE clause holding basic graph patterns, FILTER, OPTIONAL, UNION and
+// nested group graph patterns, followed by optional LIMIT and OFFSET
+// modifiers. Triple patterns may use the full Turtle triple syntax,
+// including blank node property lists and collections, with variables in
+// any position. Blank nodes in patterns act as variables that are not
+// returned by SELECT *.
+//
+// FILTER expressions may use the logical, comparison and arithmetic
+// operators and the BOUND, isIRI, isURI, isBlank, isLiteral, isNumeric,
+// STR, LANG, DATATYPE, LANGMATCHES, sameTerm, REGEX, CONTAINS, STRSTARTS,
+// STRENDS, STRLEN, UCASE and LCASE functions.
+func ParseSelect(query string) (*SelectQuery, error) {
+	p := &sparqlParser{
+		tripleParser: tripleParser{
+			lex:      newLexer(query, true, ErrInvalidSPARQL),
+			prefixes: make(map[string]string),
+		},
+		seen: make(map[string]bool),
+	}
+	p.blank = func(label string) node {
+		return node{variable: blankPrefix + label}
+	}
+	p.newBlank = func() node {
+		p.anon++
+		// Blank node labels cannot contain '#', so
+		// this can not collide with a labeled node.
+		return node{variable: fmt.Sprintf("%s#%d", blankPrefix, p.anon)}
+	}
+	p.variable = func(name string) node {
+		if !p.seen[name] {
+			p.seen[name] = true
+			p.inScope = append(p.inScope, name)
+		}
+		return node{variable: name}
+	}
+	p.emit = func(s, pred, o node) {
+		p.cur.patterns = append(p.cur.patterns, triplePattern{s: s, p: pred, o: o})
+	}
+	return p.parse()
+}
+
+// Vars returns the names of the variables projected by the query.
+func (q *SelectQuery) Vars() []string {
+	return append([]string(nil), q.vars...)
+}
+
+// Eval returns the solutions of the query over g. Solutions only hold
+// the projected variables that are bound. The order of the solutions is
+// deterministic for a given graph, but is otherwise unspecified.
+func (q *SelectQuery) Eval(g *Graph) []Solution {
+	e := evaluator{g: g, ids: make(map[string]int64)}
+	var sols []Solution
+	seen := make(map[string]bool)
+	for _, mu := range e.group(q.where) {
+		s := make(Solution, len(q.vars))
+		var key strings.Builder
+		for _, v := range q.vars {
+			t, ok := mu[v]
+			if ok {
+				s[v] = t
+				key.WriteString(t.Value)
+			}
+			key.WriteByte('\n')
+		}
+		if q.distinct {
+			if seen[key.String()] {
+				continue
+			}
+			seen[key.String()] = true
+		}
+		sols = append(sols, s)
+	}
+	if q.offset < len(sols) {
+		sols = sols[q.offset:]
+	} else {
+		sols = nil
+	}
+	if q.limit >= 0 && q.limit < len(sols) {
+		sols = sols[:q.limit]
+	}
+	return sols
+}
+
+// group is a SPARQL group graph pattern.
+type group struct {
+	elems   []any // Each element is a *bgp, *optional or *union.
+	filters []expr
+}
+
+// bgp is a basic graph pattern.
+type bgp struct {
+	patterns []triplePattern
+}
+
+// triplePattern is a triple of terms and variables.
+type triplePattern struct {
+	s, p, o node
+}
+
+// optional is an OPTIONAL group.
+type optional struct {
+	*group
+}
+
+// union is a set of alternative groups. A nested group
+// is represented as a union with a single group.
+type union struct {
+	groups []*group
+}
+
+// sparqlParser is a SPARQL SELECT query parser.
+type sparqlParser struct {
+	tripleParser
+
+	// inScope holds the variables used in
+	// triple patterns in order of use.
+	inScope []string
+	seen    map[string]bool
+	anon    int
+
+	// cur is the basic graph pattern that
+	// parsed triple patterns are added to.
+	cur *bgp
+}
+
+// parse parses the complete query.
+func (p *sparqlParser) parse() (*SelectQuery, error) {
+	q := &SelectQuery{limit: -1}
+	var star bool
+	for {
+		t, err := p.lex.next()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case t.isKeyword("PREFIX"):
+			err = p.prefix()
+		case t.isKeyword("BASE"):
+			err = p.setBase()
+		case t.isKeyword("SELECT"):
+			star, err = p.projection(q)
+			if err != nil {
+				return nil, err
+			}
+			return q, p.where(q, star)
+		case t.kind == tokWord:
+			return nil, p.lex.errorf(t.pos, "unsupported query form %s", t.text)
+		default:
+			return nil, p.lex.unexpected(t)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// projection parses the modifiers and variables of a SELECT clause,
+// returning whether all variables are selected.
+func (p *sparqlParser) projection(q *SelectQuery) (star bool, err error) {
+	t, err := p.lex.peek()
+	if err != nil {
+		return false, err
+	}
+	if t.isKeyword("DISTINCT") || t.isKeyword("REDUCED") {
+		q.distinct = t.isKeyword("DISTINCT")
+		p.lex.next()
+	}
+	for {
+		t, err := p.lex.peek()
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case t.isPunct("*") && len(q.vars) == 0 && !star:
+			p.lex.next()
+			star = true
+		case t.kind == tokVar && !star:
+			p.lex.next()
+			q.vars = append(q.vars, t.text)
+		case len(q.vars) == 0 && !star:
+			return false, p.lex.unexpected(t)
+		default:
+			return star, nil
+		}
+	}
+}
+
+// where parses the WHERE clause and solution modifiers of the query.
+func (p *sparqlParser) where(q *SelectQuery, star bool) error {
+	t, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	if t.isKeyword("WHERE") {
+		t, err = p.lex.next()
+		if err != nil {
+			return err
+		}
+	}
+	if !t.isPunct("{") {
+		return p.lex.unexpected(t)
+	}
+	q.where, err = p.group()
+	if err != nil {
+		return err
+	}
+	if star {
+		q.vars = p.inScope
+	}
+
+	var haveLimit, haveOffset bool
+	for {
+		t, err := p.lex.next()
+		if err != nil {
+			return err
+		}
+		var dst *int
+		switch {
+		case t.kind == tokEOF:
+			return nil
+		case t.isKeyword("LIMIT") && !haveLimit:
+			haveLimit = true
+			dst = &q.limit
+		case t.isKeyword("OFFSET") && !haveOffset:
+			haveOffset = true
+			dst = &q.offset
+		case t.kind == tokWord:
+			return p.lex.errorf(t.pos, "unsupported solution modifier %s", t.text)
+		default:
+			return p.lex.unexpected(t)
+		}
+		n, err := p.lex.next()
+		if err != nil {
+			return err
+		}
+		if n.kind != tokInteger {
+			return p.lex.unexpected(n)
+		}
+		*dst, err = strconv.Atoi(n.text)
+		if err != nil {
+			return p.lex.errorf(n.pos, "invalid integer %s", n.text)
+		}
+	}
+}
+
+// group parses a group graph pattern after its opening brace.
+func (p *sparqlParser) group() (*group, error) {
+	g := &group{}
+	for {
+		t, err := p.lex.peek()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case t.isPunct("}"):
+			p.lex.next()
+			return g, nil
+		case t.isPunct("."):
+			p.lex.next()
+		case t.isKeyword("FILTER"):
+			p.lex.next()
+			f, err := p.constraint()
+			if err != nil {
+				return nil, err
+			}
+			g.filters = append(g.filters, f)
+		case t.isKeyword("OPTIONAL"):
+			p.lex.next()
+			err = p.expect("{")
+			if err != nil {
+				return nil, err
+			}
+			sub, err := p.group()
+			if err != nil {
+				return nil, err
+			}
+			g.elems = append(g.elems, &optional{sub})
+		case t.isPunct("{"):
+			p.lex.next()
+			sub, err := p.group()
+			if err != nil {
+				return nil, err
+			}
+			u := &union{groups: []*group{sub}}
+			for {
+				t, err := p.lex.peek()
+				if err != nil {
+					return nil, err
+				}
+				if !t.isKeyword("UNION") {
+					break
+				}
+				p.lex.next()
+				err = p.expect("{")
+				if err != nil {
+					return nil, err
+				}
+				sub, err := p.group()
+				if err != nil {
+					return nil, err
+				}
+				u.groups = append(u.groups, sub)
+			}
+			g.elems = append(g.elems, u)
+		case t.kind == tokWord && t.text != "a":
+			return nil, p.lex.errorf(t.pos, "unsupported graph pattern %s", t.text)
+		default:
+			b, ok := lastBGP(g)
+			if !ok {
+				b = &bgp{}
+				g.elems = append(g.elems, b)
+			}
+			p.cur = b
+			err = p.triples()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// lastBGP returns the last element of g if it is a basic graph pattern.
+func lastBGP(g *group) (*bgp, bool) {
+	if len(g.elems) == 0 {
+		return nil, false
+	}
+	b, ok := g.elems[len(g.elems)-1].(*bgp)
+	return b, ok
+}
+
+// evaluator evaluates SPARQL graph patterns over a Graph.
+type evaluator struct {
+	g *Graph
+
+	// ids holds the UIDs of query terms in g.
+	// Terms that are not in g have a zero UID.
+	ids map[string]int64
+}
+
+// group returns the solutions of the group graph pattern g.
+func (e *evaluator) group(g *group) []Solution {
+	return filter(e.patterns(g), g.filters)
+}
+
+// patterns returns the solutions of the elements of g without applying the
+// filters of g.
+func (e *evaluator) patterns(g *group) []Solution {
+	sols := []Solution{{}}
+	for _, el := range g.elems {
+		switch el := el.(type) {
+		case *bgp:
+			var next []Solution
+			for _, mu := range sols {
+				e.match(el.patterns, make([]bool, len(el.patterns)), mu, func(s Solution) {
+					next = append(next, s)
+				})
+			}
+			sols = next
+		case *optional:
+			sols = leftJoin(sols, e.patterns(el.group), el.filters)
+		case *union:
+			var alt []Solution
+			for _, g := range el.groups {
+				alt = append(alt, e.group(g)...)
+			}
+			sols = join(sols, alt)
+		default:
+			panic(fmt.Sprintf("rdf: unknown graph pattern %T", el))
+		}
+	}
+	return sols
+}
+
+// match calls fn with each extension of mu that matches the unused
+// patterns, matching the pattern with the most bound positions first.
+func (e *evaluator) match(patterns []triplePattern, used []bool, mu Solution, fn func(Solution)) {
+	best, bestBound := -1, -1
+	for i, tp := range patterns {
+		if used[i] {
+			continue
+		}
+		bound := 0
+		for _, n := range [...]node{tp.s, tp.p, tp.o} {
+			if _, ok := e.value(n, mu); ok {
+				bound++
+			}
+		}
+		if bound > bestBound {
+			best, bestBound = i, bound
+		}
+	}
+	if best < 0 {
+		fn(mu)
+		return
+	}
+	used[best] = true
+	tp := patterns[best]
+	for _, s := range e.statements(tp, mu) {
+		nu, ok := bind(mu, tp, s)
+		if ok {
+			e.match(patterns, used, nu, fn)
+		}
+	}
+	used[best] = false
+}
+
+// value returns the term of n in g under the solution mu, and whether n
+// is bound.
+func (e *evaluator) value(n node, mu Solution) (Term, bool) {
+	if n.variable != "" {
+		t, ok := mu[n.variable]
+		return t, ok
+	}
+	id, ok := e.ids[n.Value]
+	if !ok {
+		var t Term
+		t, ok = e.g.TermFor(n.Value)
+		if ok {
+			id = t.UID
+		}
+		e.ids[n.Value] = id
+	}
+	return Term{Value: n.Value, UID: id}, true
+}
+
+// statements returns the statements of g that may match tp under the
+// solution mu, sorted by subject, predicate and object ID.
+func (e *evaluator) statements(tp triplePattern, mu Solution) []*Statement {
+	s, sBound := e.value(tp.s, mu)
+	p, pBound := e.value(tp.p, mu)
+	o, oBound := e.value(tp.o, mu)
+	if (sBound && s.UID == 0) || (pBound && p.UID == 0) || (oBound && o.UID == 0) {
+		return nil
+	}
+
+	var statements []*Statement
+	add := func(lines map[int64]graph.Line) {
+		if pBound {
+			if l, ok := lines[p.UID]; ok {
+				statements = append(statements, l.(*Statement))
+			}
+			return
+		}
+		for _, l := range lines {
+			statements = append(statements, l.(*Statement))
+		}
+	}
+	switch {
+	case sBound && oBound:
+		add(e.g.from[s.UID][o.UID])
+	case sBound:
+		for _, lines := range e.g.from[s.UID] {
+			add(lines)
+		}
+	case oBound:
+		for _, lines := range e.g.to[o.UID] {
+			add(lines)
+		}
+	case pBound:
+		for s := range e.g.pred[p.UID] {
+			statements = append(statements, s)
+		}
+	default:
+		for _, to := range e.g.from {
+			for _, lines := range to {
+				add(lines)
+			}
+		}
+	}
+	sortByUIDs(statements)
+	return statements
+}
+
+// sortByUIDs sorts statements by subject, predicate and object ID.
+func sortByUIDs(statements []*Statement) {
+	sort.Slice(statements, func(i, j int) bool {
+		a, b := statements[i], statements[j]
+		if a.Subject.UID != b.Subject.UID {
+			return a.Subject.UID < b.Subject.UID
+		}
+		if a.Predicate.UID != b.Predicate.UID {
+			return a.Predicate.UID < b.Predicate.UID
+		}
+		return a.Object.UID < b.Object.UID
+	})
+}
+
+// bind returns the extension of mu binding the variables of tp to the
+// terms of s, and whether the binding is consistent with mu.
+func bind(mu Solution, tp triplePattern, s *Statement) (Solution, bool) {
+	nu := mu
+	for _, b := range [...]struct {
+		n node
+		t Term
+	}{{tp.s, s.Subject}, {tp.p, s.Predicate}, {tp.o, s.Object}} {
+		if b.n.variable == "" {
+			continue
+		}
+		if t, ok := nu[b.n.variable]; ok {
+			if t.Value != b.t.Value {
+				return nil, false
+			}
+			continue
+		}
+		if len(nu) == len(mu) {
+			nu = make(Solution, len(mu)+3)
+			for k, v := range mu {
+				nu[k] = v
+			}
+		}
+		nu[b.n.variable] = b.t
+	}
+	return nu, true
+}
+
+// compatible returns whether the solutions agree on all shared variables.
+func compatible(a, b Solution) bool {
+	for k, v := range a {
+		if u, ok := b[k]; ok && u.Value != v.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// merge returns the union of the compatible solutions a and b.
+func merge(a, b Solution) Solution {
+	m := make(Solution, len(a)+len(b))
+	for k, v := range a {
+		m[k] = v
+	}
+	for k, v := range b {
+		m[k] = v
+	}
+	return m
+}
+
+// join returns the merges of the compatible pairs of solutions in a and b.
+func join(a, b []Solution) []Solution {
+	var sols []Solution
+	for _, x := range a {
+		for _, y := range b {
+			if compatible(x, y) {
+				sols = append(sols, merge(x, y))
+			}
+		}
+	}
+	return sols
+}
+
+// leftJoin returns the merges of the compatible pairs of solutions in a and
+// b that satisfy the filters, and the solutions in a that have no such
+// merge.
+func leftJoin(a, b []Solution, filters []expr) []Solution {
+	var sols []Solution
+	for _, x := range a {
+		extended := false
+		for _, y := range b {
+			if !compatible(x, y) {
+				continue
+			}
+			m := merge(x, y)
+			if satisfies(m, filters) {
+				sols = append(sols, m)
+				extended = true
+			}
+		}
+		if !extended {
+			sols = append(sols, x)
+		}
+	}
+	return sols
+}
+
+// filter returns the solutions that satisfy the filters.
+func filter(sols []Solution, filters []expr) []Solution {
+	if len(filters) == 0 {
+		return sols
+	}
+	var kept []Solution
+	for _, mu := range sols {
+		if satisfies(mu, filters) {
+			kept = append(kept, mu)
+		}
+	}
+	return kept
+}
+
+// satisfies returns whether the effective boolean value of all the
+// filters is true for mu. Filters that raise an error are not satisfied.
+func satisfies(mu Solution, filters []expr) bool {
+	for _, f := range filters {
+		v, err := f.eval(mu)
+		if err != nil {
+			return false
+		}
+		ok, err := ebv(v)
+		if err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf_test
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"gonum.org/v1/gonum/graph/formats/rdf"
+)
+
+func ExampleSelectQuery() {
+	const data = `@prefix foaf: <http://xmlns.com/foaf/0.1/> .
+@prefix ex: <http://example.org/> .
+
+ex:alice foaf:name "Alice" ; ex:age 42 ; foaf:knows ex:bob, ex:carol .
+ex:bob foaf:name "Bob" ; ex:age 17 ; foaf:mbox <mailto:bob@example.org> .
+ex:carol foaf:name "Carol" ; ex:age 29 .
+`
+
+	g := rdf.NewGraph()
+	dec := rdf.NewTurtleDecoder(strings.NewReader(data), "")
+	for {
+		s, err := dec.Unmarshal()
+		if err != nil {
+			if err != io.EOF {
+				log.Fatalf("error during decoding: %v", err)
+			}
+			break
+		}
+		g.AddStatement(s)
+	}
+
+	// Find the adults Alice knows and their email addresses if known.
+	q, err := rdf.ParseSelect(`
+PREFIX foaf: <http://xmlns.com/foaf/0.1/>
+PREFIX ex: <http://example.org/>
+
+SELECT ?name ?mbox WHERE {
+	?p foaf:name "Alice" ; foaf:knows ?friend .
+	?friend foaf:name ?name ; ex:age ?age .
+	OPTIONAL { ?friend foaf:mbox ?mbox }
+	FILTER (?age >= 18 || BOUND(?mbox))
+}`)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, s := range q.Eval(g) {
+		name, _, _, _ := s["name"].Parts()
+		mbox, ok := s["mbox"]
+		if !ok {
+			fmt.Println(name)
+			continue
+		}
+		fmt.Println(name, mbox.Value)
+	}
+
+	// Unordered output:
+	// Bob <mailto:bob@example.org>
+	// Carol
+}
@@ -0,0 +1,249 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const sparqlGraph = `@prefix : <http://example.org/> .
+@prefix foaf: <http://xmlns.com/foaf/0.1/> .
+
+:alice a foaf:Person ; foaf:name "Alice"@en ; :age 42 ; foaf:knows :bob, :carol .
+:bob a foaf:Person ; foaf:name "Bob" ; :age 17 ; foaf:mbox <mailto:bob@example.org> .
+:carol a foaf:Person ; foaf:name "Carol" ; :age 17.5 ; foaf:knows :alice .
+:dave a :Robot ; foaf:name "Dave" ; :parts ( :arm :leg ) .
+`
+
+const sparqlPrefixes = `PREFIX : <http://example.org/>
+PREFIX foaf: <http://xmlns.com/foaf/0.1/>
+`
+
+var sparqlTests = []struct {
+	name  string
+	query string
+	vars  []string
+	want  []string
+}{
+	{
+		name:  "basic graph pattern",
+		query: `SELECT ?n WHERE { ?p a foaf:Person ; foaf:name ?n }`,
+		vars:  []string{"n"},
+		want:  []string{`n="Alice"@en`, `n="Bob"`, `n="Carol"`},
+	},
+	{
+		name:  "star",
+		query: `SELECT * { ?p foaf:knows ?q . ?q foaf:name ?n }`,
+		vars:  []string{"p", "q", "n"},
+		want: []string{
+			`p=<http://example.org/alice> q=<http://example.org/bob> n="Bob"`,
+			`p=<http://example.org/alice> q=<http://example.org/carol> n="Carol"`,
+			`p=<http://example.org/carol> q=<http://example.org/alice> n="Alice"@en`,
+		},
+	},
+	{
+		name:  "blank node pattern",
+		query: `SELECT * { ?p foaf:knows [ foaf:mbox ?m ] }`,
+		vars:  []string{"p", "m"},
+		want:  []string{`p=<http://example.org/alice> m=<mailto:bob@example.org>`},
+	},
+	{
+		name:  "collection pattern",
+		query: `SELECT ?r { ?r :parts ( :arm ?x ) }`,
+		vars:  []string{"r"},
+		want:  []string{`r=<http://example.org/dave>`},
+	},
+	{
+		name:  "numeric filter",
+		query: `SELECT ?p { ?p :age ?a FILTER(?a >= 17.5) }`,
+		vars:  []string{"p"},
+		want:  []string{`p=<http://example.org/alice>`, `p=<http://example.org/carol>`},
+	},
+	{
+		name:  "arithmetic filter",
+		query: `SELECT ?p { ?p :age ?a FILTER(?a * 2 - 1 = 33 || -?a < -40) }`,
+		vars:  []string{"p"},
+		want:  []string{`p=<http://example.org/alice>`, `p=<http://example.org/bob>`},
+	},
+	{
+		name:  "string functions",
+		query: `SELECT ?n { ?p foaf:name ?n FILTER(regex(?n, "^[ab]", "i") && !langMatches(lang(?n), "en")) }`,
+		vars:  []string{"n"},
+		want:  []string{`n="Bob"`},
+	},
+	{
+		name:  "term functions",
+		query: `SELECT ?o { :bob ?p ?o FILTER(isIRI(?o) && STRSTARTS(STR(?o), "mailto:")) }`,
+		vars:  []string{"o"},
+		want:  []string{`o=<mailto:bob@example.org>`},
+	},
+	{
+		name:  "datatype",
+		query: `SELECT ?p { ?p :age ?a FILTER(DATATYPE(?a) = <http://www.w3.org/2001/XMLSchema#decimal>) }`,
+		vars:  []string{"p"},
+		want:  []string{`p=<http://example.org/carol>`},
+	},
+	{
+		name:  "optional",
+		query: `SELECT ?n ?m { ?p foaf:name ?n OPTIONAL { ?p foaf:mbox ?m } }`,
+		vars:  []string{"n", "m"},
+		want:  []string{`n="Alice"@en`, `n="Bob" m=<mailto:bob@example.org>`, `n="Carol"`, `n="Dave"`},
+	},
+	{
+		name:  "optional filter",
+		query: `SELECT ?n ?a { ?p foaf:name ?n OPTIONAL { ?p :age ?a FILTER(?a > 18) } }`,
+		vars:  []string{"n", "a"},
+		want: []string{
+			`n="Alice"@en a="42"^^<http://www.w3.org/2001/XMLSchema#integer>`,
+			`n="Bob"`, `n="Carol"`, `n="Dave"`,
+		},
+	},
+	{
+		name:  "negation by failure",
+		query: `SELECT ?n { ?p foaf:name ?n OPTIONAL { ?p :age ?a } FILTER(!BOUND(?a)) }`,
+		vars:  []string{"n"},
+		want:  []string{`n="Dave"`},
+	},
+	{
+		name:  "union",
+		query: `SELECT ?p { { ?p foaf:mbox ?m } UNION { ?p a :Robot } UNION { ?p :age 42 } }`,
+		vars:  []string{"p"},
+		want:  []string{`p=<http://example.org/alice>`, `p=<http://example.org/bob>`, `p=<http://example.org/dave>`},
+	},
+	{
+		name:  "nested group filter scope",
+		query: `SELECT ?p { ?p :age ?a { ?p foaf:name ?n FILTER(BOUND(?a)) } }`,
+		vars:  []string{"p"},
+		want:  nil,
+	},
+	{
+		name:  "distinct",
+		query: `SELECT DISTINCT ?t { ?s a ?t }`,
+		vars:  []string{"t"},
+		want:  []string{`t=<http://example.org/Robot>`, `t=<http://xmlns.com/foaf/0.1/Person>`},
+	},
+	{
+		name:  "limit and offset",
+		query: `SELECT ?s { ?s a foaf:Person } OFFSET 1 LIMIT 1`,
+		vars:  []string{"s"},
+		want:  []string{`s=<http://example.org/bob>`},
+	},
+	{
+		name:  "absent term",
+		query: `SELECT ?s { ?s a :Unicorn }`,
+		vars:  []string{"s"},
+		want:  nil,
+	},
+}
+
+func TestSelect(t *testing.T) {
+	statements, err := decodeTurtle(sparqlGraph, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	g := NewGraph()
+	for _, s := range statements {
+		g.AddStatement(s)
+	}
+
+	for _, test := range sparqlTests {
+		q, err := ParseSelect(sparqlPrefixes + test.query)
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(q.Vars(), test.vars) {
+			t.Errorf("unexpected variables for %q: got:%v want:%v", test.name, q.Vars(), test.vars)
+		}
+		var got []string
+		for _, s := range q.Eval(g) {
+			var parts []string
+			for _, v := range q.Vars() {
+				if t, ok := s[v]; ok {
+					parts = append(parts, v+"="+t.Value)
+				}
+			}
+			got = append(got, strings.Join(parts, " "))
+		}
+		if test.name != "limit and offset" {
+			sort.Strings(got)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected solutions for %q:\ngot: %q\nwant:%q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestParseSelectErrors(t *testing.T) {
+	for _, query := range []string{
+		`ASK { ?s ?p ?o }`,
+		`SELECT { ?s ?p ?o }`,
+		`SELECT ?s { ?s ?p }`,
+		`SELECT ?s { ?s ex:p ?o }`,
+		`SELECT ?s { ?s ?p ?o FILTER(unknown(?s)) }`,
+		`SELECT ?s { ?s ?p ?o FILTER(BOUND("x")) }`,
+		`SELECT ?s { ?s ?p ?o FILTER(STR(?s, ?o)) }`,
+		`SELECT ?s { ?s ?p ?o FILTER(REGEX(?s, "(")) }`,
+		`SELECT ?s { ?s ?p ?o } ORDER BY ?s`,
+		`SELECT ?s { ?s ?p ?o } LIMIT ?s`,
+		`SELECT ?s { GRAPH ?g { ?s ?p ?o } }`,
+		`SELECT ?s { ?s ?p ?o `,
+	} {
+		_, err := ParseSelect(query)
+		if !errors.Is(err, ErrInvalidSPARQL) {
+			t.Errorf("expected invalid SPARQL error for %q, got: %v", query, err)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	lit := func(text, qual string) Term {
+		t, err := NewLiteralTerm(text, qual)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+	iri := func(s string) Term {
+		t, err := NewIRITerm(s)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+	for _, test := range []struct {
+		op   string
+		x, y Term
+		want bool
+		err  bool
+	}{
+		{op: "=", x: lit("1", xsdInteger), y: lit("1.0", xsdDecimal), want: true},
+		{op: "<", x: lit("2", xsdInteger), y: lit("1e1", xsdDouble), want: true},
+		{op: "<", x: lit("a", ""), y: lit("b", xsdString), want: true},
+		{op: ">", x: lit("true", xsdBoolean), y: lit("false", xsdBoolean), want: true},
+		{op: "=", x: iri("ex:a"), y: iri("ex:a"), want: true},
+		{op: "!=", x: iri("ex:a"), y: lit("ex:a", ""), want: true},
+		{op: "=", x: lit("a", "@en"), y: lit("a", "@en"), want: true},
+		{op: "=", x: lit("a", "@en"), y: lit("b", "@en"), err: true},
+		{op: "<", x: iri("ex:a"), y: iri("ex:b"), err: true},
+		{op: "<", x: lit("1", xsdInteger), y: lit("a", ""), err: true},
+	} {
+		got, err := compare(test.op, test.x, test.y)
+		if (err != nil) != test.err {
+			t.Errorf("unexpected error for %s %s %s: %v", test.x.Value, test.op, test.y.Value, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if b, _ := ebv(got); b != test.want {
+			t.Errorf("unexpected result for %s %s %s: got:%t want:%t", test.x.Value, test.op, test.y.Value, b, test.want)
+		}
+	}
+}
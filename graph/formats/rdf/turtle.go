@@ -0,0 +1,600 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// ErrInvalidTurtle is returned when a Turtle document is not valid.
+var ErrInvalidTurtle = errors.New("invalid Turtle")
+
+const (
+	rdfNS = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xsdNS = "http://www.w3.org/2001/XMLSchema#"
+
+	xsdBoolean = xsdNS + "boolean"
+	xsdInteger = xsdNS + "integer"
+	xsdDecimal = xsdNS + "decimal"
+	xsdDouble  = xsdNS + "double"
+	xsdString  = xsdNS + "string"
+)
+
+var (
+	rdfType  = Term{Value: "<" + rdfNS + "type>"}
+	rdfFirst = Term{Value: "<" + rdfNS + "first>"}
+	rdfRest  = Term{Value: "<" + rdfNS + "rest>"}
+	rdfNil   = Term{Value: "<" + rdfNS + "nil>"}
+)
+
+// TurtleDecoder is an RDF Turtle decoder. Statements returned by calls to
+// the Unmarshal method have their Terms' UID fields set in the same way as
+// statements returned by a Decoder.
+//
+// The complete input is read and parsed by the first call to Unmarshal.
+// Blank node labels in the input are retained and anonymous blank nodes,
+// including those created for blank node property lists and collections,
+// are given labels that are not used in the input.
+type TurtleDecoder struct {
+	r    io.Reader
+	base string
+
+	parsed     bool
+	err        error
+	statements []*Statement
+	prefixes   map[string]string
+
+	ids map[string]int64
+}
+
+// NewTurtleDecoder returns a new TurtleDecoder that takes input from r.
+// If base is not empty, it is used as the base IRI for resolving relative
+// IRI references until a base directive is read from the input.
+func NewTurtleDecoder(r io.Reader, base string) *TurtleDecoder {
+	return &TurtleDecoder{r: r, base: base, ids: make(map[string]int64)}
+}
+
+// Unmarshal returns the next statement from the input.
+func (dec *TurtleDecoder) Unmarshal() (*Statement, error) {
+	if !dec.parsed {
+		dec.parsed = true
+		var data []byte
+		data, dec.err = io.ReadAll(dec.r)
+		if dec.err == nil {
+			dec.statements, dec.prefixes, dec.err = parseTurtle(string(data), dec.base)
+		}
+	}
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	if len(dec.statements) == 0 {
+		return nil, io.EOF
+	}
+	s := dec.statements[0]
+	dec.statements[0] = nil
+	dec.statements = dec.statements[1:]
+	s.Subject.UID = dec.idFor(s.Subject.Value)
+	s.Object.UID = dec.idFor(s.Object.Value)
+	s.Predicate.UID = dec.idFor(s.Predicate.Value)
+	return s, nil
+}
+
+func (dec *TurtleDecoder) idFor(s string) int64 {
+	id, ok := dec.ids[s]
+	if ok {
+		return id
+	}
+	id = int64(len(dec.ids)) + 1
+	dec.ids[s] = id
+	return id
+}
+
+// Terms returns the mapping between terms and graph node IDs constructed
+// during decoding the RDF statement stream.
+func (dec *TurtleDecoder) Terms() map[string]int64 {
+	return dec.ids
+}
+
+// Prefixes returns the prefix declarations of the input, mapping prefix
+// names to namespace IRIs. Prefixes returns nil until the first call to
+// Unmarshal.
+func (dec *TurtleDecoder) Prefixes() map[string]string {
+	return dec.prefixes
+}
+
+// parseTurtle returns the statements and prefix declarations of the Turtle
+// document in src.
+func parseTurtle(src, base string) ([]*Statement, map[string]string, error) {
+	p := &tripleParser{
+		lex:      newLexer(src, false, ErrInvalidTurtle),
+		prefixes: make(map[string]string),
+	}
+	if base != "" {
+		u, err := url.Parse(base)
+		if err != nil || !u.IsAbs() {
+			return nil, nil, fmt.Errorf("rdf: invalid base IRI %q", base)
+		}
+		p.base = u
+	}
+
+	// Find the labels used in the document so that
+	// anonymous blank nodes do not collide with them.
+	used := make(map[string]bool)
+	for l := newLexer(src, false, ErrInvalidTurtle); ; {
+		t, err := l.next()
+		if err != nil || t.kind == tokEOF {
+			break
+		}
+		if t.kind == tokBlank {
+			used[t.text] = true
+		}
+	}
+	var n int
+	p.newBlank = func() node {
+		for {
+			n++
+			label := fmt.Sprintf("b%d", n)
+			if !used[label] {
+				return node{Term: Term{Value: blankPrefix + label}}
+			}
+		}
+	}
+	p.blank = func(label string) node {
+		return node{Term: Term{Value: blankPrefix + label}}
+	}
+
+	var statements []*Statement
+	strings := make(store)
+	p.emit = func(s, pred, o node) {
+		statements = append(statements, &Statement{
+			Subject:   Term{Value: strings.intern(s.Value)},
+			Predicate: Term{Value: strings.intern(pred.Value)},
+			Object:    Term{Value: strings.intern(o.Value)},
+		})
+	}
+
+	for {
+		t, err := p.lex.peek()
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case t.kind == tokEOF:
+			return statements, p.prefixes, nil
+		case t.kind == tokLang && t.text == "prefix":
+			p.lex.next()
+			err = p.prefix()
+			if err == nil {
+				err = p.expect(".")
+			}
+		case t.kind == tokLang && t.text == "base":
+			p.lex.next()
+			err = p.setBase()
+			if err == nil {
+				err = p.expect(".")
+			}
+		case t.isKeyword("PREFIX"):
+			p.lex.next()
+			err = p.prefix()
+		case t.isKeyword("BASE"):
+			p.lex.next()
+			err = p.setBase()
+		default:
+			err = p.triples()
+			if err == nil {
+				err = p.expect(".")
+			}
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// node is a term or variable in a Turtle or SPARQL triple.
+type node struct {
+	Term
+
+	// variable is the name of a SPARQL variable.
+	// If variable is empty the node is a term.
+	variable string
+}
+
+// tripleParser parses the triples syntax shared by Turtle and SPARQL.
+type tripleParser struct {
+	lex      *lexer
+	base     *url.URL
+	prefixes map[string]string
+
+	// variable returns the node for a variable. If
+	// variable is nil, variables are not allowed.
+	variable func(name string) node
+
+	// blank returns the node for a labeled blank node
+	// and newBlank returns a new anonymous blank node.
+	blank    func(label string) node
+	newBlank func() node
+
+	// emit is called for each parsed triple.
+	emit func(s, p, o node)
+}
+
+// expect consumes the next token, returning an error if it is not the
+// punctuation want.
+func (p *tripleParser) expect(want string) error {
+	t, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	if !t.isPunct(want) {
+		return p.lex.unexpected(t)
+	}
+	return nil
+}
+
+// prefix parses the name and IRI of a prefix declaration.
+func (p *tripleParser) prefix() error {
+	name, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	if name.kind != tokPName || name.local != "" {
+		return p.lex.unexpected(name)
+	}
+	t, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	if t.kind != tokIRI {
+		return p.lex.unexpected(t)
+	}
+	iri, err := p.resolve(t)
+	if err != nil {
+		return err
+	}
+	p.prefixes[name.text] = iri
+	return nil
+}
+
+// setBase parses the IRI of a base declaration.
+func (p *tripleParser) setBase() error {
+	t, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	if t.kind != tokIRI {
+		return p.lex.unexpected(t)
+	}
+	iri, err := p.resolve(t)
+	if err != nil {
+		return err
+	}
+	p.base, err = url.Parse(iri)
+	if err != nil {
+		return p.lex.errorf(t.pos, "invalid base IRI %q", iri)
+	}
+	return nil
+}
+
+// resolve returns the IRI reference in t resolved against the base IRI.
+func (p *tripleParser) resolve(t token) (string, error) {
+	u, err := url.Parse(t.text)
+	if err != nil {
+		return "", p.lex.errorf(t.pos, "invalid IRI %q", t.text)
+	}
+	if u.IsAbs() {
+		return t.text, nil
+	}
+	if p.base == nil {
+		return "", p.lex.errorf(t.pos, "relative IRI %q without base", t.text)
+	}
+	return p.base.ResolveReference(u).String(), nil
+}
+
+// iriText returns the IRI of an IRI reference or prefixed name token.
+func (p *tripleParser) iriText(t token) (string, error) {
+	switch t.kind {
+	case tokIRI:
+		return p.resolve(t)
+	case tokPName:
+		ns, ok := p.prefixes[t.text]
+		if !ok {
+			return "", p.lex.errorf(t.pos, "undeclared prefix %q", t.text)
+		}
+		return ns + t.local, nil
+	default:
+		return "", p.lex.unexpected(t)
+	}
+}
+
+// iri returns the term for an IRI reference or prefixed name token.
+func (p *tripleParser) iri(t token) (node, error) {
+	iri, err := p.iriText(t)
+	if err != nil {
+		return node{}, err
+	}
+	term, err := NewIRITerm(iri)
+	if err == nil {
+		// Check that escapes have not introduced
+		// characters that are invalid in an IRI.
+		_, _, _, err = term.Parts()
+	}
+	if err != nil {
+		return node{}, p.lex.errorf(t.pos, "invalid IRI %q", iri)
+	}
+	return node{Term: term}, nil
+}
+
+// isVerb returns whether t may start a predicate.
+func (p *tripleParser) isVerb(t token) bool {
+	switch t.kind {
+	case tokIRI, tokPName:
+		return true
+	case tokWord:
+		return t.text == "a"
+	case tokVar:
+		return p.variable != nil
+	}
+	return false
+}
+
+// triples parses a subject and its predicate-object list.
+func (p *tripleParser) triples() error {
+	t, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	var s node
+	switch {
+	case t.isPunct("["):
+		var empty bool
+		s, empty, err = p.blankNodePropertyList()
+		if err != nil {
+			return err
+		}
+		if !empty {
+			// The predicate-object list is optional
+			// after a blank node property list.
+			t, err := p.lex.peek()
+			if err != nil {
+				return err
+			}
+			if !p.isVerb(t) {
+				return nil
+			}
+		}
+	case t.isPunct("("):
+		s, err = p.collection()
+	case t.kind == tokIRI, t.kind == tokPName:
+		s, err = p.iri(t)
+	case t.kind == tokBlank:
+		s = p.blank(t.text)
+	case t.kind == tokVar && p.variable != nil:
+		s = p.variable(t.text)
+	default:
+		return p.lex.unexpected(t)
+	}
+	if err != nil {
+		return err
+	}
+	return p.predicateObjectList(s)
+}
+
+// predicateObjectList parses the predicates and objects of the subject s.
+func (p *tripleParser) predicateObjectList(s node) error {
+	for {
+		t, err := p.lex.next()
+		if err != nil {
+			return err
+		}
+		var v node
+		switch {
+		case t.kind == tokWord && t.text == "a":
+			v = node{Term: rdfType}
+		case t.kind == tokVar && p.variable != nil:
+			v = p.variable(t.text)
+		default:
+			v, err = p.iri(t)
+			if err != nil {
+				return err
+			}
+		}
+		err = p.objectList(s, v)
+		if err != nil {
+			return err
+		}
+
+		t, err = p.lex.peek()
+		if err != nil {
+			return err
+		}
+		if !t.isPunct(";") {
+			return nil
+		}
+		for t.isPunct(";") {
+			p.lex.next()
+			t, err = p.lex.peek()
+			if err != nil {
+				return err
+			}
+		}
+		if !p.isVerb(t) {
+			return nil
+		}
+	}
+}
+
+// objectList parses the objects of the subject s and predicate v.
+func (p *tripleParser) objectList(s, v node) error {
+	for {
+		o, err := p.object()
+		if err != nil {
+			return err
+		}
+		p.emit(s, v, o)
+		t, err := p.lex.peek()
+		if err != nil {
+			return err
+		}
+		if !t.isPunct(",") {
+			return nil
+		}
+		p.lex.next()
+	}
+}
+
+// object parses an object.
+func (p *tripleParser) object() (node, error) {
+	t, err := p.lex.next()
+	if err != nil {
+		return node{}, err
+	}
+	switch {
+	case t.kind == tokIRI, t.kind == tokPName:
+		return p.iri(t)
+	case t.kind == tokBlank:
+		return p.blank(t.text), nil
+	case t.kind == tokVar && p.variable != nil:
+		return p.variable(t.text), nil
+	case t.isPunct("["):
+		o, _, err := p.blankNodePropertyList()
+		return o, err
+	case t.isPunct("("):
+		return p.collection()
+	default:
+		return p.literal(t)
+	}
+}
+
+// literal parses the literal starting with t.
+func (p *tripleParser) literal(t token) (node, error) {
+	var (
+		text, qual string
+		err        error
+	)
+	switch t.kind {
+	case tokString:
+		text = t.text
+		next, err := p.lex.peek()
+		if err != nil {
+			return node{}, err
+		}
+		switch {
+		case next.kind == tokLang:
+			p.lex.next()
+			qual = "@" + next.text
+		case next.isPunct("^^"):
+			p.lex.next()
+			dt, err := p.lex.next()
+			if err != nil {
+				return node{}, err
+			}
+			qual, err = p.iriText(dt)
+			if err != nil {
+				return node{}, err
+			}
+		}
+	case tokInteger, tokDecimal, tokDouble:
+		text, qual = t.text, numericType(t.kind)
+	case tokPunct:
+		if t.text != "+" && t.text != "-" {
+			return node{}, p.lex.unexpected(t)
+		}
+		num, err := p.lex.next()
+		if err != nil {
+			return node{}, err
+		}
+		if num.pos != t.pos+1 || numericType(num.kind) == "" {
+			return node{}, p.lex.unexpected(t)
+		}
+		text, qual = t.text+num.text, numericType(num.kind)
+	case tokWord:
+		if t.text != "true" && t.text != "false" {
+			return node{}, p.lex.unexpected(t)
+		}
+		text, qual = t.text, xsdBoolean
+	default:
+		return node{}, p.lex.unexpected(t)
+	}
+	term, err := NewLiteralTerm(text, qual)
+	if err != nil {
+		return node{}, p.lex.errorf(t.pos, "invalid literal: %v", err)
+	}
+	return node{Term: term}, nil
+}
+
+// numericType returns the datatype IRI for a numeric token kind, or the
+// empty string if the kind is not numeric.
+func numericType(kind tokenKind) string {
+	switch kind {
+	case tokInteger:
+		return xsdInteger
+	case tokDecimal:
+		return xsdDecimal
+	case tokDouble:
+		return xsdDouble
+	default:
+		return ""
+	}
+}
+
+// blankNodePropertyList parses a blank node property list after its opening
+// bracket, returning the blank node and whether the list was empty.
+func (p *tripleParser) blankNodePropertyList() (b node, empty bool, err error) {
+	b = p.newBlank()
+	t, err := p.lex.peek()
+	if err != nil {
+		return node{}, false, err
+	}
+	if t.isPunct("]") {
+		p.lex.next()
+		return b, true, nil
+	}
+	err = p.predicateObjectList(b)
+	if err != nil {
+		return node{}, false, err
+	}
+	return b, false, p.expect("]")
+}
+
+// collection parses a collection after its opening parenthesis, returning
+// the head of the list.
+func (p *tripleParser) collection() (node, error) {
+	var items []node
+	for {
+		t, err := p.lex.peek()
+		if err != nil {
+			return node{}, err
+		}
+		if t.isPunct(")") {
+			p.lex.next()
+			break
+		}
+		o, err := p.object()
+		if err != nil {
+			return node{}, err
+		}
+		items = append(items, o)
+	}
+	if len(items) == 0 {
+		return node{Term: rdfNil}, nil
+	}
+	head := p.newBlank()
+	cur := head
+	for i, item := range items {
+		p.emit(cur, node{Term: rdfFirst}, item)
+		if i == len(items)-1 {
+			p.emit(cur, node{Term: rdfRest}, node{Term: rdfNil})
+			break
+		}
+		next := p.newBlank()
+		p.emit(cur, node{Term: rdfRest}, next)
+		cur = next
+	}
+	return head, nil
+}
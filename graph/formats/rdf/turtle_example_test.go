@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf_test
+
+import (
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"gonum.org/v1/gonum/graph/formats/rdf"
+)
+
+func ExampleWriteTurtle() {
+	const data = `@prefix foaf: <http://xmlns.com/foaf/0.1/> .
+@prefix ex: <http://example.org/> .
+
+ex:alice a foaf:Person ;
+	foaf:name "Alice" ;
+	foaf:knows [ foaf:name "Bob" ] .
+`
+
+	dec := rdf.NewTurtleDecoder(strings.NewReader(data), "")
+	var statements []*rdf.Statement
+	for {
+		s, err := dec.Unmarshal()
+		if err != nil {
+			if err != io.EOF {
+				log.Fatalf("error during decoding: %v", err)
+			}
+			break
+		}
+		statements = append(statements, s)
+	}
+
+	// Write the statements as N-Triples and then as Turtle
+	// using the prefixes declared in the input.
+	err := rdf.WriteNTriples(os.Stdout, statements)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Stdout.WriteString("\n")
+	err = rdf.WriteTurtle(os.Stdout, statements, dec.Prefixes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// <http://example.org/alice> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://xmlns.com/foaf/0.1/Person> .
+	// <http://example.org/alice> <http://xmlns.com/foaf/0.1/name> "Alice" .
+	// _:b1 <http://xmlns.com/foaf/0.1/name> "Bob" .
+	// <http://example.org/alice> <http://xmlns.com/foaf/0.1/knows> _:b1 .
+	//
+	// @prefix ex: <http://example.org/> .
+	// @prefix foaf: <http://xmlns.com/foaf/0.1/> .
+	//
+	// ex:alice a foaf:Person ;
+	// 	foaf:name "Alice" ;
+	// 	foaf:knows [
+	// 		foaf:name "Bob"
+	// 	] .
+}
@@ -0,0 +1,270 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rdf
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+var turtleTests = []struct {
+	name string
+	base string
+	src  string
+	want string
+}{
+	{
+		name: "prefixes and base",
+		base: "http://example.org/doc",
+		src: `@prefix ex: <http://example.org/ns#> .
+PREFIX foaf: <http://xmlns.com/foaf/0.1/>
+@base <http://example.org/people/> .
+<alice> a foaf:Person ; foaf:knows <#bob>, <../carol> ; ex:a.b ex:c.d .
+`,
+		want: `<http://example.org/people/alice> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://xmlns.com/foaf/0.1/Person> .
+<http://example.org/people/alice> <http://xmlns.com/foaf/0.1/knows> <http://example.org/people/#bob> .
+<http://example.org/people/alice> <http://xmlns.com/foaf/0.1/knows> <http://example.org/carol> .
+<http://example.org/people/alice> <http://example.org/ns#a.b> <http://example.org/ns#c.d> .
+`,
+	},
+	{
+		name: "literals",
+		src: `@prefix : <http://example.org/> .
+:s :p "plain", 'single', "tagged"@en-GB, "typed"^^:type, """long
+"string\"""", '\u00e9\\', 42, -7, +1.5, .5, 1e3, 2.5E-1, true, false .
+`,
+		want: `<http://example.org/s> <http://example.org/p> "plain" .
+<http://example.org/s> <http://example.org/p> "single" .
+<http://example.org/s> <http://example.org/p> "tagged"@en-GB .
+<http://example.org/s> <http://example.org/p> "typed"^^<http://example.org/type> .
+<http://example.org/s> <http://example.org/p> "long\n\"string\"" .
+<http://example.org/s> <http://example.org/p> "é\\" .
+<http://example.org/s> <http://example.org/p> "42"^^<http://www.w3.org/2001/XMLSchema#integer> .
+<http://example.org/s> <http://example.org/p> "-7"^^<http://www.w3.org/2001/XMLSchema#integer> .
+<http://example.org/s> <http://example.org/p> "+1.5"^^<http://www.w3.org/2001/XMLSchema#decimal> .
+<http://example.org/s> <http://example.org/p> ".5"^^<http://www.w3.org/2001/XMLSchema#decimal> .
+<http://example.org/s> <http://example.org/p> "1e3"^^<http://www.w3.org/2001/XMLSchema#double> .
+<http://example.org/s> <http://example.org/p> "2.5E-1"^^<http://www.w3.org/2001/XMLSchema#double> .
+<http://example.org/s> <http://example.org/p> "true"^^<http://www.w3.org/2001/XMLSchema#boolean> .
+<http://example.org/s> <http://example.org/p> "false"^^<http://www.w3.org/2001/XMLSchema#boolean> .
+`,
+	},
+	{
+		name: "blank nodes",
+		src: `@prefix : <http://example.org/> .
+# Anonymous nodes must not reuse the b1 label.
+_:b1 :knows [ :name "Bob" ; :knows [] ] .
+[ :name "Eve" ] .
+[] :p :o .
+`,
+		want: `_:b2 <http://example.org/name> "Bob" .
+_:b2 <http://example.org/knows> _:b3 .
+_:b1 <http://example.org/knows> _:b2 .
+_:b4 <http://example.org/name> "Eve" .
+_:b5 <http://example.org/p> <http://example.org/o> .
+`,
+	},
+	{
+		name: "collections",
+		src: `@prefix : <http://example.org/> .
+:s :p ( 1 :o ( ) ) .
+( :a ) :q :r .
+`,
+		want: `_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> "1"^^<http://www.w3.org/2001/XMLSchema#integer> .
+_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> _:b2 .
+_:b2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> <http://example.org/o> .
+_:b2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> _:b3 .
+_:b3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .
+_:b3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .
+<http://example.org/s> <http://example.org/p> _:b1 .
+_:b4 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> <http://example.org/a> .
+_:b4 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .
+_:b4 <http://example.org/q> <http://example.org/r> .
+`,
+	},
+	{
+		name: "repeated semicolons",
+		src:  `<http://example.org/s> <http://example.org/p> <http://example.org/o> ;; <http://example.org/q> "x" ; .`,
+		want: `<http://example.org/s> <http://example.org/p> <http://example.org/o> .
+<http://example.org/s> <http://example.org/q> "x" .
+`,
+	},
+}
+
+func TestTurtleDecoder(t *testing.T) {
+	for _, test := range turtleTests {
+		statements, err := decodeTurtle(test.src, test.base)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.name, err)
+			continue
+		}
+		var buf bytes.Buffer
+		err = WriteNTriples(&buf, statements)
+		if err != nil {
+			t.Errorf("unexpected error writing %q: %v", test.name, err)
+			continue
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("unexpected statements for %q:\ngot:\n%s\nwant:\n%s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestTurtleRoundTrip(t *testing.T) {
+	prefixes := map[string]string{
+		"":    "http://example.org/",
+		"ex":  "http://example.org/ns#",
+		"xsd": xsdNS,
+	}
+	for _, test := range turtleTests {
+		want, err := decodeTurtle(test.src, test.base)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", test.name, err)
+		}
+		var buf bytes.Buffer
+		err = WriteTurtle(&buf, want, prefixes)
+		if err != nil {
+			t.Errorf("unexpected error writing %q: %v", test.name, err)
+			continue
+		}
+		got, err := decodeTurtle(buf.String(), "")
+		if err != nil {
+			t.Errorf("unexpected error reading written %q: %v\n%s", test.name, err, &buf)
+			continue
+		}
+		if !Isomorphic(got, want, false, sha256.New()) {
+			t.Errorf("round trip of %q is not isomorphic:\n%s", test.name, &buf)
+		}
+	}
+}
+
+func TestWriteTurtle(t *testing.T) {
+	const src = `@prefix : <http://example.org/> .
+:alice a :Person ; :name "Alice"@en, "Al" ; :age 42 ; :score 1.5 ;
+	:address [ :city "Paris" ; :tag [] ] .
+_:x :p _:y .
+_:y :p _:x .
+:bob :p <http://example.org/a/b%20> .
+`
+	const want = `@prefix : <http://example.org/> .
+
+:alice a :Person ;
+	:name "Alice"@en, "Al" ;
+	:age 42 ;
+	:score 1.5 ;
+	:address [
+		:city "Paris" ;
+		:tag []
+	] .
+
+:bob :p <http://example.org/a/b%20> .
+
+_:x :p [
+		:p _:x
+	] .
+`
+	statements, err := decodeTurtle(src, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var buf bytes.Buffer
+	err = WriteTurtle(&buf, statements, map[string]string{"": "http://example.org/"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected Turtle:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteLabeled(t *testing.T) {
+	s, err := ParseNQuad(`<ex:s> <ex:p> <ex:o> <ex:g> .`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, write := range []func(io.Writer, []*Statement) error{
+		WriteNTriples,
+		func(w io.Writer, s []*Statement) error { return WriteTurtle(w, s, nil) },
+	} {
+		if err := write(io.Discard, []*Statement{s}); err == nil {
+			t.Error("expected error writing labeled statement")
+		}
+	}
+}
+
+func TestTurtleErrors(t *testing.T) {
+	for _, src := range []string{
+		`<http://example.org/s> <http://example.org/p> <http://example.org/o>`,
+		`ex:s <http://example.org/p> <http://example.org/o> .`,
+		`<s> <http://example.org/p> <http://example.org/o> .`,
+		`<http://example.org/s> <http://example.org/p> "unterminated .`,
+		`<http://example.org/s> <http://example.org/p> "bad\q" .`,
+		`"literal" <http://example.org/p> <http://example.org/o> .`,
+		`<http://example.org/s> <http://example.org/p> ?o .`,
+		`<http://example.org/s> <http://example.org/p> <http://example.org/o o> .`,
+		`[] .`,
+		`<http://example.org/s> <http://example.org/p> <http://example.org/a\u0020b> .`,
+		`@prefix ex <http://example.org/> .`,
+	} {
+		_, err := decodeTurtle(src, "")
+		if !errors.Is(err, ErrInvalidTurtle) {
+			t.Errorf("expected invalid Turtle error for %q, got: %v", src, err)
+		}
+	}
+}
+
+func TestTurtleDecoderIDs(t *testing.T) {
+	dec := NewTurtleDecoder(strings.NewReader(`@prefix : <http://example.org/> .
+:a :p :b . :b :p :a .`), "")
+	var ids []int64
+	for {
+		s, err := dec.Unmarshal()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, s.Subject.UID, s.Predicate.UID, s.Object.UID)
+	}
+	want := []int64{1, 3, 2, 2, 3, 1}
+	if !equalInt64s(ids, want) {
+		t.Errorf("unexpected IDs: got:%v want:%v", ids, want)
+	}
+	if got := dec.Prefixes()[""]; got != "http://example.org/" {
+		t.Errorf("unexpected prefix: got:%q want:%q", got, "http://example.org/")
+	}
+}
+
+func equalInt64s(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if b[i] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeTurtle(src, base string) ([]*Statement, error) {
+	dec := NewTurtleDecoder(strings.NewReader(src), base)
+	var statements []*Statement
+	for {
+		s, err := dec.Unmarshal()
+		if err == io.EOF {
+			return statements, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, s)
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package isomorphism provides graph and subgraph isomorphism functions.
+//
+// Mappings between graphs are found with the VF2++ algorithm, which supports
+// graph isomorphism, induced subgraph isomorphism and subgraph monomorphism
+// for directed and undirected graphs. The Weisfeiler-Lehman hash can be used
+// to quickly rule out the isomorphism of pairs of graphs.
+package isomorphism // import "gonum.org/v1/gonum/graph/isomorphism"
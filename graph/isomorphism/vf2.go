@@ -0,0 +1,573 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package isomorphism
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Kind specifies the kind of mapping found by a Matcher.
+type Kind int
+
+const (
+	// Isomorphism is a bijection between the nodes of two graphs
+	// such that two nodes are adjacent if and only if their
+	// images are adjacent.
+	Isomorphism Kind = iota
+
+	// InducedSubgraph is an isomorphism between the pattern graph
+	// and a node-induced subgraph of the target graph.
+	InducedSubgraph
+
+	// Monomorphism is an injective mapping from the nodes of the
+	// pattern graph to the nodes of the target graph such that
+	// the images of adjacent nodes are adjacent. The target may
+	// have edges between images of non-adjacent nodes.
+	Monomorphism
+)
+
+// NodeMatch returns whether the node u of the pattern graph may be mapped to
+// the node v of the target graph.
+type NodeMatch func(u, v graph.Node) bool
+
+// EdgeMatch returns whether the edge e of the pattern graph may be mapped to
+// the edge f of the target graph. The From and To nodes of e are mapped to the
+// From and To nodes of f respectively.
+type EdgeMatch func(e, f graph.Edge) bool
+
+// Isomorphic returns whether the graphs g1 and g2 are isomorphic with node
+// and edge compatibility given by nodeMatch and edgeMatch. If nodeMatch or
+// edgeMatch is nil, all nodes or edges are compatible. Isomorphic will panic
+// if exactly one of g1 and g2 is a graph.Directed.
+func Isomorphic(g1, g2 graph.Graph, nodeMatch NodeMatch, edgeMatch EdgeMatch) bool {
+	_, ok := Mapping(g1, g2, nodeMatch, edgeMatch)
+	return ok
+}
+
+// Mapping returns an isomorphism from the nodes of g1 to the nodes of g2 as a
+// mapping between node IDs, and whether the graphs are isomorphic. Node and edge
+// compatibility is given by nodeMatch and edgeMatch as for Isomorphic.
+// Mapping will panic if exactly one of g1 and g2 is a graph.Directed.
+func Mapping(g1, g2 graph.Graph, nodeMatch NodeMatch, edgeMatch EdgeMatch) (map[int64]int64, bool) {
+	m := NewMatcher(g1, g2, Isomorphism, nodeMatch, edgeMatch)
+	if !m.Next() {
+		return nil, false
+	}
+	return m.Mapping(), true
+}
+
+// Matcher is an iterator over the mappings of a kind from the nodes of a
+// pattern graph to the nodes of a target graph, found using the VF2++
+// algorithm.
+//
+// See Jüttner and Madarasi doi:10.1016/j.dam.2018.02.018 for details of
+// the algorithm.
+type Matcher struct {
+	kind Kind
+
+	pattern, target *adjacency
+	nodeMatch       NodeMatch
+	edgeMatch       EdgeMatch
+
+	// order holds the pattern nodes in the order they are
+	// matched and parent holds an earlier node in the order
+	// adjacent to each node in the order, or -1.
+	order  []int
+	parent []int
+
+	// color holds the stable Weisfeiler-Lehman colors of the
+	// pattern and target nodes when searching for isomorphisms.
+	patternColor, targetColor []int
+
+	// core holds the mapped node of each node, or -1, and
+	// terminal holds the depth at which each node became
+	// mapped or adjacent to a mapped node plus one, or 0.
+	patternCore, targetCore         []int
+	patternTerminal, targetTerminal []int
+
+	// candidates holds the candidate target nodes at
+	// each depth of the search and next holds the index
+	// of the next candidate to consider.
+	candidates [][]int
+	next       []int
+	depth      int
+
+	started, done bool
+}
+
+// NewMatcher returns a Matcher that iterates over the mappings of the given
+// kind from the nodes of pattern to the nodes of target. Node and edge
+// compatibility is given by nodeMatch and edgeMatch. If nodeMatch or edgeMatch
+// is nil, all nodes or edges are compatible.
+//
+// NewMatcher will panic if exactly one of pattern and target is a
+// graph.Directed.
+func NewMatcher(pattern, target graph.Graph, kind Kind, nodeMatch NodeMatch, edgeMatch EdgeMatch) *Matcher {
+	_, pd := pattern.(graph.Directed)
+	_, td := target.(graph.Directed)
+	if pd != td {
+		panic("isomorphism: mixed directed and undirected graphs")
+	}
+	m := &Matcher{
+		kind:      kind,
+		pattern:   newAdjacency(pattern),
+		target:    newAdjacency(target),
+		nodeMatch: nodeMatch,
+		edgeMatch: edgeMatch,
+	}
+	m.order, m.parent = m.pattern.matchingOrder()
+
+	n1, n2 := len(m.pattern.nodes), len(m.target.nodes)
+	m.patternCore = fill(make([]int, n1), -1)
+	m.targetCore = fill(make([]int, n2), -1)
+	m.patternTerminal = make([]int, n1)
+	m.targetTerminal = make([]int, n2)
+	m.candidates = make([][]int, n1)
+	m.next = make([]int, n1)
+	return m
+}
+
+// Next advances the Matcher to the next mapping and returns whether a
+// mapping was found.
+func (m *Matcher) Next() bool {
+	if m.done {
+		return false
+	}
+	if !m.started {
+		m.started = true
+		if !m.possible() {
+			m.done = true
+			return false
+		}
+		if len(m.order) == 0 {
+			// The empty mapping is the only mapping.
+			m.done = true
+			return true
+		}
+		m.depth = 0
+		m.candidates[0] = m.candidatesAt(0)
+		m.next[0] = 0
+	} else {
+		m.depth = len(m.order) - 1
+		m.unmap(m.depth)
+	}
+
+	for m.depth >= 0 {
+		d := m.depth
+		if m.next[d] == len(m.candidates[d]) {
+			m.depth--
+			if m.depth >= 0 {
+				m.unmap(m.depth)
+			}
+			continue
+		}
+		v := m.candidates[d][m.next[d]]
+		m.next[d]++
+		u := m.order[d]
+		if !m.feasible(u, v) {
+			continue
+		}
+		m.mapNode(d, u, v)
+		if d == len(m.order)-1 {
+			return true
+		}
+		m.depth++
+		m.candidates[m.depth] = m.candidatesAt(m.depth)
+		m.next[m.depth] = 0
+	}
+	m.done = true
+	return false
+}
+
+// Mapping returns the current mapping from pattern node IDs to target node
+// IDs. Mapping returns nil if Next has not been called or has returned false.
+func (m *Matcher) Mapping() map[int64]int64 {
+	if !m.started || (m.done && len(m.order) != 0) {
+		return nil
+	}
+	mapping := make(map[int64]int64, len(m.order))
+	for u, v := range m.patternCore {
+		mapping[m.pattern.nodes[u].ID()] = m.target.nodes[v].ID()
+	}
+	return mapping
+}
+
+// possible returns whether a mapping may exist based on the sizes of the
+// graphs, and computes the node colors for isomorphism.
+func (m *Matcher) possible() bool {
+	p, t := m.pattern, m.target
+	if len(p.nodes) > len(t.nodes) {
+		return false
+	}
+	if m.kind != Isomorphism {
+		return true
+	}
+	if len(p.nodes) != len(t.nodes) || p.edges != t.edges {
+		return false
+	}
+	colors := stableColors(p, t)
+	m.patternColor, m.targetColor = colors[0], colors[1]
+	count := make(map[int]int)
+	for _, c := range m.patternColor {
+		count[c]++
+	}
+	for _, c := range m.targetColor {
+		count[c]--
+	}
+	for _, n := range count {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// candidatesAt returns the candidate target nodes for the pattern node at
+// the given depth of the matching order.
+func (m *Matcher) candidatesAt(depth int) []int {
+	u := m.order[depth]
+	var from []int
+	if p := m.parent[depth]; p >= 0 {
+		// The image of u must be adjacent to the image of
+		// its parent in the same direction.
+		v := m.patternCore[p]
+		if m.pattern.hasEdge(p, u) {
+			from = m.target.out[v]
+		} else {
+			from = m.target.in[v]
+		}
+	} else {
+		from = m.target.all
+	}
+	var cands []int
+	for _, v := range from {
+		if m.targetCore[v] >= 0 {
+			continue
+		}
+		if m.patternColor != nil && m.patternColor[u] != m.targetColor[v] {
+			continue
+		}
+		cands = append(cands, v)
+	}
+	return cands
+}
+
+// feasible returns whether the pattern node u may be mapped to the target
+// node v given the current partial mapping.
+func (m *Matcher) feasible(u, v int) bool {
+	p, t := m.pattern, m.target
+	exact := m.kind != Monomorphism
+	if m.kind == Isomorphism {
+		if len(p.out[u]) != len(t.out[v]) || len(p.in[u]) != len(t.in[v]) {
+			return false
+		}
+	} else if len(p.out[u]) > len(t.out[v]) || len(p.in[u]) > len(t.in[v]) {
+		return false
+	}
+	if m.nodeMatch != nil && !m.nodeMatch(p.nodes[u], t.nodes[v]) {
+		return false
+	}
+
+	// Self loops.
+	pSelf, tSelf := p.hasEdge(u, u), t.hasEdge(v, v)
+	if pSelf && !tSelf || exact && tSelf && !pSelf {
+		return false
+	}
+	if pSelf && !m.edgesMatch(u, u, v, v) {
+		return false
+	}
+
+	// Edges to mapped nodes must be preserved, and for induced
+	// subgraphs and isomorphisms must also be reflected.
+	for _, w := range p.out[u] {
+		if x := m.patternCore[w]; x >= 0 && (!t.hasEdge(v, x) || !m.edgesMatch(u, w, v, x)) {
+			return false
+		}
+	}
+	if p.directed {
+		for _, w := range p.in[u] {
+			if x := m.patternCore[w]; x >= 0 && (!t.hasEdge(x, v) || !m.edgesMatch(w, u, x, v)) {
+				return false
+			}
+		}
+	}
+	if exact {
+		for _, x := range t.out[v] {
+			if w := m.targetCore[x]; w >= 0 && !p.hasEdge(u, w) {
+				return false
+			}
+		}
+		if t.directed {
+			for _, x := range t.in[v] {
+				if w := m.targetCore[x]; w >= 0 && !p.hasEdge(w, u) {
+					return false
+				}
+			}
+		}
+	}
+
+	// Look ahead at the unmapped neighbors of u and v.
+	if !m.lookAhead(p.out[u], t.out[v], exact) {
+		return false
+	}
+	if p.directed && !m.lookAhead(p.in[u], t.in[v], exact) {
+		return false
+	}
+	return true
+}
+
+// lookAhead returns whether the unmapped pattern neighbors of a node can be
+// mapped to the unmapped target neighbors of its candidate image. Neighbors
+// adjacent to mapped nodes can only be mapped to neighbors adjacent to mapped
+// nodes, and for exact mappings the converse also holds.
+func (m *Matcher) lookAhead(pNeighbors, tNeighbors []int, exact bool) bool {
+	var pTerm, pNew, tTerm, tNew int
+	for _, w := range pNeighbors {
+		if m.patternCore[w] >= 0 {
+			continue
+		}
+		if m.patternTerminal[w] > 0 {
+			pTerm++
+		} else {
+			pNew++
+		}
+	}
+	for _, x := range tNeighbors {
+		if m.targetCore[x] >= 0 {
+			continue
+		}
+		if m.targetTerminal[x] > 0 {
+			tTerm++
+		} else {
+			tNew++
+		}
+	}
+	switch {
+	case m.kind == Isomorphism:
+		return pTerm == tTerm && pNew == tNew
+	case exact:
+		return pTerm <= tTerm && pNew <= tNew
+	default:
+		return pTerm <= tTerm && pTerm+pNew <= tTerm+tNew
+	}
+}
+
+// edgesMatch returns whether the pattern edge from u to w may be mapped to the
+// target edge from v to x.
+func (m *Matcher) edgesMatch(u, w, v, x int) bool {
+	if m.edgeMatch == nil {
+		return true
+	}
+	p, t := m.pattern, m.target
+	e := p.g.Edge(p.nodes[u].ID(), p.nodes[w].ID())
+	f := t.g.Edge(t.nodes[v].ID(), t.nodes[x].ID())
+	return m.edgeMatch(e, f)
+}
+
+// mapNode maps the pattern node u to the target node v at the given depth.
+func (m *Matcher) mapNode(depth, u, v int) {
+	m.patternCore[u] = v
+	m.targetCore[v] = u
+	mark(m.patternTerminal, m.pattern, u, depth+1)
+	mark(m.targetTerminal, m.target, v, depth+1)
+}
+
+// unmap removes the mapping made at the given depth.
+func (m *Matcher) unmap(depth int) {
+	u := m.order[depth]
+	v := m.patternCore[u]
+	m.patternCore[u] = -1
+	m.targetCore[v] = -1
+	unmark(m.patternTerminal, m.pattern, u, depth+1)
+	unmark(m.targetTerminal, m.target, v, depth+1)
+}
+
+// mark sets the terminal depth of u and its unmarked neighbors.
+func mark(terminal []int, g *adjacency, u, depth int) {
+	if terminal[u] == 0 {
+		terminal[u] = depth
+	}
+	for _, w := range g.out[u] {
+		if terminal[w] == 0 {
+			terminal[w] = depth
+		}
+	}
+	for _, w := range g.in[u] {
+		if terminal[w] == 0 {
+			terminal[w] = depth
+		}
+	}
+}
+
+// unmark clears the terminal depth of u and its neighbors that were marked
+// at the given depth.
+func unmark(terminal []int, g *adjacency, u, depth int) {
+	if terminal[u] == depth {
+		terminal[u] = 0
+	}
+	for _, w := range g.out[u] {
+		if terminal[w] == depth {
+			terminal[w] = 0
+		}
+	}
+	for _, w := range g.in[u] {
+		if terminal[w] == depth {
+			terminal[w] = 0
+		}
+	}
+}
+
+// adjacency is an indexed representation of a graph. For undirected graphs
+// in and out hold the same adjacency lists. Self loops are not included in
+// the adjacency lists.
+type adjacency struct {
+	g        graph.Graph
+	directed bool
+
+	nodes []graph.Node
+	all   []int
+	out   [][]int
+	in    [][]int
+	edge  map[[2]int]bool
+	edges int
+}
+
+func newAdjacency(g graph.Graph) *adjacency {
+	d, directed := g.(graph.Directed)
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	a := &adjacency{
+		g:        g,
+		directed: directed,
+		nodes:    nodes,
+		all:      make([]int, len(nodes)),
+		out:      make([][]int, len(nodes)),
+		edge:     make(map[[2]int]bool),
+	}
+	index := make(map[int64]int, len(nodes))
+	for i, u := range nodes {
+		index[u.ID()] = i
+		a.all[i] = i
+	}
+	for i, u := range nodes {
+		for it := g.From(u.ID()); it.Next(); {
+			j := index[it.Node().ID()]
+			a.edge[[2]int{i, j}] = true
+			if i != j {
+				a.out[i] = append(a.out[i], j)
+			}
+		}
+	}
+	for _, out := range a.out {
+		// Sort for a deterministic search order.
+		slices.Sort(out)
+	}
+	if directed {
+		a.in = make([][]int, len(nodes))
+		for i, u := range nodes {
+			for it := d.To(u.ID()); it.Next(); {
+				if j := index[it.Node().ID()]; i != j {
+					a.in[i] = append(a.in[i], j)
+				}
+			}
+		}
+		for _, in := range a.in {
+			slices.Sort(in)
+		}
+		a.edges = len(a.edge)
+	} else {
+		a.in = a.out
+		for e := range a.edge {
+			if e[0] <= e[1] {
+				a.edges++
+			}
+		}
+	}
+	return a
+}
+
+// hasEdge returns whether there is an edge from u to v.
+func (a *adjacency) hasEdge(u, v int) bool {
+	return a.edge[[2]int{u, v}]
+}
+
+// degree returns the number of neighbors of u.
+func (a *adjacency) degree(u int) int {
+	if a.directed {
+		return len(a.out[u]) + len(a.in[u])
+	}
+	return len(a.out[u])
+}
+
+// matchingOrder returns the VF2++ matching order of the nodes of a and an
+// earlier adjacent node for each node in the order, or -1. Each connected
+// component is ordered by a breadth-first search from its highest degree
+// node, and the nodes in each level of the search are ordered by decreasing
+// number of neighbors earlier in the order and then by decreasing degree.
+func (a *adjacency) matchingOrder() (nodeOrder, parent []int) {
+	n := len(a.nodes)
+	position := fill(make([]int, n), -1)
+	visited := make([]bool, n)
+	conn := make([]int, n)
+	for len(nodeOrder) < n {
+		root := -1
+		for u := 0; u < n; u++ {
+			if !visited[u] && (root < 0 || a.degree(u) > a.degree(root)) {
+				root = u
+			}
+		}
+		visited[root] = true
+		level := []int{root}
+		for len(level) > 0 {
+			var next []int
+			for len(level) > 0 {
+				best := 0
+				for k, u := range level {
+					b := level[best]
+					if conn[u] > conn[b] || (conn[u] == conn[b] && a.degree(u) > a.degree(b)) {
+						best = k
+					}
+				}
+				u := level[best]
+				level = append(level[:best], level[best+1:]...)
+
+				position[u] = len(nodeOrder)
+				nodeOrder = append(nodeOrder, u)
+				p := -1
+				for _, w := range a.neighbors(u) {
+					if position[w] >= 0 && w != u && (p < 0 || position[w] < position[p]) {
+						p = w
+					}
+					conn[w]++
+					if !visited[w] {
+						visited[w] = true
+						next = append(next, w)
+					}
+				}
+				parent = append(parent, p)
+			}
+			level = next
+		}
+	}
+	return nodeOrder, parent
+}
+
+// neighbors returns the neighbors of u in either direction.
+func (a *adjacency) neighbors(u int) []int {
+	if !a.directed {
+		return a.out[u]
+	}
+	return append(append([]int(nil), a.out[u]...), a.in[u]...)
+}
+
+func fill(s []int, v int) []int {
+	for i := range s {
+		s[i] = v
+	}
+	return s
+}
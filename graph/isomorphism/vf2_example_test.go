@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package isomorphism_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/graph/isomorphism"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func ExampleMatcher() {
+	// Find the occurrences of a directed path of length two that are
+	// not closed into a triangle.
+	pattern := simple.NewDirectedGraph()
+	pattern.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	pattern.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(2)})
+
+	target := simple.NewDirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {0, 2}, {2, 3}} {
+		target.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+
+	m := isomorphism.NewMatcher(pattern, target, isomorphism.InducedSubgraph, nil, nil)
+	for m.Next() {
+		mapping := m.Mapping()
+		fmt.Printf("%d -> %d -> %d\n", mapping[0], mapping[1], mapping[2])
+	}
+
+	// Output:
+	// 0 -> 2 -> 3
+	// 1 -> 2 -> 3
+}
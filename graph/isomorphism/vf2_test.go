@@ -0,0 +1,227 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package isomorphism
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// builder is a graph that edges can be added to.
+type builder interface {
+	graph.Graph
+	AddNode(graph.Node)
+	SetWeightedEdge(graph.WeightedEdge)
+}
+
+// randomGraph returns a random graph with n nodes with IDs starting at offset
+// where each ordered pair of nodes is joined with probability p. Edge weights
+// are 1 or 2.
+func randomGraph(n int, p float64, directed bool, offset int64, rnd *rand.Rand) builder {
+	var g builder
+	if directed {
+		g = simple.NewWeightedDirectedGraph(0, 0)
+	} else {
+		g = simple.NewWeightedUndirectedGraph(0, 0)
+	}
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(int64(i) + offset))
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j || (!directed && j < i) || rnd.Float64() >= p {
+				continue
+			}
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(int64(i) + offset), T: simple.Node(int64(j) + offset), W: float64(rnd.IntN(2) + 1)})
+		}
+	}
+	return g
+}
+
+// permuted returns a copy of g with node IDs permuted and offset.
+func permuted(g builder, directed bool, offset int64, rnd *rand.Rand) builder {
+	nodes := graph.NodesOf(g.Nodes())
+	perm := rnd.Perm(len(nodes))
+	var h builder
+	if directed {
+		h = simple.NewWeightedDirectedGraph(0, 0)
+	} else {
+		h = simple.NewWeightedUndirectedGraph(0, 0)
+	}
+	id := func(n graph.Node) simple.Node { return simple.Node(int64(perm[n.ID()]) + offset) }
+	for _, u := range nodes {
+		h.AddNode(id(u))
+	}
+	for _, u := range nodes {
+		for it := g.From(u.ID()); it.Next(); {
+			v := it.Node()
+			w, _ := g.(graph.Weighted).Weight(u.ID(), v.ID())
+			h.SetWeightedEdge(simple.WeightedEdge{F: id(u), T: id(v), W: w})
+		}
+	}
+	return h
+}
+
+func weightMatch(e, f graph.Edge) bool {
+	return e.(graph.WeightedEdge).Weight() == f.(graph.WeightedEdge).Weight()
+}
+
+func TestIsomorphic(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 200; trial++ {
+		directed := trial%2 == 1
+		n := rnd.IntN(8)
+		g := randomGraph(n, rnd.Float64(), directed, 0, rnd)
+		h := permuted(g, directed, 100, rnd)
+		name := fmt.Sprintf("trial %d directed=%t n=%d", trial, directed, n)
+
+		mapping, ok := Mapping(g, h, nil, weightMatch)
+		if !ok {
+			t.Errorf("%s: permuted graph not isomorphic", name)
+			continue
+		}
+		checkMapping(t, name, g, h, Isomorphism, mapping, weightMatch)
+
+		// Compare with brute force for a random graph of the same size.
+		k := randomGraph(n, rnd.Float64(), directed, 100, rnd)
+		got := Isomorphic(g, k, nil, nil)
+		want := bruteCount(g, k, Isomorphism, nil) > 0
+		if got != want {
+			t.Errorf("%s: unexpected isomorphism result: got %t, want %t", name, got, want)
+		}
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 300; trial++ {
+		directed := trial%2 == 1
+		np := rnd.IntN(4) + 1
+		nt := rnd.IntN(4) + np
+		pattern := randomGraph(np, 0.6, directed, 50, rnd)
+		target := randomGraph(nt, rnd.Float64(), directed, 0, rnd)
+
+		var nodeMatch NodeMatch
+		if trial%3 == 0 {
+			nodeMatch = func(u, v graph.Node) bool { return u.ID()%2 == v.ID()%2 }
+		}
+		var edgeMatch EdgeMatch
+		if trial%5 == 0 {
+			edgeMatch = weightMatch
+		}
+		for _, kind := range []Kind{Isomorphism, InducedSubgraph, Monomorphism} {
+			name := fmt.Sprintf("trial %d directed=%t kind=%d", trial, directed, kind)
+			m := NewMatcher(pattern, target, kind, nodeMatch, edgeMatch)
+			seen := make(map[string]bool)
+			for m.Next() {
+				mapping := m.Mapping()
+				checkMapping(t, name, pattern, target, kind, mapping, edgeMatch)
+				key := fmt.Sprint(mapping)
+				if seen[key] {
+					t.Errorf("%s: duplicate mapping %v", name, mapping)
+				}
+				seen[key] = true
+			}
+			if m.Next() {
+				t.Errorf("%s: Next returned true after exhaustion", name)
+			}
+			if edgeMatch != nil {
+				continue
+			}
+			if want := bruteCount(pattern, target, kind, nodeMatch); len(seen) != want {
+				t.Errorf("%s: unexpected number of mappings: got %d, want %d", name, len(seen), want)
+			}
+		}
+	}
+}
+
+// checkMapping checks that mapping is a valid mapping of the given kind from
+// g to h.
+func checkMapping(t *testing.T, name string, g, h graph.Graph, kind Kind, mapping map[int64]int64, edgeMatch EdgeMatch) {
+	t.Helper()
+	nodes := graph.NodesOf(g.Nodes())
+	if len(mapping) != len(nodes) {
+		t.Errorf("%s: mapping does not cover the pattern nodes", name)
+		return
+	}
+	image := make(map[int64]bool)
+	for _, v := range mapping {
+		if image[v] || h.Node(v) == nil {
+			t.Errorf("%s: invalid mapping %v", name, mapping)
+			return
+		}
+		image[v] = true
+	}
+	for _, u := range nodes {
+		for _, w := range nodes {
+			e := g.Edge(u.ID(), w.ID())
+			f := h.Edge(mapping[u.ID()], mapping[w.ID()])
+			switch {
+			case e != nil && f == nil:
+				t.Errorf("%s: edge %d-%d not preserved", name, u.ID(), w.ID())
+			case e == nil && f != nil && kind != Monomorphism:
+				t.Errorf("%s: edge %d-%d not reflected", name, u.ID(), w.ID())
+			case e != nil && edgeMatch != nil && !edgeMatch(e, f):
+				t.Errorf("%s: edge %d-%d does not match", name, u.ID(), w.ID())
+			}
+		}
+	}
+}
+
+// bruteCount returns the number of mappings of the given kind from g to h by
+// enumerating all injective mappings.
+func bruteCount(g, h graph.Graph, kind Kind, nodeMatch NodeMatch) int {
+	gn := graph.NodesOf(g.Nodes())
+	hn := graph.NodesOf(h.Nodes())
+	if kind == Isomorphism && len(gn) != len(hn) {
+		return 0
+	}
+	used := make([]bool, len(hn))
+	image := make([]graph.Node, len(gn))
+	var count int
+	var search func(k int)
+	search = func(k int) {
+		if k == len(gn) {
+			for i, u := range gn {
+				for j, w := range gn {
+					e := g.Edge(u.ID(), w.ID()) != nil
+					f := h.Edge(image[i].ID(), image[j].ID()) != nil
+					if e && !f || !e && f && kind != Monomorphism {
+						return
+					}
+				}
+			}
+			count++
+			return
+		}
+		for i, v := range hn {
+			if used[i] || (nodeMatch != nil && !nodeMatch(gn[k], v)) {
+				continue
+			}
+			used[i] = true
+			image[k] = v
+			search(k + 1)
+			used[i] = false
+		}
+	}
+	search(0)
+	return count
+}
+
+func TestMatcherMixedDirection(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for mixed directed and undirected graphs")
+		}
+	}()
+	NewMatcher(simple.NewDirectedGraph(), simple.NewUndirectedGraph(), Isomorphism, nil, nil)
+}
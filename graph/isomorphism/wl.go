@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package isomorphism
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// WeisfeilerLehmanHash returns a hash of g computed from the node colors of
+// the given number of rounds of Weisfeiler-Lehman color refinement. The
+// initial color of each node is given by label, or is the same for all nodes
+// if label is nil. In each round, the color of a node is replaced by a hash of
+// its color and the multiset of the colors of its neighbors, with incoming and
+// outgoing neighbors distinguished for directed graphs.
+//
+// Isomorphic graphs whose isomorphism preserves the node labels have equal
+// hashes, so graphs with different hashes are not isomorphic. Graphs with
+// equal hashes are not necessarily isomorphic.
+//
+// See Shervashidze et al. https://www.jmlr.org/papers/v12/shervashidze11a.html
+// for details of the algorithm.
+func WeisfeilerLehmanHash(g graph.Graph, iterations int, label func(graph.Node) string) string {
+	a := newAdjacency(g)
+	colors := make([]string, len(a.nodes))
+	for i, u := range a.nodes {
+		if label != nil {
+			colors[i] = label(u)
+		}
+		if a.hasEdge(i, i) {
+			colors[i] += "\x00loop"
+		}
+	}
+
+	h := sha256.New()
+	histogram := func() {
+		sorted := slices.Clone(colors)
+		slices.Sort(sorted)
+		for _, c := range sorted {
+			fmt.Fprintf(h, "%q,", c)
+		}
+		h.Write([]byte{';'})
+	}
+	histogram()
+	next := make([]string, len(colors))
+	for k := 0; k < iterations; k++ {
+		for u := range colors {
+			next[u] = shortHash(signature(colors, colors[u], a.out[u], a.in[u], a.directed))
+		}
+		colors, next = next, colors
+		histogram()
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// signature returns a string representing a node color and the multisets of
+// colors of its neighbors.
+func signature[C any](colors []C, color C, out, in []int, directed bool) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v", color)
+	appendColors := func(neighbors []int) {
+		c := make([]string, len(neighbors))
+		for i, w := range neighbors {
+			c[i] = fmt.Sprint(colors[w])
+		}
+		slices.Sort(c)
+		fmt.Fprintf(&b, "(%s)", strings.Join(c, ","))
+	}
+	appendColors(out)
+	if directed {
+		appendColors(in)
+	}
+	return b.String()
+}
+
+// shortHash returns a short hash of s.
+func shortHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:8])
+}
+
+// stableColors returns the stable Weisfeiler-Lehman colors of the nodes of
+// the given graphs, refined jointly so that the colors are comparable between
+// graphs. Nodes mapped to each other by an isomorphism have equal colors.
+func stableColors(graphs ...*adjacency) [][]int {
+	colors := make([][]int, len(graphs))
+	var n int
+	for i, a := range graphs {
+		colors[i] = make([]int, len(a.nodes))
+		for u := range a.nodes {
+			if a.hasEdge(u, u) {
+				colors[i][u] = 1
+			}
+		}
+		n += len(a.nodes)
+	}
+	classes := 0
+	for round := 0; round < n; round++ {
+		ids := make(map[string]int)
+		next := make([][]int, len(graphs))
+		for i, a := range graphs {
+			next[i] = make([]int, len(a.nodes))
+			for u := range a.nodes {
+				sig := signature(colors[i], colors[i][u], a.out[u], a.in[u], a.directed)
+				id, ok := ids[sig]
+				if !ok {
+					id = len(ids)
+					ids[sig] = id
+				}
+				next[i][u] = id
+			}
+		}
+		colors = next
+		if len(ids) == classes {
+			break
+		}
+		classes = len(ids)
+	}
+	return colors
+}
@@ -0,0 +1,63 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package isomorphism
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestWeisfeilerLehmanHash(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 100; trial++ {
+		directed := trial%2 == 1
+		g := randomGraph(rnd.IntN(10)+1, 0.3, directed, 0, rnd)
+		h := permuted(g, directed, 0, rnd)
+		if WeisfeilerLehmanHash(g, 3, nil) != WeisfeilerLehmanHash(h, 3, nil) {
+			t.Errorf("trial %d: hash differs for isomorphic graphs", trial)
+		}
+	}
+
+	// Two triangles and a hexagon are not isomorphic but cannot be
+	// distinguished by color refinement.
+	triangles := cycles(3, 3)
+	hexagon := cycles(6)
+	if WeisfeilerLehmanHash(triangles, 3, nil) != WeisfeilerLehmanHash(hexagon, 3, nil) {
+		t.Error("unexpected hash difference for regular graphs")
+	}
+	if Isomorphic(triangles, hexagon, nil, nil) {
+		t.Error("two triangles isomorphic to hexagon")
+	}
+	label := func(n graph.Node) string { return strconv.Itoa(int(n.ID() % 2)) }
+	if WeisfeilerLehmanHash(triangles, 3, label) == WeisfeilerLehmanHash(hexagon, 3, label) {
+		t.Error("unexpected equal hash for differently labeled graphs")
+	}
+
+	path := simple.NewUndirectedGraph()
+	for i := 0; i < 5; i++ {
+		path.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 1)})
+	}
+	if WeisfeilerLehmanHash(path, 3, nil) == WeisfeilerLehmanHash(hexagon, 3, nil) {
+		t.Error("unexpected equal hash for path and cycle")
+	}
+}
+
+// cycles returns the disjoint union of cycles with the given lengths.
+func cycles(lengths ...int) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	var offset int64
+	for _, n := range lengths {
+		for i := 0; i < n; i++ {
+			g.SetEdge(simple.Edge{F: simple.Node(offset + int64(i)), T: simple.Node(offset + int64((i+1)%n))})
+		}
+		offset += int64(n)
+	}
+	return g
+}
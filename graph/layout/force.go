@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/spatial/barneshut"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// forceLayoutR2 holds the state shared by the force-directed layouts
+// that use the Barnes-Hut approximation for global repulsion.
+type forceLayoutR2 struct {
+	nodes   []graph.Node
+	indexOf map[int64]int
+
+	// edges holds the adjacent node index
+	// pairs and the total weight of the
+	// edges between them.
+	edges []weightedPair
+
+	particles []barneshut.Particle2
+	forces    []r2.Vec
+}
+
+// weightedPair is a pair of adjacent nodes.
+type weightedPair struct {
+	u, v int
+	w    float64
+}
+
+// init places the nodes of g uniformly at random in a square with the
+// given side length, centered on the origin. The mass of each node is
+// given by mass, which is passed the number of neighbors of the node.
+func (l *forceLayoutR2) init(g graph.Graph, side float64, mass func(degree int) float64, src rand.Source) {
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+	l.nodes = graph.NodesOf(g.Nodes())
+	order.ByID(l.nodes)
+	l.indexOf = make(map[int64]int, len(l.nodes))
+	for i, n := range l.nodes {
+		l.indexOf[n.ID()] = i
+	}
+
+	weight := symmetricWeightFunc(g)
+	degree := make([]int, len(l.nodes))
+	l.edges = l.edges[:0]
+	seen := make(map[[2]int]bool)
+	for i, n := range l.nodes {
+		xid := n.ID()
+		for _, y := range graph.NodesOf(g.From(xid)) {
+			j := l.indexOf[y.ID()]
+			if i == j || seen[[2]int{i, j}] {
+				continue
+			}
+			seen[[2]int{j, i}] = true
+			seen[[2]int{i, j}] = true
+			l.edges = append(l.edges, weightedPair{u: i, v: j, w: weight(xid, y.ID())})
+			degree[i]++
+			degree[j]++
+		}
+	}
+
+	l.particles = make([]barneshut.Particle2, len(l.nodes))
+	for i, n := range l.nodes {
+		pos := r2.Vec{X: (rnd() - 0.5) * side, Y: (rnd() - 0.5) * side}
+		l.particles[i] = particleR2{id: n.ID(), pos: pos, mass: mass(degree[i])}
+	}
+	l.forces = make([]r2.Vec, len(l.nodes))
+}
+
+// symmetricWeightFunc returns a function that returns the total weight of
+// the edges between two adjacent nodes of g, or one if g is not weighted.
+func symmetricWeightFunc(g graph.Graph) func(xid, yid int64) float64 {
+	wg, ok := g.(graph.Weighted)
+	if !ok {
+		// This is only called when the adjacency is known so just return unit.
+		return func(_, _ int64) float64 { return 1 }
+	}
+	if _, ok := g.(graph.Directed); ok {
+		return func(xid, yid int64) float64 {
+			var w float64
+			f, ok := wg.Weight(xid, yid)
+			if ok {
+				w += f
+			}
+			r, ok := wg.Weight(yid, xid)
+			if ok {
+				w += r
+			}
+			return w
+		}
+	}
+	return func(xid, yid int64) float64 {
+		w, ok := wg.Weight(xid, yid)
+		if ok {
+			return w
+		}
+		return 0
+	}
+}
+
+// particleR2 is a node in a force-directed layout.
+type particleR2 struct {
+	id   int64
+	pos  r2.Vec
+	mass float64
+}
+
+func (p particleR2) Coord2() r2.Vec { return p.pos }
+func (p particleR2) Mass() float64  { return p.mass }
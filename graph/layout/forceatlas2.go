@@ -0,0 +1,177 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/spatial/barneshut"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// ForceAtlas2R2 implements the graph layout algorithm described in
+// "ForceAtlas2, a continuous graph layout algorithm for handy network
+// visualization designed for the Gephi software", PLoS ONE 9(6):e98679.
+// Each node has a mass of one more than its number of neighbors. Nodes
+// repel each other with force Scaling⋅m₁⋅m₂/d and adjacent nodes attract
+// each other with force w⋅d, or w⋅log(1+d) in LinLog mode, where d is the
+// distance between the nodes and w is the weight of the edges between
+// them. The displacement of each node is set by the adaptive local and
+// global speeds described in the paper. The implementation here uses the
+// Barnes-Hut approximation for global repulsion calculation.
+type ForceAtlas2R2 struct {
+	// Updates is the number of updates to perform.
+	Updates int
+
+	// Scaling is the strength of the repulsion
+	// between nodes. If Scaling is zero, a value
+	// of 2 is used.
+	Scaling float64
+
+	// Gravity is the strength of the attraction
+	// of nodes toward the origin, m⋅Gravity, or
+	// m⋅Gravity⋅d when StrongGravity is true,
+	// where d is the distance of a node with mass
+	// m from the origin.
+	Gravity       float64
+	StrongGravity bool
+
+	// LinLog specifies whether to use the
+	// logarithmic attraction force.
+	LinLog bool
+
+	// Tolerance is the tolerance to node swinging
+	// used to set the global speed. If Tolerance
+	// is zero, a tolerance of 1 is used.
+	Tolerance float64
+
+	// Theta is the Barnes-Hut theta constant.
+	Theta float64
+
+	// Src is the source of randomness used
+	// to initialize the nodes' locations. If
+	// Src is nil, the global random number
+	// generator is used.
+	Src rand.Source
+
+	state forceLayoutR2
+
+	// previous holds the forces of the previous
+	// update and speed is the global speed.
+	previous []r2.Vec
+	speed    float64
+}
+
+// Update is the ForceAtlas2R2 spatial graph update function.
+func (u *ForceAtlas2R2) Update(g graph.Graph, layout LayoutR2) bool {
+	if u.Updates <= 0 {
+		return false
+	}
+	u.Updates--
+	scaling := u.Scaling
+	if scaling == 0 {
+		scaling = 2
+	}
+	tolerance := u.Tolerance
+	if tolerance == 0 {
+		tolerance = 1
+	}
+
+	s := &u.state
+	if !layout.IsInitialized() {
+		side := math.Sqrt(float64(len(graph.NodesOf(g.Nodes()))))
+		s.init(g, side, func(degree int) float64 { return float64(degree + 1) }, u.Src)
+		u.previous = make([]r2.Vec, len(s.particles))
+		u.speed = 1
+	}
+
+	// Apply global repulsion.
+	plane, err := barneshut.NewPlane(s.particles)
+	if err != nil {
+		return false
+	}
+	repulsion := func(_, _ barneshut.Particle2, m1, m2 float64, v r2.Vec) r2.Vec {
+		d2 := v.X*v.X + v.Y*v.Y
+		if d2 == 0 {
+			return r2.Vec{}
+		}
+		return r2.Scale(-scaling*m1*m2/d2, v)
+	}
+	for i, p := range s.particles {
+		f := plane.ForceOn(p, u.Theta, repulsion)
+
+		// Apply gravity toward the origin.
+		pos := p.Coord2()
+		if d := math.Hypot(pos.X, pos.Y); d != 0 {
+			g := u.Gravity * p.Mass()
+			if !u.StrongGravity {
+				g /= d
+			}
+			f = r2.Sub(f, r2.Scale(g, pos))
+		}
+		s.forces[i] = f
+	}
+
+	// Apply adjacent node attraction.
+	for _, e := range s.edges {
+		v := r2.Sub(s.particles[e.v].Coord2(), s.particles[e.u].Coord2())
+		scale := e.w
+		if u.LinLog {
+			d := math.Hypot(v.X, v.Y)
+			if d == 0 {
+				continue
+			}
+			scale *= math.Log1p(d) / d
+		}
+		f := r2.Scale(scale, v)
+		s.forces[e.u] = r2.Add(s.forces[e.u], f)
+		s.forces[e.v] = r2.Sub(s.forces[e.v], f)
+	}
+
+	// Adapt the global speed to the swinging
+	// and traction of the nodes.
+	var swinging, traction float64
+	swing := make([]float64, len(s.forces))
+	for i, f := range s.forces {
+		m := s.particles[i].Mass()
+		prev := u.previous[i]
+		swing[i] = math.Hypot(f.X-prev.X, f.Y-prev.Y)
+		swinging += m * swing[i]
+		traction += m * math.Hypot(f.X+prev.X, f.Y+prev.Y) / 2
+	}
+	if swinging != 0 {
+		u.speed = min(tolerance*traction/swinging, 1.5*u.speed)
+	}
+
+	// Move the nodes with their local speeds.
+	const (
+		ks    = 0.1
+		ksMax = 10
+	)
+	var updated bool
+	for i, f := range s.forces {
+		d := math.Hypot(f.X, f.Y)
+		if d == 0 || math.IsNaN(d) {
+			continue
+		}
+		speed := ks * u.speed / (1 + u.speed*math.Sqrt(swing[i]))
+		speed = min(speed, ksMax/d)
+		step := r2.Scale(speed, f)
+		if math.Hypot(step.X, step.Y) > 1e-12 {
+			updated = true
+		}
+		n := s.particles[i].(particleR2)
+		n.pos = r2.Add(n.pos, step)
+		s.particles[i] = n
+	}
+	copy(u.previous, s.forces)
+	for _, p := range s.particles {
+		n := p.(particleR2)
+		layout.SetCoord2(n.id, n.pos)
+	}
+	return updated
+}
@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/spatial/r2"
+
+	. "gonum.org/v1/gonum/graph/layout"
+)
+
+func TestForceAtlas2R2(t *testing.T) {
+	t.Parallel()
+	// Two adjacent nodes, each with mass 2, come
+	// to rest where the attraction d balances the
+	// repulsion 4⋅Scaling/d.
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	fa2 := ForceAtlas2R2{Updates: 500, Scaling: 4, Theta: 0.1, Src: rand.NewPCG(1, 1)}
+	o := NewOptimizerR2(g, fa2.Update)
+	for o.Update() {
+	}
+	d := r2.Norm(r2.Sub(o.Coord2(0), o.Coord2(1)))
+	if math.Abs(d-4) > 1e-3 {
+		t.Errorf("unexpected distance between adjacent nodes: got:%v want:4", d)
+	}
+
+	// Gravity holds disconnected components together.
+	g = simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	g.SetEdge(simple.Edge{F: simple.Node(2), T: simple.Node(3)})
+	for _, strong := range []bool{false, true} {
+		fa2 = ForceAtlas2R2{Updates: 1000, Gravity: 1, StrongGravity: strong, LinLog: true, Theta: 0.1, Src: rand.NewPCG(1, 1)}
+		o = NewOptimizerR2(orderedGraph{g}, fa2.Update)
+		for o.Update() {
+		}
+		for id := int64(0); id < 4; id++ {
+			if p := o.Coord2(id); r2.Norm(p) > 10 {
+				t.Errorf("node %d too far from origin with strong gravity=%t: %v", id, strong, p)
+			}
+		}
+	}
+}
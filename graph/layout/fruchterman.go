@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/spatial/barneshut"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// FruchtermanReingoldR2 implements the graph layout algorithm described in
+// "Graph drawing by force-directed placement", Software: Practice and
+// Experience 21(11):1129-1164.
+// Nodes repel each other with force K²/d and adjacent nodes attract each
+// other with force w⋅d²/K, where d is the distance between the nodes and w
+// is the weight of the edges between them. The displacement of each node in
+// an update is limited by a temperature that decreases linearly to zero over
+// the updates. The implementation here uses the Barnes-Hut approximation for
+// global repulsion calculation.
+type FruchtermanReingoldR2 struct {
+	// Updates is the number of updates to perform.
+	Updates int
+
+	// K is the ideal distance between adjacent
+	// nodes. If K is zero, a distance of 1 is
+	// used.
+	K float64
+
+	// Temperature is the initial maximum
+	// displacement of a node in an update. If
+	// Temperature is zero, one tenth of the side
+	// of the initial layout square is used.
+	Temperature float64
+
+	// Theta is the Barnes-Hut theta constant.
+	Theta float64
+
+	// Src is the source of randomness used
+	// to initialize the nodes' locations. If
+	// Src is nil, the global random number
+	// generator is used.
+	Src rand.Source
+
+	state forceLayoutR2
+
+	// temperature and cooling are the current
+	// temperature and its decrease per update.
+	temperature, cooling float64
+}
+
+// Update is the FruchtermanReingoldR2 spatial graph update function.
+func (u *FruchtermanReingoldR2) Update(g graph.Graph, layout LayoutR2) bool {
+	if u.Updates <= 0 {
+		return false
+	}
+	k := u.K
+	if k == 0 {
+		k = 1
+	}
+
+	s := &u.state
+	if !layout.IsInitialized() {
+		// Place the nodes in a square with an area of K²
+		// for each node.
+		side := k * math.Sqrt(float64(len(graph.NodesOf(g.Nodes()))))
+		s.init(g, side, func(int) float64 { return 1 }, u.Src)
+		u.temperature = u.Temperature
+		if u.temperature == 0 {
+			u.temperature = side / 10
+		}
+		u.cooling = u.temperature / float64(u.Updates)
+	}
+	u.Updates--
+
+	// Apply global repulsion.
+	plane, err := barneshut.NewPlane(s.particles)
+	if err != nil {
+		return false
+	}
+	repulsion := func(_, _ barneshut.Particle2, m1, m2 float64, v r2.Vec) r2.Vec {
+		d2 := v.X*v.X + v.Y*v.Y
+		if d2 == 0 {
+			return r2.Vec{}
+		}
+		return r2.Scale(-k*k*m1*m2/d2, v)
+	}
+	for i, p := range s.particles {
+		s.forces[i] = plane.ForceOn(p, u.Theta, repulsion)
+	}
+
+	// Apply adjacent node attraction.
+	for _, e := range s.edges {
+		v := r2.Sub(s.particles[e.v].Coord2(), s.particles[e.u].Coord2())
+		f := r2.Scale(e.w*math.Hypot(v.X, v.Y)/k, v)
+		s.forces[e.u] = r2.Add(s.forces[e.u], f)
+		s.forces[e.v] = r2.Sub(s.forces[e.v], f)
+	}
+
+	// Move the nodes, limiting the displacement
+	// by the current temperature.
+	var updated bool
+	for i, f := range s.forces {
+		d := math.Hypot(f.X, f.Y)
+		if d == 0 || math.IsNaN(d) {
+			continue
+		}
+		step := min(d, u.temperature)
+		if step > 1e-12 {
+			updated = true
+		}
+		n := s.particles[i].(particleR2)
+		n.pos = r2.Add(n.pos, r2.Scale(step/d, f))
+		s.particles[i] = n
+	}
+	for _, p := range s.particles {
+		n := p.(particleR2)
+		layout.SetCoord2(n.id, n.pos)
+	}
+	u.temperature = max(u.temperature-u.cooling, 0)
+	return updated
+}
@@ -0,0 +1,58 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/spatial/r2"
+
+	. "gonum.org/v1/gonum/graph/layout"
+)
+
+func TestFruchtermanReingoldR2(t *testing.T) {
+	t.Parallel()
+	// Two adjacent nodes come to rest at the
+	// ideal distance.
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	fr := FruchtermanReingoldR2{Updates: 200, K: 2, Theta: 0.1, Src: rand.NewPCG(1, 1)}
+	o := NewOptimizerR2(g, fr.Update)
+	for o.Update() {
+	}
+	d := r2.Norm(r2.Sub(o.Coord2(0), o.Coord2(1)))
+	if math.Abs(d-2) > 1e-3 {
+		t.Errorf("unexpected distance between adjacent nodes: got:%v want:2", d)
+	}
+
+	// The layout is reproducible and adjacent nodes
+	// are closer than non-adjacent nodes in a path.
+	path := simple.NewUndirectedGraph()
+	for i := 0; i < 5; i++ {
+		path.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 1)})
+	}
+	layout := func() OptimizerR2 {
+		fr := FruchtermanReingoldR2{Updates: 500, Theta: 0.1, Src: rand.NewPCG(1, 1)}
+		o := NewOptimizerR2(orderedGraph{path}, fr.Update)
+		for o.Update() {
+		}
+		return o
+	}
+	a, b := layout(), layout()
+	for _, n := range graph.NodesOf(path.Nodes()) {
+		if a.Coord2(n.ID()) != b.Coord2(n.ID()) {
+			t.Errorf("layout not reproducible for node %d", n.ID())
+		}
+	}
+	near := r2.Norm(r2.Sub(a.Coord2(0), a.Coord2(1)))
+	far := r2.Norm(r2.Sub(a.Coord2(0), a.Coord2(5)))
+	if near >= far {
+		t.Errorf("unexpected path layout: adjacent distance %v not less than end distance %v", near, far)
+	}
+}
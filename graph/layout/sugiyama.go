@@ -0,0 +1,446 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// SugiyamaR2 implements the layered graph layout algorithm described
+// in "Methods for visual understanding of hierarchical system structures",
+// IEEE Transactions on Systems, Man, and Cybernetics 11(2):109-125.
+//
+// The layout is constructed in four phases. Cycles are broken by reversing
+// the edges of a feedback arc set found with the greedy heuristic of Eades,
+// Lin and Smyth, nodes are assigned to layers by longest path from the
+// sources, with dummy nodes inserted along edges that span more than one
+// layer, the order of nodes within each layer is chosen to reduce edge
+// crossings using the barycenter heuristic, and horizontal coordinates are
+// found by iteratively moving nodes toward the mean position of their
+// neighbors subject to the node separation constraint.
+//
+// Nodes in layer i are placed at Y = -i*LayerSeparation, so edges point
+// in the negative Y direction. Undirected edges are treated as directed
+// from the node with the lower ID to the node with the higher ID. Self
+// edges are ignored.
+type SugiyamaR2 struct {
+	// LayerSeparation is the distance between
+	// adjacent layers. If LayerSeparation is
+	// zero, a separation of 1 is used.
+	LayerSeparation float64
+
+	// NodeSeparation is the minimum distance
+	// between adjacent nodes in a layer. If
+	// NodeSeparation is zero, a separation
+	// of 1 is used.
+	NodeSeparation float64
+
+	// Sweeps is the number of barycenter
+	// sweeps performed during crossing
+	// reduction and coordinate assignment.
+	// If Sweeps is zero, 8 sweeps are used.
+	Sweeps int
+}
+
+// Update is the SugiyamaR2 spatial graph update function. The layout is
+// complete after a single call, so Update always returns false.
+func (u SugiyamaR2) Update(g graph.Graph, layout LayoutR2) bool {
+	layerSep := u.LayerSeparation
+	if layerSep == 0 {
+		layerSep = 1
+	}
+	nodeSep := u.NodeSeparation
+	if nodeSep == 0 {
+		nodeSep = 1
+	}
+	sweeps := u.Sweeps
+	if sweeps == 0 {
+		sweeps = 8
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	h := newHierarchy(g, nodes)
+	h.reduceCrossings(sweeps)
+	x := h.coordinates(sweeps, nodeSep)
+	for i, n := range nodes {
+		layout.SetCoord2(n.ID(), r2.Vec{X: x[i], Y: -float64(h.layer[i]) * layerSep})
+	}
+	return false
+}
+
+// hierarchy is a proper layered graph. Vertices with index less than the
+// number of graph nodes are the nodes of the input graph in ID order and
+// the remainder are dummy vertices on long edges.
+type hierarchy struct {
+	// layer is the layer of each vertex and
+	// layers holds the order of vertices in
+	// each layer.
+	layer  []int
+	layers [][]int
+
+	// pos is the position of each vertex
+	// in its layer.
+	pos []int
+
+	// up and down are the neighbors of each
+	// vertex in the previous and next layer.
+	up, down [][]int
+}
+
+// newHierarchy returns the layered graph of g with its nodes in ID order.
+func newHierarchy(g graph.Graph, nodes []graph.Node) *hierarchy {
+	n := len(nodes)
+	indexOf := make(map[int64]int, n)
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+	_, isDirected := g.(graph.Directed)
+	out := make([][]int, n)
+	for i, u := range nodes {
+		for _, v := range graph.NodesOf(g.From(u.ID())) {
+			j := indexOf[v.ID()]
+			if j == i || (!isDirected && j < i) {
+				continue
+			}
+			out[i] = append(out[i], j)
+		}
+		slices.Sort(out[i])
+	}
+
+	// Break cycles by reversing the edges that point
+	// backwards in a low feedback ordering. The ordering
+	// is then a topological order of the acyclic graph.
+	seq := feedbackOrder(out)
+	rank := make([]int, n)
+	for r, v := range seq {
+		rank[v] = r
+	}
+	var edges [][2]int
+	seen := make(map[[2]int]bool)
+	for u, to := range out {
+		for _, v := range to {
+			e := [2]int{u, v}
+			if rank[u] > rank[v] {
+				e = [2]int{v, u}
+			}
+			if !seen[e] {
+				seen[e] = true
+				edges = append(edges, e)
+			}
+		}
+	}
+	slices.SortFunc(edges, func(a, b [2]int) int {
+		if rank[a[0]] != rank[b[0]] {
+			return rank[a[0]] - rank[b[0]]
+		}
+		return rank[a[1]] - rank[b[1]]
+	})
+
+	// Assign layers by longest path from the sources.
+	h := &hierarchy{
+		layer: make([]int, n),
+		up:    make([][]int, n),
+		down:  make([][]int, n),
+	}
+	for _, e := range edges {
+		h.layer[e[1]] = max(h.layer[e[1]], h.layer[e[0]]+1)
+	}
+
+	// Insert dummy vertices along long edges.
+	for _, e := range edges {
+		u := e[0]
+		for l := h.layer[e[0]] + 1; l < h.layer[e[1]]; l++ {
+			d := len(h.layer)
+			h.layer = append(h.layer, l)
+			h.up = append(h.up, []int{u})
+			h.down = append(h.down, nil)
+			h.down[u] = append(h.down[u], d)
+			u = d
+		}
+		h.down[u] = append(h.down[u], e[1])
+		h.up[e[1]] = append(h.up[e[1]], u)
+	}
+
+	// Place vertices in their initial order
+	// given by the feedback ordering.
+	var depth int
+	for _, l := range h.layer {
+		depth = max(depth, l+1)
+	}
+	h.layers = make([][]int, depth)
+	for _, v := range seq {
+		h.layers[h.layer[v]] = append(h.layers[h.layer[v]], v)
+	}
+	for d := n; d < len(h.layer); d++ {
+		h.layers[h.layer[d]] = append(h.layers[h.layer[d]], d)
+	}
+	h.pos = make([]int, len(h.layer))
+	h.setPositions()
+	return h
+}
+
+// feedbackOrder returns an ordering of the vertices of the graph with the
+// given out adjacencies such that few edges point backwards, using the
+// greedy heuristic described in "A fast and effective heuristic for the
+// feedback arc set problem", Information Processing Letters 47(6):319-323.
+func feedbackOrder(out [][]int) []int {
+	n := len(out)
+	in := make([][]int, n)
+	for u, to := range out {
+		for _, v := range to {
+			in[v] = append(in[v], u)
+		}
+	}
+	indeg := make([]int, n)
+	outdeg := make([]int, n)
+	for u := range out {
+		outdeg[u] = len(out[u])
+		indeg[u] = len(in[u])
+	}
+	removed := make([]bool, n)
+	remove := func(u int) {
+		removed[u] = true
+		for _, v := range out[u] {
+			indeg[v]--
+		}
+		for _, v := range in[u] {
+			outdeg[v]--
+		}
+	}
+
+	var head, tail []int
+	for remaining := n; remaining > 0; {
+		progress := true
+		for progress {
+			progress = false
+			for u := 0; u < n; u++ {
+				if removed[u] {
+					continue
+				}
+				switch {
+				case outdeg[u] == 0:
+					tail = append(tail, u)
+				case indeg[u] == 0:
+					head = append(head, u)
+				default:
+					continue
+				}
+				remove(u)
+				remaining--
+				progress = true
+			}
+		}
+		if remaining == 0 {
+			break
+		}
+		best := -1
+		for u := 0; u < n; u++ {
+			if !removed[u] && (best < 0 || outdeg[u]-indeg[u] > outdeg[best]-indeg[best]) {
+				best = u
+			}
+		}
+		head = append(head, best)
+		remove(best)
+		remaining--
+	}
+	slices.Reverse(tail)
+	return append(head, tail...)
+}
+
+// setPositions updates the positions of the vertices from the
+// layer orders.
+func (h *hierarchy) setPositions() {
+	for _, layer := range h.layers {
+		for i, v := range layer {
+			h.pos[v] = i
+		}
+	}
+}
+
+// reduceCrossings reorders the vertices within layers by alternating
+// downward and upward barycenter sweeps, retaining the ordering with the
+// fewest crossings.
+func (h *hierarchy) reduceCrossings(sweeps int) {
+	best := h.crossings()
+	saved := make([][]int, len(h.layers))
+	save := func() {
+		for i, layer := range h.layers {
+			saved[i] = append(saved[i][:0], layer...)
+		}
+	}
+	save()
+	key := make([]float64, len(h.pos))
+	for s := 0; s < sweeps && best != 0; s++ {
+		for i := 1; i < len(h.layers); i++ {
+			h.sortByBarycenter(h.layers[i], h.up, key)
+		}
+		for i := len(h.layers) - 2; i >= 0; i-- {
+			h.sortByBarycenter(h.layers[i], h.down, key)
+		}
+		if c := h.crossings(); c < best {
+			best = c
+			save()
+		}
+	}
+	for i := range h.layers {
+		h.layers[i] = append(h.layers[i][:0], saved[i]...)
+	}
+	h.setPositions()
+}
+
+// sortByBarycenter sorts layer by the mean position of the neighbors of
+// each vertex. Vertices without neighbors keep their position.
+func (h *hierarchy) sortByBarycenter(layer []int, neighbors [][]int, key []float64) {
+	var movable []int
+	for _, v := range layer {
+		nbrs := neighbors[v]
+		if len(nbrs) == 0 {
+			continue
+		}
+		var sum float64
+		for _, w := range nbrs {
+			sum += float64(h.pos[w])
+		}
+		key[v] = sum / float64(len(nbrs))
+		movable = append(movable, v)
+	}
+	slices.SortStableFunc(movable, func(a, b int) int {
+		switch {
+		case key[a] < key[b]:
+			return -1
+		case key[a] > key[b]:
+			return 1
+		}
+		return 0
+	})
+	for i, v := range layer {
+		if len(neighbors[v]) != 0 {
+			layer[i] = movable[0]
+			movable = movable[1:]
+		}
+	}
+	for i, v := range layer {
+		h.pos[v] = i
+	}
+}
+
+// crossings returns the number of edge crossings in the current ordering.
+func (h *hierarchy) crossings() int {
+	var c int
+	var ends []int
+	for i := 0; i+1 < len(h.layers); i++ {
+		// Count the inversions in the lower ends of
+		// the edges ordered by their upper ends.
+		ends = ends[:0]
+		for _, u := range h.layers[i] {
+			start := len(ends)
+			for _, v := range h.down[u] {
+				ends = append(ends, h.pos[v])
+			}
+			slices.Sort(ends[start:])
+		}
+		tree := make([]int, len(h.layers[i+1])+1)
+		for k, p := range ends {
+			// Count earlier ends strictly to the
+			// right of p using a Fenwick tree.
+			var le int
+			for j := p + 1; j > 0; j -= j & -j {
+				le += tree[j]
+			}
+			c += k - le
+			for j := p + 1; j < len(tree); j += j & -j {
+				tree[j]++
+			}
+		}
+	}
+	return c
+}
+
+// coordinates returns the horizontal coordinates of the vertices of h.
+// Each sweep moves the vertices of each layer toward the mean coordinate
+// of their neighbors in the adjacent layers, as closely as possible while
+// keeping the layer order and the minimum separation sep.
+func (h *hierarchy) coordinates(sweeps int, sep float64) []float64 {
+	x := make([]float64, len(h.pos))
+	for _, layer := range h.layers {
+		for i, v := range layer {
+			x[v] = float64(i) * sep
+		}
+	}
+	var want []float64
+	place := func(layer []int) {
+		want = want[:0]
+		for _, v := range layer {
+			var sum float64
+			n := len(h.up[v]) + len(h.down[v])
+			for _, w := range h.up[v] {
+				sum += x[w]
+			}
+			for _, w := range h.down[v] {
+				sum += x[w]
+			}
+			if n == 0 {
+				want = append(want, x[v])
+			} else {
+				want = append(want, sum/float64(n))
+			}
+		}
+		for i, p := range separated(want, sep) {
+			x[layer[i]] = p
+		}
+	}
+	for s := 0; s < sweeps; s++ {
+		for _, layer := range h.layers {
+			place(layer)
+		}
+		for i := len(h.layers) - 1; i >= 0; i-- {
+			place(h.layers[i])
+		}
+	}
+
+	if len(x) != 0 {
+		left := slices.Min(x)
+		for i := range x {
+			x[i] -= left
+		}
+	}
+	return x
+}
+
+// separated returns the positions closest to want in the least squares
+// sense that are increasing with a minimum separation of sep. The
+// positions are found by isotonic regression of want[i]-i*sep using the
+// pool adjacent violators algorithm.
+func separated(want []float64, sep float64) []float64 {
+	type block struct {
+		sum   float64
+		count int
+	}
+	var blocks []block
+	for i, w := range want {
+		blocks = append(blocks, block{sum: w - float64(i)*sep, count: 1})
+		for len(blocks) > 1 {
+			a, b := blocks[len(blocks)-2], blocks[len(blocks)-1]
+			if a.sum/float64(a.count) <= b.sum/float64(b.count) {
+				break
+			}
+			blocks = blocks[:len(blocks)-1]
+			blocks[len(blocks)-1] = block{sum: a.sum + b.sum, count: a.count + b.count}
+		}
+	}
+	pos := make([]float64, 0, len(want))
+	for _, b := range blocks {
+		mean := b.sum / float64(b.count)
+		for j := 0; j < b.count; j++ {
+			pos = append(pos, mean+float64(len(pos))*sep)
+		}
+	}
+	return pos
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/spatial/r2"
+
+	. "gonum.org/v1/gonum/graph/layout"
+)
+
+func TestSugiyamaR2(t *testing.T) {
+	t.Parallel()
+	sugiyamaR2Tests := []struct {
+		name  string
+		edges [][2]int64
+
+		// acyclic indicates that every edge
+		// must point down the layout.
+		acyclic bool
+		// planar indicates that the layout
+		// must have no crossings.
+		planar bool
+	}{
+		{
+			name:    "tree",
+			edges:   [][2]int64{{0, 1}, {0, 2}, {1, 3}, {1, 4}, {2, 5}, {2, 6}, {5, 7}},
+			acyclic: true,
+			planar:  true,
+		},
+		{
+			// A crossing in the initial order
+			// that is removed by the sweeps.
+			name:    "crossed",
+			edges:   [][2]int64{{0, 3}, {1, 2}, {0, 4}, {1, 5}},
+			acyclic: true,
+			planar:  true,
+		},
+		{
+			name:    "diamond with long edge",
+			edges:   [][2]int64{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {3, 4}, {0, 4}},
+			acyclic: true,
+		},
+		{
+			name:  "cycle",
+			edges: [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {3, 4}},
+		},
+	}
+	for _, test := range sugiyamaR2Tests {
+		g := simple.NewDirectedGraph()
+		for _, e := range test.edges {
+			g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+		}
+		sugiyama := SugiyamaR2{LayerSeparation: 2, NodeSeparation: 1}
+		o := NewOptimizerR2(g, sugiyama.Update)
+		if o.Update() {
+			t.Errorf("unexpected further update for %q", test.name)
+		}
+
+		layers := make(map[float64][]float64)
+		for _, n := range graph.NodesOf(g.Nodes()) {
+			p := o.Coord2(n.ID())
+			if p.Y > 0 || math.Mod(p.Y, 2) != 0 {
+				t.Errorf("node %d of %q not on a layer: %v", n.ID(), test.name, p)
+			}
+			layers[p.Y] = append(layers[p.Y], p.X)
+		}
+		for y, xs := range layers {
+			slices.Sort(xs)
+			for i := 1; i < len(xs); i++ {
+				if xs[i]-xs[i-1] < 1-1e-9 {
+					t.Errorf("nodes of %q in layer %v too close: %v", test.name, y, xs)
+				}
+			}
+		}
+
+		var down int
+		var spans [][2]r2.Vec
+		for _, e := range test.edges {
+			from, to := o.Coord2(e[0]), o.Coord2(e[1])
+			if from.Y > to.Y {
+				down++
+			}
+			if from.Y == to.Y {
+				t.Errorf("edge %v of %q within a layer", e, test.name)
+			}
+			if from.Y-to.Y == 2 {
+				spans = append(spans, [2]r2.Vec{from, to})
+			}
+		}
+		if test.acyclic && down != len(test.edges) {
+			t.Errorf("edges of acyclic %q not all directed down the layout", test.name)
+		}
+		if !test.acyclic && down != len(test.edges)-1 {
+			t.Errorf("unexpected number of reversed edges for %q: got:%d want:1", test.name, len(test.edges)-down)
+		}
+		if test.planar {
+			for i, a := range spans {
+				for _, b := range spans[i+1:] {
+					if a[0].Y == b[0].Y && (a[0].X-b[0].X)*(a[1].X-b[1].X) < 0 {
+						t.Errorf("edges of %q cross: %v %v", test.name, a, b)
+					}
+				}
+			}
+		}
+	}
+}
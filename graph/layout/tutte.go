@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/topo"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// TutteR2 implements the barycentric planar graph layout algorithm described
+// in "How to draw a graph", Proceedings of the London Mathematical Society
+// 13:743-767.
+//
+// The layout is constructed from a planar embedding of the graph, as returned
+// by topo.Planarity. The longest face of each connected component of the
+// embedding is taken as its outer face and the nodes on the outer face are
+// placed on a circle in the order they are met walking around the face. Every
+// other node is placed at the mean position of its neighbors. If a component
+// is 3-connected, its drawing has no edge crossings and every face is convex.
+// Components are placed side by side along the X axis.
+//
+// The positions of the nodes that are not on an outer face are found by
+// solving a dense linear system, so TutteR2 is suitable for graphs with up to
+// a few thousand nodes.
+type TutteR2 struct {
+	// Embedding is the planar embedding of
+	// the graph to lay out.
+	Embedding *topo.Embedding
+
+	// Radius is the radius of the circle
+	// holding the outer face of each
+	// component. If Radius is zero, a
+	// radius of 1 is used.
+	Radius float64
+}
+
+// Update is the TutteR2 spatial graph update function. The positions of the
+// nodes of the embedding are set, and the edges of g are not considered. The
+// layout is complete after a single call, so Update always returns false.
+// Update will panic if u.Embedding is nil.
+func (u TutteR2) Update(g graph.Graph, layout LayoutR2) bool {
+	if u.Embedding == nil {
+		panic("layout: nil embedding")
+	}
+	radius := u.Radius
+	if radius == 0 {
+		radius = 1
+	}
+
+	nodes := u.Embedding.Nodes()
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	adj := make([][]int, len(nodes))
+	for i, n := range nodes {
+		for _, v := range u.Embedding.Neighbors(n.ID()) {
+			adj[i] = append(adj[i], indexOf[v.ID()])
+		}
+	}
+
+	// Label the connected components in order of
+	// their lowest ID node.
+	component := make([]int, len(nodes))
+	for i := range component {
+		component[i] = -1
+	}
+	var components int
+	for i := range nodes {
+		if component[i] >= 0 {
+			continue
+		}
+		component[i] = components
+		stack := []int{i}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, w := range adj[v] {
+				if component[w] < 0 {
+					component[w] = components
+					stack = append(stack, w)
+				}
+			}
+		}
+		components++
+	}
+
+	outer := make([][]graph.Node, components)
+	for _, f := range u.Embedding.Faces() {
+		c := component[indexOf[f[0].ID()]]
+		if len(f) > len(outer[c]) {
+			outer[c] = f
+		}
+	}
+
+	// Place the nodes of each outer face on a circle.
+	// Isolated nodes are on no face and are placed at
+	// the centre of the circle.
+	pos := make([]r2.Vec, len(nodes))
+	fixed := make([]bool, len(nodes))
+	for i := range nodes {
+		if len(adj[i]) == 0 {
+			pos[i] = r2.Vec{X: 3 * radius * float64(component[i])}
+			fixed[i] = true
+		}
+	}
+	for c, face := range outer {
+		var ring []int
+		for _, n := range face {
+			i := indexOf[n.ID()]
+			if !fixed[i] {
+				fixed[i] = true
+				ring = append(ring, i)
+			}
+		}
+		for k, i := range ring {
+			theta := 2 * math.Pi * float64(k) / float64(len(ring))
+			pos[i] = r2.Vec{
+				X: 3*radius*float64(c) + radius*math.Cos(theta),
+				Y: radius * math.Sin(theta),
+			}
+		}
+	}
+
+	// Place the remaining nodes at the barycenters of
+	// their neighbors by solving the Laplacian system
+	// restricted to them.
+	var interior []int
+	where := make([]int, len(nodes))
+	for i := range nodes {
+		if !fixed[i] {
+			where[i] = len(interior)
+			interior = append(interior, i)
+		}
+	}
+	if len(interior) != 0 {
+		n := len(interior)
+		laplacian := mat.NewSymDense(n, nil)
+		bx := mat.NewVecDense(n, nil)
+		by := mat.NewVecDense(n, nil)
+		for a, i := range interior {
+			laplacian.SetSym(a, a, float64(len(adj[i])))
+			for _, j := range adj[i] {
+				if fixed[j] {
+					bx.SetVec(a, bx.AtVec(a)+pos[j].X)
+					by.SetVec(a, by.AtVec(a)+pos[j].Y)
+				} else {
+					laplacian.SetSym(a, where[j], -1)
+				}
+			}
+		}
+		var chol mat.Cholesky
+		if !chol.Factorize(laplacian) {
+			panic("layout: singular barycentric system")
+		}
+		var x, y mat.VecDense
+		err := chol.SolveVecTo(&x, bx)
+		if err != nil {
+			panic(err)
+		}
+		err = chol.SolveVecTo(&y, by)
+		if err != nil {
+			panic(err)
+		}
+		for a, i := range interior {
+			pos[i] = r2.Vec{X: x.AtVec(a), Y: y.AtVec(a)}
+		}
+	}
+
+	for i, n := range nodes {
+		layout.SetCoord2(n.ID(), pos[i])
+	}
+	return false
+}
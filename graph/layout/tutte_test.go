@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package layout_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+	"gonum.org/v1/gonum/spatial/r2"
+
+	. "gonum.org/v1/gonum/graph/layout"
+)
+
+func TestTutteR2(t *testing.T) {
+	t.Parallel()
+	tutteR2Tests := []struct {
+		name  string
+		nodes []int64
+		edges [][2]int64
+
+		// crossingFree indicates that the
+		// layout must have no crossings.
+		crossingFree bool
+	}{
+		{
+			name:         "K4",
+			edges:        [][2]int64{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}},
+			crossingFree: true,
+		},
+		{
+			name: "cube",
+			edges: [][2]int64{
+				{0, 1}, {1, 2}, {2, 3}, {3, 0},
+				{4, 5}, {5, 6}, {6, 7}, {7, 4},
+				{0, 4}, {1, 5}, {2, 6}, {3, 7},
+			},
+			crossingFree: true,
+		},
+		{
+			name: "wheel",
+			edges: [][2]int64{
+				{0, 1}, {0, 2}, {0, 3}, {0, 4}, {0, 5}, {0, 6},
+				{1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 1},
+			},
+			crossingFree: true,
+		},
+		{
+			name:         "tree",
+			edges:        [][2]int64{{0, 1}, {0, 2}, {1, 3}, {1, 4}, {2, 5}},
+			crossingFree: true,
+		},
+		{
+			name:  "components",
+			nodes: []int64{9},
+			edges: [][2]int64{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {3, 5}, {3, 6}, {4, 5}, {5, 6}},
+		},
+	}
+	for _, test := range tutteR2Tests {
+		g := simple.NewUndirectedGraph()
+		for _, id := range test.nodes {
+			g.AddNode(simple.Node(id))
+		}
+		for _, e := range test.edges {
+			g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+		}
+		embedding, _, ok := topo.Planarity(g)
+		if !ok {
+			t.Fatalf("%q is not planar", test.name)
+		}
+
+		tutte := TutteR2{Embedding: embedding, Radius: 2}
+		o := NewOptimizerR2(g, tutte.Update)
+		if o.Update() {
+			t.Errorf("unexpected further update for %q", test.name)
+		}
+
+		// Every node is either on a circle of radius 2 or at the
+		// barycenter of its neighbors.
+		for _, n := range graph.NodesOf(g.Nodes()) {
+			p := o.Coord2(n.ID())
+			neighbors := graph.NodesOf(g.From(n.ID()))
+			if len(neighbors) == 0 {
+				continue
+			}
+			var mean r2.Vec
+			for _, v := range neighbors {
+				mean = r2.Add(mean, o.Coord2(v.ID()))
+			}
+			mean = r2.Scale(1/float64(len(neighbors)), mean)
+			centre := r2.Vec{X: math.Round(p.X/6) * 6}
+			onCircle := scalar.EqualWithinAbs(r2.Norm(r2.Sub(p, centre)), 2, 1e-12)
+			atMean := scalar.EqualWithinAbs(r2.Norm(r2.Sub(p, mean)), 0, 1e-12)
+			if !onCircle && !atMean {
+				t.Errorf("node %d of %q neither on outer circle nor at barycenter: %v", n.ID(), test.name, p)
+			}
+		}
+
+		if !test.crossingFree {
+			continue
+		}
+		edges := graph.EdgesOf(g.Edges())
+		for i, a := range edges {
+			for _, b := range edges[i+1:] {
+				if shareNode(a, b) {
+					continue
+				}
+				if crosses(o.Coord2(a.From().ID()), o.Coord2(a.To().ID()), o.Coord2(b.From().ID()), o.Coord2(b.To().ID())) {
+					t.Errorf("edges %d-%d and %d-%d of %q cross", a.From().ID(), a.To().ID(), b.From().ID(), b.To().ID(), test.name)
+				}
+			}
+		}
+	}
+}
+
+// shareNode returns whether the edges a and b have a common end.
+func shareNode(a, b graph.Edge) bool {
+	return a.From().ID() == b.From().ID() || a.From().ID() == b.To().ID() ||
+		a.To().ID() == b.From().ID() || a.To().ID() == b.To().ID()
+}
+
+// crosses returns whether the segments p1-p2 and q1-q2 intersect.
+func crosses(p1, p2, q1, q2 r2.Vec) bool {
+	side := func(a, b, c r2.Vec) float64 {
+		d := r2.Cross(r2.Sub(b, a), r2.Sub(c, a))
+		if math.Abs(d) < 1e-12 {
+			return 0
+		}
+		return d
+	}
+	d1, d2 := side(q1, q2, p1), side(q1, q2, p2)
+	d3, d4 := side(p1, p2, q1), side(p1, p2, q2)
+	if d1 == 0 && d2 == 0 {
+		// The segments are collinear so they intersect
+		// if their projections onto p1-p2 overlap.
+		dir := r2.Sub(p2, p1)
+		t1 := r2.Dot(r2.Sub(q1, p1), dir) / r2.Norm2(dir)
+		t2 := r2.Dot(r2.Sub(q2, p1), dir) / r2.Norm2(dir)
+		return math.Max(t1, t2) >= 0 && math.Min(t1, t2) <= 1
+	}
+	return d1*d2 <= 0 && d3*d4 <= 0
+}
@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/mat"
+)
+
+// KuhnMunkres returns a minimum cost assignment of the rows of the cost
+// matrix to its columns using the Kuhn-Munkres (Hungarian) algorithm. Row i
+// is assigned to column assign[i], and the total cost of the assignment is
+// returned.
+//
+// If cost has more rows than columns, only as many rows as there are columns
+// are assigned and the remaining rows have an assigned column of -1. Each
+// column is assigned to at most one row.
+//
+// KuhnMunkres will panic if any element of cost is not finite.
+//
+// See Kuhn doi:10.1002/nav.3800020109 and Munkres doi:10.1137/0105003 for
+// details of the algorithm.
+func KuhnMunkres(cost mat.Matrix) (assign []int, total float64) {
+	r, c := cost.Dims()
+	at := cost.At
+	if r > c {
+		// Assign the columns to the rows.
+		r, c = c, r
+		at = func(i, j int) float64 { return cost.At(j, i) }
+	}
+
+	// The algorithm maintains dual potentials u and v for the rows and
+	// columns and extends the assignment one row at a time along a
+	// shortest augmenting path with respect to the reduced costs. Column
+	// 0 and row 0 are sentinels, so the rows and columns of cost are
+	// offset by one.
+	u := make([]float64, r+1)
+	v := make([]float64, c+1)
+	p := make([]int, c+1)   // p[j] is the row assigned to column j.
+	way := make([]int, c+1) // way[j] is the previous column on the path to j.
+	minv := make([]float64, c+1)
+	used := make([]bool, c+1)
+	for i := 1; i <= r; i++ {
+		p[0] = i
+		j0 := 0
+		for j := range minv {
+			minv[j] = math.Inf(1)
+			used[j] = false
+		}
+		for {
+			used[j0] = true
+			i0 := p[j0]
+			delta := math.Inf(1)
+			j1 := 0
+			for j := 1; j <= c; j++ {
+				if used[j] {
+					continue
+				}
+				a := at(i0-1, j-1)
+				if math.IsInf(a, 0) || math.IsNaN(a) {
+					panic("matching: cost not finite")
+				}
+				if cur := a - u[i0] - v[j]; cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= c; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+		// Augment the assignment along the path.
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	rows, _ := cost.Dims()
+	assign = make([]int, rows)
+	for i := range assign {
+		assign[i] = -1
+	}
+	for j := 1; j <= c; j++ {
+		if p[j] == 0 {
+			continue
+		}
+		i := p[j] - 1
+		if rows == r {
+			assign[i] = j - 1
+			total += cost.At(i, j-1)
+		} else {
+			assign[j-1] = i
+			total += cost.At(j-1, i)
+		}
+	}
+	return assign, total
+}
+
+// MaxWeightBipartite returns a maximum weight matching of the bipartite graph
+// g and its total weight using the Kuhn-Munkres algorithm. Edges with a
+// non-positive weight are never included in the matching. If g is not
+// bipartite, MaxWeightBipartite returns ErrNotBipartite.
+//
+// The nodes of each connected component of g are partitioned into two sides
+// with the lowest ID node of the component on the first side. The From node
+// of each returned edge is on the first side, and the edges are ordered by
+// the ID of their From node.
+func MaxWeightBipartite(g graph.WeightedUndirected) ([]graph.WeightedEdge, float64, error) {
+	nodes, adj := adjacency(g)
+	side, ok := bipartition(adj)
+	if !ok {
+		return nil, 0, ErrNotBipartite
+	}
+	var left, right []int
+	index := make([]int, len(nodes))
+	for u, s := range side {
+		if s == 0 {
+			index[u] = len(left)
+			left = append(left, u)
+		} else {
+			index[u] = len(right)
+			right = append(right, u)
+		}
+	}
+	if len(left) == 0 || len(right) == 0 {
+		return nil, 0, nil
+	}
+
+	// Find a minimum cost assignment with the negated positive weights
+	// as costs. Pairs of nodes that are not adjacent have zero cost and
+	// may be assigned without being matched.
+	cost := mat.NewDense(len(left), len(right), nil)
+	for i, u := range left {
+		uid := nodes[u].ID()
+		for _, v := range adj[u] {
+			w, _ := g.Weight(uid, nodes[v].ID())
+			if w > 0 {
+				cost.Set(i, index[v], -w)
+			}
+		}
+	}
+	assign, _ := KuhnMunkres(cost)
+
+	var (
+		edges []graph.WeightedEdge
+		total float64
+	)
+	for i, j := range assign {
+		if j < 0 || cost.At(i, j) == 0 {
+			continue
+		}
+		e := g.WeightedEdge(nodes[left[i]].ID(), nodes[right[j]].ID())
+		if e.From().ID() != nodes[left[i]].ID() {
+			e = e.ReversedEdge().(graph.WeightedEdge)
+		}
+		edges = append(edges, e)
+		total += e.Weight()
+	}
+	return edges, total, nil
+}
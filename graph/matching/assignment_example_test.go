@@ -0,0 +1,33 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/graph/matching"
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleKuhnMunkres() {
+	// The cost of each of three workers performing each of four jobs.
+	cost := mat.NewDense(3, 4, []float64{
+		9, 2, 7, 8,
+		6, 4, 3, 7,
+		5, 8, 1, 8,
+	})
+
+	assign, total := matching.KuhnMunkres(cost)
+	for worker, job := range assign {
+		fmt.Printf("worker %d performs job %d\n", worker, job)
+	}
+	fmt.Printf("total cost: %v\n", total)
+
+	// Output:
+	// worker 0 performs job 1
+	// worker 1 performs job 0
+	// worker 2 performs job 2
+	// total cost: 9
+}
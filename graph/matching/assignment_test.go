@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestKuhnMunkres(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 300; trial++ {
+		r := rnd.IntN(6) + 1
+		c := rnd.IntN(6) + 1
+		cost := mat.NewDense(r, c, nil)
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				cost.Set(i, j, math.Round(rnd.NormFloat64()*10))
+			}
+		}
+		name := fmt.Sprintf("trial %d %d×%d", trial, r, c)
+
+		assign, total := KuhnMunkres(cost)
+		if len(assign) != r {
+			t.Errorf("%s: unexpected assignment length: got %d, want %d", name, len(assign), r)
+			continue
+		}
+		var sum float64
+		var n int
+		used := make(map[int]bool)
+		for i, j := range assign {
+			if j < 0 {
+				continue
+			}
+			if used[j] {
+				t.Errorf("%s: column %d assigned twice", name, j)
+			}
+			used[j] = true
+			sum += cost.At(i, j)
+			n++
+		}
+		if n != min(r, c) {
+			t.Errorf("%s: unexpected number of assigned rows: got %d, want %d", name, n, min(r, c))
+		}
+		if sum != total {
+			t.Errorf("%s: total cost mismatch: got %v, want %v", name, total, sum)
+		}
+		if want := bruteAssignment(cost); !scalar.EqualWithinAbsOrRel(total, want, 1e-10, 1e-10) {
+			t.Errorf("%s: unexpected total cost: got %v, want %v", name, total, want)
+		}
+	}
+}
+
+// bruteAssignment returns the minimum cost of an assignment by enumerating
+// all assignments.
+func bruteAssignment(cost mat.Matrix) float64 {
+	r, c := cost.Dims()
+	used := make([]bool, c)
+	best := math.Inf(1)
+	var search func(i, n int, sum float64)
+	search = func(i, n int, sum float64) {
+		if n == min(r, c) {
+			best = math.Min(best, sum)
+			return
+		}
+		if i == r {
+			return
+		}
+		if r-i > min(r, c)-n {
+			// Leave row i unassigned.
+			search(i+1, n, sum)
+		}
+		for j := 0; j < c; j++ {
+			if !used[j] {
+				used[j] = true
+				search(i+1, n+1, sum+cost.At(i, j))
+				used[j] = false
+			}
+		}
+	}
+	search(0, 0, 0)
+	return best
+}
+
+func TestMaxWeightBipartite(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 200; trial++ {
+		n := rnd.IntN(12)
+		p := rnd.Float64()
+		g := randomGraph(n, p, true, rnd)
+		name := fmt.Sprintf("trial %d n=%d p=%.2f", trial, n, p)
+
+		edges, total, err := MaxWeightBipartite(g)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		checkMatching(t, name, g, edges)
+		var sum float64
+		for _, e := range edges {
+			if e.Weight() <= 0 {
+				t.Errorf("%s: matched edge with non-positive weight %v", name, e.Weight())
+			}
+			sum += e.Weight()
+		}
+		if sum != total {
+			t.Errorf("%s: total weight mismatch: got %v, want %v", name, total, sum)
+		}
+		if _, want := bruteMatching(g); !scalar.EqualWithinAbsOrRel(total, want, 1e-10, 1e-10) {
+			t.Errorf("%s: unexpected total weight: got %v, want %v", name, total, want)
+		}
+	}
+
+	g := randomGraph(5, 1, false, rnd)
+	if _, _, err := MaxWeightBipartite(g); err != ErrNotBipartite {
+		t.Errorf("unexpected error for complete graph: got %v, want %v", err, ErrNotBipartite)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"errors"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// ErrNotBipartite is returned when a bipartite matching is requested for a
+// graph that is not bipartite.
+var ErrNotBipartite = errors.New("matching: graph is not bipartite")
+
+// HopcroftKarp returns a maximum cardinality matching of the bipartite graph
+// g using the Hopcroft-Karp algorithm. If g is not bipartite, HopcroftKarp
+// returns ErrNotBipartite.
+//
+// The nodes of each connected component of g are partitioned into two sides
+// with the lowest ID node of the component on the first side. The From node
+// of each returned edge is on the first side, and the edges are ordered by
+// the ID of their From node.
+//
+// See Hopcroft and Karp doi:10.1137/0202019 for details of the algorithm.
+func HopcroftKarp(g graph.Undirected) ([]graph.Edge, error) {
+	nodes, adj := adjacency(g)
+	side, ok := bipartition(adj)
+	if !ok {
+		return nil, ErrNotBipartite
+	}
+
+	const inf = int(^uint(0) >> 1)
+	n := len(nodes)
+	mate := make([]int, n)
+	for i := range mate {
+		mate[i] = -1
+	}
+	var left []int
+	for u, s := range side {
+		if s == 0 {
+			left = append(left, u)
+		}
+	}
+	dist := make([]int, n)
+	queue := make([]int, 0, n)
+
+	// bfs computes the layered graph of shortest alternating paths from
+	// the free left nodes, returning whether an augmenting path exists.
+	bfs := func() bool {
+		queue = queue[:0]
+		for _, u := range left {
+			if mate[u] < 0 {
+				dist[u] = 0
+				queue = append(queue, u)
+			} else {
+				dist[u] = inf
+			}
+		}
+		found := false
+		for head := 0; head < len(queue); head++ {
+			u := queue[head]
+			for _, v := range adj[u] {
+				w := mate[v]
+				if w < 0 {
+					found = true
+				} else if dist[w] == inf {
+					dist[w] = dist[u] + 1
+					queue = append(queue, w)
+				}
+			}
+		}
+		return found
+	}
+
+	// dfs searches for an augmenting path from the left node u in the
+	// layered graph, augmenting the matching along it if found.
+	var dfs func(u int) bool
+	dfs = func(u int) bool {
+		for _, v := range adj[u] {
+			w := mate[v]
+			if w < 0 || (dist[w] == dist[u]+1 && dfs(w)) {
+				mate[u] = v
+				mate[v] = u
+				return true
+			}
+		}
+		dist[u] = inf
+		return false
+	}
+
+	for bfs() {
+		for _, u := range left {
+			if mate[u] < 0 {
+				dfs(u)
+			}
+		}
+	}
+
+	var edges []graph.Edge
+	for _, u := range left {
+		if mate[u] >= 0 {
+			e := g.Edge(nodes[u].ID(), nodes[mate[u]].ID())
+			if e.From().ID() != nodes[u].ID() {
+				e = e.ReversedEdge()
+			}
+			edges = append(edges, e)
+		}
+	}
+	return edges, nil
+}
+
+// adjacency returns the nodes of g ordered by ID and the adjacency lists of
+// the nodes held as ascending indices into the returned nodes. Self loops are
+// included.
+func adjacency(g graph.Graph) (nodes []graph.Node, adj [][]int) {
+	nodes = graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	index := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		index[n.ID()] = i
+	}
+	adj = make([][]int, len(nodes))
+	for i, u := range nodes {
+		to := g.From(u.ID())
+		for to.Next() {
+			adj[i] = append(adj[i], index[to.Node().ID()])
+		}
+		slices.Sort(adj[i])
+	}
+	return nodes, adj
+}
+
+// bipartition returns the side, 0 or 1, of each node in a two-coloring of
+// the graph with the given adjacency lists. The lowest index node of each
+// connected component is placed on side 0. If the graph is not bipartite,
+// bipartition returns false.
+func bipartition(adj [][]int) (side []int8, ok bool) {
+	side = make([]int8, len(adj))
+	for i := range side {
+		side[i] = -1
+	}
+	var queue []int
+	for root := range adj {
+		if side[root] >= 0 {
+			continue
+		}
+		side[root] = 0
+		queue = append(queue[:0], root)
+		for head := 0; head < len(queue); head++ {
+			u := queue[head]
+			for _, v := range adj[u] {
+				switch side[v] {
+				case -1:
+					side[v] = 1 - side[u]
+					queue = append(queue, v)
+				case side[u]:
+					return nil, false
+				}
+			}
+		}
+	}
+	return side, true
+}
@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestHopcroftKarp(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 200; trial++ {
+		n := rnd.IntN(12)
+		p := rnd.Float64()
+		g := randomGraph(n, p, true, rnd)
+		name := fmt.Sprintf("trial %d n=%d p=%.2f", trial, n, p)
+
+		edges, err := HopcroftKarp(g)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		checkMatching(t, name, g, edges)
+		want, _ := bruteMatching(g)
+		if len(edges) != want {
+			t.Errorf("%s: unexpected matching size: got %d, want %d", name, len(edges), want)
+		}
+		for k, e := range edges {
+			if k > 0 && e.From().ID() <= edges[k-1].From().ID() {
+				t.Errorf("%s: edges not ordered by From node ID", name)
+			}
+		}
+	}
+}
+
+func TestHopcroftKarpNotBipartite(t *testing.T) {
+	t.Parallel()
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	_, err := HopcroftKarp(g)
+	if err != ErrNotBipartite {
+		t.Errorf("unexpected error for odd cycle: got %v, want %v", err, ErrNotBipartite)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import "gonum.org/v1/gonum/graph"
+
+// Blossom returns a maximum cardinality matching of the undirected graph g
+// using Edmonds' blossom algorithm. Self loops are ignored. The From node of
+// each returned edge has a lower ID than its To node, and the edges are
+// ordered by the ID of their From node.
+//
+// See Edmonds doi:10.4153/CJM-1965-045-4 for details of the algorithm.
+func Blossom(g graph.Undirected) []graph.Edge {
+	nodes, adj := adjacency(g)
+	b := newBlossom(adj)
+
+	// Start from a greedy matching.
+	for u := range adj {
+		if b.mate[u] >= 0 {
+			continue
+		}
+		for _, v := range adj[u] {
+			if v != u && b.mate[v] < 0 {
+				b.mate[u] = v
+				b.mate[v] = u
+				break
+			}
+		}
+	}
+	for u := range adj {
+		if b.mate[u] < 0 {
+			if v := b.findPath(u); v >= 0 {
+				b.augment(v)
+			}
+		}
+	}
+
+	var edges []graph.Edge
+	for u, v := range b.mate {
+		if u < v {
+			e := g.Edge(nodes[u].ID(), nodes[v].ID())
+			if e.From().ID() != nodes[u].ID() {
+				e = e.ReversedEdge()
+			}
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// blossom holds the state of the search for augmenting paths in Edmonds'
+// blossom algorithm.
+type blossom struct {
+	adj  [][]int
+	mate []int
+
+	// parent is the predecessor of each odd node in the alternating
+	// tree, and base is the base of the blossom holding each node.
+	parent []int
+	base   []int
+
+	// used marks the even nodes of the alternating tree and inBlossom
+	// marks the bases of the blossom being contracted.
+	used      []bool
+	inBlossom []bool
+	onPath    []bool
+
+	queue []int
+}
+
+func newBlossom(adj [][]int) *blossom {
+	n := len(adj)
+	b := &blossom{
+		adj:       adj,
+		mate:      make([]int, n),
+		parent:    make([]int, n),
+		base:      make([]int, n),
+		used:      make([]bool, n),
+		inBlossom: make([]bool, n),
+		onPath:    make([]bool, n),
+	}
+	for i := range b.mate {
+		b.mate[i] = -1
+	}
+	return b
+}
+
+// findPath grows an alternating tree from the exposed node root, contracting
+// blossoms as they are found. It returns the exposed node at the end of an
+// augmenting path, or -1 if there is no augmenting path from root.
+func (b *blossom) findPath(root int) int {
+	for i := range b.adj {
+		b.used[i] = false
+		b.parent[i] = -1
+		b.base[i] = i
+	}
+	b.used[root] = true
+	b.queue = append(b.queue[:0], root)
+	for head := 0; head < len(b.queue); head++ {
+		v := b.queue[head]
+		for _, to := range b.adj[v] {
+			if b.base[v] == b.base[to] || b.mate[v] == to {
+				continue
+			}
+			if to == root || (b.mate[to] >= 0 && b.parent[b.mate[to]] >= 0) {
+				// to is even, so the edge closes a blossom.
+				b.contract(v, to)
+				continue
+			}
+			if b.parent[to] < 0 {
+				b.parent[to] = v
+				if b.mate[to] < 0 {
+					return to
+				}
+				next := b.mate[to]
+				b.used[next] = true
+				b.queue = append(b.queue, next)
+			}
+		}
+	}
+	return -1
+}
+
+// contract contracts the blossom formed by the edge between the even nodes
+// v and w into its base.
+func (b *blossom) contract(v, w int) {
+	lca := b.lca(v, w)
+	for i := range b.inBlossom {
+		b.inBlossom[i] = false
+	}
+	b.markPath(v, lca, w)
+	b.markPath(w, lca, v)
+	for i := range b.adj {
+		if b.inBlossom[b.base[i]] {
+			b.base[i] = lca
+			if !b.used[i] {
+				b.used[i] = true
+				b.queue = append(b.queue, i)
+			}
+		}
+	}
+}
+
+// lca returns the base of the lowest common ancestor of the even nodes v and
+// w in the alternating tree.
+func (b *blossom) lca(v, w int) int {
+	for i := range b.onPath {
+		b.onPath[i] = false
+	}
+	for {
+		v = b.base[v]
+		b.onPath[v] = true
+		if b.mate[v] < 0 {
+			break
+		}
+		v = b.parent[b.mate[v]]
+	}
+	for {
+		w = b.base[w]
+		if b.onPath[w] {
+			return w
+		}
+		w = b.parent[b.mate[w]]
+	}
+}
+
+// markPath marks the blossoms on the path from v to the base of the blossom,
+// setting the parents of the odd nodes on the path so that the blossom can
+// be traversed in the direction of child.
+func (b *blossom) markPath(v, base, child int) {
+	for b.base[v] != base {
+		b.inBlossom[b.base[v]] = true
+		b.inBlossom[b.base[b.mate[v]]] = true
+		b.parent[v] = child
+		child = b.mate[v]
+		v = b.parent[b.mate[v]]
+	}
+}
+
+// augment flips the matching along the augmenting path ending at the exposed
+// node v.
+func (b *blossom) augment(v int) {
+	for v >= 0 {
+		pv := b.parent[v]
+		next := b.mate[pv]
+		b.mate[v] = pv
+		b.mate[pv] = v
+		v = next
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestBlossom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 500; trial++ {
+		n := rnd.IntN(12)
+		p := rnd.Float64()
+		g := randomGraph(n, p, trial%4 == 0, rnd)
+		name := fmt.Sprintf("trial %d n=%d p=%.2f", trial, n, p)
+
+		edges := Blossom(g)
+		checkMatching(t, name, g, edges)
+		want, _ := bruteMatching(g)
+		if len(edges) != want {
+			t.Errorf("%s: unexpected matching size: got %d, want %d", name, len(edges), want)
+		}
+	}
+}
+
+func TestBlossomGraphs(t *testing.T) {
+	t.Parallel()
+	petersen := simple.NewUndirectedGraph()
+	for i := 0; i < 5; i++ {
+		petersen.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node((i + 1) % 5)})
+		petersen.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 5)})
+		petersen.SetEdge(simple.Edge{F: simple.Node(i + 5), T: simple.Node((i+2)%5 + 5)})
+	}
+
+	// Two triangles joined by a path, which requires blossom contraction
+	// to find the perfect matching when starting from a poor matching.
+	flower := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 7}, {7, 5}} {
+		flower.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+
+	// A 7×9 grid has an odd number of nodes so one node is unmatched.
+	grid := simple.NewUndirectedGraph()
+	const rows, cols = 7, 9
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			u := simple.Node(i*cols + j)
+			if i+1 < rows {
+				grid.SetEdge(simple.Edge{F: u, T: simple.Node((i+1)*cols + j)})
+			}
+			if j+1 < cols {
+				grid.SetEdge(simple.Edge{F: u, T: simple.Node(i*cols + j + 1)})
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		g    *simple.UndirectedGraph
+		want int
+	}{
+		{name: "empty", g: simple.NewUndirectedGraph(), want: 0},
+		{name: "petersen", g: petersen, want: 5},
+		{name: "flower", g: flower, want: 4},
+		{name: "grid", g: grid, want: 31},
+	} {
+		edges := Blossom(test.g)
+		checkMatching(t, test.name, test.g, edges)
+		if len(edges) != test.want {
+			t.Errorf("%s: unexpected matching size: got %d, want %d", test.name, len(edges), test.want)
+		}
+	}
+}
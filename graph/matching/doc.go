@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package matching provides graph matching and assignment functions.
+//
+// A matching of a graph is a set of edges without common nodes. The package
+// provides maximum cardinality matching for bipartite graphs using the
+// Hopcroft-Karp algorithm and for general undirected graphs using Edmonds'
+// blossom algorithm, maximum weight bipartite matching and minimum cost
+// assignment using the Kuhn-Munkres (Hungarian) algorithm, and maximum weight
+// matching for general undirected graphs using the weighted blossom algorithm.
+package matching // import "gonum.org/v1/gonum/graph/matching"
@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// randomGraph returns a random weighted undirected graph with n nodes where
+// each pair of nodes is joined with probability p. If bipartite is true, only
+// nodes with IDs of different parity are joined.
+func randomGraph(n int, p float64, bipartite bool, rnd *rand.Rand) *simple.WeightedUndirectedGraph {
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if bipartite && (i+j)%2 == 0 {
+				continue
+			}
+			if rnd.Float64() < p {
+				w := math.Round(rnd.NormFloat64()*10+5) / 2
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: w})
+			}
+		}
+	}
+	return g
+}
+
+// bruteMatching returns the maximum cardinality and the maximum weight of a
+// matching of g, ignoring edges with non-positive weight for the weight, by
+// enumerating all matchings.
+func bruteMatching(g *simple.WeightedUndirectedGraph) (size int, weight float64) {
+	edges := graph.WeightedEdgesOf(g.WeightedEdges())
+	used := make(map[int64]bool)
+	var search func(k, n int, w float64)
+	search = func(k, n int, w float64) {
+		size = max(size, n)
+		weight = math.Max(weight, w)
+		for ; k < len(edges); k++ {
+			e := edges[k]
+			uid, vid := e.From().ID(), e.To().ID()
+			if uid == vid || used[uid] || used[vid] {
+				continue
+			}
+			used[uid], used[vid] = true, true
+			search(k+1, n+1, w+math.Max(e.Weight(), 0))
+			used[uid], used[vid] = false, false
+		}
+	}
+	search(0, 0, 0)
+	return size, weight
+}
+
+// checkMatching checks that the edges are a matching of g.
+func checkMatching[E graph.Edge](t *testing.T, name string, g graph.Undirected, edges []E) {
+	t.Helper()
+	used := make(map[int64]bool)
+	for _, e := range edges {
+		uid, vid := e.From().ID(), e.To().ID()
+		if !g.HasEdgeBetween(uid, vid) {
+			t.Errorf("%s: matched edge %d-%d not in graph", name, uid, vid)
+		}
+		if uid == vid || used[uid] || used[vid] {
+			t.Errorf("%s: edges share a node", name)
+		}
+		used[uid], used[vid] = true, true
+	}
+}
+
+// highToLow is a weighted undirected graph that returns its edges oriented
+// from their higher ID node.
+type highToLow struct {
+	*simple.WeightedUndirectedGraph
+}
+
+func (g highToLow) Edge(uid, vid int64) graph.Edge {
+	return g.WeightedEdge(uid, vid)
+}
+
+func (g highToLow) WeightedEdge(uid, vid int64) graph.WeightedEdge {
+	e := g.WeightedUndirectedGraph.WeightedEdge(uid, vid)
+	if e != nil && e.From().ID() < e.To().ID() {
+		e = e.ReversedEdge().(graph.WeightedEdge)
+	}
+	return e
+}
+
+func TestEdgeOrientation(t *testing.T) {
+	t.Parallel()
+	g := highToLow{simple.NewWeightedUndirectedGraph(0, math.Inf(1))}
+	for i := int64(1); i < 6; i++ {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i - 1), T: simple.Node(i), W: 1})
+	}
+
+	check := func(name string, edges []graph.Edge) {
+		t.Helper()
+		checkMatching(t, name, g, edges)
+		if len(edges) != 3 {
+			t.Errorf("%s: unexpected matching size: got %d, want 3", name, len(edges))
+		}
+		for i, e := range edges {
+			if e.From().ID() >= e.To().ID() {
+				t.Errorf("%s: edge %d-%d not oriented from its lower ID node", name, e.From().ID(), e.To().ID())
+			}
+			if i > 0 && edges[i-1].From().ID() >= e.From().ID() {
+				t.Errorf("%s: edges not ordered by From node ID", name)
+			}
+		}
+	}
+	weighted := func(edges []graph.WeightedEdge) []graph.Edge {
+		out := make([]graph.Edge, len(edges))
+		for i, e := range edges {
+			out[i] = e
+		}
+		return out
+	}
+
+	check("Blossom", Blossom(g))
+	edges, err := HopcroftKarp(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check("HopcroftKarp", edges)
+	wedges, _ := MaxWeight(g, false)
+	check("MaxWeight", weighted(wedges))
+	wedges, _, err = MaxWeightBipartite(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check("MaxWeightBipartite", weighted(wedges))
+}
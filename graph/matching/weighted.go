@@ -0,0 +1,656 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// MaxWeight returns a maximum weight matching of the undirected graph g and
+// its total weight using Edmonds' weighted blossom algorithm. If
+// maxCardinality is true, MaxWeight returns a matching with the maximum
+// weight among the matchings of maximum cardinality. Otherwise edges with a
+// non-positive weight are never included in the matching. Self loops are
+// ignored.
+//
+// The From node of each returned edge has a lower ID than its To node, and
+// the edges are ordered by the ID of their From node.
+//
+// MaxWeight will panic if g has an edge weight that is not finite.
+//
+// See Galil doi:10.1145/6462.6502 for details of the algorithm.
+func MaxWeight(g graph.WeightedUndirected, maxCardinality bool) ([]graph.WeightedEdge, float64) {
+	nodes, adj := adjacency(g)
+	var edges []weightedEdge
+	for u, to := range adj {
+		uid := nodes[u].ID()
+		for _, v := range to {
+			if v <= u {
+				continue
+			}
+			w, _ := g.Weight(uid, nodes[v].ID())
+			if math.IsInf(w, 0) || math.IsNaN(w) {
+				panic("matching: weight not finite")
+			}
+			edges = append(edges, weightedEdge{u: u, v: v, w: w})
+		}
+	}
+
+	mate := newWeightedBlossom(len(nodes), edges).solve(maxCardinality)
+
+	var (
+		matched []graph.WeightedEdge
+		total   float64
+	)
+	for u, v := range mate {
+		if u < v {
+			e := g.WeightedEdge(nodes[u].ID(), nodes[v].ID())
+			if e.From().ID() != nodes[u].ID() {
+				e = e.ReversedEdge().(graph.WeightedEdge)
+			}
+			matched = append(matched, e)
+			total += e.Weight()
+		}
+	}
+	return matched, total
+}
+
+// weightedEdge is an edge between the nodes with indices u and v.
+type weightedEdge struct {
+	u, v int
+	w    float64
+}
+
+// weightedBlossom holds the state of the primal-dual weighted blossom
+// algorithm. Nodes are numbered from 0 to n-1 and non-trivial blossoms from
+// n to 2n-1. The two endpoints of edge k are referred to as 2k and 2k+1.
+type weightedBlossom struct {
+	n     int
+	edges []weightedEdge
+
+	// endpoint holds the node at each edge endpoint and
+	// neighbors holds the remote endpoints of the edges
+	// incident to each node.
+	endpoint  []int
+	neighbors [][]int
+
+	// mate holds the remote endpoint of the matched edge
+	// of each node, or -1.
+	mate []int
+
+	// label holds the label of each top-level blossom,
+	// 0 for unlabeled, 1 for S and 2 for T, and labelEnd
+	// holds the endpoint through which the label was
+	// assigned, or -1.
+	label    []int
+	labelEnd []int
+
+	// inBlossom holds the top-level blossom of each node.
+	inBlossom []int
+
+	// parent, children, base and endpoints hold the
+	// structure of the blossoms. The endpoints of a
+	// blossom connect its consecutive children.
+	parent    []int
+	children  [][]int
+	base      []int
+	endpoints [][]int
+
+	// bestEdge holds the least-slack edge to an S blossom
+	// for each node or blossom and bestEdges holds the
+	// least-slack edges to neighboring S blossoms for
+	// each S blossom.
+	bestEdge  []int
+	bestEdges [][]int
+
+	unused    []int
+	dual      []float64
+	allowEdge []bool
+	queue     []int
+}
+
+func newWeightedBlossom(n int, edges []weightedEdge) *weightedBlossom {
+	b := &weightedBlossom{
+		n:         n,
+		edges:     edges,
+		endpoint:  make([]int, 2*len(edges)),
+		neighbors: make([][]int, n),
+		mate:      make([]int, n),
+		label:     make([]int, 2*n),
+		labelEnd:  make([]int, 2*n),
+		inBlossom: make([]int, n),
+		parent:    make([]int, 2*n),
+		children:  make([][]int, 2*n),
+		base:      make([]int, 2*n),
+		endpoints: make([][]int, 2*n),
+		bestEdge:  make([]int, 2*n),
+		bestEdges: make([][]int, 2*n),
+		dual:      make([]float64, 2*n),
+		allowEdge: make([]bool, len(edges)),
+	}
+	var maxWeight float64
+	for k, e := range edges {
+		b.endpoint[2*k] = e.u
+		b.endpoint[2*k+1] = e.v
+		b.neighbors[e.u] = append(b.neighbors[e.u], 2*k+1)
+		b.neighbors[e.v] = append(b.neighbors[e.v], 2*k)
+		maxWeight = math.Max(maxWeight, e.w)
+	}
+	for v := 0; v < n; v++ {
+		b.mate[v] = -1
+		b.inBlossom[v] = v
+		b.base[v] = v
+		b.base[n+v] = -1
+		b.dual[v] = maxWeight
+		b.unused = append(b.unused, n+v)
+	}
+	for i := range b.parent {
+		b.labelEnd[i] = -1
+		b.parent[i] = -1
+		b.bestEdge[i] = -1
+	}
+	return b
+}
+
+// slack returns twice the slack of edge k, which is non-negative for a
+// feasible dual solution.
+func (b *weightedBlossom) slack(k int) float64 {
+	e := b.edges[k]
+	return b.dual[e.u] + b.dual[e.v] - 2*e.w
+}
+
+// leaves returns the nodes contained in the blossom t.
+func (b *weightedBlossom) leaves(t int, dst []int) []int {
+	if t < b.n {
+		return append(dst, t)
+	}
+	for _, c := range b.children[t] {
+		dst = b.leaves(c, dst)
+	}
+	return dst
+}
+
+// assignLabel assigns the label t to the top-level blossom containing the
+// node w through the endpoint p, and labels the mate of the base of a T
+// blossom with S.
+func (b *weightedBlossom) assignLabel(w, t, p int) {
+	bw := b.inBlossom[w]
+	b.label[w], b.label[bw] = t, t
+	b.labelEnd[w], b.labelEnd[bw] = p, p
+	b.bestEdge[w], b.bestEdge[bw] = -1, -1
+	switch t {
+	case 1:
+		b.queue = b.leaves(bw, b.queue)
+	case 2:
+		base := b.base[bw]
+		m := b.mate[base]
+		b.assignLabel(b.endpoint[m], 1, m^1)
+	}
+}
+
+// scanBlossom traces back from the S nodes v and w to find either a new
+// blossom or an augmenting path. It returns the base of the new blossom, or
+// -1 if an augmenting path was found.
+func (b *weightedBlossom) scanBlossom(v, w int) int {
+	var path []int
+	base := -1
+	for v != -1 || w != -1 {
+		bv := b.inBlossom[v]
+		if b.label[bv]&4 != 0 {
+			base = b.base[bv]
+			break
+		}
+		path = append(path, bv)
+		b.label[bv] = 5
+		if b.labelEnd[bv] == -1 {
+			// The root of the alternating tree.
+			v = -1
+		} else {
+			v = b.endpoint[b.labelEnd[bv]]
+			bv = b.inBlossom[v]
+			v = b.endpoint[b.labelEnd[bv]]
+		}
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+	for _, bv := range path {
+		b.label[bv] = 1
+	}
+	return base
+}
+
+// addBlossom constructs a new blossom with the given base from the S
+// blossoms joined by edge k.
+func (b *weightedBlossom) addBlossom(base, k int) {
+	v, w := b.edges[k].u, b.edges[k].v
+	bb := b.inBlossom[base]
+	bv := b.inBlossom[v]
+	bw := b.inBlossom[w]
+
+	nb := b.unused[len(b.unused)-1]
+	b.unused = b.unused[:len(b.unused)-1]
+	b.base[nb] = base
+	b.parent[nb] = -1
+	b.parent[bb] = nb
+
+	var path, endps []int
+	for bv != bb {
+		b.parent[bv] = nb
+		path = append(path, bv)
+		endps = append(endps, b.labelEnd[bv])
+		v = b.endpoint[b.labelEnd[bv]]
+		bv = b.inBlossom[v]
+	}
+	path = append(path, bb)
+	slices.Reverse(path)
+	slices.Reverse(endps)
+	endps = append(endps, 2*k)
+	for bw != bb {
+		b.parent[bw] = nb
+		path = append(path, bw)
+		endps = append(endps, b.labelEnd[bw]^1)
+		w = b.endpoint[b.labelEnd[bw]]
+		bw = b.inBlossom[w]
+	}
+	b.children[nb] = path
+	b.endpoints[nb] = endps
+
+	b.label[nb] = 1
+	b.labelEnd[nb] = b.labelEnd[bb]
+	b.dual[nb] = 0
+	for _, v := range b.leaves(nb, nil) {
+		if b.label[b.inBlossom[v]] == 2 {
+			// T nodes become S nodes in the new blossom.
+			b.queue = append(b.queue, v)
+		}
+		b.inBlossom[v] = nb
+	}
+
+	// Compute the least-slack edges to neighboring S blossoms.
+	bestTo := make([]int, 2*b.n)
+	for i := range bestTo {
+		bestTo[i] = -1
+	}
+	for _, bv := range path {
+		var lists [][]int
+		if b.bestEdges[bv] == nil {
+			for _, v := range b.leaves(bv, nil) {
+				list := make([]int, len(b.neighbors[v]))
+				for i, p := range b.neighbors[v] {
+					list[i] = p / 2
+				}
+				lists = append(lists, list)
+			}
+		} else {
+			lists = [][]int{b.bestEdges[bv]}
+		}
+		for _, list := range lists {
+			for _, k := range list {
+				j := b.edges[k].v
+				if b.inBlossom[j] == nb {
+					j = b.edges[k].u
+				}
+				bj := b.inBlossom[j]
+				if bj != nb && b.label[bj] == 1 && (bestTo[bj] == -1 || b.slack(k) < b.slack(bestTo[bj])) {
+					bestTo[bj] = k
+				}
+			}
+		}
+		b.bestEdges[bv] = nil
+		b.bestEdge[bv] = -1
+	}
+	var best []int
+	for _, k := range bestTo {
+		if k != -1 {
+			best = append(best, k)
+		}
+	}
+	b.bestEdges[nb] = best
+	b.bestEdge[nb] = -1
+	for _, k := range best {
+		if b.bestEdge[nb] == -1 || b.slack(k) < b.slack(b.bestEdge[nb]) {
+			b.bestEdge[nb] = k
+		}
+	}
+}
+
+// expandBlossom expands the top-level blossom t into its children. If
+// endStage is true, blossoms with a zero dual are expanded recursively.
+func (b *weightedBlossom) expandBlossom(t int, endStage bool) {
+	for _, s := range b.children[t] {
+		b.parent[s] = -1
+		switch {
+		case s < b.n:
+			b.inBlossom[s] = s
+		case endStage && b.dual[s] == 0:
+			b.expandBlossom(s, endStage)
+		default:
+			for _, v := range b.leaves(s, nil) {
+				b.inBlossom[v] = s
+			}
+		}
+	}
+
+	if !endStage && b.label[t] == 2 {
+		// Relabel the children on the even length path from
+		// the entry child to the base as T and S blossoms.
+		children := b.children[t]
+		endps := b.endpoints[t]
+		at := func(s []int, j int) int { return s[((j%len(s))+len(s))%len(s)] }
+
+		entry := b.inBlossom[b.endpoint[b.labelEnd[t]^1]]
+		j := slices.Index(children, entry)
+		var step, trick int
+		if j&1 != 0 {
+			j -= len(children)
+			step, trick = 1, 0
+		} else {
+			step, trick = -1, 1
+		}
+		p := b.labelEnd[t]
+		for j != 0 {
+			b.label[b.endpoint[p^1]] = 0
+			b.label[b.endpoint[at(endps, j-trick)^trick^1]] = 0
+			b.assignLabel(b.endpoint[p^1], 2, p)
+			b.allowEdge[at(endps, j-trick)/2] = true
+			j += step
+			p = at(endps, j-trick) ^ trick
+			b.allowEdge[p/2] = true
+			j += step
+		}
+		bv := at(children, j)
+		b.label[b.endpoint[p^1]], b.label[bv] = 2, 2
+		b.labelEnd[b.endpoint[p^1]], b.labelEnd[bv] = p, p
+		b.bestEdge[bv] = -1
+		j += step
+		for at(children, j) != entry {
+			bv := at(children, j)
+			if b.label[bv] == 1 {
+				// Already labeled S through another path.
+				j += step
+				continue
+			}
+			for _, v := range b.leaves(bv, nil) {
+				if b.label[v] != 0 {
+					// The child is reachable through a
+					// neighboring S blossom.
+					b.label[v] = 0
+					b.label[b.endpoint[b.mate[b.base[bv]]]] = 0
+					b.assignLabel(v, 2, b.labelEnd[v])
+					break
+				}
+			}
+			j += step
+		}
+	}
+
+	b.label[t], b.labelEnd[t] = -1, -1
+	b.children[t], b.endpoints[t] = nil, nil
+	b.base[t] = -1
+	b.bestEdges[t] = nil
+	b.bestEdge[t] = -1
+	b.unused = append(b.unused, t)
+}
+
+// augmentBlossom swaps the matched and unmatched edges along the even length
+// path from the node v to the base of the blossom t, making v the new base.
+func (b *weightedBlossom) augmentBlossom(t, v int) {
+	s := v
+	for b.parent[s] != t {
+		s = b.parent[s]
+	}
+	if s >= b.n {
+		b.augmentBlossom(s, v)
+	}
+
+	children := b.children[t]
+	endps := b.endpoints[t]
+	at := func(s []int, j int) int { return s[((j%len(s))+len(s))%len(s)] }
+
+	i := slices.Index(children, s)
+	j := i
+	var step, trick int
+	if i&1 != 0 {
+		j -= len(children)
+		step, trick = 1, 0
+	} else {
+		step, trick = -1, 1
+	}
+	for j != 0 {
+		j += step
+		s = at(children, j)
+		p := at(endps, j-trick) ^ trick
+		if s >= b.n {
+			b.augmentBlossom(s, b.endpoint[p])
+		}
+		j += step
+		s = at(children, j)
+		if s >= b.n {
+			b.augmentBlossom(s, b.endpoint[p^1])
+		}
+		b.mate[b.endpoint[p]] = p ^ 1
+		b.mate[b.endpoint[p^1]] = p
+	}
+
+	// Rotate the children so that the new base is first.
+	b.children[t] = append(slices.Clone(children[i:]), children[:i]...)
+	b.endpoints[t] = append(slices.Clone(endps[i:]), endps[:i]...)
+	b.base[t] = b.base[b.children[t][0]]
+}
+
+// augmentMatching swaps the matched and unmatched edges along the augmenting
+// path through the edge k between two S nodes.
+func (b *weightedBlossom) augmentMatching(k int) {
+	e := b.edges[k]
+	for _, sp := range [2][2]int{{e.u, 2*k + 1}, {e.v, 2 * k}} {
+		s, p := sp[0], sp[1]
+		for {
+			bs := b.inBlossom[s]
+			if bs >= b.n {
+				b.augmentBlossom(bs, s)
+			}
+			b.mate[s] = p
+			if b.labelEnd[bs] == -1 {
+				// Reached the root of the alternating tree.
+				break
+			}
+			t := b.endpoint[b.labelEnd[bs]]
+			bt := b.inBlossom[t]
+			s = b.endpoint[b.labelEnd[bt]]
+			j := b.endpoint[b.labelEnd[bt]^1]
+			if bt >= b.n {
+				b.augmentBlossom(bt, j)
+			}
+			b.mate[j] = b.labelEnd[bt]
+			p = b.labelEnd[bt] ^ 1
+		}
+	}
+}
+
+// solve returns the mate of each node in a maximum weight matching, or -1
+// for unmatched nodes.
+func (b *weightedBlossom) solve(maxCardinality bool) []int {
+	n := b.n
+	if n == 0 {
+		return nil
+	}
+	for stage := 0; stage < n; stage++ {
+		for i := range b.label {
+			b.label[i] = 0
+			b.bestEdge[i] = -1
+		}
+		for i := n; i < 2*n; i++ {
+			b.bestEdges[i] = nil
+		}
+		for i := range b.allowEdge {
+			b.allowEdge[i] = false
+		}
+		b.queue = b.queue[:0]
+		for v := 0; v < n; v++ {
+			if b.mate[v] == -1 && b.label[b.inBlossom[v]] == 0 {
+				b.assignLabel(v, 1, -1)
+			}
+		}
+
+		augmented := false
+		for {
+			// Grow the alternating forest along tight edges.
+			for len(b.queue) > 0 && !augmented {
+				v := b.queue[len(b.queue)-1]
+				b.queue = b.queue[:len(b.queue)-1]
+				for _, p := range b.neighbors[v] {
+					k := p / 2
+					w := b.endpoint[p]
+					if b.inBlossom[v] == b.inBlossom[w] {
+						continue
+					}
+					var slack float64
+					if !b.allowEdge[k] {
+						slack = b.slack(k)
+						if slack <= 0 {
+							b.allowEdge[k] = true
+						}
+					}
+					switch {
+					case b.allowEdge[k]:
+						switch {
+						case b.label[b.inBlossom[w]] == 0:
+							b.assignLabel(w, 2, p^1)
+						case b.label[b.inBlossom[w]] == 1:
+							if base := b.scanBlossom(v, w); base >= 0 {
+								b.addBlossom(base, k)
+							} else {
+								b.augmentMatching(k)
+								augmented = true
+							}
+						case b.label[w] == 0:
+							b.label[w] = 2
+							b.labelEnd[w] = p ^ 1
+						}
+					case b.label[b.inBlossom[w]] == 1:
+						bv := b.inBlossom[v]
+						if b.bestEdge[bv] == -1 || slack < b.slack(b.bestEdge[bv]) {
+							b.bestEdge[bv] = k
+						}
+					case b.label[w] == 0:
+						if b.bestEdge[w] == -1 || slack < b.slack(b.bestEdge[w]) {
+							b.bestEdge[w] = k
+						}
+					}
+					if augmented {
+						break
+					}
+				}
+			}
+			if augmented {
+				break
+			}
+
+			// Find the largest dual update that keeps the
+			// dual solution feasible.
+			deltaType := -1
+			var (
+				delta        float64
+				deltaEdge    = -1
+				deltaBlossom = -1
+			)
+			if !maxCardinality {
+				deltaType = 1
+				delta = slices.Min(b.dual[:n])
+			}
+			for v := 0; v < n; v++ {
+				if b.label[b.inBlossom[v]] == 0 && b.bestEdge[v] != -1 {
+					d := b.slack(b.bestEdge[v])
+					if deltaType == -1 || d < delta {
+						delta, deltaType, deltaEdge = d, 2, b.bestEdge[v]
+					}
+				}
+			}
+			for t := 0; t < 2*n; t++ {
+				if b.parent[t] == -1 && b.label[t] == 1 && b.bestEdge[t] != -1 {
+					d := b.slack(b.bestEdge[t]) / 2
+					if deltaType == -1 || d < delta {
+						delta, deltaType, deltaEdge = d, 3, b.bestEdge[t]
+					}
+				}
+			}
+			for t := n; t < 2*n; t++ {
+				if b.base[t] >= 0 && b.parent[t] == -1 && b.label[t] == 2 && (deltaType == -1 || b.dual[t] < delta) {
+					delta, deltaType, deltaBlossom = b.dual[t], 4, t
+				}
+			}
+			if deltaType == -1 {
+				// No further improvement is possible with a
+				// maximum cardinality matching.
+				deltaType = 1
+				delta = math.Max(0, slices.Min(b.dual[:n]))
+			}
+
+			for v := 0; v < n; v++ {
+				switch b.label[b.inBlossom[v]] {
+				case 1:
+					b.dual[v] -= delta
+				case 2:
+					b.dual[v] += delta
+				}
+			}
+			for t := n; t < 2*n; t++ {
+				if b.base[t] >= 0 && b.parent[t] == -1 {
+					switch b.label[t] {
+					case 1:
+						b.dual[t] += delta
+					case 2:
+						b.dual[t] -= delta
+					}
+				}
+			}
+
+			switch deltaType {
+			case 1:
+				// The optimum has been reached.
+			case 2:
+				b.allowEdge[deltaEdge] = true
+				i := b.edges[deltaEdge].u
+				if b.label[b.inBlossom[i]] == 0 {
+					i = b.edges[deltaEdge].v
+				}
+				b.queue = append(b.queue, i)
+				continue
+			case 3:
+				b.allowEdge[deltaEdge] = true
+				b.queue = append(b.queue, b.edges[deltaEdge].u)
+				continue
+			case 4:
+				b.expandBlossom(deltaBlossom, false)
+				continue
+			}
+			break
+		}
+		if !augmented {
+			break
+		}
+
+		// Expand S blossoms with a zero dual at the end of the stage.
+		for t := n; t < 2*n; t++ {
+			if b.parent[t] == -1 && b.base[t] >= 0 && b.label[t] == 1 && b.dual[t] == 0 {
+				b.expandBlossom(t, true)
+			}
+		}
+	}
+
+	mate := make([]int, n)
+	for v, p := range b.mate {
+		if p >= 0 {
+			mate[v] = b.endpoint[p]
+		} else {
+			mate[v] = -1
+		}
+	}
+	return mate
+}
@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestMaxWeight(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 500; trial++ {
+		n := rnd.IntN(11)
+		p := rnd.Float64()
+		g := randomGraph(n, p, false, rnd)
+		wantSize, wantWeight := bruteMatching(g)
+		wantCardWeight := bruteMaxCardinality(g, wantSize)
+
+		for _, maxCardinality := range []bool{false, true} {
+			name := fmt.Sprintf("trial %d n=%d p=%.2f maxCardinality=%t", trial, n, p, maxCardinality)
+			edges, total := MaxWeight(g, maxCardinality)
+			checkMatching(t, name, g, edges)
+			var sum float64
+			for i, e := range edges {
+				if e.From().ID() >= e.To().ID() {
+					t.Errorf("%s: edge not ordered: %d-%d", name, e.From().ID(), e.To().ID())
+				}
+				if i > 0 && edges[i-1].From().ID() >= e.From().ID() {
+					t.Errorf("%s: edges not sorted", name)
+				}
+				sum += e.Weight()
+			}
+			if sum != total {
+				t.Errorf("%s: total weight mismatch: got %v, want %v", name, total, sum)
+			}
+			want := wantWeight
+			if maxCardinality {
+				if len(edges) != wantSize {
+					t.Errorf("%s: unexpected matching size: got %d, want %d", name, len(edges), wantSize)
+				}
+				want = wantCardWeight
+			}
+			if !scalar.EqualWithinAbsOrRel(total, want, 1e-10, 1e-10) {
+				t.Errorf("%s: unexpected total weight: got %v, want %v", name, total, want)
+			}
+		}
+	}
+}
+
+func TestMaxWeightBipartiteAgreement(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		g := randomGraph(60, 0.2, true, rnd)
+		_, got := MaxWeight(g, false)
+		_, want, err := MaxWeightBipartite(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+			t.Errorf("trial %d: unexpected total weight: got %v, want %v", trial, got, want)
+		}
+	}
+}
+
+// bruteMaxCardinality returns the maximum weight of a matching of g with the
+// given size by enumerating all matchings.
+func bruteMaxCardinality(g *simple.WeightedUndirectedGraph, size int) float64 {
+	edges := graph.WeightedEdgesOf(g.WeightedEdges())
+	used := make(map[int64]bool)
+	best := math.Inf(-1)
+	var search func(k, n int, w float64)
+	search = func(k, n int, w float64) {
+		if n == size {
+			best = math.Max(best, w)
+			return
+		}
+		for ; k < len(edges); k++ {
+			e := edges[k]
+			uid, vid := e.From().ID(), e.To().ID()
+			if used[uid] || used[vid] {
+				continue
+			}
+			used[uid], used[vid] = true, true
+			search(k+1, n+1, w+e.Weight())
+			used[uid], used[vid] = false, false
+		}
+	}
+	search(0, 0, 0)
+	return best
+}
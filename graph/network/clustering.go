@@ -0,0 +1,306 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Triangles returns the number of triangles containing each node of the
+// undirected graph g. Self edges are ignored. The total number of triangles
+// in g is a third of the sum of the returned values.
+func Triangles(g graph.Undirected) map[int64]int {
+	a := newArcs(g, nil)
+	t := make(map[int64]int, len(a.nodes))
+	for v, n := range a.nodes {
+		var c int
+		for j := range a.out[v] {
+			for h := range a.out[v] {
+				if j < h && a.adjacent(j, h) {
+					c++
+				}
+			}
+		}
+		t[n.ID()] = c
+	}
+	return t
+}
+
+// Transitivity returns the global clustering coefficient of the undirected
+// graph g, the fraction of connected triples of nodes that are closed into
+// triangles.
+//
+//	T = 3 × triangles / connected triples
+//
+// Self edges are ignored. If g has no connected triples, Transitivity returns
+// zero.
+func Transitivity(g graph.Undirected) float64 {
+	var triangles, triples int
+	a := newArcs(g, nil)
+	for v := range a.nodes {
+		k := len(a.out[v])
+		triples += k * (k - 1) / 2
+	}
+	for _, t := range Triangles(g) {
+		triangles += t
+	}
+	if triples == 0 {
+		return 0
+	}
+	// Each triangle is counted once at each of its nodes.
+	return float64(triangles) / float64(triples)
+}
+
+// LocalClustering returns the local clustering coefficient for nodes of the
+// graph g. For undirected graphs the local clustering coefficient of a node
+// is the fraction of pairs of its neighbors that are adjacent.
+//
+//	C(v) = 2 T(v) / (k_v (k_v - 1))
+//
+// where T(v) is the number of triangles through v and k_v is the degree of v.
+//
+// For directed graphs the clustering coefficient is the fraction of all
+// possible directed triangles through v that exist.
+//
+//	C(v) = T(v) / (k_v^tot (k_v^tot - 1) - 2 k_v^↔)
+//
+// where T(v) is the number of directed triangles through v, k_v^tot is the
+// sum of the in- and out-degrees of v and k_v^↔ is the number of reciprocated
+// edges of v. Nodes with fewer than two neighbors have zero clustering.
+// Self edges are ignored.
+//
+// See Fagiolo, "Clustering in complex directed networks", Phys. Rev. E 76
+// (2007) for details of the directed clustering coefficient.
+func LocalClustering(g graph.Graph) map[int64]float64 {
+	return newArcs(g, nil).clustering()
+}
+
+// LocalClusteringWeighted returns the weighted local clustering coefficient
+// for nodes of the weighted graph g. Edge weights must be non-negative.
+//
+// For undirected graphs the clustering coefficient is the generalization of
+// Onnela et al. which replaces the count of triangles in LocalClustering with
+// the sum of the geometric means of the triangle edge weights normalized by
+// the maximum edge weight in g.
+//
+//	C(v) = 1/(k_v (k_v - 1)) \sum_{j,h} (ŵ_{vj} ŵ_{jh} ŵ_{hv})^{1/3}
+//
+// For directed graphs the weighted generalization of Fagiolo is used, which
+// similarly replaces each directed triangle with the geometric mean of its
+// normalized edge weights. Nodes with fewer than two neighbors have zero
+// clustering. Self edges are ignored.
+//
+// See Onnela, Saramäki, Kertész and Kaski, "Intensity and coherence of motifs
+// in weighted complex networks", Phys. Rev. E 71 (2005) for details.
+func LocalClusteringWeighted(g graph.Weighted) map[int64]float64 {
+	a := newArcs(g, func(uid, vid int64) float64 {
+		w, _ := g.Weight(uid, vid)
+		if w < 0 {
+			panic("network: negative edge weight")
+		}
+		return w
+	})
+	var max float64
+	for _, out := range a.out {
+		for _, w := range out {
+			max = math.Max(max, w)
+		}
+	}
+	if max == 0 {
+		max = 1
+	}
+	normalize := func(arcs []map[int]float64) {
+		for _, e := range arcs {
+			for u, w := range e {
+				e[u] = math.Cbrt(w / max)
+			}
+		}
+	}
+	normalize(a.out)
+	if a.directed {
+		normalize(a.in)
+	}
+	return a.clustering()
+}
+
+// BarratClustering returns the weighted local clustering coefficient of
+// Barrat et al. for nodes of the weighted undirected graph g.
+//
+//	C(v) = 1/(s_v (k_v - 1)) \sum_{j,h} (w_{vj} + w_{vh})/2 a_{vj} a_{jh} a_{hv}
+//
+// where s_v is the sum of the weights of edges of v, k_v is the degree of v
+// and a_{ij} is one if i and j are adjacent and zero otherwise. The sum is over
+// ordered pairs of neighbors of v. Nodes with fewer than two neighbors or with
+// zero strength have zero clustering. Self edges are ignored.
+//
+// See Barrat, Barthélemy, Pastor-Satorras and Vespignani, "The architecture
+// of complex weighted networks", PNAS 101 (2004) for details.
+func BarratClustering(g graph.WeightedUndirected) map[int64]float64 {
+	a := newArcs(g, func(uid, vid int64) float64 {
+		w, _ := g.Weight(uid, vid)
+		return w
+	})
+	c := make(map[int64]float64, len(a.nodes))
+	for v, n := range a.nodes {
+		k := len(a.out[v])
+		var s float64
+		for _, w := range a.out[v] {
+			s += w
+		}
+		if k < 2 || s == 0 {
+			c[n.ID()] = 0
+			continue
+		}
+		var sum float64
+		for j, wj := range a.out[v] {
+			for h, wh := range a.out[v] {
+				if j != h && a.adjacent(j, h) {
+					sum += (wj + wh) / 2
+				}
+			}
+		}
+		c[n.ID()] = sum / (s * float64(k-1))
+	}
+	return c
+}
+
+// arcs is a weighted adjacency representation of a graph with nodes
+// identified by their index.
+type arcs struct {
+	nodes    []graph.Node
+	directed bool
+
+	// out and in hold the weights of the edges
+	// leaving and entering each node. For
+	// undirected graphs in is the same as out.
+	out, in []map[int]float64
+}
+
+// newArcs returns the arcs of g ignoring self edges, with the nodes of g
+// ordered by ID. If weight is nil all edges have unit weight.
+func newArcs(g graph.Graph, weight func(uid, vid int64) float64) arcs {
+	if weight == nil {
+		weight = func(uid, vid int64) float64 { return 1 }
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	a := arcs{nodes: nodes, out: make([]map[int]float64, len(nodes))}
+	for u, n := range nodes {
+		uid := n.ID()
+		a.out[u] = make(map[int]float64)
+		for to := g.From(uid); to.Next(); {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			a.out[u][indexOf[vid]] = weight(uid, vid)
+		}
+	}
+	a.in = a.out
+	if _, ok := g.(graph.Directed); ok {
+		a.directed = true
+		a.in = make([]map[int]float64, len(nodes))
+		for u := range a.in {
+			a.in[u] = make(map[int]float64)
+		}
+		for u, out := range a.out {
+			for v, w := range out {
+				a.in[v][u] = w
+			}
+		}
+	}
+	return a
+}
+
+// adjacent returns whether there is an edge from u to v.
+func (a arcs) adjacent(u, v int) bool {
+	_, ok := a.out[u][v]
+	return ok
+}
+
+// neighbors returns the union of the in and out neighbors of each node.
+func (a arcs) neighbors() []map[int]bool {
+	neighbors := make([]map[int]bool, len(a.nodes))
+	for v := range neighbors {
+		neighbors[v] = make(map[int]bool, len(a.out[v])+len(a.in[v]))
+		for u := range a.out[v] {
+			neighbors[v][u] = true
+		}
+		for u := range a.in[v] {
+			neighbors[v][u] = true
+		}
+	}
+	return neighbors
+}
+
+// clustering returns the local clustering coefficients of the nodes using the
+// arc weights as the weights of triangle edges.
+func (a arcs) clustering() map[int64]float64 {
+	c := make(map[int64]float64, len(a.nodes))
+	for v, n := range a.nodes {
+		if !a.directed {
+			k := len(a.out[v])
+			if k < 2 {
+				c[n.ID()] = 0
+				continue
+			}
+			var sum float64
+			for j, wj := range a.out[v] {
+				for h, wh := range a.out[v] {
+					if j == h {
+						continue
+					}
+					if wjh, ok := a.out[j][h]; ok {
+						sum += wj * wjh * wh
+					}
+				}
+			}
+			c[n.ID()] = sum / float64(k*(k-1))
+			continue
+		}
+
+		// Directed clustering sums the products of
+		// (w_{vj} + w_{jv})(w_{jh} + w_{hj})(w_{hv} + w_{vh})
+		// over ordered pairs of neighbors.
+		neighbors := make(map[int]bool)
+		var reciprocal int
+		for u := range a.out[v] {
+			neighbors[u] = true
+			if _, ok := a.in[v][u]; ok {
+				reciprocal++
+			}
+		}
+		for u := range a.in[v] {
+			neighbors[u] = true
+		}
+		total := len(a.out[v]) + len(a.in[v])
+		denom := 2 * float64(total*(total-1)-2*reciprocal)
+		if denom == 0 {
+			c[n.ID()] = 0
+			continue
+		}
+		var sum float64
+		for j := range neighbors {
+			vj := a.out[v][j] + a.in[v][j]
+			for h := range neighbors {
+				if j == h {
+					continue
+				}
+				jh := a.out[j][h] + a.in[j][h]
+				hv := a.out[h][v] + a.in[h][v]
+				sum += vj * jh * hv
+			}
+		}
+		c[n.ID()] = sum / denom
+	}
+	return c
+}
@@ -0,0 +1,241 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+var clusteringTests = []struct {
+	name string
+	g    []set
+
+	wantTriangles    map[int64]int
+	wantClustering   map[int64]float64
+	wantTransitivity float64
+}{
+	{
+		name: "paw",
+		g: []set{
+			A: linksTo(B, C),
+			B: linksTo(C),
+			C: linksTo(D),
+			D: nil,
+		},
+		wantTriangles:    map[int64]int{A: 1, B: 1, C: 1, D: 0},
+		wantClustering:   map[int64]float64{A: 1, B: 1, C: 1.0 / 3, D: 0},
+		wantTransitivity: 3.0 / 5,
+	},
+	{
+		name: "K4",
+		g: []set{
+			A: linksTo(B, C, D),
+			B: linksTo(C, D),
+			C: linksTo(D),
+			D: nil,
+		},
+		wantTriangles:    map[int64]int{A: 3, B: 3, C: 3, D: 3},
+		wantClustering:   map[int64]float64{A: 1, B: 1, C: 1, D: 1},
+		wantTransitivity: 1,
+	},
+	{
+		name: "star",
+		g: []set{
+			A: linksTo(B, C, D),
+			B: nil,
+			C: nil,
+			D: nil,
+		},
+		wantTriangles:    map[int64]int{A: 0, B: 0, C: 0, D: 0},
+		wantClustering:   map[int64]float64{A: 0, B: 0, C: 0, D: 0},
+		wantTransitivity: 0,
+	},
+}
+
+func TestClustering(t *testing.T) {
+	t.Parallel()
+	for _, test := range clusteringTests {
+		g := simple.NewWeightedUndirectedGraph(0, 0)
+		for u, e := range test.g {
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: 2})
+			}
+		}
+
+		triangles := Triangles(g)
+		for n, want := range test.wantTriangles {
+			if triangles[n] != want {
+				t.Errorf("unexpected triangle count for %q node %d: got:%d want:%d", test.name, n, triangles[n], want)
+			}
+		}
+		for _, fn := range []struct {
+			name string
+			got  map[int64]float64
+		}{
+			{name: "LocalClustering", got: LocalClustering(g)},
+			{name: "LocalClusteringWeighted", got: LocalClusteringWeighted(g)},
+			{name: "BarratClustering", got: BarratClustering(g)},
+		} {
+			if !equalCentralities(fn.got, test.wantClustering, 1e-12) {
+				t.Errorf("unexpected %s result for %q:\ngot: %v\nwant:%v",
+					fn.name, test.name, orderedFloats(fn.got, 3), orderedFloats(test.wantClustering, 3))
+			}
+		}
+		if got := Transitivity(g); !scalar.EqualWithinAbs(got, test.wantTransitivity, 1e-12) {
+			t.Errorf("unexpected transitivity for %q: got:%v want:%v", test.name, got, test.wantTransitivity)
+		}
+	}
+}
+
+func TestBarratClustering(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(A), T: simple.Node(B), W: 1},
+		{F: simple.Node(A), T: simple.Node(C), W: 3},
+		{F: simple.Node(B), T: simple.Node(C), W: 5},
+		{F: simple.Node(A), T: simple.Node(D), W: 4},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	// The triangle at A has weight (1+3)/2 for each of the
+	// two orderings of B and C, and A has strength 8.
+	want := map[int64]float64{A: 4.0 / 16, B: 1, C: 1, D: 0}
+	got := BarratClustering(g)
+	if !equalCentralities(got, want, 1e-12) {
+		t.Errorf("unexpected Barrat clustering:\ngot: %v\nwant:%v", orderedFloats(got, 3), orderedFloats(want, 3))
+	}
+}
+
+func TestLocalClusteringRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 40; trial++ {
+		n := 1 + rnd.IntN(10)
+		directed := trial%2 == 0
+		name := fmt.Sprintf("trial %d directed=%t", trial, directed)
+
+		var (
+			g     graph.Weighted
+			edges []simple.WeightedEdge
+		)
+		w := mat.NewDense(n, n, nil)
+		if directed {
+			dg := simple.NewWeightedDirectedGraph(0, 0)
+			for i := 0; i < n; i++ {
+				dg.AddNode(simple.Node(i))
+			}
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if i != j && rnd.Float64() < 0.4 {
+						e := simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: 1 + rnd.Float64()}
+						dg.SetWeightedEdge(e)
+						edges = append(edges, e)
+					}
+				}
+			}
+			g = dg
+		} else {
+			ug := simple.NewWeightedUndirectedGraph(0, 0)
+			for i := 0; i < n; i++ {
+				ug.AddNode(simple.Node(i))
+				for j := 0; j < i; j++ {
+					if rnd.Float64() < 0.5 {
+						e := simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: 1 + rnd.Float64()}
+						ug.SetWeightedEdge(e)
+						edges = append(edges, e)
+					}
+				}
+			}
+			g = ug
+		}
+		var max float64
+		for _, e := range edges {
+			max = math.Max(max, e.Weight())
+		}
+		for _, e := range edges {
+			u, v := int(e.From().ID()), int(e.To().ID())
+			w.Set(u, v, math.Cbrt(e.Weight()/max))
+			if !directed {
+				w.Set(v, u, w.At(u, v))
+			}
+		}
+
+		for _, weighted := range []bool{false, true} {
+			// The clustering coefficients are given by the
+			// diagonal of the cube of the symmetrized
+			// adjacency matrix.
+			var a mat.Dense
+			if weighted {
+				a.CloneFrom(w)
+			} else {
+				a.Apply(func(_, _ int, v float64) float64 {
+					if v != 0 {
+						return 1
+					}
+					return 0
+				}, w)
+			}
+			var s, s2, s3 mat.Dense
+			if directed {
+				s.Add(&a, a.T())
+			} else {
+				s.CloneFrom(&a)
+			}
+			s2.Mul(&s, &s)
+			s3.Mul(&s2, &s)
+
+			want := make(map[int64]float64)
+			for v := 0; v < n; v++ {
+				var in, out, reciprocal int
+				for u := 0; u < n; u++ {
+					if w.At(v, u) != 0 {
+						out++
+					}
+					if w.At(u, v) != 0 {
+						in++
+					}
+					if w.At(v, u) != 0 && w.At(u, v) != 0 {
+						reciprocal++
+					}
+				}
+				var possible float64
+				if directed {
+					possible = float64((in+out)*(in+out-1) - 2*reciprocal)
+				} else {
+					possible = float64(out * (out - 1))
+				}
+				if possible != 0 {
+					want[int64(v)] = s3.At(v, v) / possible
+					if directed {
+						want[int64(v)] /= 2
+					}
+				}
+			}
+
+			var got map[int64]float64
+			if weighted {
+				got = LocalClusteringWeighted(g)
+			} else {
+				got = LocalClustering(g)
+			}
+			if !equalCentralities(got, want, 1e-12) {
+				t.Errorf("%s: unexpected clustering weighted=%t:\ngot: %v\nwant:%v",
+					name, weighted, orderedFloats(got, 3), orderedFloats(want, 3))
+			}
+		}
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/mat"
+)
+
+// CurrentFlowBetweenness returns the non-zero current-flow betweenness
+// centrality for nodes in the undirected graph g, also known as random-walk
+// betweenness.
+//
+//	C_CB(v) = \sum_{s ≠ v ≠ t ∈ V} \tau_{st}(v)
+//
+// where \tau_{st}(v) is the current passing through v when the edges of g are
+// treated as resistors and a unit current is supplied at s and removed at t.
+// If g is a graph.WeightedUndirected, the edge weights are used as
+// conductances, otherwise each edge has unit conductance. Edge weights must be
+// positive. Self edges are ignored and only pairs of nodes in the same
+// connected component contribute.
+//
+// Pairs of nodes are counted in both orders, consistent with Betweenness, so
+// on a tree the current-flow betweenness equals the shortest path betweenness.
+//
+// See Newman, "A measure of betweenness centrality based on random walks",
+// Social Networks 27 (2005) and Brandes and Fleischer, "Centrality Measures
+// Based on Current Flow", STACS 2005 for details.
+func CurrentFlowBetweenness(g graph.Undirected) map[int64]float64 {
+	cb := make(map[int64]float64)
+	for _, c := range newCircuits(g) {
+		n := len(c.nodes)
+		p := make([]float64, n)
+		tau := make([]float64, n)
+		for s := 0; s < n; s++ {
+			for t := s + 1; t < n; t++ {
+				// The potentials with unit current
+				// supplied at s and removed at t.
+				for v := range p {
+					p[v] = c.inv.At(v, s) - c.inv.At(v, t)
+				}
+				for v := range tau {
+					tau[v] = 0
+				}
+				for _, e := range c.edges {
+					f := e.w * math.Abs(p[e.u]-p[e.v])
+					tau[e.u] += f
+					tau[e.v] += f
+				}
+				// The current through v is half of the
+				// current on its edges, and each pair
+				// is counted in both orders.
+				for v, f := range tau {
+					if v != s && v != t && f != 0 {
+						cb[c.nodes[v].ID()] += f
+					}
+				}
+			}
+		}
+	}
+	return cb
+}
+
+// CurrentFlowCloseness returns the current-flow closeness centrality for nodes
+// in the undirected graph g, also known as information centrality.
+//
+//	C(v) = 1 / \sum_u R(u,v)
+//
+// where R(u,v) is the effective resistance between u and v when the edges of g
+// are treated as resistors. If g is a graph.WeightedUndirected, the edge
+// weights are used as conductances, otherwise each edge has unit conductance.
+// Edge weights must be positive. Self edges are ignored and nodes in other
+// connected components are not considered.
+//
+// On a tree the effective resistance is the shortest path distance, so the
+// current-flow closeness equals the closeness.
+//
+// See Stephenson and Zelen, "Rethinking centrality: Methods and examples",
+// Social Networks 11 (1989) for details.
+func CurrentFlowCloseness(g graph.Undirected) map[int64]float64 {
+	c := make(map[int64]float64)
+	for _, cc := range newCircuits(g) {
+		for u, un := range cc.nodes {
+			var sum float64
+			for v := range cc.nodes {
+				sum += cc.inv.At(u, u) + cc.inv.At(v, v) - 2*cc.inv.At(u, v)
+			}
+			c[un.ID()] = 1 / sum
+		}
+	}
+	return c
+}
+
+// circuit is a connected component of an undirected graph treated as an
+// electrical network.
+type circuit struct {
+	nodes []graph.Node
+	edges []conductance
+
+	// inv is the inverse of the Laplacian of
+	// the circuit with the first node grounded,
+	// padded with a zero first row and column.
+	inv *mat.SymDense
+}
+
+// conductance is an edge between the nodes indexed by u and v with
+// conductance w.
+type conductance struct {
+	u, v int
+	w    float64
+}
+
+// newCircuits returns the connected components of g as electrical networks.
+func newCircuits(g graph.Undirected) []circuit {
+	weight := func(uid, vid int64) float64 { return 1 }
+	if wg, ok := g.(graph.WeightedUndirected); ok {
+		weight = func(uid, vid int64) float64 {
+			w, _ := wg.Weight(uid, vid)
+			return w
+		}
+	}
+
+	var circuits []circuit
+	seen := make(map[int64]bool)
+	for _, root := range graph.NodesOf(g.Nodes()) {
+		if seen[root.ID()] {
+			continue
+		}
+		seen[root.ID()] = true
+
+		// Collect the component containing root.
+		c := circuit{nodes: []graph.Node{root}}
+		indexOf := map[int64]int{root.ID(): 0}
+		for i := 0; i < len(c.nodes); i++ {
+			uid := c.nodes[i].ID()
+			for to := g.From(uid); to.Next(); {
+				v := to.Node()
+				vid := v.ID()
+				if !seen[vid] {
+					seen[vid] = true
+					indexOf[vid] = len(c.nodes)
+					c.nodes = append(c.nodes, v)
+				}
+				j := indexOf[vid]
+				if j <= i {
+					// Self edges and edges already seen.
+					continue
+				}
+				w := weight(uid, vid)
+				if w <= 0 || math.IsNaN(w) {
+					panic("network: non-positive edge weight")
+				}
+				c.edges = append(c.edges, conductance{u: i, v: j, w: w})
+			}
+		}
+
+		// Construct and invert the grounded Laplacian.
+		n := len(c.nodes)
+		c.inv = mat.NewSymDense(n, nil)
+		if n > 1 {
+			l := mat.NewSymDense(n-1, nil)
+			for _, e := range c.edges {
+				for _, k := range [2]int{e.u, e.v} {
+					if k != 0 {
+						l.SetSym(k-1, k-1, l.At(k-1, k-1)+e.w)
+					}
+				}
+				if e.u != 0 {
+					l.SetSym(e.u-1, e.v-1, l.At(e.u-1, e.v-1)-e.w)
+				}
+			}
+			var chol mat.Cholesky
+			if !chol.Factorize(l) {
+				panic("network: current-flow Laplacian not positive definite")
+			}
+			var inv mat.SymDense
+			err := chol.InverseTo(&inv)
+			if err != nil {
+				panic(err)
+			}
+			c.inv.SliceSym(1, n).(*mat.SymDense).CopySym(&inv)
+		}
+		circuits = append(circuits, c)
+	}
+	return circuits
+}
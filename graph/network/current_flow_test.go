@@ -0,0 +1,182 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+var currentFlowTests = []struct {
+	name string
+	g    []set
+
+	wantBetweenness map[int64]float64
+	wantCloseness   map[int64]float64
+}{
+	{
+		name: "triangle",
+		g: []set{
+			A: linksTo(B, C),
+			B: linksTo(C),
+			C: nil,
+		},
+		wantBetweenness: map[int64]float64{A: 2.0 / 3, B: 2.0 / 3, C: 2.0 / 3},
+		wantCloseness:   map[int64]float64{A: 0.75, B: 0.75, C: 0.75},
+	},
+	{
+		name: "square",
+		g: []set{
+			A: linksTo(B, D),
+			B: linksTo(C),
+			C: linksTo(D),
+			D: nil,
+		},
+		wantBetweenness: map[int64]float64{A: 2, B: 2, C: 2, D: 2},
+		wantCloseness:   map[int64]float64{A: 0.4, B: 0.4, C: 0.4, D: 0.4},
+	},
+	{
+		name: "disconnected",
+		g: []set{
+			A: linksTo(B),
+			B: linksTo(C),
+			C: nil,
+			D: linksTo(E),
+			E: nil,
+			F: nil,
+		},
+		wantBetweenness: map[int64]float64{B: 2},
+		wantCloseness:   map[int64]float64{A: 1.0 / 3, B: 0.5, C: 1.0 / 3, D: 1, E: 1, F: math.Inf(1)},
+	},
+}
+
+func TestCurrentFlow(t *testing.T) {
+	t.Parallel()
+	for _, test := range currentFlowTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		got := CurrentFlowBetweenness(g)
+		if !equalCentralities(got, test.wantBetweenness, 1e-12) {
+			t.Errorf("unexpected current-flow betweenness for %q:\ngot: %v\nwant:%v",
+				test.name, orderedFloats(got, 3), orderedFloats(test.wantBetweenness, 3))
+		}
+		got = CurrentFlowCloseness(g)
+		if !equalCentralities(got, test.wantCloseness, 1e-12) {
+			t.Errorf("unexpected current-flow closeness for %q:\ngot: %v\nwant:%v",
+				test.name, orderedFloats(got, 3), orderedFloats(test.wantCloseness, 3))
+		}
+	}
+}
+
+func TestCurrentFlowTree(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		n := 1 + rnd.IntN(12)
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		g.AddNode(simple.Node(0))
+		for i := 1; i < n; i++ {
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(rnd.IntN(i)), T: simple.Node(i), W: 1})
+		}
+		name := fmt.Sprintf("trial %d", trial)
+
+		got := CurrentFlowBetweenness(g)
+		want := Betweenness(g)
+		if !equalCentralities(got, want, 1e-9) {
+			t.Errorf("%s: unexpected current-flow betweenness for tree:\ngot: %v\nwant:%v",
+				name, orderedFloats(got, 3), orderedFloats(want, 3))
+		}
+		got = CurrentFlowCloseness(g)
+		want = Closeness(g, path.DijkstraAllPaths(g))
+		if !equalCentralities(got, want, 1e-9) {
+			t.Errorf("%s: unexpected current-flow closeness for tree:\ngot: %v\nwant:%v",
+				name, orderedFloats(got, 3), orderedFloats(want, 3))
+		}
+	}
+}
+
+func TestCurrentFlowWeighted(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		n := 2 + rnd.IntN(10)
+		g := randomConnectedWeighted(n, rnd)
+		name := fmt.Sprintf("trial %d", trial)
+
+		// The pseudo-inverse of the Laplacian L of a
+		// connected graph is (L + J/n)⁻¹ - J/n, and the
+		// J/n terms cancel in potential differences.
+		l := mat.NewDense(n, n, nil)
+		for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+			u, v := int(e.From().ID()), int(e.To().ID())
+			w := e.Weight()
+			l.Set(u, u, l.At(u, u)+w)
+			l.Set(v, v, l.At(v, v)+w)
+			l.Set(u, v, -w)
+			l.Set(v, u, -w)
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				l.Set(i, j, l.At(i, j)+1/float64(n))
+			}
+		}
+		var inv mat.Dense
+		err := inv.Inverse(l)
+		if err != nil {
+			t.Fatalf("%s: unexpected error inverting Laplacian: %v", name, err)
+		}
+
+		wantBetweenness := make(map[int64]float64)
+		wantCloseness := make(map[int64]float64)
+		for s := 0; s < n; s++ {
+			var sum float64
+			for tgt := 0; tgt < n; tgt++ {
+				sum += inv.At(s, s) + inv.At(tgt, tgt) - 2*inv.At(s, tgt)
+				if s == tgt {
+					continue
+				}
+				for v := 0; v < n; v++ {
+					if v == s || v == tgt {
+						continue
+					}
+					pv := inv.At(v, s) - inv.At(v, tgt)
+					var tau float64
+					for _, u := range graph.NodesOf(g.From(int64(v))) {
+						w, _ := g.Weight(int64(v), u.ID())
+						pu := inv.At(int(u.ID()), s) - inv.At(int(u.ID()), tgt)
+						tau += w * math.Abs(pv-pu)
+					}
+					wantBetweenness[int64(v)] += tau / 2
+				}
+			}
+			wantCloseness[int64(s)] = 1 / sum
+		}
+
+		got := CurrentFlowBetweenness(g)
+		if !equalCentralities(got, wantBetweenness, 1e-9) {
+			t.Errorf("%s: unexpected current-flow betweenness:\ngot: %v\nwant:%v",
+				name, orderedFloats(got, 3), orderedFloats(wantBetweenness, 3))
+		}
+		got = CurrentFlowCloseness(g)
+		if !equalCentralities(got, wantCloseness, 1e-9) {
+			t.Errorf("%s: unexpected current-flow closeness:\ngot: %v\nwant:%v",
+				name, orderedFloats(got, 3), orderedFloats(wantCloseness, 3))
+		}
+	}
+}
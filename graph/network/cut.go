@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/linear"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Cut is a partition of the nodes of a graph into two sets.
+type Cut struct {
+	// Value is the total weight of the edges
+	// crossing the cut.
+	Value float64
+
+	// Source and Sink are the nodes on each
+	// side of the cut, ordered by ID.
+	Source, Sink []graph.Node
+
+	// Edges holds the edges from a node in
+	// Source to a node in Sink, ordered by the
+	// IDs of their From and then To nodes.
+	Edges []graph.WeightedEdge
+}
+
+// MinCutDinic returns a minimum s-t cut of the directed graph g where the
+// capacity of each edge is its weight. The cut is found from the residual
+// graph of the maximum flow computed by MaxFlowDinic, with Source holding the
+// nodes reachable from s in the residual graph. The Value of the cut is equal
+// to the maximum flow from s to t.
+//
+// MinCutDinic will panic under the same conditions as MaxFlowDinic, and eps
+// is interpreted in the same way.
+func MinCutDinic(g graph.WeightedDirected, s, t graph.Node, eps float64) Cut {
+	_, r := maxFlowDinic(g, s, t, eps)
+	if eps < 0 {
+		eps = 1e-12
+	}
+
+	source := make(map[int64]bool)
+	source[s.ID()] = true
+	var queue linear.NodeQueue
+	queue.Enqueue(s)
+	for queue.Len() > 0 {
+		uid := queue.Dequeue().ID()
+		for it := r.From(uid); it.Next(); {
+			v := it.Node()
+			if source[v.ID()] {
+				continue
+			}
+			if capacity, _ := r.Weight(uid, v.ID()); capacity > eps {
+				source[v.ID()] = true
+				queue.Enqueue(v)
+			}
+		}
+	}
+	return newCut(g, source)
+}
+
+// newCut returns the cut of g with the nodes in the source set on the Source
+// side of the cut.
+func newCut(g graph.Weighted, source map[int64]bool) Cut {
+	var c Cut
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	for _, u := range nodes {
+		if !source[u.ID()] {
+			c.Sink = append(c.Sink, u)
+			continue
+		}
+		c.Source = append(c.Source, u)
+		for it := g.From(u.ID()); it.Next(); {
+			vid := it.Node().ID()
+			if source[vid] {
+				continue
+			}
+			e := g.WeightedEdge(u.ID(), vid)
+			c.Edges = append(c.Edges, e)
+			c.Value += e.Weight()
+		}
+	}
+	slices.SortFunc(c.Edges, func(a, b graph.WeightedEdge) int {
+		return cmp.Or(
+			cmp.Compare(a.From().ID(), b.From().ID()),
+			cmp.Compare(a.To().ID(), b.To().ID()),
+		)
+	})
+	return c
+}
+
+// StoerWagner returns a global minimum cut of the undirected graph g, the
+// partition of its nodes into two non-empty sets with the minimum total weight
+// of the edges between the sets. The Source side of the returned cut holds the
+// lowest ID node of g. Self loops are ignored.
+//
+// If g has fewer than two nodes, StoerWagner returns a cut with an infinite
+// Value and all the nodes of g in Source. StoerWagner will panic if g has a
+// negative edge weight.
+//
+// See Stoer and Wagner doi:10.1145/263867.263872 for details of the algorithm.
+func StoerWagner(g graph.WeightedUndirected) Cut {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	n := len(nodes)
+	if n < 2 {
+		return Cut{Value: math.Inf(1), Source: nodes}
+	}
+	index := make(map[int64]int, n)
+	for i, u := range nodes {
+		index[u.ID()] = i
+	}
+	w := make([][]float64, n)
+	for i, u := range nodes {
+		w[i] = make([]float64, n)
+		for it := g.From(u.ID()); it.Next(); {
+			v := it.Node()
+			j := index[v.ID()]
+			if i == j {
+				continue
+			}
+			weight, _ := g.Weight(u.ID(), v.ID())
+			if weight < 0 {
+				panic("network: negative edge weight")
+			}
+			w[i][j] = weight
+		}
+	}
+
+	// groups[i] holds the original nodes merged into node i.
+	groups := make([][]int, n)
+	active := make([]int, n)
+	for i := range groups {
+		groups[i] = []int{i}
+		active[i] = i
+	}
+	key := make([]float64, n)
+	added := make([]bool, n)
+	best := math.Inf(1)
+	var bestGroup []int
+	for len(active) > 1 {
+		// Order the active nodes by maximum adjacency.
+		for _, v := range active {
+			key[v] = 0
+			added[v] = false
+		}
+		prev, last := -1, -1
+		for range active {
+			sel := -1
+			for _, v := range active {
+				if !added[v] && (sel < 0 || key[v] > key[sel]) {
+					sel = v
+				}
+			}
+			added[sel] = true
+			prev, last = last, sel
+			for _, v := range active {
+				if !added[v] {
+					key[v] += w[sel][v]
+				}
+			}
+		}
+
+		// The cut of the phase separates the last node from the rest.
+		if key[last] < best {
+			best = key[last]
+			bestGroup = append(bestGroup[:0], groups[last]...)
+		}
+
+		// Merge the last node into the previous node.
+		groups[prev] = append(groups[prev], groups[last]...)
+		for _, v := range active {
+			w[prev][v] += w[last][v]
+			w[v][prev] = w[prev][v]
+		}
+		w[prev][prev] = 0
+		active = slices.DeleteFunc(active, func(v int) bool { return v == last })
+	}
+
+	inGroup := make([]bool, n)
+	for _, i := range bestGroup {
+		inGroup[i] = true
+	}
+	source := make(map[int64]bool)
+	for i, u := range nodes {
+		if inGroup[i] == inGroup[0] {
+			source[u.ID()] = true
+		}
+	}
+	return newCut(g, source)
+}
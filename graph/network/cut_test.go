@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestMinCutDinic(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-10
+	for trial := 0; trial < 100; trial++ {
+		n := rnd.IntN(10) + 2
+		g := simple.NewWeightedDirectedGraph(0, 0)
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i != j && rnd.Float64() < 0.3 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: rnd.Float64()})
+				}
+			}
+		}
+		s, tgt := simple.Node(0), simple.Node(n-1)
+		name := fmt.Sprintf("trial %d", trial)
+
+		c := MinCutDinic(g, s, tgt, tol)
+		checkCut(t, name, g, c)
+		if c.Source[0].ID() != s.ID() {
+			t.Errorf("%s: source node not in Source", name)
+		}
+		for _, u := range c.Source {
+			if u.ID() == tgt.ID() {
+				t.Errorf("%s: target node in Source", name)
+			}
+		}
+		if want := MaxFlowDinic(g, s, tgt, tol); !scalar.EqualWithinAbs(c.Value, want, 1e-8) {
+			t.Errorf("%s: cut value not equal to maximum flow: got %v, want %v", name, c.Value, want)
+		}
+	}
+}
+
+func TestStoerWagner(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 200; trial++ {
+		n := rnd.IntN(10) + 2
+		g := simple.NewWeightedUndirectedGraph(0, 0)
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i + 10))
+		}
+		p := rnd.Float64()
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				if rnd.Float64() < p {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i + 10), T: simple.Node(j + 10), W: float64(rnd.IntN(10))})
+				}
+			}
+		}
+		name := fmt.Sprintf("trial %d", trial)
+
+		c := StoerWagner(g)
+		checkCut(t, name, g, c)
+		if len(c.Source) == 0 || len(c.Sink) == 0 {
+			t.Errorf("%s: empty side of cut", name)
+			continue
+		}
+		if c.Source[0].ID() != 10 {
+			t.Errorf("%s: lowest ID node not in Source", name)
+		}
+		if want := bruteGlobalMinCut(g); c.Value != want {
+			t.Errorf("%s: unexpected cut value: got %v, want %v", name, c.Value, want)
+		}
+	}
+
+	c := StoerWagner(simple.NewWeightedUndirectedGraph(0, 0))
+	if !math.IsInf(c.Value, 1) {
+		t.Errorf("unexpected cut value for empty graph: got %v, want +Inf", c.Value)
+	}
+}
+
+// bruteGlobalMinCut returns the global minimum cut value of g by enumerating
+// all partitions of its nodes.
+func bruteGlobalMinCut(g *simple.WeightedUndirectedGraph) float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	edges := graph.WeightedEdgesOf(g.WeightedEdges())
+	best := math.Inf(1)
+	for mask := 1; mask < 1<<(len(nodes)-1); mask++ {
+		in := make(map[int64]bool)
+		for i, u := range nodes {
+			if mask&(1<<i) != 0 {
+				in[u.ID()] = true
+			}
+		}
+		var v float64
+		for _, e := range edges {
+			if in[e.From().ID()] != in[e.To().ID()] {
+				v += e.Weight()
+			}
+		}
+		best = math.Min(best, v)
+	}
+	return best
+}
+
+// checkCut checks that c is a consistent cut of g.
+func checkCut(t *testing.T, name string, g graph.Weighted, c Cut) {
+	t.Helper()
+	if len(c.Source)+len(c.Sink) != g.Nodes().Len() {
+		t.Errorf("%s: cut does not partition the nodes", name)
+	}
+	source := make(map[int64]bool)
+	for _, u := range c.Source {
+		source[u.ID()] = true
+	}
+	var v float64
+	for _, e := range c.Edges {
+		if !source[e.From().ID()] || source[e.To().ID()] {
+			t.Errorf("%s: edge %d-%d does not cross the cut", name, e.From().ID(), e.To().ID())
+		}
+		v += e.Weight()
+	}
+	if !scalar.EqualWithinAbs(v, c.Value, 1e-10) {
+		t.Errorf("%s: cut value mismatch: got %v, want %v", name, c.Value, v)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph"
+)
+
+// Eigenvector returns the eigenvector centrality for nodes of the graph g,
+// the principal eigenvector x of the adjacency matrix A of g satisfying
+//
+//	λ x_v = \sum_u A_{uv} x_u
+//
+// where λ is the largest eigenvalue of A. For directed graphs the incoming
+// edges of each node are used. If g is a graph.Weighted, A_{uv} is the weight
+// of the edge from u to v, otherwise it is one for each edge. Edge weights
+// must not be negative.
+//
+// The returned vector has unit 2-norm. Eigenvector terminates when the 2-norm
+// of the vector difference between iterations is below tol, which must be
+// positive. If the iteration does not converge within 100000 steps,
+// Eigenvector will panic. The returned map is keyed on the graph node IDs.
+func Eigenvector(g graph.Graph, tol float64) map[int64]float64 {
+	if !(tol > 0) {
+		panic("network: non-positive tolerance")
+	}
+	nodes, to := inAdjacency(g)
+
+	// The power iteration is performed on A+I which has
+	// the same principal eigenvector as A, but which does
+	// not oscillate when g is bipartite.
+	x := make([]float64, len(nodes))
+	for i := range x {
+		x[i] = 1 / math.Sqrt(float64(len(x)))
+	}
+	last := make([]float64, len(nodes))
+	for iter := 0; len(x) != 0; iter++ {
+		if iter == maxPowerIterations {
+			panic("network: Eigenvector iteration did not converge")
+		}
+		x, last = last, x
+		for v, in := range to {
+			sum := last[v]
+			for _, e := range in {
+				sum += e.w * last[e.u]
+			}
+			x[v] = sum
+		}
+		norm := floats.Norm(x, 2)
+		if norm == 0 {
+			break
+		}
+		floats.Scale(1/norm, x)
+		if normDiff(x, last) < tol {
+			break
+		}
+	}
+
+	c := make(map[int64]float64, len(nodes))
+	for i, n := range nodes {
+		c[n.ID()] = x[i]
+	}
+	return c
+}
+
+// Katz returns the Katz centrality for nodes of the graph g, the solution of
+//
+//	x_v = α \sum_u A_{uv} x_u + β
+//
+// where A is the adjacency matrix of g. For directed graphs the incoming edges
+// of each node are used. If g is a graph.Weighted, A_{uv} is the weight of the
+// edge from u to v, otherwise it is one for each edge. Edge weights must not be
+// negative.
+//
+// The attenuation factor alpha must be less than the reciprocal of the largest
+// eigenvalue of A for the solution to exist. Katz terminates when the 2-norm of
+// the vector difference between iterations is below tol, which must be
+// positive. If the iteration diverges or does not converge within 100000
+// steps, Katz will panic. The returned map is keyed on the graph node IDs.
+func Katz(g graph.Graph, alpha, beta, tol float64) map[int64]float64 {
+	if !(tol > 0) {
+		panic("network: non-positive tolerance")
+	}
+	nodes, to := inAdjacency(g)
+
+	x := make([]float64, len(nodes))
+	last := make([]float64, len(nodes))
+	for iter := 0; len(x) != 0; iter++ {
+		if iter == maxPowerIterations {
+			panic("network: Katz iteration did not converge")
+		}
+		x, last = last, x
+		for v, in := range to {
+			var sum float64
+			for _, e := range in {
+				sum += e.w * last[e.u]
+			}
+			x[v] = alpha*sum + beta
+		}
+		d := normDiff(x, last)
+		if math.IsInf(d, 0) || math.IsNaN(d) {
+			panic("network: Katz iteration diverged")
+		}
+		if d < tol {
+			break
+		}
+	}
+
+	c := make(map[int64]float64, len(nodes))
+	for i, n := range nodes {
+		c[n.ID()] = x[i]
+	}
+	return c
+}
+
+// maxPowerIterations is the maximum number of iterations
+// performed by Eigenvector and Katz.
+const maxPowerIterations = 100000
+
+// weightedArc is an edge from the node with index u weighted by w.
+type weightedArc struct {
+	u int
+	w float64
+}
+
+// inAdjacency returns the nodes of g and the weighted incoming edges of each
+// node indexed by the position of the nodes in the returned slice. It panics
+// if g has a negative edge weight.
+func inAdjacency(g graph.Graph) ([]graph.Node, [][]weightedArc) {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+
+	weight := func(uid, vid int64) float64 { return 1 }
+	if wg, ok := g.(graph.Weighted); ok {
+		weight = func(uid, vid int64) float64 {
+			w, _ := wg.Weight(uid, vid)
+			return w
+		}
+	}
+	var from func(id int64) graph.Nodes
+	if dg, ok := g.(graph.Directed); ok {
+		from = dg.To
+	} else {
+		from = g.From
+	}
+
+	to := make([][]weightedArc, len(nodes))
+	for v, n := range nodes {
+		vid := n.ID()
+		for it := from(vid); it.Next(); {
+			uid := it.Node().ID()
+			w := weight(uid, vid)
+			if w < 0 {
+				panic("network: negative edge weight")
+			}
+			to[v] = append(to[v], weightedArc{u: indexOf[uid], w: w})
+		}
+	}
+	return nodes, to
+}
@@ -0,0 +1,271 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+var eigenvectorTests = []struct {
+	name     string
+	g        []set
+	directed bool
+
+	want map[int64]float64
+}{
+	{
+		name: "star",
+		g: []set{
+			A: linksTo(B, C, D),
+			B: nil,
+			C: nil,
+			D: nil,
+		},
+		want: map[int64]float64{
+			A: 1 / math.Sqrt2,
+			B: 1 / math.Sqrt(6),
+			C: 1 / math.Sqrt(6),
+			D: 1 / math.Sqrt(6),
+		},
+	},
+	{
+		name: "directed cycle",
+		g: []set{
+			A: linksTo(B),
+			B: linksTo(C),
+			C: linksTo(A),
+		},
+		directed: true,
+		want: map[int64]float64{
+			A: 1 / math.Sqrt(3),
+			B: 1 / math.Sqrt(3),
+			C: 1 / math.Sqrt(3),
+		},
+	},
+}
+
+func TestEigenvector(t *testing.T) {
+	t.Parallel()
+	for _, test := range eigenvectorTests {
+		var g graph.Graph
+		if test.directed {
+			dg := simple.NewDirectedGraph()
+			for u, e := range test.g {
+				if dg.Node(int64(u)) == nil {
+					dg.AddNode(simple.Node(u))
+				}
+				for v := range e {
+					dg.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+				}
+			}
+			g = dg
+		} else {
+			ug := simple.NewUndirectedGraph()
+			for u, e := range test.g {
+				if ug.Node(int64(u)) == nil {
+					ug.AddNode(simple.Node(u))
+				}
+				for v := range e {
+					ug.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+				}
+			}
+			g = ug
+		}
+		got := Eigenvector(g, 1e-8)
+		for n, want := range test.want {
+			if !scalar.EqualWithinAbs(got[n], want, 1e-4) {
+				t.Errorf("unexpected eigenvector centrality for %q:\ngot: %v\nwant:%v",
+					test.name, orderedFloats(got, 4), orderedFloats(test.want, 4))
+				break
+			}
+		}
+	}
+}
+
+func TestEigenvectorRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		n := 2 + rnd.IntN(10)
+		g := randomConnectedWeighted(n, rnd)
+		name := fmt.Sprintf("trial %d", trial)
+
+		a := mat.NewSymDense(n, nil)
+		for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+			a.SetSym(int(e.From().ID()), int(e.To().ID()), e.Weight())
+		}
+		var eig mat.EigenSym
+		if !eig.Factorize(a, true) {
+			t.Fatalf("%s: eigendecomposition failed", name)
+		}
+		var vecs mat.Dense
+		eig.VectorsTo(&vecs)
+
+		got := Eigenvector(g, 1e-12)
+		for i := 0; i < n; i++ {
+			// Eigenvalues are in ascending order, and the
+			// principal eigenvector has a single sign.
+			want := math.Abs(vecs.At(i, n-1))
+			if !scalar.EqualWithinAbs(got[int64(i)], want, 1e-6) {
+				t.Errorf("%s: unexpected eigenvector centrality for node %d: got:%v want:%v", name, i, got[int64(i)], want)
+			}
+		}
+	}
+}
+
+func TestKatz(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		n := 1 + rnd.IntN(10)
+		g := simple.NewWeightedDirectedGraph(0, 0)
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		a := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i == j || rnd.Float64() > 0.4 {
+					continue
+				}
+				w := 1 + rnd.Float64()
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: w})
+				a.Set(i, j, w)
+			}
+		}
+		name := fmt.Sprintf("trial %d", trial)
+
+		// The spectral radius is bounded by the largest
+		// column sum of A.
+		var bound float64
+		for j := 0; j < n; j++ {
+			bound = math.Max(bound, mat.Sum(a.ColView(j)))
+		}
+		alpha := 0.9 / math.Max(bound, 1)
+		const beta = 2
+
+		// Solve (I - αAᵀ) x = β1 directly.
+		var m mat.Dense
+		m.Scale(-alpha, a.T())
+		for i := 0; i < n; i++ {
+			m.Set(i, i, m.At(i, i)+1)
+		}
+		b := mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			b.SetVec(i, beta)
+		}
+		var want mat.VecDense
+		err := want.SolveVec(&m, b)
+		if err != nil {
+			t.Fatalf("%s: unexpected error solving system: %v", name, err)
+		}
+
+		got := Katz(g, alpha, beta, 1e-12)
+		for i := 0; i < n; i++ {
+			if !scalar.EqualWithinAbsOrRel(got[int64(i)], want.AtVec(i), 1e-8, 1e-8) {
+				t.Errorf("%s: unexpected Katz centrality for node %d: got:%v want:%v", name, i, got[int64(i)], want.AtVec(i))
+			}
+		}
+	}
+}
+
+func TestKatzDiverges(t *testing.T) {
+	t.Parallel()
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	g.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(2)})
+	g.SetEdge(simple.Edge{F: simple.Node(2), T: simple.Node(0)})
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for divergent Katz iteration")
+		}
+	}()
+	Katz(g, 1, 1, 1e-12)
+}
+
+func TestEigenPanics(t *testing.T) {
+	t.Parallel()
+	triangle := simple.NewUndirectedGraph()
+	triangle.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	triangle.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(2)})
+	triangle.SetEdge(simple.Edge{F: simple.Node(2), T: simple.Node(0)})
+	negative := simple.NewWeightedUndirectedGraph(0, 0)
+	negative.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: -1})
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{
+			name: "Eigenvector zero tolerance",
+			fn:   func() { Eigenvector(triangle, 0) },
+			want: "network: non-positive tolerance",
+		},
+		{
+			name: "Eigenvector NaN tolerance",
+			fn:   func() { Eigenvector(triangle, math.NaN()) },
+			want: "network: non-positive tolerance",
+		},
+		{
+			name: "Eigenvector negative weight",
+			fn:   func() { Eigenvector(negative, 1e-8) },
+			want: "network: negative edge weight",
+		},
+		{
+			name: "Katz negative tolerance",
+			fn:   func() { Katz(triangle, 0.1, 1, -1) },
+			want: "network: non-positive tolerance",
+		},
+		{
+			name: "Katz negative weight",
+			fn:   func() { Katz(negative, 0.1, 1, 1e-8) },
+			want: "network: negative edge weight",
+		},
+		{
+			// The largest eigenvalue of the triangle is 2, so
+			// with α = 1/2 each node's centrality increases by
+			// β at each iteration without diverging.
+			name: "Katz no convergence",
+			fn:   func() { Katz(triangle, 0.5, 1, 1e-8) },
+			want: "network: Katz iteration did not converge",
+		},
+	} {
+		func() {
+			defer func() {
+				r := recover()
+				if r != test.want {
+					t.Errorf("%s: unexpected panic: got:%v want:%s", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+// randomConnectedWeighted returns a random connected undirected graph with n
+// nodes and positive edge weights.
+func randomConnectedWeighted(n int, rnd *rand.Rand) *simple.WeightedUndirectedGraph {
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	g.AddNode(simple.Node(0))
+	for i := 1; i < n; i++ {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(rnd.IntN(i)), T: simple.Node(i), W: 0.5 + rnd.Float64()})
+	}
+	for k := 0; k < n; k++ {
+		i, j := rnd.IntN(n), rnd.IntN(n)
+		if i != j {
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: 0.5 + rnd.Float64()})
+		}
+	}
+	return g
+}
@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/linear"
+	"gonum.org/v1/gonum/graph/path"
+)
+
+// Load returns the non-zero load centrality for nodes in the unweighted graph g.
+//
+// The load of a node is the amount of a unit commodity sent between each
+// ordered pair of nodes s and t that passes through the node when the
+// commodity is routed along shortest paths. Tracing the paths back from t, the
+// commodity is divided equally among the shortest path predecessors of each
+// node, in contrast to Betweenness where the division is in proportion to the
+// number of shortest paths through each predecessor. Loads at s and t are not
+// counted.
+//
+// See Goh, Kahng and Kim, "Universal Behavior of Load Distribution in
+// Scale-Free Networks", Phys. Rev. Lett. 87 (2001) for details.
+func Load(g graph.Graph) map[int64]float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+
+	var (
+		cb    = make(map[int64]float64)
+		d     = make([]int, len(nodes))
+		pred  = make([][]int, len(nodes))
+		order []int
+		queue linear.NodeQueue
+	)
+	for s, sn := range nodes {
+		order = order[:0]
+		for i := range d {
+			d[i] = -1
+			pred[i] = pred[i][:0]
+		}
+		d[s] = 0
+
+		queue.Enqueue(sn)
+		for queue.Len() != 0 {
+			u := queue.Dequeue()
+			ui := indexOf[u.ID()]
+			order = append(order, ui)
+			for to := g.From(u.ID()); to.Next(); {
+				v := to.Node()
+				vi := indexOf[v.ID()]
+				if d[vi] < 0 {
+					queue.Enqueue(v)
+					d[vi] = d[ui] + 1
+				}
+				if d[vi] == d[ui]+1 {
+					pred[vi] = append(pred[vi], ui)
+				}
+			}
+		}
+
+		accumulateLoad(cb, nodes, s, order, pred)
+	}
+	return cb
+}
+
+// LoadWeighted returns the non-zero load centrality for nodes in the weighted
+// graph g used to construct the given shortest paths. Edge weights must be
+// positive.
+//
+// The load of a node is the amount of a unit commodity sent between each
+// ordered pair of nodes s and t that passes through the node when the
+// commodity is routed along shortest paths. Tracing the paths back from t, the
+// commodity is divided equally among the shortest path predecessors of each
+// node. Loads at s and t are not counted.
+func LoadWeighted(g graph.Weighted, p path.AllShortest) map[int64]float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+
+	var (
+		cb    = make(map[int64]float64)
+		d     = make([]float64, len(nodes))
+		pred  = make([][]int, len(nodes))
+		order []int
+	)
+	for s, sn := range nodes {
+		sid := sn.ID()
+		order = order[:0]
+		for i, n := range nodes {
+			d[i] = p.Weight(sid, n.ID())
+			pred[i] = pred[i][:0]
+			if !math.IsInf(d[i], 1) {
+				order = append(order, i)
+			}
+		}
+		slices.SortStableFunc(order, func(a, b int) int { return cmp.Compare(d[a], d[b]) })
+
+		for _, ui := range order {
+			uid := nodes[ui].ID()
+			for to := g.From(uid); to.Next(); {
+				vid := to.Node().ID()
+				vi := indexOf[vid]
+				if vi == s || vi == ui {
+					continue
+				}
+				w, _ := g.Weight(uid, vid)
+				// u is a predecessor of v on a shortest
+				// path from s when the edge from u to v
+				// is tight.
+				if d[ui]+w == d[vi] {
+					pred[vi] = append(pred[vi], ui)
+				}
+			}
+		}
+
+		accumulateLoad(cb, nodes, s, order, pred)
+	}
+	return cb
+}
+
+// accumulateLoad adds the loads of the shortest paths from the node indexed
+// by s to cb. The order slice holds the indices of the nodes reachable from s
+// in non-decreasing distance and pred holds the shortest path predecessors
+// of each node.
+func accumulateLoad(cb map[int64]float64, nodes []graph.Node, s int, order []int, pred [][]int) {
+	load := make([]float64, len(nodes))
+	for _, v := range order {
+		load[v] = 1
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		if len(pred[v]) == 0 {
+			continue
+		}
+		f := load[v] / float64(len(pred[v]))
+		for _, u := range pred[v] {
+			load[u] += f
+		}
+	}
+	for _, v := range order {
+		if v == s {
+			continue
+		}
+		if l := load[v] - 1; l != 0 {
+			cb[nodes[v].ID()] += l
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var loadTests = []struct {
+	name string
+	g    []set
+
+	want map[int64]float64
+}{
+	{
+		// Shortest paths from A to G pass through
+		// D on two paths and through F on one.
+		name: "unbalanced",
+		g: []set{
+			A: linksTo(B, C, E),
+			B: linksTo(D),
+			C: linksTo(D),
+			D: linksTo(G),
+			E: linksTo(F),
+			F: linksTo(G),
+			G: nil,
+		},
+		want: map[int64]float64{
+			B: 0.75,
+			C: 0.75,
+			D: 2.5,
+			E: 1.5,
+			F: 1.5,
+		},
+	},
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	for _, test := range loadTests {
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for u, e := range test.g {
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: 1})
+			}
+		}
+		for _, fn := range []struct {
+			name string
+			got  map[int64]float64
+		}{
+			{name: "Load", got: Load(g)},
+			{name: "LoadWeighted", got: LoadWeighted(g, path.DijkstraAllPaths(g))},
+		} {
+			if !equalCentralities(fn.got, test.want, 1e-12) {
+				t.Errorf("unexpected %s result for %q:\ngot: %v\nwant:%v",
+					fn.name, test.name, orderedFloats(fn.got, 3), orderedFloats(test.want, 3))
+			}
+		}
+	}
+}
+
+func TestLoadRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		n := 1 + rnd.IntN(12)
+		name := fmt.Sprintf("trial %d", trial)
+
+		// Load is betweenness on trees.
+		tree := simple.NewUndirectedGraph()
+		tree.AddNode(simple.Node(0))
+		for i := 1; i < n; i++ {
+			tree.SetEdge(simple.Edge{F: simple.Node(rnd.IntN(i)), T: simple.Node(i)})
+		}
+		got := Load(tree)
+		want := Betweenness(tree)
+		if !equalCentralities(got, want, 1e-12) {
+			t.Errorf("%s: unexpected load for tree:\ngot: %v\nwant:%v", name, orderedFloats(got, 3), orderedFloats(want, 3))
+		}
+
+		// Load with unit weights is unweighted load.
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for k := 0; k < 2*n; k++ {
+			i, j := rnd.IntN(n), rnd.IntN(n)
+			if i != j {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: 1})
+			}
+		}
+		got = LoadWeighted(g, path.DijkstraAllPaths(g))
+		want = Load(g)
+		if !equalCentralities(got, want, 1e-12) {
+			t.Errorf("%s: unexpected weighted load:\ngot: %v\nwant:%v", name, orderedFloats(got, 3), orderedFloats(want, 3))
+		}
+
+		// The total load is the total number of
+		// intermediate nodes on shortest paths.
+		p := path.DijkstraAllPaths(g)
+		var total, sum float64
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if d := p.Weight(int64(i), int64(j)); i != j && !math.IsInf(d, 1) {
+					total += d - 1
+				}
+			}
+		}
+		for _, l := range got {
+			sum += l
+		}
+		if !scalar.EqualWithinAbsOrRel(sum, total, 1e-9, 1e-9) {
+			t.Errorf("%s: unexpected total load: got:%v want:%v", name, sum, total)
+		}
+	}
+}
+
+// equalCentralities returns whether the centralities in a and b are equal
+// within tol, treating missing values as zero.
+func equalCentralities(a, b map[int64]float64, tol float64) bool {
+	for k, v := range a {
+		if !scalar.EqualWithinAbsOrRel(v, b[k], tol, tol) {
+			return false
+		}
+	}
+	for k, v := range b {
+		if !scalar.EqualWithinAbsOrRel(v, a[k], tol, tol) {
+			return false
+		}
+	}
+	return true
+}
@@ -25,6 +25,13 @@ import (
 //
 // [Dinic's algorithm]: https://en.wikipedia.org/wiki/Dinic%27s_algorithm
 func MaxFlowDinic(g graph.WeightedDirected, s, t graph.Node, eps float64) float64 {
+	maxFlow, _ := maxFlowDinic(g, s, t, eps)
+	return maxFlow
+}
+
+// maxFlowDinic returns the maximum flow from s to t in g and the residual
+// graph of the maximum flow.
+func maxFlowDinic(g graph.WeightedDirected, s, t graph.Node, eps float64) (float64, *simple.WeightedDirectedGraph) {
 	if s.ID() == t.ID() {
 		panic("no cut between s and t")
 	}
@@ -42,7 +49,7 @@ func MaxFlowDinic(g graph.WeightedDirected, s, t graph.Node, eps float64) float6
 		}
 		maxFlow += flow
 	}
-	return maxFlow
+	return maxFlow, r
 }
 
 // initializeResidualGraph builds the residual graph for Dinic’s algorithm.
@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"cmp"
+	"container/heap"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Flow is a flow in a network.
+type Flow struct {
+	// Value is the amount of flow from
+	// the source to the target.
+	Value float64
+
+	// Cost is the total cost of the flow.
+	Cost float64
+
+	// Edges holds the edges with non-zero
+	// flow, weighted by the flow along the
+	// edge and ordered by the IDs of their
+	// From and then To nodes.
+	Edges []graph.WeightedEdge
+}
+
+// MinCostFlow returns a minimum cost flow of at most the given value from s
+// to t in the directed graph g using successive shortest augmenting paths with
+// node potentials. The capacity of each edge is its weight in g and the cost
+// of a unit of flow along the edge from u to v is cost(u, v). If value is
+// infinite, MinCostFlow returns a minimum cost maximum flow. Edge costs may be
+// negative, but g must not have a cycle of edges with positive capacity and
+// negative total cost.
+//
+// MinCostFlow will panic if s and t are the same node, g has any negative edge
+// weight or any cycle of negative cost.
+//
+// The eps parameter specifies an absolute tolerance for treating tiny flow
+// updates as zero. If eps is negative a default of 1e-12 is used.
+//
+// See Ahuja, Magnanti and Orlin, Network Flows, chapter 9 for details of the
+// algorithm.
+func MinCostFlow(g graph.WeightedDirected, s, t graph.Node, value float64, cost func(uid, vid int64) float64, eps float64) Flow {
+	if s.ID() == t.ID() {
+		panic("network: no cut between s and t")
+	}
+	if eps < 0 {
+		eps = 1e-12
+	}
+	r := newResidualNetwork(g, cost)
+	r.eps = eps
+	src, dst := r.index[s.ID()], r.index[t.ID()]
+	r.initPotentials(src)
+
+	var f Flow
+	n := len(r.nodes)
+	dist := make([]float64, n)
+	via := make([]int, n)
+	for f.Value < value-eps {
+		if !r.shortestPaths(src, dist, via) || math.IsInf(dist[dst], 1) {
+			break
+		}
+		// Find the bottleneck capacity of the shortest path.
+		delta := value - f.Value
+		for v := dst; v != src; v = r.to[via[v]^1] {
+			delta = math.Min(delta, r.capacity[via[v]])
+		}
+		if delta <= eps {
+			break
+		}
+		for v := dst; v != src; v = r.to[via[v]^1] {
+			a := via[v]
+			r.capacity[a] -= delta
+			r.capacity[a^1] += delta
+			f.Cost += delta * r.cost[a]
+		}
+		f.Value += delta
+	}
+
+	for a := 0; a < len(r.to); a += 2 {
+		// The flow along an edge is the capacity of its reverse arc.
+		flow := r.capacity[a+1]
+		if flow <= eps {
+			continue
+		}
+		u := r.nodes[r.to[a+1]]
+		v := r.nodes[r.to[a]]
+		f.Edges = append(f.Edges, simple.WeightedEdge{F: u, T: v, W: flow})
+	}
+	slices.SortFunc(f.Edges, func(a, b graph.WeightedEdge) int {
+		return cmp.Or(
+			cmp.Compare(a.From().ID(), b.From().ID()),
+			cmp.Compare(a.To().ID(), b.To().ID()),
+		)
+	})
+	return f
+}
+
+// residualNetwork is the residual network of a flow held as a list of arcs.
+// Arc 2k is the k-th edge of the graph and arc 2k+1 is its reverse.
+type residualNetwork struct {
+	nodes []graph.Node
+	index map[int64]int
+
+	// out[u] holds the arcs leaving node u.
+	out [][]int
+
+	to       []int
+	capacity []float64
+	cost     []float64
+
+	// potential holds the node potentials that make
+	// the reduced costs of residual arcs non-negative.
+	potential []float64
+
+	// eps is the capacity below which an arc is
+	// treated as saturated.
+	eps float64
+}
+
+func newResidualNetwork(g graph.WeightedDirected, cost func(uid, vid int64) float64) *residualNetwork {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	r := &residualNetwork{
+		nodes:     nodes,
+		index:     make(map[int64]int, len(nodes)),
+		out:       make([][]int, len(nodes)),
+		potential: make([]float64, len(nodes)),
+	}
+	for i, u := range nodes {
+		r.index[u.ID()] = i
+	}
+	for i, u := range nodes {
+		for it := g.From(u.ID()); it.Next(); {
+			vid := it.Node().ID()
+			capacity, ok := g.Weight(u.ID(), vid)
+			if !ok {
+				panic("network: expected a weight for existing edge")
+			}
+			if capacity < 0 {
+				panic("network: negative edge weight")
+			}
+			j := r.index[vid]
+			c := cost(u.ID(), vid)
+			r.out[i] = append(r.out[i], len(r.to))
+			r.to = append(r.to, j)
+			r.capacity = append(r.capacity, capacity)
+			r.cost = append(r.cost, c)
+			r.out[j] = append(r.out[j], len(r.to))
+			r.to = append(r.to, i)
+			r.capacity = append(r.capacity, 0)
+			r.cost = append(r.cost, -c)
+		}
+	}
+	return r
+}
+
+// initPotentials sets the node potentials to the shortest path costs from
+// src using the Bellman-Ford algorithm so that negative edge costs are
+// allowed. It panics if a negative cost cycle is reachable from src.
+func (r *residualNetwork) initPotentials(src int) {
+	n := len(r.nodes)
+	for i := range r.potential {
+		r.potential[i] = math.Inf(1)
+	}
+	r.potential[src] = 0
+	for i := 0; i <= n; i++ {
+		changed := false
+		for u := range r.out {
+			if math.IsInf(r.potential[u], 1) {
+				continue
+			}
+			for _, a := range r.out[u] {
+				if r.capacity[a] <= r.eps {
+					continue
+				}
+				if d := r.potential[u] + r.cost[a]; d < r.potential[r.to[a]] {
+					r.potential[r.to[a]] = d
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+		if i == n {
+			panic("network: negative cost cycle")
+		}
+	}
+	// Nodes that are not reachable from the source can never be
+	// reached, so their potentials are arbitrary.
+	for i, p := range r.potential {
+		if math.IsInf(p, 1) {
+			r.potential[i] = 0
+		}
+	}
+}
+
+// shortestPaths computes the shortest path costs from src with respect to the
+// reduced arc costs using Dijkstra's algorithm, storing the arc used to reach
+// each node in via, and updates the node potentials. It returns false if no
+// node other than src is reachable.
+func (r *residualNetwork) shortestPaths(src int, dist []float64, via []int) bool {
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		via[i] = -1
+	}
+	dist[src] = 0
+	queue := &costQueue{{node: src}}
+	reached := false
+	for queue.Len() > 0 {
+		c := heap.Pop(queue).(nodeCost)
+		u := c.node
+		if c.cost > dist[u] {
+			continue
+		}
+		for _, a := range r.out[u] {
+			if r.capacity[a] <= r.eps {
+				continue
+			}
+			v := r.to[a]
+			// Rounding may make reduced costs slightly negative.
+			reduced := math.Max(r.cost[a]+r.potential[u]-r.potential[v], 0)
+			if d := dist[u] + reduced; d < dist[v] {
+				dist[v] = d
+				via[v] = a
+				reached = true
+				heap.Push(queue, nodeCost{node: v, cost: d})
+			}
+		}
+	}
+	for i, d := range dist {
+		if !math.IsInf(d, 1) {
+			r.potential[i] += d
+		}
+	}
+	return reached
+}
+
+// nodeCost is a node with the cost of a path to it.
+type nodeCost struct {
+	node int
+	cost float64
+}
+
+// costQueue is a priority queue of nodes ordered by increasing cost.
+type costQueue []nodeCost
+
+func (q costQueue) Len() int            { return len(q) }
+func (q costQueue) Less(i, j int) bool  { return q[i].cost < q[j].cost }
+func (q costQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *costQueue) Push(x interface{}) { *q = append(*q, x.(nodeCost)) }
+func (q *costQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	c := old[n-1]
+	*q = old[:n-1]
+	return c
+}
@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+func TestMinCostFlow(t *testing.T) {
+	t.Parallel()
+	// A small network with edges weighted by capacity and with costs
+	// held separately.
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	costs := make(map[[2]int64]float64)
+	for _, e := range []struct {
+		u, v           int64
+		capacity, cost float64
+	}{
+		{u: 0, v: 1, capacity: 4, cost: 2},
+		{u: 0, v: 2, capacity: 2, cost: 2},
+		{u: 1, v: 2, capacity: 2, cost: 1},
+		{u: 1, v: 3, capacity: 3, cost: 3},
+		{u: 2, v: 4, capacity: 5, cost: 5},
+		{u: 3, v: 2, capacity: 1, cost: 1},
+		{u: 3, v: 4, capacity: 4, cost: 1},
+	} {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(e.u), T: simple.Node(e.v), W: e.capacity})
+		costs[[2]int64{e.u, e.v}] = e.cost
+	}
+	cost := func(uid, vid int64) float64 { return costs[[2]int64{uid, vid}] }
+
+	for _, test := range []struct {
+		value    float64
+		want     float64
+		wantCost float64
+	}{
+		{value: 1, want: 1, wantCost: 6},
+		{value: 4, want: 4, wantCost: 25},
+		{value: math.Inf(1), want: 6, wantCost: 40},
+	} {
+		f := MinCostFlow(g, simple.Node(0), simple.Node(4), test.value, cost, -1)
+		if f.Value != test.want || f.Cost != test.wantCost {
+			t.Errorf("unexpected flow for value %v: got value=%v cost=%v, want value=%v cost=%v",
+				test.value, f.Value, f.Cost, test.want, test.wantCost)
+		}
+		checkFlow(t, fmt.Sprint(test.value), g, simple.Node(0), simple.Node(4), cost, f)
+	}
+}
+
+func TestMinCostFlowRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-8
+	for trial := 0; trial < 100; trial++ {
+		n := rnd.IntN(8) + 2
+		g := simple.NewWeightedDirectedGraph(0, 0)
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		costs := make(map[[2]int64]float64)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i == j || rnd.Float64() > 0.4 {
+					continue
+				}
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(rnd.IntN(10))})
+				// Costs are non-negative so there are no
+				// negative cost cycles.
+				costs[[2]int64{int64(i), int64(j)}] = float64(rnd.IntN(10))
+			}
+		}
+		cost := func(uid, vid int64) float64 { return costs[[2]int64{uid, vid}] }
+		s, tgt := simple.Node(0), simple.Node(n-1)
+		name := fmt.Sprintf("trial %d", trial)
+
+		maxFlow := MaxFlowDinic(g, s, tgt, tol)
+		f := MinCostFlow(g, s, tgt, math.Inf(1), cost, tol)
+		if !scalar.EqualWithinAbs(f.Value, maxFlow, tol) {
+			t.Errorf("%s: unexpected flow value: got %v, want %v", name, f.Value, maxFlow)
+		}
+		checkFlow(t, name, g, s, tgt, cost, f)
+		if f.Value == 0 {
+			continue
+		}
+		if want := lpMinCost(g, s, tgt, f.Value, cost); !scalar.EqualWithinAbsOrRel(f.Cost, want, tol, tol) {
+			t.Errorf("%s: unexpected cost: got %v, want %v", name, f.Cost, want)
+		}
+	}
+}
+
+// checkFlow checks that f is a feasible flow in g with the reported value and
+// cost.
+func checkFlow(t *testing.T, name string, g graph.WeightedDirected, s, tgt graph.Node, cost func(uid, vid int64) float64, f Flow) {
+	t.Helper()
+	const tol = 1e-8
+	net := make(map[int64]float64)
+	var c float64
+	for _, e := range f.Edges {
+		capacity, ok := g.Weight(e.From().ID(), e.To().ID())
+		if !ok {
+			t.Errorf("%s: flow on edge not in graph", name)
+			continue
+		}
+		if e.Weight() > capacity+tol {
+			t.Errorf("%s: flow %v exceeds capacity %v", name, e.Weight(), capacity)
+		}
+		net[e.From().ID()] -= e.Weight()
+		net[e.To().ID()] += e.Weight()
+		c += e.Weight() * cost(e.From().ID(), e.To().ID())
+	}
+	for id, v := range net {
+		want := 0.0
+		switch id {
+		case s.ID():
+			want = -f.Value
+		case tgt.ID():
+			want = f.Value
+		}
+		if !scalar.EqualWithinAbs(v, want, tol) {
+			t.Errorf("%s: flow not conserved at node %d: got %v, want %v", name, id, v, want)
+		}
+	}
+	if !scalar.EqualWithinAbsOrRel(c, f.Cost, tol, tol) {
+		t.Errorf("%s: cost mismatch: got %v, want %v", name, f.Cost, c)
+	}
+}
+
+// lpMinCost returns the minimum cost of a flow of the given value from s to
+// t in g by solving the linear program
+//
+//	minimize   ∑ cost(e) x_e
+//	subject to ∑ x_out - ∑ x_in = b_v  for all nodes v except t
+//	           x_e + s_e = capacity(e)
+//	           x, s ≥ 0.
+func lpMinCost(g *simple.WeightedDirectedGraph, s, tgt graph.Node, value float64, cost func(uid, vid int64) float64) float64 {
+	edges := graph.WeightedEdgesOf(g.WeightedEdges())
+	n := g.Nodes().Len()
+	m := len(edges)
+	a := mat.NewDense(n-1+m, 2*m, nil)
+	b := make([]float64, n-1+m)
+	c := make([]float64, 2*m)
+	row := func(id int64) int {
+		if id > tgt.ID() {
+			return int(id) - 1
+		}
+		return int(id)
+	}
+	for k, e := range edges {
+		uid, vid := e.From().ID(), e.To().ID()
+		if uid != tgt.ID() {
+			a.Set(row(uid), k, 1)
+		}
+		if vid != tgt.ID() {
+			a.Set(row(vid), k, -1)
+		}
+		a.Set(n-1+k, k, 1)
+		a.Set(n-1+k, m+k, 1)
+		b[n-1+k] = e.Weight()
+		c[k] = cost(uid, vid)
+	}
+	b[row(s.ID())] = value
+	opt, _, err := lp.Simplex(c, a, b, 0, nil)
+	if err != nil {
+		panic(err)
+	}
+	return opt
+}
+
+func TestMinCostFlowNegativeCycle(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 1}, {2, 3}} {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(e[0]), T: simple.Node(e[1]), W: 1})
+	}
+	cost := func(uid, vid int64) float64 { return -1 }
+	defer func() {
+		if r := recover(); r != "network: negative cost cycle" {
+			t.Errorf("unexpected panic: got %v, want %q", r, "network: negative cost cycle")
+		}
+	}()
+	MinCostFlow(g, simple.Node(0), simple.Node(3), 1, cost, -1)
+}
@@ -0,0 +1,329 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Motif is an isomorphism class of weakly connected graphs on a small number
+// of nodes, identified by the adjacency matrix of a canonical member of the
+// class.
+type Motif struct {
+	// Size is the number of nodes in the motif.
+	Size int
+
+	// Directed is whether the motif is of
+	// a directed graph.
+	Directed bool
+
+	// Code holds the adjacency matrix of the
+	// canonical member of the class, with bit
+	// i*Size+j set when there is an edge from
+	// node i to node j. Undirected edges set
+	// both bits.
+	Code uint16
+}
+
+// Edges returns the edges of the canonical member of the motif's class as
+// pairs of node indices. For undirected motifs, the first node of each pair
+// is the lower index.
+func (m Motif) Edges() [][2]int {
+	var edges [][2]int
+	for i := 0; i < m.Size; i++ {
+		for j := 0; j < m.Size; j++ {
+			if i == j || (!m.Directed && j < i) {
+				continue
+			}
+			if m.Code&(1<<(i*m.Size+j)) != 0 {
+				edges = append(edges, [2]int{i, j})
+			}
+		}
+	}
+	return edges
+}
+
+// MotifCensus returns the number of induced weakly connected subgraphs of g
+// with the given number of nodes in each isomorphism class. The size must be
+// three or four. If g is a graph.Directed, the direction of edges is used to
+// distinguish classes. Self edges are ignored.
+//
+// MotifCensus uses the ESU enumeration algorithm described in Wernicke,
+// "Efficient detection of network motifs", IEEE/ACM Trans. Comput. Biol.
+// Bioinform. 3 (2006).
+func MotifCensus(g graph.Graph, size int) map[Motif]int {
+	if size != 3 && size != 4 {
+		panic("network: invalid motif size")
+	}
+	return newArcs(g, nil).motifCensus(size)
+}
+
+// MotifScore is the significance of the count of a motif in a graph relative
+// to an ensemble of randomized graphs.
+type MotifScore struct {
+	// Count is the number of occurrences
+	// of the motif in the graph.
+	Count int
+
+	// Mean and StdDev are the sample mean and
+	// standard deviation of the number of
+	// occurrences in the randomized graphs.
+	Mean, StdDev float64
+
+	// Z is the z-score of Count, (Count-Mean)/StdDev.
+	// Z is infinite or NaN when StdDev is zero.
+	Z float64
+}
+
+// MotifSignificance returns the motif census of g with the given motif size
+// and the z-scores of the counts relative to the given number of randomized
+// graphs with the same degree sequence as g. For directed graphs the in- and
+// out-degrees of each node are retained. The returned map holds each motif
+// found in g or in any of the randomized graphs.
+//
+// Each randomized graph is obtained from g by a sequence of edge swaps that
+// replace the edges u–v and x–y with u–y and x–v if neither exists, making ten
+// swap attempts for each edge of g. If src is nil, the global random source is
+// used.
+//
+// See Milo et al., "Network Motifs: Simple Building Blocks of Complex
+// Networks", Science 298 (2002) for details.
+func MotifSignificance(g graph.Graph, size, samples int, src rand.Source) map[Motif]MotifScore {
+	if size != 3 && size != 4 {
+		panic("network: invalid motif size")
+	}
+	rnd := rand.IntN
+	if src != nil {
+		rnd = rand.New(src).IntN
+	}
+
+	a := newArcs(g, nil)
+	var edges [][2]int
+	for u, out := range a.out {
+		for v := range out {
+			if a.directed || u < v {
+				edges = append(edges, [2]int{u, v})
+			}
+		}
+	}
+	// Sort the edges so that the randomization
+	// is determined by src.
+	slices.SortFunc(edges, compareEdges)
+
+	counts := a.motifCensus(size)
+	sum := make(map[Motif]float64)
+	sumSq := make(map[Motif]float64)
+	for i := 0; i < samples; i++ {
+		for m, c := range a.randomized(edges, rnd).motifCensus(size) {
+			sum[m] += float64(c)
+			sumSq[m] += float64(c) * float64(c)
+		}
+	}
+
+	scores := make(map[Motif]MotifScore, len(counts))
+	for m := range counts {
+		// Include motifs that are not found
+		// in any of the randomized graphs.
+		if _, ok := sum[m]; !ok {
+			sum[m] = 0
+		}
+	}
+	n := float64(samples)
+	for m, s := range sum {
+		mean := s / n
+		std := math.Sqrt(math.Max(0, sumSq[m]-n*mean*mean) / (n - 1))
+		c := counts[m]
+		scores[m] = MotifScore{
+			Count:  c,
+			Mean:   mean,
+			StdDev: std,
+			Z:      (float64(c) - mean) / std,
+		}
+	}
+	return scores
+}
+
+// randomized returns a copy of a with the given edges rewired by degree
+// preserving edge swaps.
+func (a arcs) randomized(edges [][2]int, rnd func(int) int) arcs {
+	edges = append([][2]int(nil), edges...)
+	r := arcs{
+		nodes:    a.nodes,
+		directed: a.directed,
+		out:      make([]map[int]float64, len(a.nodes)),
+	}
+	for u := range r.out {
+		r.out[u] = make(map[int]float64, len(a.out[u]))
+	}
+	for _, e := range edges {
+		r.out[e[0]][e[1]] = 1
+		if !r.directed {
+			r.out[e[1]][e[0]] = 1
+		}
+	}
+
+	if len(edges) > 1 {
+		for i := 0; i < 10*len(edges); i++ {
+			p, q := rnd(len(edges)), rnd(len(edges))
+			if p == q {
+				continue
+			}
+			u, v := edges[p][0], edges[p][1]
+			x, y := edges[q][0], edges[q][1]
+			if !r.directed && rnd(2) == 0 {
+				x, y = y, x
+			}
+			if u == y || x == v || r.adjacent(u, y) || r.adjacent(x, v) {
+				continue
+			}
+			delete(r.out[u], v)
+			delete(r.out[x], y)
+			r.out[u][y] = 1
+			r.out[x][v] = 1
+			if !r.directed {
+				delete(r.out[v], u)
+				delete(r.out[y], x)
+				r.out[y][u] = 1
+				r.out[v][x] = 1
+			}
+			edges[p] = [2]int{u, y}
+			edges[q] = [2]int{x, v}
+		}
+	}
+
+	r.in = r.out
+	if r.directed {
+		r.in = make([]map[int]float64, len(r.nodes))
+		for u := range r.in {
+			r.in[u] = make(map[int]float64)
+		}
+		for u, out := range r.out {
+			for v, w := range out {
+				r.in[v][u] = w
+			}
+		}
+	}
+	return r
+}
+
+// motifCensus returns the motif census of a for the given motif size.
+func (a arcs) motifCensus(size int) map[Motif]int {
+	neighbors := a.neighbors()
+	perms := permutations(size)
+
+	census := make(map[Motif]int)
+	canonical := make(map[uint16]uint16)
+	sub := make([]int, 0, size)
+
+	// inNeighborhood returns whether u is in sub
+	// or adjacent to a node in sub.
+	inNeighborhood := func(u int) bool {
+		for _, s := range sub {
+			if s == u || neighbors[s][u] {
+				return true
+			}
+		}
+		return false
+	}
+
+	var extend func(ext []int, v int)
+	extend = func(ext []int, v int) {
+		if len(sub) == size {
+			code := a.adjacencyCode(sub)
+			c, ok := canonical[code]
+			if !ok {
+				c = canonicalCode(code, size, perms)
+				canonical[code] = c
+			}
+			census[Motif{Size: size, Directed: a.directed, Code: c}]++
+			return
+		}
+		for i, w := range ext {
+			next := append([]int(nil), ext[i+1:]...)
+			for u := range neighbors[w] {
+				if u > v && !inNeighborhood(u) {
+					next = append(next, u)
+				}
+			}
+			sub = append(sub, w)
+			extend(next, v)
+			sub = sub[:len(sub)-1]
+		}
+	}
+	for v := range a.nodes {
+		var ext []int
+		for u := range neighbors[v] {
+			if u > v {
+				ext = append(ext, u)
+			}
+		}
+		sub = append(sub[:0], v)
+		extend(ext, v)
+	}
+	return census
+}
+
+// adjacencyCode returns the adjacency matrix of the subgraph induced by the
+// nodes in sub as a bit set.
+func (a arcs) adjacencyCode(sub []int) uint16 {
+	var code uint16
+	for i, u := range sub {
+		for j, v := range sub {
+			if i != j && a.adjacent(u, v) {
+				code |= 1 << (i*len(sub) + j)
+			}
+		}
+	}
+	return code
+}
+
+// canonicalCode returns the smallest adjacency code over all relabelings of
+// the nodes of the graph with the given code.
+func canonicalCode(code uint16, size int, perms [][]int) uint16 {
+	best := uint16(math.MaxUint16)
+	for _, p := range perms {
+		var c uint16
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				if code&(1<<(i*size+j)) != 0 {
+					c |= 1 << (p[i]*size + p[j])
+				}
+			}
+		}
+		best = min(best, c)
+	}
+	return best
+}
+
+// permutations returns all permutations of 0, 1, …, n-1.
+func permutations(n int) [][]int {
+	if n == 0 {
+		return [][]int{{}}
+	}
+	var perms [][]int
+	for _, p := range permutations(n - 1) {
+		for i := 0; i <= len(p); i++ {
+			q := make([]int, 0, n)
+			q = append(q, p[:i]...)
+			q = append(q, n-1)
+			q = append(q, p[i:]...)
+			perms = append(perms, q)
+		}
+	}
+	return perms
+}
+
+// compareEdges orders edges by their first and then second node.
+func compareEdges(a, b [2]int) int {
+	if c := cmp.Compare(a[0], b[0]); c != 0 {
+		return c
+	}
+	return cmp.Compare(a[1], b[1])
+}
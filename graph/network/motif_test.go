@@ -0,0 +1,232 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestMotifClasses(t *testing.T) {
+	t.Parallel()
+	// The number of isomorphism classes of weakly
+	// connected graphs on three and four nodes.
+	for _, test := range []struct {
+		size     int
+		directed bool
+		want     int
+	}{
+		{size: 3, directed: false, want: 2},
+		{size: 3, directed: true, want: 13},
+		{size: 4, directed: false, want: 6},
+		{size: 4, directed: true, want: 199},
+	} {
+		perms := permutations(test.size)
+		classes := make(map[uint16]bool)
+		for code := 0; code < 1<<(test.size*test.size); code++ {
+			a := arcsFromCode(uint16(code), test.size, test.directed)
+			if a == nil || !weaklyConnected(a) {
+				continue
+			}
+			classes[canonicalCode(uint16(code), test.size, perms)] = true
+		}
+		if len(classes) != test.want {
+			t.Errorf("unexpected number of classes for size %d directed=%t: got:%d want:%d",
+				test.size, test.directed, len(classes), test.want)
+		}
+	}
+}
+
+func TestMotifCensus(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 40; trial++ {
+		n := rnd.IntN(9)
+		directed := trial%2 == 0
+		g := randomGraph(n, 0.4, directed, rnd)
+		a := newArcs(g, nil)
+		for _, size := range []int{3, 4} {
+			name := fmt.Sprintf("trial %d size %d directed=%t", trial, size, directed)
+
+			// Enumerate all subsets of nodes of the given size.
+			perms := permutations(size)
+			want := make(map[Motif]int)
+			var sub []int
+			var choose func(next int)
+			choose = func(next int) {
+				if len(sub) == size {
+					code := a.adjacencyCode(sub)
+					if weaklyConnected(arcsFromCode(code, size, directed)) {
+						want[Motif{Size: size, Directed: directed, Code: canonicalCode(code, size, perms)}]++
+					}
+					return
+				}
+				for v := next; v < n; v++ {
+					sub = append(sub, v)
+					choose(v + 1)
+					sub = sub[:len(sub)-1]
+				}
+			}
+			choose(0)
+
+			got := MotifCensus(g, size)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: unexpected census:\ngot: %v\nwant:%v", name, got, want)
+			}
+		}
+	}
+}
+
+func TestMotifSignificance(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		n := 4 + rnd.IntN(8)
+		directed := trial%2 == 0
+		g := randomGraph(n, 0.3, directed, rnd)
+		name := fmt.Sprintf("trial %d directed=%t", trial, directed)
+
+		a := newArcs(g, nil)
+		var edges [][2]int
+		for u, out := range a.out {
+			for v := range out {
+				if directed || u < v {
+					edges = append(edges, [2]int{u, v})
+				}
+			}
+		}
+		r := a.randomized(edges, rnd.IntN)
+		for v := range a.nodes {
+			if len(r.out[v]) != len(a.out[v]) || len(r.in[v]) != len(a.in[v]) {
+				t.Errorf("%s: degree of node %d not preserved", name, v)
+			}
+			if r.adjacent(v, v) {
+				t.Errorf("%s: self edge introduced at node %d", name, v)
+			}
+		}
+
+		census := MotifCensus(g, 3)
+		scores := MotifSignificance(g, 3, 10, rand.NewPCG(uint64(trial), 1))
+		for m, c := range census {
+			if scores[m].Count != c {
+				t.Errorf("%s: unexpected count for motif %v: got:%d want:%d", name, m.Edges(), scores[m].Count, c)
+			}
+		}
+		if again := MotifSignificance(g, 3, 10, rand.NewPCG(uint64(trial), 1)); !equalScores(scores, again) {
+			t.Errorf("%s: significance not reproducible", name)
+		}
+	}
+
+	// A complete graph cannot be rewired.
+	g := randomGraph(5, 1, false, rnd)
+	for m, s := range MotifSignificance(g, 4, 5, rand.NewPCG(1, 1)) {
+		if s.Mean != float64(s.Count) || s.StdDev != 0 || !math.IsNaN(s.Z) {
+			t.Errorf("unexpected score for complete graph motif %v: %+v", m.Edges(), s)
+		}
+	}
+}
+
+func equalScores(a, b map[Motif]MotifScore) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for m, s := range a {
+		t, ok := b[m]
+		if !ok || s.Count != t.Count || s.Mean != t.Mean || s.StdDev != t.StdDev {
+			return false
+		}
+	}
+	return true
+}
+
+// randomGraph returns a random graph with n nodes and edge probability p.
+func randomGraph(n int, p float64, directed bool, rnd *rand.Rand) graph.Graph {
+	if directed {
+		g := simple.NewDirectedGraph()
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i != j && rnd.Float64() < p {
+					g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+				}
+			}
+		}
+		return g
+	}
+	g := simple.NewUndirectedGraph()
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+		for j := 0; j < i; j++ {
+			if rnd.Float64() < p {
+				g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+			}
+		}
+	}
+	return g
+}
+
+// arcsFromCode returns the arcs of the graph with the given adjacency code,
+// or nil if the code is not valid for the graph kind.
+func arcsFromCode(code uint16, size int, directed bool) *arcs {
+	a := arcs{nodes: make([]graph.Node, size), directed: directed, out: make([]map[int]float64, size), in: make([]map[int]float64, size)}
+	for i := range a.out {
+		a.out[i] = make(map[int]float64)
+		a.in[i] = make(map[int]float64)
+	}
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			if code&(1<<(i*size+j)) == 0 {
+				continue
+			}
+			if i == j || (!directed && code&(1<<(j*size+i)) == 0) {
+				return nil
+			}
+			a.out[i][j] = 1
+			a.in[j][i] = 1
+		}
+	}
+	return &a
+}
+
+// weaklyConnected returns whether the graph is weakly connected.
+func weaklyConnected(a *arcs) bool {
+	neighbors := a.neighbors()
+	seen := map[int]bool{0: true}
+	stack := []int{0}
+	for len(stack) != 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for v := range neighbors[u] {
+			if !seen[v] {
+				seen[v] = true
+				stack = append(stack, v)
+			}
+		}
+	}
+	return len(seen) == len(a.nodes)
+}
+
+func ExampleMotifCensus() {
+	// A square with one diagonal.
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	for m, n := range MotifCensus(g, 3) {
+		fmt.Printf("%d edges: %d\n", len(m.Edges()), n)
+	}
+
+	// Unordered output:
+	// 2 edges: 2
+	// 3 edges: 2
+}
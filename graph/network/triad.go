@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"gonum.org/v1/gonum/graph"
+)
+
+// Triad is an isomorphism class of directed graphs on three nodes. The
+// classes are named by the numbers of mutual, asymmetric and null dyads in
+// the triad, with a letter distinguishing classes with the same numbers
+// (Down, Up, Cyclic or Transitive).
+type Triad int
+
+// The sixteen triad classes. The edges of a representative of each class on
+// the nodes a, b and c are shown.
+const (
+	Triad003  Triad = iota // empty
+	Triad012               // a→b
+	Triad102               // a↔b
+	Triad021D              // a←b→c
+	Triad021U              // a→b←c
+	Triad021C              // a→b→c
+	Triad111D              // a↔c, b→c
+	Triad111U              // a↔c, c→b
+	Triad030T              // a→b←c, a→c
+	Triad030C              // a←b←c, a→c
+	Triad201               // a↔b, a↔c
+	Triad120D              // a↔c, b→a, b→c
+	Triad120U              // a↔c, a→b, c→b
+	Triad120C              // a↔c, a→b→c
+	Triad210               // a↔c, a→b, b↔c
+	Triad300               // a↔b, b↔c, a↔c
+
+	// NumTriads is the number of triad classes.
+	NumTriads = int(Triad300) + 1
+)
+
+var triadNames = [NumTriads]string{
+	"003", "012", "102", "021D", "021U", "021C", "111D", "111U",
+	"030T", "030C", "201", "120D", "120U", "120C", "210", "300",
+}
+
+func (t Triad) String() string {
+	if t < 0 || int(t) >= NumTriads {
+		return "<invalid triad>"
+	}
+	return triadNames[t]
+}
+
+// triadOf maps the six bit code of the edges between three nodes to the
+// triad class of the nodes. The bits of the code from least to most
+// significant are set for the edges v→u, u→v, v→w, w→v, u→w and w→u.
+var triadOf [64]Triad
+
+func init() {
+	// Representative edges of each triad class on
+	// the nodes 0, 1 and 2 in the order of the
+	// Triad constants.
+	representatives := [NumTriads][][2]int{
+		{},
+		{{0, 1}},
+		{{0, 1}, {1, 0}},
+		{{1, 0}, {1, 2}},
+		{{0, 1}, {2, 1}},
+		{{0, 1}, {1, 2}},
+		{{0, 2}, {2, 0}, {1, 2}},
+		{{0, 2}, {2, 0}, {2, 1}},
+		{{0, 1}, {2, 1}, {0, 2}},
+		{{1, 0}, {2, 1}, {0, 2}},
+		{{0, 1}, {1, 0}, {0, 2}, {2, 0}},
+		{{1, 2}, {1, 0}, {0, 2}, {2, 0}},
+		{{0, 1}, {2, 1}, {0, 2}, {2, 0}},
+		{{0, 1}, {1, 2}, {0, 2}, {2, 0}},
+		{{0, 1}, {1, 2}, {2, 1}, {0, 2}, {2, 0}},
+		{{0, 1}, {1, 0}, {1, 2}, {2, 1}, {0, 2}, {2, 0}},
+	}
+	permutations := [][3]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	for t, edges := range representatives {
+		for _, p := range permutations {
+			var adj [3][3]bool
+			for _, e := range edges {
+				adj[p[e[0]]][p[e[1]]] = true
+			}
+			triadOf[triadCode(adj[0][1], adj[1][0], adj[0][2], adj[2][0], adj[1][2], adj[2][1])] = Triad(t)
+		}
+	}
+}
+
+// triadCode returns the code of the triad on v, u and w with the given edges.
+func triadCode(vu, uv, vw, wv, uw, wu bool) int {
+	var code int
+	for i, e := range [6]bool{vu, uv, vw, wv, uw, wu} {
+		if e {
+			code |= 1 << i
+		}
+	}
+	return code
+}
+
+// TriadCensus returns the number of triples of nodes of the directed graph g
+// in each triad class, indexed by Triad. Self edges are ignored.
+//
+// TriadCensus uses the subquadratic algorithm of Batagelj and Mrvar, "A
+// subquadratic triad census algorithm for large sparse networks with small
+// maximum degree", Social Networks 23 (2001).
+func TriadCensus(g graph.Directed) [NumTriads]int {
+	a := newArcs(g, nil)
+	n := len(a.nodes)
+
+	neighbors := a.neighbors()
+
+	var census [NumTriads]int
+	s := make(map[int]bool)
+	for v := 0; v < n; v++ {
+		for u := range neighbors[v] {
+			if u <= v {
+				continue
+			}
+			clear(s)
+			for w := range neighbors[u] {
+				s[w] = true
+			}
+			for w := range neighbors[v] {
+				s[w] = true
+			}
+			delete(s, u)
+			delete(s, v)
+
+			// Triads with a single dyad.
+			dyad := Triad012
+			if a.adjacent(u, v) && a.adjacent(v, u) {
+				dyad = Triad102
+			}
+			census[dyad] += n - len(s) - 2
+
+			// Connected triads, counted once from
+			// their lowest connected pair.
+			for w := range s {
+				if u < w || (v < w && w < u && !neighbors[v][w]) {
+					code := triadCode(
+						a.adjacent(v, u), a.adjacent(u, v),
+						a.adjacent(v, w), a.adjacent(w, v),
+						a.adjacent(u, w), a.adjacent(w, u),
+					)
+					census[triadOf[code]]++
+				}
+			}
+		}
+	}
+
+	var sum int
+	for _, c := range census[1:] {
+		sum += c
+	}
+	census[Triad003] = n*(n-1)*(n-2)/6 - sum
+	return census
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestTriadClasses(t *testing.T) {
+	t.Parallel()
+	// The number of labeled triads in each class.
+	want := [NumTriads]int{1, 6, 3, 3, 3, 6, 6, 6, 6, 2, 3, 3, 3, 6, 6, 1}
+	var got [NumTriads]int
+	for code, triad := range triadOf {
+		got[triad]++
+
+		// The class name holds the numbers of mutual,
+		// asymmetric and null dyads.
+		var dyads [3]int
+		for _, d := range [3]int{code & 3, code >> 2 & 3, code >> 4 & 3} {
+			switch d {
+			case 3:
+				dyads[0]++
+			case 1, 2:
+				dyads[1]++
+			default:
+				dyads[2]++
+			}
+		}
+		name := triad.String()
+		if man := fmt.Sprintf("%d%d%d", dyads[0], dyads[1], dyads[2]); name[:3] != man {
+			t.Errorf("unexpected class for code %06b: got:%s want MAN:%s", code, name, man)
+		}
+	}
+	if got != want {
+		t.Errorf("unexpected class sizes: got:%v want:%v", got, want)
+	}
+}
+
+func TestTriadCensus(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		n := rnd.IntN(12)
+		p := rnd.Float64() * 0.5
+		g := simple.NewDirectedGraph()
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i != j && rnd.Float64() < p {
+					g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+				}
+			}
+		}
+
+		var want [NumTriads]int
+		for v := 0; v < n; v++ {
+			for u := v + 1; u < n; u++ {
+				for w := u + 1; w < n; w++ {
+					code := triadCode(
+						g.HasEdgeFromTo(int64(v), int64(u)), g.HasEdgeFromTo(int64(u), int64(v)),
+						g.HasEdgeFromTo(int64(v), int64(w)), g.HasEdgeFromTo(int64(w), int64(v)),
+						g.HasEdgeFromTo(int64(u), int64(w)), g.HasEdgeFromTo(int64(w), int64(u)),
+					)
+					want[triadOf[code]]++
+				}
+			}
+		}
+		got := TriadCensus(g)
+		if got != want {
+			t.Errorf("unexpected triad census for trial %d:\ngot: %v\nwant:%v", trial, got, want)
+		}
+	}
+}
+
+func ExampleTriadCensus() {
+	// A directed 3-cycle with a pendant node.
+	g := simple.NewDirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	g.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(2)})
+	g.SetEdge(simple.Edge{F: simple.Node(2), T: simple.Node(0)})
+	g.SetEdge(simple.Edge{F: simple.Node(2), T: simple.Node(3)})
+
+	for t, n := range TriadCensus(g) {
+		if n != 0 {
+			fmt.Printf("%s: %d\n", Triad(t), n)
+		}
+	}
+
+	// Output:
+	// 012: 1
+	// 021D: 1
+	// 021C: 1
+	// 030C: 1
+}
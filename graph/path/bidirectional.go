@@ -0,0 +1,191 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/set"
+)
+
+// BidirectionalDijkstra finds a shortest path from s to t in g by searching
+// forward from s and backward from t until the two searches meet. The path
+// and its cost are returned in a Shortest that holds only the nodes of the
+// path. The number of expanded nodes is also returned.
+//
+// If g is a graph.Directed, the backward search follows the edges into each
+// node, otherwise g is treated as undirected. If the graph does not implement
+// Weighted, UniformCost is used. BidirectionalDijkstra will panic if g has a
+// negative edge weight that is reached by either search.
+func BidirectionalDijkstra(s, t graph.Node, g graph.Graph) (path Shortest, expanded int) {
+	return BidirectionalAStar(s, t, g, NullHeuristic)
+}
+
+// BidirectionalAStar finds an A*-shortest path from s to t in g using the
+// heuristic h by searching forward from s and backward from t until the two
+// searches meet. The path and its cost are returned in a Shortest that holds
+// only the nodes of the path. The number of expanded nodes is also returned.
+//
+// The searches are guided by the average of the forward and backward
+// potentials, (h(n, t) - h(s, n))/2, so the path will be the shortest path
+// if the heuristic is consistent in both directions; for every edge from u
+// to v, h(u, t) ≤ w(u, v) + h(v, t) and h(s, v) ≤ h(s, u) + w(u, v).
+//
+// If h is nil, BidirectionalAStar will use the g.HeuristicCost method if g
+// implements HeuristicCoster, falling back to NullHeuristic otherwise. If g
+// is a graph.Directed, the backward search follows the edges into each node,
+// otherwise g is treated as undirected. If the graph does not implement
+// Weighted, UniformCost is used. BidirectionalAStar will panic if g has a
+// negative edge weight that is reached by either search.
+func BidirectionalAStar(s, t graph.Node, g graph.Graph, h Heuristic) (path Shortest, expanded int) {
+	if g.Node(s.ID()) == nil || g.Node(t.ID()) == nil {
+		return Shortest{from: s}, 0
+	}
+	sid, tid := s.ID(), t.ID()
+	if sid == tid {
+		return newShortestFrom(s, []graph.Node{s}), 0
+	}
+
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+	if h == nil {
+		if g, ok := g.(HeuristicCoster); ok {
+			h = g.HeuristicCost
+		} else {
+			h = NullHeuristic
+		}
+	}
+	to := g.From
+	if g, ok := g.(graph.Directed); ok {
+		to = g.To
+	}
+
+	// The forward search uses the potential p(n) and the
+	// backward search uses -p(n), so that the reduced edge
+	// weights are the same in both directions.
+	potentials := make(map[int64]float64)
+	potential := func(n graph.Node) float64 {
+		id := n.ID()
+		p, ok := potentials[id]
+		if !ok {
+			p = (h(n, t) - h(s, n)) / 2
+			potentials[id] = p
+		}
+		return p
+	}
+
+	fwd := newBidirectionalSearch(s, potential(s))
+	bwd := newBidirectionalSearch(t, -potential(t))
+	best := math.Inf(1)
+	var meet graph.Node
+	for fwd.queue.Len() != 0 && bwd.queue.Len() != 0 {
+		if fwd.queue[0].dist+bwd.queue[0].dist >= best {
+			break
+		}
+
+		search, other, next, sign := fwd, bwd, g.From, 1.0
+		if bwd.queue.Len() < fwd.queue.Len() {
+			search, other, next, sign = bwd, fwd, to, -1
+		}
+
+		u := heap.Pop(&search.queue).(distanceNode).node
+		uid := u.ID()
+		if search.settled.Has(uid) {
+			continue
+		}
+		search.settled.Add(uid)
+		expanded++
+
+		du := search.dist[uid]
+		it := next(uid)
+		for it.Next() {
+			v := it.Node()
+			vid := v.ID()
+			if search.settled.Has(vid) {
+				continue
+			}
+			var (
+				w  float64
+				ok bool
+			)
+			if search == fwd {
+				w, ok = weight(uid, vid)
+			} else {
+				w, ok = weight(vid, uid)
+			}
+			if !ok {
+				panic("path: bidirectional search unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("path: bidirectional search negative edge weight")
+			}
+			dv := du + w
+			if d, ok := search.dist[vid]; ok && dv >= d {
+				continue
+			}
+			search.dist[vid] = dv
+			search.prev[vid] = u
+			heap.Push(&search.queue, distanceNode{node: v, dist: dv + sign*potential(v)})
+			if d, ok := other.dist[vid]; ok && dv+d < best {
+				best = dv + d
+				meet = v
+			}
+		}
+	}
+
+	if math.IsInf(best, 1) {
+		return newShortestFrom(s, []graph.Node{s, t}), expanded
+	}
+
+	// Construct the path from s to the meeting node and
+	// from the meeting node to t.
+	nodes := []graph.Node{meet}
+	for n := meet; n.ID() != sid; {
+		n = fwd.prev[n.ID()]
+		nodes = append(nodes, n)
+	}
+	slices.Reverse(nodes)
+	k := len(nodes) - 1
+	for n := meet; n.ID() != tid; {
+		n = bwd.prev[n.ID()]
+		nodes = append(nodes, n)
+	}
+	path = newShortestFrom(s, nodes)
+	for i, n := range nodes[1:] {
+		d := fwd.dist[n.ID()]
+		if i+1 > k {
+			d = best - bwd.dist[n.ID()]
+		}
+		path.set(i+1, d, i)
+	}
+	return path, expanded
+}
+
+// bidirectionalSearch holds the state of one direction of a bidirectional
+// shortest path search.
+type bidirectionalSearch struct {
+	dist    map[int64]float64
+	prev    map[int64]graph.Node
+	settled set.Ints[int64]
+	queue   priorityQueue
+}
+
+// newBidirectionalSearch returns a search starting from n with the given
+// potential.
+func newBidirectionalSearch(n graph.Node, potential float64) *bidirectionalSearch {
+	return &bidirectionalSearch{
+		dist:    map[int64]float64{n.ID(): 0},
+		prev:    make(map[int64]graph.Node),
+		settled: make(set.Ints[int64]),
+		queue:   priorityQueue{{node: n, dist: potential}},
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path/internal/testgraphs"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+func TestBidirectionalDijkstra(t *testing.T) {
+	t.Parallel()
+	for _, test := range testgraphs.ShortestPathTests {
+		g := test.Graph()
+		for _, e := range test.Edges {
+			g.SetWeightedEdge(e)
+		}
+
+		var (
+			pt Shortest
+
+			panicked bool
+		)
+		func() {
+			defer func() {
+				panicked = recover() != nil
+			}()
+			pt, _ = BidirectionalDijkstra(test.Query.From(), test.Query.To(), g.(graph.Graph))
+		}()
+		if panicked || test.HasNegativeWeight {
+			if !test.HasNegativeWeight {
+				t.Errorf("%q: unexpected panic", test.Name)
+			}
+			continue
+		}
+
+		if pt.From().ID() != test.Query.From().ID() {
+			t.Fatalf("%q: unexpected from node ID: got:%d want:%d", test.Name, pt.From().ID(), test.Query.From().ID())
+		}
+
+		p, weight := pt.To(test.Query.To().ID())
+		if weight != test.Weight {
+			t.Errorf("%q: unexpected weight from To: got:%f want:%f",
+				test.Name, weight, test.Weight)
+		}
+		if weight := pt.WeightTo(test.Query.To().ID()); weight != test.Weight {
+			t.Errorf("%q: unexpected weight from WeightTo: got:%f want:%f",
+				test.Name, weight, test.Weight)
+		}
+
+		var got []int64
+		for _, n := range p {
+			got = append(got, n.ID())
+		}
+		ok := len(got) == 0 && len(test.WantPaths) == 0
+		for _, sp := range test.WantPaths {
+			if reflect.DeepEqual(got, sp) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			t.Errorf("%q: unexpected shortest path:\ngot: %v\nwant from:%v",
+				test.Name, p, test.WantPaths)
+		}
+
+		np, weight := pt.To(test.NoPathFor.To().ID())
+		if pt.From().ID() == test.NoPathFor.From().ID() && (np != nil || !math.IsInf(weight, 1)) {
+			t.Errorf("%q: unexpected path:\ngot: path=%v weight=%f\nwant:path=<nil> weight=+Inf",
+				test.Name, np, weight)
+		}
+	}
+}
+
+func TestBidirectionalAStar(t *testing.T) {
+	t.Parallel()
+	for _, test := range aStarTests {
+		pt, _ := BidirectionalAStar(simple.Node(test.s), simple.Node(test.t), test.g, test.heuristic)
+
+		p, cost := pt.To(test.t)
+
+		if !topo.IsPathIn(test.g, p) {
+			t.Errorf("got path that is not path in input graph for %q", test.name)
+		}
+
+		bfp, ok := BellmanFordFrom(simple.Node(test.s), test.g)
+		if !ok {
+			t.Fatalf("unexpected negative cycle in %q", test.name)
+		}
+		if want := bfp.WeightTo(test.t); cost != want {
+			t.Errorf("unexpected cost for %q: got:%v want:%v", test.name, cost, want)
+		}
+
+		var got = make([]int64, 0, len(p))
+		for _, n := range p {
+			got = append(got, n.ID())
+		}
+		if test.wantPath != nil && !reflect.DeepEqual(got, test.wantPath) {
+			t.Errorf("unexpected result for %q:\ngot: %v\nwant:%v", test.name, got, test.wantPath)
+		}
+	}
+}
+
+func TestExhaustiveBidirectionalAStar(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	nodes := []locatedNode{
+		{id: 1, x: 0, y: 6},
+		{id: 2, x: 1, y: 0},
+		{id: 3, x: 8, y: 7},
+		{id: 4, x: 16, y: 0},
+		{id: 5, x: 17, y: 6},
+		{id: 6, x: 9, y: 8},
+	}
+	for _, n := range nodes {
+		g.AddNode(n)
+	}
+
+	edges := []weightedEdge{
+		{from: g.Node(1), to: g.Node(2), cost: 7},
+		{from: g.Node(1), to: g.Node(3), cost: 9},
+		{from: g.Node(1), to: g.Node(6), cost: 14},
+		{from: g.Node(2), to: g.Node(3), cost: 10},
+		{from: g.Node(2), to: g.Node(4), cost: 15},
+		{from: g.Node(3), to: g.Node(4), cost: 11},
+		{from: g.Node(3), to: g.Node(6), cost: 2},
+		{from: g.Node(4), to: g.Node(5), cost: 7},
+		{from: g.Node(5), to: g.Node(6), cost: 9},
+		{from: g.Node(6), to: g.Node(1), cost: 20},
+	}
+	for _, e := range edges {
+		g.SetWeightedEdge(e)
+	}
+
+	heuristic := func(u, v graph.Node) float64 {
+		lu := u.(locatedNode)
+		lv := v.(locatedNode)
+		return math.Hypot(lu.x-lv.x, lu.y-lv.y)
+	}
+
+	ps := DijkstraAllPaths(g)
+	ends := graph.NodesOf(g.Nodes())
+	for _, start := range ends {
+		for _, goal := range ends {
+			for _, h := range []Heuristic{nil, heuristic} {
+				pt, _ := BidirectionalAStar(start, goal, g, h)
+				gotPath, gotWeight := pt.To(goal.ID())
+				wantPath, wantWeight, _ := ps.Between(start.ID(), goal.ID())
+				if gotWeight != wantWeight {
+					t.Errorf("unexpected path weight from %v to %v result: got:%f want:%f",
+						start, goal, gotWeight, wantWeight)
+				}
+				if !reflect.DeepEqual(gotPath, wantPath) {
+					t.Errorf("unexpected path from %v to %v result:\ngot: %v\nwant:%v",
+						start, goal, gotPath, wantPath)
+				}
+			}
+		}
+	}
+}
+
+func TestBidirectionalExpanded(t *testing.T) {
+	t.Parallel()
+	g := testgraphs.NewGrid(100, 100, true)
+	s, dst := g.NodeAt(50, 10), g.NodeAt(50, 90)
+
+	_, uni := AStar(s, dst, g, NullHeuristic)
+	pt, bi := BidirectionalDijkstra(s, dst, g)
+	if _, w := pt.To(dst.ID()); w != 80 {
+		t.Errorf("unexpected path weight: got:%f want:80", w)
+	}
+	if bi >= uni {
+		t.Errorf("bidirectional search expanded more nodes than unidirectional search: %d >= %d", bi, uni)
+	}
+}
@@ -0,0 +1,420 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"cmp"
+	"container/heap"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// ContractionHierarchy is a preprocessed representation of a weighted
+// directed graph that answers point-to-point shortest path queries
+// quickly. A ContractionHierarchy is safe for concurrent queries.
+//
+// See Geisberger et al. doi:10.1007/978-3-540-68552-4_24 for details
+// of the algorithm.
+type ContractionHierarchy struct {
+	// nodes holds the nodes of the graph
+	// in order of their ID and indexOf
+	// maps node IDs to indices into nodes.
+	nodes   []graph.Node
+	indexOf map[int64]int
+
+	// rank holds the contraction order
+	// of each node.
+	rank []int
+
+	// up holds the edges from each node
+	// to higher ranked nodes and down
+	// holds the edges from higher ranked
+	// nodes into each node.
+	up, down [][]chEdge
+
+	// via holds the contracted node
+	// that each shortcut bypasses.
+	via map[[2]int]int
+}
+
+// chEdge is an edge in a contraction hierarchy.
+type chEdge struct {
+	to     int
+	weight float64
+}
+
+// witnessLimit is the maximum number of nodes settled
+// by a witness search during contraction.
+const witnessLimit = 500
+
+// NewContractionHierarchy returns a contraction hierarchy for g. Nodes are
+// contracted in order of their edge difference, the number of shortcuts
+// required to contract the node less the number of its edges, with ties
+// broken by node ID. NewContractionHierarchy will panic if g has a
+// negative edge weight.
+func NewContractionHierarchy(g graph.WeightedDirected) *ContractionHierarchy {
+	nodes := graph.NodesOf(g.Nodes())
+	slices.SortFunc(nodes, func(a, b graph.Node) int { return cmp.Compare(a.ID(), b.ID()) })
+	ch := &ContractionHierarchy{
+		nodes:   nodes,
+		indexOf: make(map[int64]int, len(nodes)),
+		rank:    make([]int, len(nodes)),
+		up:      make([][]chEdge, len(nodes)),
+		down:    make([][]chEdge, len(nodes)),
+	}
+	for i, n := range nodes {
+		ch.indexOf[n.ID()] = i
+	}
+
+	c := newContractor(len(nodes))
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			w, ok := g.Weight(uid, vid)
+			if !ok {
+				panic("path: contraction hierarchy unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("path: contraction hierarchy negative edge weight")
+			}
+			c.addEdge(i, ch.indexOf[vid], w, -1)
+		}
+	}
+	c.contract(ch)
+
+	return ch
+}
+
+// Shortest returns a shortest path from s to t in the graph used to
+// construct the hierarchy. The path and its cost are returned in a
+// Shortest that holds only the nodes of the path.
+func (ch *ContractionHierarchy) Shortest(s, t graph.Node) Shortest {
+	si, ok := ch.indexOf[s.ID()]
+	if !ok {
+		return Shortest{from: s}
+	}
+	ti, ok := ch.indexOf[t.ID()]
+	if !ok {
+		return Shortest{from: s}
+	}
+	if si == ti {
+		return newShortestFrom(ch.nodes[si], []graph.Node{ch.nodes[si]})
+	}
+
+	// Search upward from s and from t, the search from t
+	// following edges backwards, until neither search can
+	// improve on the best meeting point.
+	fwd := newCHSearch(si)
+	bwd := newCHSearch(ti)
+	best := math.Inf(1)
+	meet := -1
+	for fwd.queue.Len() != 0 || bwd.queue.Len() != 0 {
+		search, other, edges := fwd, bwd, ch.up
+		if fwd.queue.Len() == 0 || (bwd.queue.Len() != 0 && bwd.queue[0].dist < fwd.queue[0].dist) {
+			search, other, edges = bwd, fwd, ch.down
+		}
+		u := heap.Pop(&search.queue).(chDistance)
+		if u.dist > search.dist[u.node] {
+			continue
+		}
+		if u.dist >= best {
+			search.queue = search.queue[:0]
+			continue
+		}
+		if d, ok := other.dist[u.node]; ok && u.dist+d < best {
+			best = u.dist + d
+			meet = u.node
+		}
+		for _, e := range edges[u.node] {
+			dv := u.dist + e.weight
+			if d, ok := search.dist[e.to]; ok && dv >= d {
+				continue
+			}
+			search.dist[e.to] = dv
+			search.prev[e.to] = u.node
+			heap.Push(&search.queue, chDistance{node: e.to, dist: dv})
+		}
+	}
+
+	if meet < 0 {
+		return newShortestFrom(ch.nodes[si], []graph.Node{ch.nodes[si], ch.nodes[ti]})
+	}
+
+	// Collect the hierarchy path from s through the
+	// meeting node to t and then unpack its shortcuts.
+	hops := []int{meet}
+	for i := meet; i != si; {
+		i = fwd.prev[i]
+		hops = append(hops, i)
+	}
+	slices.Reverse(hops)
+	for i := meet; i != ti; {
+		i = bwd.prev[i]
+		hops = append(hops, i)
+	}
+	nodes := []graph.Node{ch.nodes[si]}
+	dist := []float64{0}
+	for i := 1; i < len(hops); i++ {
+		nodes, dist = ch.unpack(hops[i-1], hops[i], nodes, dist)
+	}
+	path := newShortestFrom(ch.nodes[si], nodes)
+	for i := 1; i < len(nodes); i++ {
+		path.set(i, dist[i], i-1)
+	}
+	return path
+}
+
+// unpack appends the nodes and distances along the edge from u to v,
+// expanding shortcuts, to nodes and dist.
+func (ch *ContractionHierarchy) unpack(u, v int, nodes []graph.Node, dist []float64) ([]graph.Node, []float64) {
+	if mid, ok := ch.via[[2]int{u, v}]; ok {
+		nodes, dist = ch.unpack(u, mid, nodes, dist)
+		return ch.unpack(mid, v, nodes, dist)
+	}
+	return append(nodes, ch.nodes[v]), append(dist, dist[len(dist)-1]+ch.weight(u, v))
+}
+
+// weight returns the weight of the hierarchy edge from u to v.
+func (ch *ContractionHierarchy) weight(u, v int) float64 {
+	if ch.rank[u] < ch.rank[v] {
+		for _, e := range ch.up[u] {
+			if e.to == v {
+				return e.weight
+			}
+		}
+	} else {
+		for _, e := range ch.down[v] {
+			if e.to == u {
+				return e.weight
+			}
+		}
+	}
+	panic("path: contraction hierarchy missing edge")
+}
+
+// chSearch holds the state of one direction of a contraction hierarchy
+// query.
+type chSearch struct {
+	dist  map[int]float64
+	prev  map[int]int
+	queue chQueue
+}
+
+func newCHSearch(i int) *chSearch {
+	return &chSearch{
+		dist:  map[int]float64{i: 0},
+		prev:  make(map[int]int),
+		queue: chQueue{{node: i, dist: 0}},
+	}
+}
+
+// contractor holds the remaining graph during construction of a
+// contraction hierarchy.
+type contractor struct {
+	// out and in hold the edge weights from and to
+	// each uncontracted node. via holds the node
+	// bypassed by each shortcut.
+	out, in []map[int]float64
+	via     map[[2]int]int
+
+	// deleted holds the number of contracted
+	// neighbours of each node.
+	deleted []int
+
+	// dist and touched hold the working state
+	// of witness searches.
+	dist    []float64
+	touched []int
+	queue   chQueue
+}
+
+func newContractor(n int) *contractor {
+	c := &contractor{
+		out:     make([]map[int]float64, n),
+		in:      make([]map[int]float64, n),
+		via:     make(map[[2]int]int),
+		deleted: make([]int, n),
+		dist:    make([]float64, n),
+	}
+	for i := range c.out {
+		c.out[i] = make(map[int]float64)
+		c.in[i] = make(map[int]float64)
+		c.dist[i] = math.Inf(1)
+	}
+	return c
+}
+
+// addEdge adds an edge from u to v with weight w bypassing the node mid
+// if it is lighter than any existing edge from u to v. If mid is negative
+// the edge is an edge of the original graph.
+func (c *contractor) addEdge(u, v int, w float64, mid int) {
+	if old, ok := c.out[u][v]; ok && old <= w {
+		return
+	}
+	c.out[u][v] = w
+	c.in[v][u] = w
+	if mid < 0 {
+		delete(c.via, [2]int{u, v})
+	} else {
+		c.via[[2]int{u, v}] = mid
+	}
+}
+
+// contract contracts all the nodes of the graph, recording the resulting
+// hierarchy in ch.
+func (c *contractor) contract(ch *ContractionHierarchy) {
+	q := make(chQueue, len(c.out))
+	for i := range q {
+		q[i] = chDistance{node: i, dist: c.priority(i)}
+	}
+	heap.Init(&q)
+	for rank := 0; q.Len() != 0; {
+		v := heap.Pop(&q).(chDistance)
+
+		// Lazily update the priority of the node
+		// and defer it if it is no longer minimal.
+		p := c.priority(v.node)
+		if q.Len() != 0 && p > q[0].dist {
+			heap.Push(&q, chDistance{node: v.node, dist: p})
+			continue
+		}
+
+		ch.rank[v.node] = rank
+		rank++
+		for _, s := range c.shortcuts(v.node) {
+			c.addEdge(s.from, s.to, s.weight, v.node)
+		}
+		for w, weight := range c.out[v.node] {
+			ch.up[v.node] = append(ch.up[v.node], chEdge{to: w, weight: weight})
+			delete(c.in[w], v.node)
+			c.deleted[w]++
+		}
+		for u, weight := range c.in[v.node] {
+			ch.down[v.node] = append(ch.down[v.node], chEdge{to: u, weight: weight})
+			delete(c.out[u], v.node)
+			c.deleted[u]++
+		}
+		c.out[v.node] = nil
+		c.in[v.node] = nil
+	}
+	ch.via = c.via
+
+	// Order the edges so that queries are deterministic.
+	byTo := func(a, b chEdge) int { return a.to - b.to }
+	for i := range ch.up {
+		slices.SortFunc(ch.up[i], byTo)
+		slices.SortFunc(ch.down[i], byTo)
+	}
+}
+
+// priority returns the contraction priority of the node v.
+func (c *contractor) priority(v int) float64 {
+	return float64(len(c.shortcuts(v)) - len(c.out[v]) - len(c.in[v]) + c.deleted[v])
+}
+
+// shortcut is an edge added to the graph when a node is contracted.
+type shortcut struct {
+	from, to int
+	weight   float64
+}
+
+// shortcuts returns the shortcuts required to preserve shortest path
+// distances between the remaining nodes when the node v is contracted.
+func (c *contractor) shortcuts(v int) []shortcut {
+	var shortcuts []shortcut
+	for u, wu := range c.in[v] {
+		limit := math.Inf(-1)
+		for w, ww := range c.out[v] {
+			if w != u {
+				limit = math.Max(limit, wu+ww)
+			}
+		}
+		if math.IsInf(limit, -1) {
+			continue
+		}
+		c.witness(u, v, limit)
+		for w, ww := range c.out[v] {
+			if w != u && c.dist[w] > wu+ww {
+				shortcuts = append(shortcuts, shortcut{from: u, to: w, weight: wu + ww})
+			}
+		}
+		c.reset()
+	}
+	return shortcuts
+}
+
+// witness searches for paths from u that avoid v with weights no greater
+// than limit, leaving the distances in c.dist. The search is bounded by
+// witnessLimit settled nodes, so some distances may be overestimated.
+func (c *contractor) witness(u, v int, limit float64) {
+	c.dist[u] = 0
+	c.touched = append(c.touched, u)
+	c.queue = append(c.queue[:0], chDistance{node: u, dist: 0})
+	for settled := 0; c.queue.Len() != 0 && settled < witnessLimit; {
+		x := heap.Pop(&c.queue).(chDistance)
+		if x.dist > c.dist[x.node] {
+			continue
+		}
+		if x.dist > limit {
+			break
+		}
+		settled++
+		for y, w := range c.out[x.node] {
+			if y == v {
+				continue
+			}
+			d := x.dist + w
+			if d < c.dist[y] {
+				if math.IsInf(c.dist[y], 1) {
+					c.touched = append(c.touched, y)
+				}
+				c.dist[y] = d
+				heap.Push(&c.queue, chDistance{node: y, dist: d})
+			}
+		}
+	}
+}
+
+// reset clears the distances set by the last witness search.
+func (c *contractor) reset() {
+	for _, i := range c.touched {
+		c.dist[i] = math.Inf(1)
+	}
+	c.touched = c.touched[:0]
+}
+
+// chDistance is a node index and its distance used in contraction
+// hierarchy priority queues.
+type chDistance struct {
+	node int
+	dist float64
+}
+
+// chQueue is a no-dec priority queue of node indices ordered by distance
+// and then by index.
+type chQueue []chDistance
+
+func (q chQueue) Len() int { return len(q) }
+func (q chQueue) Less(i, j int) bool {
+	if q[i].dist != q[j].dist {
+		return q[i].dist < q[j].dist
+	}
+	return q[i].node < q[j].node
+}
+func (q chQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *chQueue) Push(n interface{}) { *q = append(*q, n.(chDistance)) }
+func (q *chQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path/internal/testgraphs"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestContractionHierarchy(t *testing.T) {
+	t.Parallel()
+	for _, test := range testgraphs.ShortestPathTests {
+		g, ok := test.Graph().(graph.WeightedDirected)
+		if !ok || test.HasNegativeWeight {
+			continue
+		}
+		for _, e := range test.Edges {
+			g.(graph.WeightedEdgeAdder).SetWeightedEdge(e)
+		}
+
+		ch := NewContractionHierarchy(g)
+		pt := ch.Shortest(test.Query.From(), test.Query.To())
+
+		if pt.From().ID() != test.Query.From().ID() {
+			t.Fatalf("%q: unexpected from node ID: got:%d want:%d", test.Name, pt.From().ID(), test.Query.From().ID())
+		}
+
+		p, weight := pt.To(test.Query.To().ID())
+		if weight != test.Weight {
+			t.Errorf("%q: unexpected weight from To: got:%f want:%f",
+				test.Name, weight, test.Weight)
+		}
+
+		var got []int64
+		for _, n := range p {
+			got = append(got, n.ID())
+		}
+		ok = len(got) == 0 && len(test.WantPaths) == 0
+		for _, sp := range test.WantPaths {
+			if reflect.DeepEqual(got, sp) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			t.Errorf("%q: unexpected shortest path:\ngot: %v\nwant from:%v",
+				test.Name, p, test.WantPaths)
+		}
+
+		np, weight := ch.Shortest(test.NoPathFor.From(), test.NoPathFor.To()).To(test.NoPathFor.To().ID())
+		if np != nil || !math.IsInf(weight, 1) {
+			t.Errorf("%q: unexpected path:\ngot: path=%v weight=%f\nwant:path=<nil> weight=+Inf",
+				test.Name, np, weight)
+		}
+	}
+}
+
+func TestContractionHierarchyRandom(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		n     int
+		p     float64
+		integ bool
+		seed  uint64
+	}{
+		{n: 20, p: 0.1, seed: 1},
+		{n: 50, p: 0.05, seed: 2},
+		{n: 50, p: 0.2, integ: true, seed: 3},
+		{n: 100, p: 0.03, integ: true, seed: 4},
+	} {
+		rnd := rand.New(rand.NewPCG(test.seed, test.seed))
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for i := 0; i < test.n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < test.n; i++ {
+			for j := 0; j < test.n; j++ {
+				if i == j || rnd.Float64() >= test.p {
+					continue
+				}
+				w := rnd.Float64() * 10
+				if test.integ {
+					// Integer weights give many equal length paths.
+					w = math.Ceil(w)
+				}
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: w})
+			}
+		}
+
+		ch := NewContractionHierarchy(g)
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			want := DijkstraFrom(u, g)
+			for _, v := range graph.NodesOf(g.Nodes()) {
+				p, weight := ch.Shortest(u, v).To(v.ID())
+				wantWeight := want.WeightTo(v.ID())
+				if !scalar.EqualWithinAbsOrRel(weight, wantWeight, 1e-12, 1e-12) {
+					t.Errorf("unexpected weight from %d to %d for n=%d seed=%d: got:%f want:%f",
+						u.ID(), v.ID(), test.n, test.seed, weight, wantWeight)
+				}
+				if math.IsInf(wantWeight, 1) {
+					if p != nil {
+						t.Errorf("unexpected path from %d to %d for n=%d seed=%d: got:%v",
+							u.ID(), v.ID(), test.n, test.seed, p)
+					}
+					continue
+				}
+				if p[0].ID() != u.ID() || p[len(p)-1].ID() != v.ID() {
+					t.Errorf("unexpected path ends from %d to %d for n=%d seed=%d: got:%v",
+						u.ID(), v.ID(), test.n, test.seed, p)
+				}
+				var sum float64
+				for i := 1; i < len(p); i++ {
+					w, ok := g.Weight(p[i-1].ID(), p[i].ID())
+					if !ok {
+						t.Fatalf("path from %d to %d for n=%d seed=%d is not a path in the graph: %v",
+							u.ID(), v.ID(), test.n, test.seed, p)
+					}
+					sum += w
+				}
+				if !scalar.EqualWithinAbsOrRel(sum, wantWeight, 1e-12, 1e-12) {
+					t.Errorf("unexpected path weight sum from %d to %d for n=%d seed=%d: got:%f want:%f",
+						u.ID(), v.ID(), test.n, test.seed, sum, wantWeight)
+				}
+			}
+		}
+	}
+}
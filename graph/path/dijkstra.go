@@ -32,8 +32,8 @@ func DijkstraFrom(u graph.Node, g traverse.Graph) Shortest {
 //
 // The time complexity of DijkstraFromTo is O(|E|.log|V|).
 func DijkstraFromTo(u, t graph.Node, g traverse.Graph) (path []graph.Node, weight float64) {
-	// An implementation of Bidirectional Dijkstra's algorithm could be even
-	// more efficient, but it requires a transposed (or undirected) graph.
+	// BidirectionalDijkstra can be even more efficient, but
+	// it requires a transposed (or undirected) graph.
 	if t == nil {
 		panic("dijkstra: nil target node")
 	}
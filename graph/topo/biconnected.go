@@ -0,0 +1,368 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"cmp"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// ArticulationPoints returns the articulation points of the undirected graph
+// g, the nodes whose removal increases the number of connected components of
+// g. The returned nodes are ordered by ID.
+func ArticulationPoints(g graph.Undirected) []graph.Node {
+	b := newBiconnected(g)
+	var cuts []graph.Node
+	for i, isCut := range b.cut {
+		if isCut {
+			cuts = append(cuts, b.nodes[i])
+		}
+	}
+	return cuts
+}
+
+// Bridges returns the bridges of the undirected graph g, the edges whose
+// removal increases the number of connected components of g. If g is a
+// graph.Multigraph, edges holding parallel lines are not bridges. The From node
+// of each returned edge has a lower ID than its To node and the edges are
+// ordered by the IDs of their From and then To nodes.
+func Bridges(g graph.Undirected) []graph.Edge {
+	b := newBiconnected(g)
+	bridges := make([]graph.Edge, len(b.bridges))
+	for i, e := range b.bridges {
+		bridges[i] = b.edge(g, e)
+	}
+	return bridges
+}
+
+// BiconnectedComponents returns the biconnected components of the undirected
+// graph g as sets of edges. Each edge of g that is not a self loop is in
+// exactly one component, and two edges are in the same component if and only
+// if they lie on a common simple cycle. Isolated nodes are not in any
+// component. If g is a graph.Multigraph, the parallel lines between a pair of
+// nodes are held in a single edge and form a cycle.
+//
+// The From node of each returned edge has a lower ID than its To node. The
+// edges of each component are ordered by the IDs of their From and then To
+// nodes, and the components are ordered by their first edge.
+func BiconnectedComponents(g graph.Undirected) [][]graph.Edge {
+	b := newBiconnected(g)
+	components := make([][]graph.Edge, len(b.blocks))
+	for i, block := range b.blocks {
+		components[i] = make([]graph.Edge, len(block))
+		for j, e := range block {
+			components[i][j] = b.edge(g, e)
+		}
+	}
+	return components
+}
+
+// TwoEdgeConnectedComponents returns the 2-edge-connected components of the
+// undirected graph g, the connected components that remain after removing the
+// bridges of g. Each node of g is in exactly one component. The nodes of each
+// component are ordered by ID and the components are ordered by their first
+// node.
+func TwoEdgeConnectedComponents(g graph.Undirected) [][]graph.Node {
+	b := newBiconnected(g)
+	bridge := make(map[[2]int]bool, len(b.bridges))
+	for _, e := range b.bridges {
+		bridge[e] = true
+	}
+
+	comp := make([]int, len(b.nodes))
+	for i := range comp {
+		comp[i] = -1
+	}
+	var (
+		components [][]graph.Node
+		stack      []int
+	)
+	for i := range b.nodes {
+		if comp[i] >= 0 {
+			continue
+		}
+		id := len(components)
+		components = append(components, nil)
+		comp[i] = id
+		stack = append(stack[:0], i)
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			components[id] = append(components[id], b.nodes[u])
+			for _, v := range b.adj[u] {
+				if comp[v] >= 0 || bridge[[2]int{min(u, v), max(u, v)}] {
+					continue
+				}
+				comp[v] = id
+				stack = append(stack, v)
+			}
+		}
+		order.ByID(components[id])
+	}
+	return components
+}
+
+// BlockCutTree builds the block-cut tree of the undirected graph g in dst
+// using Block and CutVertex nodes. Each biconnected component of g, as
+// returned by BiconnectedComponents, is a Block node and each articulation
+// point of g is a CutVertex node. A Block and a CutVertex are joined by an
+// edge when the articulation point is in the block. The block-cut tree of a
+// connected graph is a tree, and is a forest otherwise.
+//
+// Block nodes have IDs from zero in the order of the components returned by
+// BiconnectedComponents, followed by the CutVertex nodes in order of the IDs
+// of their articulation points. The dst graph is not cleared.
+func BlockCutTree(dst Builder, g graph.Undirected) {
+	b := newBiconnected(g)
+
+	cuts := make(map[int]CutVertex)
+	id := int64(len(b.blocks))
+	for i, isCut := range b.cut {
+		if isCut {
+			cuts[i] = CutVertex{id: id, node: b.nodes[i]}
+			id++
+		}
+	}
+
+	blocks := make([]Block, len(b.blocks))
+	for i, block := range b.blocks {
+		edges := make([]graph.Edge, len(block))
+		seen := make(map[int]bool)
+		var members []int
+		for j, e := range block {
+			edges[j] = b.edge(g, e)
+			for _, u := range e {
+				if !seen[u] {
+					seen[u] = true
+					members = append(members, u)
+				}
+			}
+		}
+		slices.Sort(members)
+		nodes := make([]graph.Node, len(members))
+		for j, u := range members {
+			nodes[j] = b.nodes[u]
+		}
+		blocks[i] = Block{id: int64(i), nodes: nodes, edges: edges}
+		dst.AddNode(blocks[i])
+	}
+	for i := range b.cut {
+		if c, ok := cuts[i]; ok {
+			dst.AddNode(c)
+		}
+	}
+
+	for _, block := range blocks {
+		for _, n := range block.nodes {
+			c, ok := cuts[b.index[n.ID()]]
+			if ok {
+				dst.SetEdge(blockCutEdge{from: block, to: c})
+			}
+		}
+	}
+}
+
+// Block is a biconnected component node in a block-cut tree.
+type Block struct {
+	id    int64
+	nodes []graph.Node
+	edges []graph.Edge
+}
+
+// ID returns the node ID.
+func (n Block) ID() int64 { return n.id }
+
+// Nodes returns the nodes in the biconnected component, ordered by ID.
+func (n Block) Nodes() []graph.Node { return n.nodes }
+
+// Edges returns the edges in the biconnected component.
+func (n Block) Edges() []graph.Edge { return n.edges }
+
+// CutVertex is an articulation point node in a block-cut tree.
+type CutVertex struct {
+	id   int64
+	node graph.Node
+}
+
+// ID returns the node ID.
+func (n CutVertex) ID() int64 { return n.id }
+
+// Node returns the articulation point in the underlying graph.
+func (n CutVertex) Node() graph.Node { return n.node }
+
+// blockCutEdge is an edge in a block-cut tree.
+type blockCutEdge struct {
+	from, to graph.Node
+}
+
+func (e blockCutEdge) From() graph.Node { return e.from }
+func (e blockCutEdge) To() graph.Node   { return e.to }
+func (e blockCutEdge) ReversedEdge() graph.Edge {
+	e.from, e.to = e.to, e.from
+	return e
+}
+
+// biconnected holds the biconnectivity structure of an undirected graph
+// found by the Hopcroft-Tarjan depth first search.
+type biconnected struct {
+	nodes []graph.Node
+	index map[int64]int
+	adj   [][]int
+
+	// cut marks the articulation points, bridges holds
+	// the bridges and blocks holds the edges of the
+	// biconnected components. Edges are held as pairs of
+	// node indices with the lower index first.
+	cut     []bool
+	bridges [][2]int
+	blocks  [][][2]int
+}
+
+// newBiconnected returns the biconnectivity structure of g.
+//
+// See Hopcroft and Tarjan doi:10.1145/362248.362272 for details of the
+// algorithm.
+func newBiconnected(g graph.Undirected) *biconnected {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	b := &biconnected{
+		nodes: nodes,
+		index: make(map[int64]int, len(nodes)),
+		adj:   make([][]int, len(nodes)),
+		cut:   make([]bool, len(nodes)),
+	}
+	for i, u := range nodes {
+		b.index[u.ID()] = i
+	}
+	for i, u := range nodes {
+		for it := g.From(u.ID()); it.Next(); {
+			j := b.index[it.Node().ID()]
+			if j != i {
+				b.adj[i] = append(b.adj[i], j)
+			}
+		}
+		slices.Sort(b.adj[i])
+	}
+
+	// parallel returns whether the nodes at indices u and v
+	// are joined by more than one line of a multigraph.
+	parallel := func(u, v int) bool { return false }
+	if mg, ok := g.(graph.Multigraph); ok {
+		parallel = func(u, v int) bool {
+			it := mg.Lines(nodes[u].ID(), nodes[v].ID())
+			return it.Next() && it.Next()
+		}
+	}
+
+	type frame struct {
+		node, parent, next int
+
+		// parallelParent indicates that the node is
+		// joined to its parent by parallel lines.
+		parallelParent bool
+	}
+	disc := make([]int, len(nodes))
+	low := make([]int, len(nodes))
+	for i := range disc {
+		disc[i] = -1
+	}
+	var (
+		time    int
+		stack   []frame
+		edges   [][2]int
+		ordered = func(u, v int) [2]int { return [2]int{min(u, v), max(u, v)} }
+	)
+	for root := range nodes {
+		if disc[root] >= 0 {
+			continue
+		}
+		disc[root], low[root] = time, time
+		time++
+		children := 0
+		stack = append(stack[:0], frame{node: root, parent: -1})
+		for len(stack) > 0 {
+			f := &stack[len(stack)-1]
+			u := f.node
+			if f.next < len(b.adj[u]) {
+				v := b.adj[u][f.next]
+				f.next++
+				switch {
+				case v == f.parent:
+					// The edge to the parent is not a back
+					// edge unless it holds parallel lines, in
+					// which case it is not a bridge.
+					if f.parallelParent {
+						low[u] = min(low[u], disc[v])
+					}
+				case disc[v] < 0:
+					if u == root {
+						children++
+					}
+					edges = append(edges, ordered(u, v))
+					disc[v], low[v] = time, time
+					time++
+					stack = append(stack, frame{node: v, parent: u, parallelParent: parallel(u, v)})
+				case disc[v] < disc[u]:
+					edges = append(edges, ordered(u, v))
+					low[u] = min(low[u], disc[v])
+				}
+				continue
+			}
+
+			stack = stack[:len(stack)-1]
+			p := f.parent
+			if p < 0 {
+				continue
+			}
+			low[p] = min(low[p], low[u])
+			if low[u] > disc[p] {
+				b.bridges = append(b.bridges, ordered(p, u))
+			}
+			if low[u] >= disc[p] {
+				if p != root {
+					b.cut[p] = true
+				}
+				// Pop the edges of the component up to and
+				// including the tree edge from p to u.
+				tree := ordered(p, u)
+				i := len(edges) - 1
+				for edges[i] != tree {
+					i--
+				}
+				block := slices.Clone(edges[i:])
+				edges = edges[:i]
+				slices.SortFunc(block, compareEdges)
+				b.blocks = append(b.blocks, block)
+			}
+		}
+		if children > 1 {
+			b.cut[root] = true
+		}
+	}
+	slices.SortFunc(b.bridges, compareEdges)
+	slices.SortFunc(b.blocks, func(a, b [][2]int) int {
+		return compareEdges(a[0], b[0])
+	})
+	return b
+}
+
+// edge returns the edge of g between the nodes at the indices in e.
+// The From node of the returned edge is the node at e[0].
+func (b *biconnected) edge(g graph.Undirected, e [2]int) graph.Edge {
+	uid := b.nodes[e[0]].ID()
+	edge := g.Edge(uid, b.nodes[e[1]].ID())
+	if edge.From().ID() != uid {
+		edge = edge.ReversedEdge()
+	}
+	return edge
+}
+
+// compareEdges compares edges held as node index pairs lexically.
+func compareEdges(a, b [2]int) int {
+	return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+}
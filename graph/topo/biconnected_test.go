@@ -0,0 +1,327 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var biconnectedTests = []struct {
+	name string
+	g    []intset
+
+	wantCuts       []int64
+	wantBridges    [][2]int64
+	wantComponents [][][2]int64
+	wantTwoEdge    [][]int64
+	wantTree       [][2]int64
+}{
+	{
+		name: "empty",
+	},
+	{
+		name: "isolated",
+		g: []intset{
+			0: nil,
+			1: nil,
+		},
+		wantTwoEdge: [][]int64{{0}, {1}},
+	},
+	{
+		name: "path",
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(2),
+			2: nil,
+		},
+		wantCuts:       []int64{1},
+		wantBridges:    [][2]int64{{0, 1}, {1, 2}},
+		wantComponents: [][][2]int64{{{0, 1}}, {{1, 2}}},
+		wantTwoEdge:    [][]int64{{0}, {1}, {2}},
+		wantTree:       [][2]int64{{0, 2}, {1, 2}},
+	},
+	{
+		name: "bowtie with tail",
+		g: []intset{
+			0: linksTo(1, 2),
+			1: linksTo(2),
+			2: linksTo(3, 4),
+			3: linksTo(4),
+			4: linksTo(5),
+			5: nil,
+			6: nil,
+		},
+		wantCuts:       []int64{2, 4},
+		wantBridges:    [][2]int64{{4, 5}},
+		wantComponents: [][][2]int64{{{0, 1}, {0, 2}, {1, 2}}, {{2, 3}, {2, 4}, {3, 4}}, {{4, 5}}},
+		wantTwoEdge:    [][]int64{{0, 1, 2, 3, 4}, {5}, {6}},
+		wantTree:       [][2]int64{{0, 3}, {1, 3}, {1, 4}, {2, 4}},
+	},
+	{
+		name:     "Batagelj-Zaversnik Graph",
+		g:        batageljZaversnikGraph,
+		wantCuts: []int64{4, 11, 15},
+		wantBridges: [][2]int64{
+			{4, 5}, {9, 11}, {10, 11}, {15, 16},
+		},
+		wantComponents: [][][2]int64{
+			{{1, 2}, {1, 3}, {2, 4}, {3, 4}},
+			{{4, 5}},
+			{
+				{6, 7}, {6, 8}, {6, 14}, {7, 8}, {7, 11}, {7, 12}, {7, 14},
+				{8, 14}, {11, 12}, {12, 18}, {13, 14}, {13, 15}, {14, 15},
+				{14, 17}, {15, 17}, {17, 18}, {17, 19}, {17, 20}, {18, 19},
+				{18, 20}, {19, 20},
+			},
+			{{9, 11}},
+			{{10, 11}},
+			{{15, 16}},
+		},
+		wantTwoEdge: [][]int64{
+			{0}, {1, 2, 3, 4}, {5}, {6, 7, 8, 11, 12, 13, 14, 15, 17, 18, 19, 20},
+			{9}, {10}, {16},
+		},
+		wantTree: [][2]int64{{0, 6}, {1, 6}, {2, 7}, {2, 8}, {3, 7}, {4, 7}, {5, 8}},
+	},
+}
+
+func TestBiconnected(t *testing.T) {
+	t.Parallel()
+	for _, test := range biconnectedTests {
+		g := undirectedFrom(test.g)
+
+		var gotCuts []int64
+		for _, n := range ArticulationPoints(g) {
+			gotCuts = append(gotCuts, n.ID())
+		}
+		if !reflect.DeepEqual(gotCuts, test.wantCuts) {
+			t.Errorf("unexpected articulation points for %q: got:%v want:%v", test.name, gotCuts, test.wantCuts)
+		}
+
+		gotBridges := edgeIDs(Bridges(g))
+		if !reflect.DeepEqual(gotBridges, test.wantBridges) {
+			t.Errorf("unexpected bridges for %q: got:%v want:%v", test.name, gotBridges, test.wantBridges)
+		}
+
+		var gotComponents [][][2]int64
+		for _, c := range BiconnectedComponents(g) {
+			gotComponents = append(gotComponents, edgeIDs(c))
+		}
+		if !reflect.DeepEqual(gotComponents, test.wantComponents) {
+			t.Errorf("unexpected biconnected components for %q: got:%v want:%v", test.name, gotComponents, test.wantComponents)
+		}
+
+		var gotTwoEdge [][]int64
+		for _, c := range TwoEdgeConnectedComponents(g) {
+			gotTwoEdge = append(gotTwoEdge, nodeIDs(c))
+		}
+		if !reflect.DeepEqual(gotTwoEdge, test.wantTwoEdge) {
+			t.Errorf("unexpected 2-edge-connected components for %q: got:%v want:%v", test.name, gotTwoEdge, test.wantTwoEdge)
+		}
+
+		tree := simple.NewUndirectedGraph()
+		BlockCutTree(tree, g)
+		if tree.Nodes().Len() != len(gotComponents)+len(gotCuts) {
+			t.Errorf("unexpected number of block-cut tree nodes for %q: got:%d want:%d",
+				test.name, tree.Nodes().Len(), len(gotComponents)+len(gotCuts))
+		}
+		if test.wantTree != nil {
+			gotTree := edgeIDs(graph.EdgesOf(tree.Edges()))
+			slices.SortFunc(gotTree, func(a, b [2]int64) int {
+				return slices.Compare(a[:], b[:])
+			})
+			if !reflect.DeepEqual(gotTree, test.wantTree) {
+				t.Errorf("unexpected block-cut tree edges for %q: got:%v want:%v", test.name, gotTree, test.wantTree)
+			}
+		}
+		if len(ConnectedComponents(g)) == 1 && tree.Edges().Len() != tree.Nodes().Len()-1 {
+			t.Errorf("block-cut tree of connected graph %q is not a tree", test.name)
+		}
+	}
+}
+
+func TestBiconnectedRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		n := 2 + rnd.IntN(12)
+		p := rnd.Float64() * 0.5
+		g := simple.NewUndirectedGraph()
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				if rnd.Float64() < p {
+					g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+				}
+			}
+		}
+		components := len(ConnectedComponents(g))
+
+		var wantCuts []int64
+		for i := 0; i < n; i++ {
+			h := simple.NewUndirectedGraph()
+			graph.Copy(h, g)
+			h.RemoveNode(int64(i))
+			// Removing an isolated node reduces the number of components.
+			if len(ConnectedComponents(h)) > components-btoi(g.From(int64(i)).Len() == 0) {
+				wantCuts = append(wantCuts, int64(i))
+			}
+		}
+		var gotCuts []int64
+		for _, n := range ArticulationPoints(g) {
+			gotCuts = append(gotCuts, n.ID())
+		}
+		if !reflect.DeepEqual(gotCuts, wantCuts) {
+			t.Errorf("unexpected articulation points for trial %d: got:%v want:%v", trial, gotCuts, wantCuts)
+		}
+
+		var wantBridges [][2]int64
+		for _, e := range edgeIDs(graph.EdgesOf(g.Edges())) {
+			h := simple.NewUndirectedGraph()
+			graph.Copy(h, g)
+			h.RemoveEdge(e[0], e[1])
+			if len(ConnectedComponents(h)) > components {
+				wantBridges = append(wantBridges, e)
+			}
+		}
+		slices.SortFunc(wantBridges, func(a, b [2]int64) int {
+			return slices.Compare(a[:], b[:])
+		})
+		gotBridges := edgeIDs(Bridges(g))
+		if !reflect.DeepEqual(gotBridges, wantBridges) {
+			t.Errorf("unexpected bridges for trial %d: got:%v want:%v", trial, gotBridges, wantBridges)
+		}
+
+		// Every edge is in exactly one biconnected component and
+		// every component is biconnected.
+		seen := make(map[[2]int64]bool)
+		for _, c := range BiconnectedComponents(g) {
+			h := simple.NewUndirectedGraph()
+			for _, e := range c {
+				id := edgeIDs([]graph.Edge{e})[0]
+				if seen[id] {
+					t.Errorf("edge %v in more than one component for trial %d", id, trial)
+				}
+				seen[id] = true
+				h.SetEdge(e)
+			}
+			if len(ArticulationPoints(h)) != 0 || len(ConnectedComponents(h)) != 1 {
+				t.Errorf("component %v is not biconnected for trial %d", edgeIDs(c), trial)
+			}
+		}
+		if len(seen) != g.Edges().Len() {
+			t.Errorf("unexpected number of edges in components for trial %d: got:%d want:%d", trial, len(seen), g.Edges().Len())
+		}
+	}
+}
+
+func TestBiconnectedMultigraph(t *testing.T) {
+	t.Parallel()
+	// Parallel lines between 0 and 1 and between 3 and 4,
+	// and a triangle on 1, 2 and 3 joined to 4 by a line.
+	g := multi.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 0}, {1, 2}, {2, 3}, {3, 1}, {3, 4}, {3, 4}, {4, 5}} {
+		g.SetLine(g.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+	}
+
+	gotCuts := nodeIDs(ArticulationPoints(g))
+	if want := []int64{1, 3, 4}; !reflect.DeepEqual(gotCuts, want) {
+		t.Errorf("unexpected articulation points: got:%v want:%v", gotCuts, want)
+	}
+	gotBridges := edgeIDs(Bridges(g))
+	if want := [][2]int64{{4, 5}}; !reflect.DeepEqual(gotBridges, want) {
+		t.Errorf("unexpected bridges: got:%v want:%v", gotBridges, want)
+	}
+	var gotComponents [][][2]int64
+	for _, c := range BiconnectedComponents(g) {
+		gotComponents = append(gotComponents, edgeIDs(c))
+	}
+	wantComponents := [][][2]int64{{{0, 1}}, {{1, 2}, {1, 3}, {2, 3}}, {{3, 4}}, {{4, 5}}}
+	if !reflect.DeepEqual(gotComponents, wantComponents) {
+		t.Errorf("unexpected biconnected components: got:%v want:%v", gotComponents, wantComponents)
+	}
+	var gotTwoEdge [][]int64
+	for _, c := range TwoEdgeConnectedComponents(g) {
+		gotTwoEdge = append(gotTwoEdge, nodeIDs(c))
+	}
+	if want := [][]int64{{0, 1, 2, 3, 4}, {5}}; !reflect.DeepEqual(gotTwoEdge, want) {
+		t.Errorf("unexpected 2-edge-connected components: got:%v want:%v", gotTwoEdge, want)
+	}
+}
+
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func undirectedFrom(adj []intset) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	for u, e := range adj {
+		if g.Node(int64(u)) == nil {
+			g.AddNode(simple.Node(u))
+		}
+		for v := range e {
+			g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+		}
+	}
+	return g
+}
+
+func edgeIDs(edges []graph.Edge) [][2]int64 {
+	var ids [][2]int64
+	for _, e := range edges {
+		u, v := e.From().ID(), e.To().ID()
+		ids = append(ids, [2]int64{min(u, v), max(u, v)})
+	}
+	return ids
+}
+
+func nodeIDs(nodes []graph.Node) []int64 {
+	ids := make([]int64, len(nodes))
+	for i, n := range nodes {
+		ids[i] = n.ID()
+	}
+	return ids
+}
+
+func ExampleBlockCutTree() {
+	// Two triangles sharing node 2 with a pendant node 5 on node 4.
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {0, 2}, {1, 2}, {2, 3}, {2, 4}, {3, 4}, {4, 5}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+
+	tree := simple.NewUndirectedGraph()
+	BlockCutTree(tree, g)
+	nodes := graph.NodesOf(tree.Nodes())
+	slices.SortFunc(nodes, func(a, b graph.Node) int { return int(a.ID() - b.ID()) })
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case Block:
+			fmt.Printf("block %d: %v\n", n.ID(), n.Nodes())
+		case CutVertex:
+			fmt.Printf("cut vertex %d: node %v adjacent to %d blocks\n", n.ID(), n.Node(), tree.From(n.ID()).Len())
+		}
+	}
+
+	// Output:
+	// block 0: [0 1 2]
+	// block 1: [2 3 4]
+	// block 2: [4 5]
+	// cut vertex 3: node 2 adjacent to 2 blocks
+	// cut vertex 4: node 4 adjacent to 2 blocks
+}
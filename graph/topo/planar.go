@@ -0,0 +1,615 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Planarity returns whether the undirected graph g is planar. If g is planar,
+// Planarity returns a combinatorial embedding of g in the plane. Otherwise
+// Planarity returns the edges of a Kuratowski subgraph of g, a subdivision of
+// K₅ or K₃,₃, as a witness that g is not planar. Self loops are ignored.
+//
+// Planarity uses the left-right planarity test. The Kuratowski subgraph is
+// found by removing batches of edges from g, halving the batch size down to
+// single edges, while the remaining graph is not planar.
+// The From node of each returned edge has a lower ID than its To node, and
+// the edges are ordered by the IDs of their From and then To nodes.
+//
+// See Brandes, "The Left-Right Planarity Test" (2009) and de Fraysseix,
+// Ossona de Mendez and Rosenstiehl, "Trémaux Trees and Planarity" (2006) for
+// details of the algorithm.
+func Planarity(g graph.Undirected) (embedding *Embedding, kuratowski []graph.Edge, planar bool) {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	index := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		index[n.ID()] = i
+	}
+	var edges [][2]int
+	for u, n := range nodes {
+		for it := g.From(n.ID()); it.Next(); {
+			v := index[it.Node().ID()]
+			if u < v {
+				edges = append(edges, [2]int{u, v})
+			}
+		}
+	}
+	slices.SortFunc(edges, compareEdges)
+
+	if rotation, ok := lrPlanarity(len(nodes), edges); ok {
+		return &Embedding{nodes: nodes, index: index, rotation: rotation}, nil, true
+	}
+
+	// Remove batches of edges, retaining a batch only if its
+	// removal makes the graph planar, and halve the batch size
+	// after each pass. The final pass removes single edges, so
+	// the retained edges form a minimal non-planar subgraph
+	// which is a Kuratowski subgraph. Most edges are removed in
+	// large batches, so the number of planarity tests grows with
+	// the size of the witness rather than the size of g.
+	witness := edges
+	for size := (len(witness) + 1) / 2; ; size = (size + 1) / 2 {
+		for i := 0; i < len(witness); {
+			j := min(i+size, len(witness))
+			trial := slices.Delete(slices.Clone(witness), i, j)
+			if _, ok := lrPlanarity(len(nodes), trial); !ok {
+				witness = trial
+			} else {
+				i = j
+			}
+		}
+		if size == 1 {
+			break
+		}
+	}
+	kuratowski = make([]graph.Edge, len(witness))
+	for i, e := range witness {
+		uid := nodes[e[0]].ID()
+		edge := g.Edge(uid, nodes[e[1]].ID())
+		if edge.From().ID() != uid {
+			edge = edge.ReversedEdge()
+		}
+		kuratowski[i] = edge
+	}
+	return nil, kuratowski, false
+}
+
+// Embedding is a combinatorial embedding of a planar graph, the clockwise
+// cyclic order of the neighbors of each node in a drawing of the graph in the
+// plane without edge crossings. A straight-line drawing of an Embedding can be
+// made with the gonum.org/v1/gonum/graph/layout TutteR2 layout.
+type Embedding struct {
+	nodes    []graph.Node
+	index    map[int64]int
+	rotation [][]int
+}
+
+// Nodes returns the nodes of the embedded graph ordered by ID.
+func (e *Embedding) Nodes() []graph.Node {
+	return e.nodes
+}
+
+// Neighbors returns the neighbors of the node with the given ID in clockwise
+// order. The order is cyclic, so the first neighbor follows the last. If the
+// node is not in the embedding, Neighbors returns nil.
+func (e *Embedding) Neighbors(id int64) []graph.Node {
+	u, ok := e.index[id]
+	if !ok {
+		return nil
+	}
+	neighbors := make([]graph.Node, len(e.rotation[u]))
+	for i, v := range e.rotation[u] {
+		neighbors[i] = e.nodes[v]
+	}
+	return neighbors
+}
+
+// Faces returns the faces of the embedding. Each face is given by the nodes
+// on a closed walk around its boundary, with the face on the right of each
+// traversed edge and without repeating the first node at the end. A node or
+// edge may appear more than once in the walk around a face. Isolated nodes are
+// not on any face.
+//
+// A connected planar graph with n nodes and m edges has m-n+2 faces.
+func (e *Embedding) Faces() [][]graph.Node {
+	// position[u][v] is the position of v in the rotation of u.
+	position := make([]map[int]int, len(e.nodes))
+	for u, rot := range e.rotation {
+		position[u] = make(map[int]int, len(rot))
+		for i, v := range rot {
+			position[u][v] = i
+		}
+	}
+	visited := make([]map[int]bool, len(e.nodes))
+	for u := range visited {
+		visited[u] = make(map[int]bool)
+	}
+	var faces [][]graph.Node
+	for u, rot := range e.rotation {
+		for _, v := range rot {
+			if visited[u][v] {
+				continue
+			}
+			// Walk the face to the right of the half edge u→v.
+			var face []graph.Node
+			for a, b := u, v; !visited[a][b]; {
+				visited[a][b] = true
+				face = append(face, e.nodes[a])
+				rb := e.rotation[b]
+				// The next edge leaves b counterclockwise
+				// from the edge back to a.
+				next := rb[(position[b][a]+len(rb)-1)%len(rb)]
+				a, b = b, next
+			}
+			faces = append(faces, face)
+		}
+	}
+	return faces
+}
+
+// lrPlanarity returns a clockwise rotation system of the graph with n nodes
+// and the given edges if the graph is planar.
+func lrPlanarity(n int, edges [][2]int) (rotation [][]int, ok bool) {
+	if n > 2 && len(edges) > 3*n-6 {
+		// Euler's formula bounds the number of edges
+		// of a planar graph.
+		return nil, false
+	}
+	s := newLRState(n, edges)
+	for v := 0; v < n; v++ {
+		if s.height[v] < 0 {
+			s.height[v] = 0
+			s.roots = append(s.roots, v)
+			s.orient(v)
+		}
+	}
+	for v := range s.out {
+		s.sortOut(v)
+	}
+	for _, v := range s.roots {
+		if !s.test(v) {
+			return nil, false
+		}
+	}
+	for e := range s.edges {
+		s.nesting[e] *= s.sign(e)
+	}
+	return s.embed(), true
+}
+
+// lrState holds the state of the left-right planarity test. Edges are
+// identified by their index and are oriented by the depth first search.
+type lrState struct {
+	adj   [][]lrArc
+	edges []lrEdge
+
+	height     []int
+	parentEdge []int
+	roots      []int
+
+	// out holds the oriented edges leaving each node,
+	// ordered by nesting depth after orientation.
+	out [][]int
+
+	stack []*conflictPair
+
+	// leftRef and rightRef hold the left-most and right-most
+	// neighbors of each node during embedding, and cw and ccw
+	// hold the clockwise and counterclockwise neighbors of
+	// each neighbor in the rotation of each node.
+	leftRef, rightRef []int
+	cw, ccw           []map[int]int
+	first             []int
+
+	nesting []int
+}
+
+// lrArc is an arc to a node along the edge with the given index.
+type lrArc struct {
+	to, edge int
+}
+
+// lrEdge holds the orientation and left-right test state of an edge.
+type lrEdge struct {
+	from, to int
+	oriented bool
+
+	lowpt, lowpt2 int
+	lowptEdge     int
+	ref           int
+	side          int
+	stackBottom   *conflictPair
+}
+
+// returnInterval is an interval of return edges.
+type returnInterval struct {
+	low, high int
+}
+
+func (i returnInterval) empty() bool { return i.low < 0 && i.high < 0 }
+
+// conflicting returns whether the interval has a return edge with a higher
+// lowpoint than the edge b.
+func (s *lrState) conflicting(i returnInterval, b int) bool {
+	return !i.empty() && s.edges[i.high].lowpt > s.edges[b].lowpt
+}
+
+// conflictPair is a pair of intervals of return edges that must be on
+// different sides.
+type conflictPair struct {
+	left, right returnInterval
+}
+
+func newConflictPair() *conflictPair {
+	return &conflictPair{left: returnInterval{-1, -1}, right: returnInterval{-1, -1}}
+}
+
+func (p *conflictPair) swap() { p.left, p.right = p.right, p.left }
+
+// lowest returns the lowest lowpoint of the return edges in the pair.
+func (s *lrState) lowest(p *conflictPair) int {
+	switch {
+	case p.left.empty():
+		return s.edges[p.right.low].lowpt
+	case p.right.empty():
+		return s.edges[p.left.low].lowpt
+	default:
+		return min(s.edges[p.left.low].lowpt, s.edges[p.right.low].lowpt)
+	}
+}
+
+func newLRState(n int, edges [][2]int) *lrState {
+	s := &lrState{
+		adj:        make([][]lrArc, n),
+		edges:      make([]lrEdge, len(edges)),
+		height:     make([]int, n),
+		parentEdge: make([]int, n),
+		out:        make([][]int, n),
+		nesting:    make([]int, len(edges)),
+	}
+	for i := range s.height {
+		s.height[i] = -1
+		s.parentEdge[i] = -1
+	}
+	for k, e := range edges {
+		s.adj[e[0]] = append(s.adj[e[0]], lrArc{to: e[1], edge: k})
+		s.adj[e[1]] = append(s.adj[e[1]], lrArc{to: e[0], edge: k})
+		s.edges[k] = lrEdge{lowptEdge: -1, ref: -1, side: 1}
+	}
+	return s
+}
+
+func (s *lrState) top() *conflictPair {
+	if len(s.stack) == 0 {
+		return nil
+	}
+	return s.stack[len(s.stack)-1]
+}
+
+func (s *lrState) pop() *conflictPair {
+	p := s.stack[len(s.stack)-1]
+	s.stack = s.stack[:len(s.stack)-1]
+	return p
+}
+
+// orient orients the edges by a depth first search from v, computing the
+// lowpoints and nesting depths of the edges.
+func (s *lrState) orient(v int) {
+	e := s.parentEdge[v]
+	for _, a := range s.adj[v] {
+		ei := &s.edges[a.edge]
+		if ei.oriented {
+			continue
+		}
+		ei.oriented = true
+		ei.from, ei.to = v, a.to
+		s.out[v] = append(s.out[v], a.edge)
+		ei.lowpt = s.height[v]
+		ei.lowpt2 = s.height[v]
+		if s.height[a.to] < 0 {
+			// Tree edge.
+			s.parentEdge[a.to] = a.edge
+			s.height[a.to] = s.height[v] + 1
+			s.orient(a.to)
+		} else {
+			// Back edge.
+			ei.lowpt = s.height[a.to]
+		}
+
+		s.nesting[a.edge] = 2 * ei.lowpt
+		if ei.lowpt2 < s.height[v] {
+			// Chordal edge.
+			s.nesting[a.edge]++
+		}
+
+		if e >= 0 {
+			pe := &s.edges[e]
+			switch {
+			case ei.lowpt < pe.lowpt:
+				pe.lowpt2 = min(pe.lowpt, ei.lowpt2)
+				pe.lowpt = ei.lowpt
+			case ei.lowpt > pe.lowpt:
+				pe.lowpt2 = min(pe.lowpt2, ei.lowpt)
+			default:
+				pe.lowpt2 = min(pe.lowpt2, ei.lowpt2)
+			}
+		}
+	}
+}
+
+// sortOut orders the oriented edges leaving v by nesting depth.
+func (s *lrState) sortOut(v int) {
+	slices.SortStableFunc(s.out[v], func(a, b int) int {
+		return s.nesting[a] - s.nesting[b]
+	})
+}
+
+// test performs the left-right test on the edges below v, returning false
+// if a conflict shows that the graph is not planar.
+func (s *lrState) test(v int) bool {
+	e := s.parentEdge[v]
+	for i, ei := range s.out[v] {
+		w := s.edges[ei].to
+		s.edges[ei].stackBottom = s.top()
+		if ei == s.parentEdge[w] {
+			// Tree edge.
+			if !s.test(w) {
+				return false
+			}
+		} else {
+			// Back edge.
+			s.edges[ei].lowptEdge = ei
+			p := newConflictPair()
+			p.right = returnInterval{low: ei, high: ei}
+			s.stack = append(s.stack, p)
+		}
+
+		// Integrate the new return edges.
+		if s.edges[ei].lowpt < s.height[v] {
+			if i == 0 {
+				s.edges[e].lowptEdge = s.edges[ei].lowptEdge
+			} else if !s.addConstraints(ei, e) {
+				return false
+			}
+		}
+	}
+	if e >= 0 {
+		s.removeBackEdges(e)
+	}
+	return true
+}
+
+// addConstraints adds the constraints between the return edges of ei and
+// the return edges of the preceding edges leaving the source of ei, with e
+// the parent edge.
+func (s *lrState) addConstraints(ei, e int) bool {
+	p := newConflictPair()
+
+	// Merge the return edges of ei into p.right.
+	for {
+		q := s.pop()
+		if !q.left.empty() {
+			q.swap()
+		}
+		if !q.left.empty() {
+			return false
+		}
+		if s.edges[q.right.low].lowpt > s.edges[e].lowpt {
+			// Merge the intervals.
+			if p.right.empty() {
+				p.right = q.right
+			} else {
+				s.edges[p.right.low].ref = q.right.high
+			}
+			p.right.low = q.right.low
+		} else {
+			// Align.
+			s.edges[q.right.low].ref = s.edges[e].lowptEdge
+		}
+		if s.top() == s.edges[ei].stackBottom {
+			break
+		}
+	}
+
+	// Merge the conflicting return edges of the preceding
+	// edges into p.left.
+	for len(s.stack) != 0 && (s.conflicting(s.top().left, ei) || s.conflicting(s.top().right, ei)) {
+		q := s.pop()
+		if s.conflicting(q.right, ei) {
+			q.swap()
+		}
+		if s.conflicting(q.right, ei) {
+			return false
+		}
+		// Merge the returnInterval below the lowpoint of ei into p.right.
+		s.edges[p.right.low].ref = q.right.high
+		if q.right.low >= 0 {
+			p.right.low = q.right.low
+		}
+		if p.left.empty() {
+			p.left = q.left
+		} else {
+			s.edges[p.left.low].ref = q.left.high
+		}
+		p.left.low = q.left.low
+	}
+
+	if !p.left.empty() || !p.right.empty() {
+		s.stack = append(s.stack, p)
+	}
+	return true
+}
+
+// removeBackEdges removes the back edges returning to the source of the tree
+// edge e from the conflict pairs, and sets the side reference of e.
+func (s *lrState) removeBackEdges(e int) {
+	u := s.edges[e].from
+
+	// Drop entire conflict pairs.
+	for len(s.stack) != 0 && s.lowest(s.top()) == s.height[u] {
+		p := s.pop()
+		if p.left.low >= 0 {
+			s.edges[p.left.low].side = -1
+		}
+	}
+
+	if len(s.stack) != 0 {
+		// One more conflict pair to consider.
+		p := s.pop()
+
+		// Trim the left returnInterval.
+		for p.left.high >= 0 && s.edges[p.left.high].to == u {
+			p.left.high = s.edges[p.left.high].ref
+		}
+		if p.left.high < 0 && p.left.low >= 0 {
+			// Just emptied.
+			s.edges[p.left.low].ref = p.right.low
+			s.edges[p.left.low].side = -1
+			p.left.low = -1
+		}
+
+		// Trim the right returnInterval.
+		for p.right.high >= 0 && s.edges[p.right.high].to == u {
+			p.right.high = s.edges[p.right.high].ref
+		}
+		if p.right.high < 0 && p.right.low >= 0 {
+			// Just emptied.
+			s.edges[p.right.low].ref = p.left.low
+			s.edges[p.right.low].side = -1
+			p.right.low = -1
+		}
+		s.stack = append(s.stack, p)
+	}
+
+	// The side of e is the side of a highest return edge.
+	if s.edges[e].lowpt < s.height[u] {
+		top := s.top()
+		hl, hr := top.left.high, top.right.high
+		if hl >= 0 && (hr < 0 || s.edges[hl].lowpt > s.edges[hr].lowpt) {
+			s.edges[e].ref = hl
+		} else {
+			s.edges[e].ref = hr
+		}
+	}
+}
+
+// sign resolves the side of edge e relative to its reference edges.
+func (s *lrState) sign(e int) int {
+	// Follow the references iteratively to avoid deep recursion.
+	var chain []int
+	for f := e; s.edges[f].ref >= 0; f = s.edges[f].ref {
+		chain = append(chain, f)
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		f := chain[i]
+		s.edges[f].side *= s.edges[s.edges[f].ref].side
+		s.edges[f].ref = -1
+	}
+	return s.edges[e].side
+}
+
+// embed returns the clockwise rotation system of the planar graph.
+func (s *lrState) embed() [][]int {
+	n := len(s.adj)
+	s.cw = make([]map[int]int, n)
+	s.ccw = make([]map[int]int, n)
+	s.first = make([]int, n)
+	s.leftRef = make([]int, n)
+	s.rightRef = make([]int, n)
+	for v := range s.cw {
+		s.cw[v] = make(map[int]int)
+		s.ccw[v] = make(map[int]int)
+		s.first[v] = -1
+	}
+	for v := range s.out {
+		s.sortOut(v)
+		prev := -1
+		for _, e := range s.out[v] {
+			w := s.edges[e].to
+			s.addCW(v, w, prev)
+			prev = w
+		}
+	}
+	for _, v := range s.roots {
+		s.embedFrom(v)
+	}
+
+	rotation := make([][]int, n)
+	for v := range rotation {
+		if s.first[v] < 0 {
+			continue
+		}
+		w := s.first[v]
+		for {
+			rotation[v] = append(rotation[v], w)
+			w = s.cw[v][w]
+			if w == s.first[v] {
+				break
+			}
+		}
+	}
+	return rotation
+}
+
+// embedFrom adds the half edges into the nodes below v to the rotation
+// system.
+func (s *lrState) embedFrom(v int) {
+	for _, e := range s.out[v] {
+		w := s.edges[e].to
+		if e == s.parentEdge[w] {
+			// Tree edge.
+			s.addFirst(w, v)
+			s.leftRef[v] = w
+			s.rightRef[v] = w
+			s.embedFrom(w)
+		} else if s.edges[e].side == 1 {
+			// Back edge on the right.
+			s.addCW(w, v, s.rightRef[w])
+		} else {
+			// Back edge on the left.
+			s.addCCW(w, v, s.leftRef[w])
+			s.leftRef[w] = v
+		}
+	}
+}
+
+// addCW adds w to the rotation of v immediately clockwise of ref, or as the
+// only neighbor if ref is negative.
+func (s *lrState) addCW(v, w, ref int) {
+	if ref < 0 {
+		s.cw[v][w] = w
+		s.ccw[v][w] = w
+		s.first[v] = w
+		return
+	}
+	next := s.cw[v][ref]
+	s.cw[v][ref] = w
+	s.ccw[v][w] = ref
+	s.cw[v][w] = next
+	s.ccw[v][next] = w
+}
+
+// addCCW adds w to the rotation of v immediately counterclockwise of ref, or
+// as the only neighbor if ref is negative.
+func (s *lrState) addCCW(v, w, ref int) {
+	if ref < 0 {
+		s.addCW(v, w, -1)
+		return
+	}
+	s.addCW(v, w, s.ccw[v][ref])
+	if ref == s.first[v] {
+		s.first[v] = w
+	}
+}
+
+// addFirst adds w to the rotation of v as its first neighbor.
+func (s *lrState) addFirst(v, w int) {
+	s.addCCW(v, w, s.first[v])
+}
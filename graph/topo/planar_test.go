@@ -0,0 +1,326 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var planarityTests = []struct {
+	name  string
+	g     []intset
+	want  bool
+	kind  string
+	nodes int
+}{
+	{name: "empty", want: true},
+	{name: "single", g: []intset{0: nil}, want: true},
+	{
+		name: "K4",
+		g: []intset{
+			0: linksTo(1, 2, 3),
+			1: linksTo(2, 3),
+			2: linksTo(3),
+			3: nil,
+		},
+		want: true,
+	},
+	{
+		name: "K5",
+		g: []intset{
+			0: linksTo(1, 2, 3, 4),
+			1: linksTo(2, 3, 4),
+			2: linksTo(3, 4),
+			3: linksTo(4),
+			4: nil,
+		},
+		want: false,
+		kind: "K5",
+	},
+	{
+		name: "K3,3",
+		g: []intset{
+			0: linksTo(3, 4, 5),
+			1: linksTo(3, 4, 5),
+			2: linksTo(3, 4, 5),
+			3: nil,
+			4: nil,
+			5: nil,
+		},
+		want: false,
+		kind: "K3,3",
+	},
+	{
+		name: "Petersen",
+		g: []intset{
+			0: linksTo(1, 4, 5),
+			1: linksTo(2, 6),
+			2: linksTo(3, 7),
+			3: linksTo(4, 8),
+			4: linksTo(9),
+			5: linksTo(7, 8),
+			6: linksTo(8, 9),
+			7: linksTo(9),
+			8: nil,
+			9: nil,
+		},
+		want: false,
+		kind: "K3,3",
+	},
+	{
+		name: "cube",
+		g: []intset{
+			0: linksTo(1, 2, 4),
+			1: linksTo(3, 5),
+			2: linksTo(3, 6),
+			3: linksTo(7),
+			4: linksTo(5, 6),
+			5: linksTo(7),
+			6: linksTo(7),
+			7: nil,
+		},
+		want: true,
+	},
+	{name: "Batagelj-Zaversnik Graph", g: batageljZaversnikGraph, want: true},
+}
+
+func TestPlanarity(t *testing.T) {
+	t.Parallel()
+	for _, test := range planarityTests {
+		g := undirectedFrom(test.g)
+		embedding, kuratowski, planar := Planarity(g)
+		if planar != test.want {
+			t.Errorf("unexpected planarity for %q: got:%t want:%t", test.name, planar, test.want)
+			continue
+		}
+		if planar {
+			checkEmbedding(t, test.name, g, embedding)
+			continue
+		}
+		if kind := checkKuratowski(t, test.name, g, kuratowski); kind != test.kind {
+			t.Errorf("unexpected Kuratowski subgraph kind for %q: got:%s want:%s", test.name, kind, test.kind)
+		}
+	}
+}
+
+func TestPlanarityRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	var planar, nonPlanar int
+	for trial := 0; trial < 300; trial++ {
+		n := 1 + rnd.IntN(12)
+		p := rnd.Float64() * 0.6
+		g := simple.NewUndirectedGraph()
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				if rnd.Float64() < p {
+					g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+				}
+			}
+		}
+		name := fmt.Sprintf("trial %d", trial)
+		embedding, kuratowski, ok := Planarity(g)
+		if ok {
+			planar++
+			checkEmbedding(t, name, g, embedding)
+		} else {
+			nonPlanar++
+			checkKuratowski(t, name, g, kuratowski)
+		}
+	}
+	if planar == 0 || nonPlanar == 0 {
+		t.Errorf("unexpected test coverage: planar:%d non-planar:%d", planar, nonPlanar)
+	}
+
+	// Grids are planar.
+	g := simple.NewUndirectedGraph()
+	const size = 30
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			u := simple.Node(i*size + j)
+			g.AddNode(u)
+			if i > 0 {
+				g.SetEdge(simple.Edge{F: simple.Node((i-1)*size + j), T: u})
+			}
+			if j > 0 {
+				g.SetEdge(simple.Edge{F: simple.Node(i*size + j - 1), T: u})
+			}
+		}
+	}
+	embedding, _, ok := Planarity(g)
+	if !ok {
+		t.Fatal("grid not planar")
+	}
+	checkEmbedding(t, "grid", g, embedding)
+}
+
+func TestPlanarityLarge(t *testing.T) {
+	t.Parallel()
+	// A grid joined to a K5 is not planar and
+	// the witness is the K5.
+	g := simple.NewUndirectedGraph()
+	const size = 60
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			u := simple.Node(i*size + j)
+			g.AddNode(u)
+			if i > 0 {
+				g.SetEdge(simple.Edge{F: simple.Node((i-1)*size + j), T: u})
+			}
+			if j > 0 {
+				g.SetEdge(simple.Edge{F: simple.Node(i*size + j - 1), T: u})
+			}
+		}
+	}
+	for i := int64(0); i < 5; i++ {
+		for j := int64(0); j < i; j++ {
+			g.SetEdge(simple.Edge{F: simple.Node(size*size + j), T: simple.Node(size*size + i)})
+		}
+	}
+	g.SetEdge(simple.Edge{F: simple.Node(size*size - 1), T: simple.Node(size * size)})
+
+	_, kuratowski, planar := Planarity(g)
+	if planar {
+		t.Fatal("grid with K5 is planar")
+	}
+	if kind := checkKuratowski(t, "grid with K5", g, kuratowski); kind != "K5" {
+		t.Errorf("unexpected Kuratowski subgraph kind: got:%s want:K5", kind)
+	}
+	if len(kuratowski) != 10 {
+		t.Errorf("unexpected number of witness edges: got:%d want:10", len(kuratowski))
+	}
+}
+
+// checkEmbedding checks that the embedding is a planar embedding of g by
+// checking the neighbors of each node and Euler's formula for each connected
+// component.
+func checkEmbedding(t *testing.T, name string, g graph.Undirected, embedding *Embedding) {
+	t.Helper()
+	for _, u := range graph.NodesOf(g.Nodes()) {
+		got := nodeIDs(embedding.Neighbors(u.ID()))
+		slices.Sort(got)
+		want := nodeIDs(graph.NodesOf(g.From(u.ID())))
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: unexpected neighbors of %d: got:%v want:%v", name, u.ID(), got, want)
+			return
+		}
+	}
+
+	component := make(map[int64]int)
+	cc := ConnectedComponents(g)
+	for i, c := range cc {
+		for _, u := range c {
+			component[u.ID()] = i
+		}
+	}
+	faces := make([]int, len(cc))
+	for _, f := range embedding.Faces() {
+		faces[component[f[0].ID()]]++
+	}
+	for i, c := range cc {
+		var edges int
+		for _, u := range c {
+			edges += g.From(u.ID()).Len()
+		}
+		edges /= 2
+		if edges == 0 {
+			continue
+		}
+		if len(c)-edges+faces[i] != 2 {
+			t.Errorf("%s: Euler's formula not satisfied for component %d: n=%d m=%d f=%d", name, i, len(c), edges, faces[i])
+		}
+	}
+}
+
+// checkKuratowski checks that the edges form a minimal non-planar subgraph of
+// g that is a subdivision of K₅ or K₃,₃, and returns which.
+func checkKuratowski(t *testing.T, name string, g graph.Undirected, edges []graph.Edge) string {
+	t.Helper()
+	h := simple.NewUndirectedGraph()
+	for _, e := range edges {
+		if !g.HasEdgeBetween(e.From().ID(), e.To().ID()) {
+			t.Errorf("%s: witness edge %d-%d not in graph", name, e.From().ID(), e.To().ID())
+		}
+		h.SetEdge(simple.Edge{F: simple.Node(e.From().ID()), T: simple.Node(e.To().ID())})
+	}
+
+	// Smooth away the nodes of degree two.
+	for _, u := range graph.NodesOf(h.Nodes()) {
+		if h.From(u.ID()).Len() != 2 {
+			continue
+		}
+		to := graph.NodesOf(h.From(u.ID()))
+		if h.HasEdgeBetween(to[0].ID(), to[1].ID()) {
+			t.Errorf("%s: witness is not a subdivision", name)
+			return ""
+		}
+		h.RemoveNode(u.ID())
+		h.SetEdge(simple.Edge{F: to[0], T: to[1]})
+	}
+
+	nodes := graph.NodesOf(h.Nodes())
+	degrees := make(map[int]int)
+	for _, u := range nodes {
+		degrees[h.From(u.ID()).Len()]++
+	}
+	switch {
+	case len(nodes) == 5 && degrees[4] == 5:
+		return "K5"
+	case len(nodes) == 6 && degrees[3] == 6:
+		side := make(map[int64]bool)
+		for _, v := range graph.NodesOf(h.From(nodes[0].ID())) {
+			side[v.ID()] = true
+		}
+		for _, u := range nodes {
+			for _, v := range graph.NodesOf(h.From(u.ID())) {
+				if side[u.ID()] == side[v.ID()] {
+					t.Errorf("%s: witness is not a subdivision of K3,3", name)
+					return ""
+				}
+			}
+		}
+		return "K3,3"
+	default:
+		t.Errorf("%s: witness is not a Kuratowski subgraph: degrees:%v", name, degrees)
+		return ""
+	}
+}
+
+func ExamplePlanarity() {
+	// The complete graph on four nodes is planar.
+	g := simple.NewUndirectedGraph()
+	for u := 0; u < 4; u++ {
+		for v := u + 1; v < 4; v++ {
+			g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+		}
+	}
+	embedding, _, planar := Planarity(g)
+	fmt.Println("K4 planar:", planar)
+	fmt.Println("faces:", len(embedding.Faces()))
+
+	// Adding a fifth node joined to all others gives K5.
+	for u := 0; u < 4; u++ {
+		g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(4)})
+	}
+	_, kuratowski, planar := Planarity(g)
+	fmt.Println("K5 planar:", planar)
+	fmt.Println("witness edges:", len(kuratowski))
+
+	// Output:
+	// K4 planar: true
+	// faces: 4
+	// K5 planar: false
+	// witness edges: 10
+}
@@ -0,0 +1,14 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tour provides functions for finding walks that visit every edge or
+// every node of a graph.
+//
+// The package provides Eulerian paths and circuits using Hierholzer's
+// algorithm, solutions of the undirected Chinese postman problem, and
+// heuristics for the travelling salesman problem on complete weighted graphs.
+//
+// Closed walks and tours are returned as node sequences that start and end at
+// the same node.
+package tour // import "gonum.org/v1/gonum/graph/tour"
@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tour
+
+import (
+	"errors"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+var (
+	// ErrNoEulerianCircuit is returned when a graph
+	// does not have an Eulerian circuit.
+	ErrNoEulerianCircuit = errors.New("tour: no Eulerian circuit")
+
+	// ErrNoEulerianPath is returned when a graph
+	// does not have an Eulerian path.
+	ErrNoEulerianPath = errors.New("tour: no Eulerian path")
+)
+
+// EulerianCircuit returns an Eulerian circuit of g, a closed walk that
+// traverses every edge of g exactly once, using Hierholzer's algorithm. The
+// circuit starts and ends at the lowest ID node with an edge. If g has no
+// edges, EulerianCircuit returns nil.
+//
+// If g is a graph.Directed, edges are traversed in their direction. If g is a
+// graph.Multigraph, each line is traversed once, and self loops are traversed
+// once. If g does not have an Eulerian circuit, EulerianCircuit returns
+// ErrNoEulerianCircuit.
+//
+// See Hierholzer and Wiener doi:10.1007/BF01442866 for details of the
+// algorithm.
+func EulerianCircuit(g graph.Graph) ([]graph.Node, error) {
+	m := newMultigraph(g)
+	if m.edges == 0 {
+		return nil, nil
+	}
+	for u := range m.nodes {
+		if m.outDegree[u] != m.inDegree[u] || (!m.directed && m.outDegree[u]%2 != 0) {
+			return nil, ErrNoEulerianCircuit
+		}
+	}
+	walk, ok := m.hierholzer(m.firstWithEdge())
+	if !ok {
+		return nil, ErrNoEulerianCircuit
+	}
+	return nodesOf(m.nodes, walk), nil
+}
+
+// EulerianPath returns an Eulerian path of g, a walk that traverses every
+// edge of g exactly once, using Hierholzer's algorithm. If g has an Eulerian
+// circuit, the path is the circuit returned by EulerianCircuit. Otherwise the
+// path starts at the lowest ID node that can start an Eulerian path. If g has
+// no edges, EulerianPath returns nil.
+//
+// Edges are traversed as described for EulerianCircuit. If g does not have an
+// Eulerian path, EulerianPath returns ErrNoEulerianPath.
+func EulerianPath(g graph.Graph) ([]graph.Node, error) {
+	m := newMultigraph(g)
+	if m.edges == 0 {
+		return nil, nil
+	}
+	var (
+		start = -1
+		odd   int
+	)
+	for u := range m.nodes {
+		if m.directed {
+			switch m.outDegree[u] - m.inDegree[u] {
+			case 0:
+				continue
+			case 1:
+				start = u
+			case -1:
+			default:
+				return nil, ErrNoEulerianPath
+			}
+			odd++
+		} else if m.outDegree[u]%2 != 0 {
+			if start < 0 {
+				start = u
+			}
+			odd++
+		}
+	}
+	if odd != 0 && odd != 2 {
+		return nil, ErrNoEulerianPath
+	}
+	if start < 0 {
+		start = m.firstWithEdge()
+	}
+	walk, ok := m.hierholzer(start)
+	if !ok {
+		return nil, ErrNoEulerianPath
+	}
+	return nodesOf(m.nodes, walk), nil
+}
+
+// multigraph is an adjacency list representation of a graph or multigraph
+// with each edge or line held as an arc, or as a pair of arcs sharing an
+// edge index for undirected graphs.
+type multigraph struct {
+	nodes    []graph.Node
+	index    map[int64]int
+	directed bool
+
+	// arcs holds the arcs leaving each node.
+	arcs [][]arc
+
+	// edges is the number of edges.
+	edges int
+
+	// outDegree and inDegree hold the degrees of the
+	// nodes. For undirected graphs, inDegree is equal
+	// to outDegree and self loops count twice.
+	outDegree, inDegree []int
+}
+
+// arc is an arc to the node with index to along the edge with index edge.
+type arc struct {
+	to, edge int
+}
+
+// newMultigraph returns a multigraph holding the nodes and edges of g.
+func newMultigraph(g graph.Graph) *multigraph {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	_, directed := g.(graph.Directed)
+	m := newEmptyMultigraph(nodes, directed)
+	mg, isMulti := g.(graph.Multigraph)
+	for u, n := range nodes {
+		uid := n.ID()
+		to := graph.NodesOf(g.From(uid))
+		// Sort for a deterministic walk.
+		order.ByID(to)
+		for _, w := range to {
+			vid := w.ID()
+			v := m.index[vid]
+			if !directed && v < u {
+				// Undirected edges are added once from
+				// their lower index end.
+				continue
+			}
+			count := 1
+			if isMulti {
+				count = mg.Lines(uid, vid).Len()
+			}
+			for i := 0; i < count; i++ {
+				m.addEdge(u, v)
+			}
+		}
+	}
+	return m
+}
+
+// newEmptyMultigraph returns a multigraph with the given nodes and no edges.
+func newEmptyMultigraph(nodes []graph.Node, directed bool) *multigraph {
+	m := &multigraph{
+		nodes:     nodes,
+		index:     make(map[int64]int, len(nodes)),
+		directed:  directed,
+		arcs:      make([][]arc, len(nodes)),
+		outDegree: make([]int, len(nodes)),
+		inDegree:  make([]int, len(nodes)),
+	}
+	for i, n := range nodes {
+		m.index[n.ID()] = i
+	}
+	return m
+}
+
+// addEdge adds an edge from the node with index u to the node with index v.
+func (m *multigraph) addEdge(u, v int) {
+	e := m.edges
+	m.edges++
+	m.arcs[u] = append(m.arcs[u], arc{to: v, edge: e})
+	m.outDegree[u]++
+	m.inDegree[v]++
+	if m.directed {
+		return
+	}
+	if u != v {
+		m.arcs[v] = append(m.arcs[v], arc{to: u, edge: e})
+	}
+	m.outDegree[v]++
+	m.inDegree[u]++
+}
+
+// firstWithEdge returns the lowest index node with an edge, or -1.
+func (m *multigraph) firstWithEdge() int {
+	for u, d := range m.outDegree {
+		if d != 0 {
+			return u
+		}
+	}
+	return -1
+}
+
+// hierholzer returns a walk from the node with index start that traverses
+// every edge exactly once if all the edges are reachable from start. The
+// degree conditions for the walk to exist must be checked by the caller.
+func (m *multigraph) hierholzer(start int) (walk []int, ok bool) {
+	used := make([]bool, m.edges)
+	next := make([]int, len(m.nodes))
+	stack := []int{start}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		arcs := m.arcs[u]
+		for next[u] < len(arcs) && used[arcs[next[u]].edge] {
+			next[u]++
+		}
+		if next[u] == len(arcs) {
+			walk = append(walk, u)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		a := arcs[next[u]]
+		used[a.edge] = true
+		stack = append(stack, a.to)
+	}
+	if len(walk) != m.edges+1 {
+		// Some edges are not reachable from start.
+		return nil, false
+	}
+	slices.Reverse(walk)
+	return walk, true
+}
@@ -0,0 +1,267 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tour
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var eulerianTests = []struct {
+	name     string
+	directed bool
+	multi    bool
+	edges    [][2]int64
+	nodes    []int64
+
+	wantCircuit bool
+	wantPath    bool
+	wantStart   int64
+}{
+	{name: "empty", wantCircuit: true, wantPath: true},
+	{name: "isolated", nodes: []int64{0, 1}, wantCircuit: true, wantPath: true},
+	{
+		name:        "triangle",
+		edges:       [][2]int64{{0, 1}, {1, 2}, {2, 0}},
+		wantCircuit: true, wantPath: true, wantStart: 0,
+	},
+	{
+		name:     "path",
+		edges:    [][2]int64{{2, 1}, {1, 0}},
+		wantPath: true, wantStart: 0,
+	},
+	{
+		name:     "house",
+		edges:    [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 4}, {1, 4}, {0, 2}},
+		wantPath: true, wantStart: 1,
+	},
+	{
+		name:  "star",
+		edges: [][2]int64{{0, 1}, {0, 2}, {0, 3}},
+	},
+	{
+		name:  "two triangles",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}},
+	},
+	{
+		name:        "directed cycle",
+		directed:    true,
+		edges:       [][2]int64{{1, 2}, {2, 3}, {3, 1}},
+		wantCircuit: true, wantPath: true, wantStart: 1,
+	},
+	{
+		name:     "directed path",
+		directed: true,
+		edges:    [][2]int64{{3, 2}, {2, 1}, {1, 3}, {3, 0}},
+		wantPath: true, wantStart: 3,
+	},
+	{
+		name:     "directed two sources",
+		directed: true,
+		edges:    [][2]int64{{0, 1}, {2, 1}},
+	},
+	{
+		name:        "multi parallel",
+		multi:       true,
+		edges:       [][2]int64{{0, 1}, {0, 1}, {1, 2}, {1, 2}},
+		wantCircuit: true, wantPath: true, wantStart: 0,
+	},
+	{
+		name:        "multi loops",
+		multi:       true,
+		edges:       [][2]int64{{0, 0}, {0, 1}, {1, 1}, {1, 1}, {1, 0}},
+		wantCircuit: true, wantPath: true, wantStart: 0,
+	},
+	{
+		name:     "multi odd",
+		multi:    true,
+		edges:    [][2]int64{{0, 1}, {0, 1}, {0, 1}, {1, 2}},
+		wantPath: true, wantStart: 0,
+	},
+	{
+		name:        "multi directed",
+		multi:       true,
+		directed:    true,
+		edges:       [][2]int64{{0, 1}, {1, 0}, {0, 1}, {1, 0}, {1, 1}},
+		wantCircuit: true, wantPath: true, wantStart: 0,
+	},
+}
+
+func TestEulerian(t *testing.T) {
+	t.Parallel()
+	for _, test := range eulerianTests {
+		g := newTestGraph(test.directed, test.multi, test.nodes, test.edges)
+
+		circuit, err := EulerianCircuit(g)
+		if (err == nil) != test.wantCircuit {
+			t.Errorf("unexpected error for %q circuit: %v", test.name, err)
+		}
+		if err == nil {
+			checkWalk(t, test.name+" circuit", test.directed, test.edges, circuit, true)
+		}
+
+		path, err := EulerianPath(g)
+		if (err == nil) != test.wantPath {
+			t.Errorf("unexpected error for %q path: %v", test.name, err)
+		}
+		if err == nil {
+			checkWalk(t, test.name+" path", test.directed, test.edges, path, false)
+			if len(path) != 0 && path[0].ID() != test.wantStart {
+				t.Errorf("unexpected start for %q path: got:%d want:%d", test.name, path[0].ID(), test.wantStart)
+			}
+		}
+	}
+}
+
+func TestEulerianRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 100; trial++ {
+		directed := trial%2 == 0
+		n := 2 + rnd.IntN(8)
+
+		// A union of closed walks through a common node has an
+		// Eulerian circuit.
+		var edges [][2]int64
+		for c := 0; c < 1+rnd.IntN(4); c++ {
+			u := int64(0)
+			for k := 0; k < 1+rnd.IntN(6); k++ {
+				v := int64(rnd.IntN(n))
+				edges = append(edges, [2]int64{u, v})
+				u = v
+			}
+			edges = append(edges, [2]int64{u, 0})
+		}
+		name := fmt.Sprintf("trial %d", trial)
+		g := newTestGraph(directed, true, nil, edges)
+		circuit, err := EulerianCircuit(g)
+		if err != nil {
+			t.Errorf("unexpected error for %s circuit: %v", name, err)
+			continue
+		}
+		checkWalk(t, name+" circuit", directed, edges, circuit, true)
+
+		// Removing an edge that is not a self loop leaves an
+		// Eulerian path.
+		for i, e := range edges {
+			if e[0] == e[1] {
+				continue
+			}
+			edges = append(edges[:i:i], edges[i+1:]...)
+			break
+		}
+		g = newTestGraph(directed, true, nil, edges)
+		path, err := EulerianPath(g)
+		if err != nil {
+			t.Errorf("unexpected error for %s path: %v", name, err)
+			continue
+		}
+		checkWalk(t, name+" path", directed, edges, path, false)
+	}
+}
+
+// newTestGraph returns a graph with the given nodes and edges.
+func newTestGraph(directed, isMulti bool, nodes []int64, edges [][2]int64) graph.Graph {
+	switch {
+	case isMulti && directed:
+		g := multi.NewDirectedGraph()
+		for _, id := range nodes {
+			g.AddNode(multi.Node(id))
+		}
+		for _, e := range edges {
+			g.SetLine(g.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+		}
+		return g
+	case isMulti:
+		g := multi.NewUndirectedGraph()
+		for _, id := range nodes {
+			g.AddNode(multi.Node(id))
+		}
+		for _, e := range edges {
+			g.SetLine(g.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+		}
+		return g
+	case directed:
+		g := simple.NewDirectedGraph()
+		for _, id := range nodes {
+			g.AddNode(simple.Node(id))
+		}
+		for _, e := range edges {
+			g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+		}
+		return g
+	default:
+		g := simple.NewUndirectedGraph()
+		for _, id := range nodes {
+			g.AddNode(simple.Node(id))
+		}
+		for _, e := range edges {
+			g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+		}
+		return g
+	}
+}
+
+// checkWalk checks that walk traverses each of the edges exactly once.
+func checkWalk(t *testing.T, name string, directed bool, edges [][2]int64, walk []graph.Node, closed bool) {
+	t.Helper()
+	if len(edges) == 0 {
+		if walk != nil {
+			t.Errorf("%s: unexpected walk for graph without edges: %v", name, walk)
+		}
+		return
+	}
+	if len(walk) != len(edges)+1 {
+		t.Errorf("%s: unexpected walk length: got:%d want:%d", name, len(walk), len(edges)+1)
+		return
+	}
+	if closed && walk[0].ID() != walk[len(walk)-1].ID() {
+		t.Errorf("%s: walk is not closed: %v", name, walk)
+	}
+	remaining := make(map[[2]int64]int)
+	key := func(u, v int64) [2]int64 {
+		if !directed && v < u {
+			u, v = v, u
+		}
+		return [2]int64{u, v}
+	}
+	for _, e := range edges {
+		remaining[key(e[0], e[1])]++
+	}
+	for i := 1; i < len(walk); i++ {
+		k := key(walk[i-1].ID(), walk[i].ID())
+		if remaining[k] == 0 {
+			t.Errorf("%s: walk traverses missing or used edge %v: %v", name, k, walk)
+			return
+		}
+		remaining[k]--
+	}
+}
+
+func ExampleEulerianPath() {
+	// The "house" drawn without lifting the pen.
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 4}, {1, 4}, {0, 2}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	path, err := EulerianPath(g)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(path)
+
+	_, err = EulerianCircuit(g)
+	fmt.Println(err)
+
+	// Output:
+	// [1 0 2 1 4 0 3 2]
+	// tour: no Eulerian circuit
+}
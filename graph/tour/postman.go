@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tour
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/matching"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// ErrDisconnected is returned when the edges of a graph are not connected.
+var ErrDisconnected = errors.New("tour: edges are not connected")
+
+// ChinesePostman returns a shortest closed walk in the undirected graph g that
+// traverses every edge of g at least once, and the total weight of the walk.
+// The walk starts and ends at the lowest ID node with an edge. If g has no
+// edges, ChinesePostman returns nil.
+//
+// The nodes of g with odd degree are paired by a minimum weight perfect
+// matching of the shortest path distances between them, and the edges along
+// the shortest path between each pair are traversed twice. If the edges of g
+// are not connected, ChinesePostman returns ErrDisconnected.
+//
+// If g is a graph.WeightedMultigraph, every line of g is traversed and the
+// weight of each line is counted once. Repeated traversals between a pair of
+// nodes use the lightest line joining them.
+//
+// ChinesePostman will panic if g has a negative edge weight.
+//
+// See Edmonds and Johnson doi:10.1007/BF01580113 for details of the algorithm.
+func ChinesePostman(g graph.WeightedUndirected) ([]graph.Node, float64, error) {
+	m := newMultigraph(g)
+	if m.edges == 0 {
+		return nil, 0, nil
+	}
+	// The weights of parallel lines of a multigraph are
+	// summed individually, and shortest paths between odd
+	// nodes are found over the lightest line joining each
+	// pair of nodes.
+	mg, isMulti := g.(graph.WeightedMultigraph)
+	var lightest *simple.WeightedUndirectedGraph
+	if isMulti {
+		lightest = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	}
+	var (
+		weight float64
+		odd    []int
+	)
+	for u, n := range m.nodes {
+		uid := n.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if m.index[vid] < u {
+				continue
+			}
+			if !isMulti {
+				w, _ := g.Weight(uid, vid)
+				if w < 0 {
+					panic("tour: negative edge weight")
+				}
+				weight += w
+				continue
+			}
+			light := math.Inf(1)
+			for it := mg.WeightedLines(uid, vid); it.Next(); {
+				w := it.WeightedLine().Weight()
+				if w < 0 {
+					panic("tour: negative edge weight")
+				}
+				weight += w
+				light = math.Min(light, w)
+			}
+			if vid != uid {
+				lightest.SetWeightedEdge(simple.WeightedEdge{F: n, T: v, W: light})
+			}
+		}
+		if m.outDegree[u]%2 != 0 {
+			odd = append(odd, u)
+		}
+	}
+
+	if len(odd) != 0 {
+		var paths path.AllShortest
+		if isMulti {
+			paths = path.DijkstraAllPaths(lightest)
+		} else {
+			paths = path.DijkstraAllPaths(g)
+		}
+
+		// Pair the odd nodes with a maximum weight perfect
+		// matching of the negated distances between them.
+		pairs := simple.NewWeightedUndirectedGraph(0, 0)
+		for i, u := range odd {
+			pairs.AddNode(m.nodes[u])
+			for _, v := range odd[:i] {
+				d := paths.Weight(m.nodes[u].ID(), m.nodes[v].ID())
+				if math.IsInf(d, 1) {
+					return nil, 0, ErrDisconnected
+				}
+				pairs.SetWeightedEdge(simple.WeightedEdge{F: m.nodes[v], T: m.nodes[u], W: -d})
+			}
+		}
+		pairing, _ := matching.MaxWeight(pairs, true)
+		for _, e := range pairing {
+			p, w, _ := paths.Between(e.From().ID(), e.To().ID())
+			weight += w
+			for i, u := range p[1:] {
+				m.addEdge(m.index[p[i].ID()], m.index[u.ID()])
+			}
+		}
+	}
+
+	walk, ok := m.hierholzer(m.firstWithEdge())
+	if !ok {
+		return nil, 0, ErrDisconnected
+	}
+	return nodesOf(m.nodes, walk), weight, nil
+}
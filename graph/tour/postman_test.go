@@ -0,0 +1,257 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tour
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var chinesePostmanTests = []struct {
+	name  string
+	edges []simple.WeightedEdge
+
+	want    float64
+	wantErr error
+}{
+	{name: "empty"},
+	{
+		name: "square with diagonal",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(3), T: simple.Node(0), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 3},
+		},
+		want: 9,
+	},
+	{
+		name: "path",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 2},
+		},
+		want: 6,
+	},
+	{
+		name: "disconnected",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+		},
+		wantErr: ErrDisconnected,
+	},
+	{
+		name: "disconnected even",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(0), W: 1},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+			{F: simple.Node(4), T: simple.Node(5), W: 1},
+			{F: simple.Node(5), T: simple.Node(3), W: 1},
+		},
+		wantErr: ErrDisconnected,
+	},
+}
+
+func TestChinesePostman(t *testing.T) {
+	t.Parallel()
+	for _, test := range chinesePostmanTests {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		walk, weight, err := ChinesePostman(g)
+		if err != test.wantErr {
+			t.Errorf("unexpected error for %q: got:%v want:%v", test.name, err, test.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if weight != test.want {
+			t.Errorf("unexpected weight for %q: got:%v want:%v", test.name, weight, test.want)
+		}
+		checkPostmanWalk(t, test.name, g, walk, weight)
+	}
+}
+
+func TestChinesePostmanRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		n := 2 + rnd.IntN(10)
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		// Join the nodes with a random spanning tree and then
+		// add random edges.
+		for i := 1; i < n; i++ {
+			j := rnd.IntN(i)
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(j), T: simple.Node(i), W: float64(1 + rnd.IntN(9))})
+		}
+		for k := 0; k < rnd.IntN(2*n); k++ {
+			i, j := rnd.IntN(n), rnd.IntN(n)
+			if i != j {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(1 + rnd.IntN(9))})
+			}
+		}
+		name := fmt.Sprintf("trial %d", trial)
+
+		walk, weight, err := ChinesePostman(g)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", name, err)
+			continue
+		}
+		checkPostmanWalk(t, name, g, walk, weight)
+
+		var total float64
+		for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+			total += e.Weight()
+		}
+		want := total + bruteOddPairing(g)
+		if !scalar.EqualWithinAbsOrRel(weight, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected weight for %s: got:%v want:%v", name, weight, want)
+		}
+	}
+}
+
+// testLine is a weighted line between the nodes with IDs u and v.
+type testLine struct {
+	u, v int64
+	w    float64
+}
+
+var chinesePostmanMultiTests = []struct {
+	name  string
+	lines []testLine
+	want  float64
+}{
+	{
+		name:  "parallel pair",
+		lines: []testLine{{0, 1, 1}, {0, 1, 2}},
+		want:  3,
+	},
+	{
+		name:  "odd parallel",
+		lines: []testLine{{0, 1, 1}, {0, 1, 2}, {1, 0, 4}, {1, 2, 1}},
+		// Every line once and the path 0-1-2 over
+		// the lightest of the lines joining 0 and 1.
+		want: 8 + 2,
+	},
+	{
+		name:  "self loop",
+		lines: []testLine{{0, 0, 5}, {0, 1, 1}, {1, 0, 1}},
+		want:  7,
+	},
+}
+
+func TestChinesePostmanMultigraph(t *testing.T) {
+	t.Parallel()
+	for _, test := range chinesePostmanMultiTests {
+		g := multi.NewWeightedUndirectedGraph()
+		lines := make(map[[2]int64]int)
+		for _, l := range test.lines {
+			g.SetWeightedLine(g.NewWeightedLine(multi.Node(l.u), multi.Node(l.v), l.w))
+			lines[[2]int64{min(l.u, l.v), max(l.u, l.v)}]++
+		}
+		walk, weight, err := ChinesePostman(g)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.name, err)
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(weight, test.want, 1e-12, 1e-12) {
+			t.Errorf("unexpected weight for %q: got:%v want:%v", test.name, weight, test.want)
+		}
+		if walk[0].ID() != walk[len(walk)-1].ID() {
+			t.Errorf("walk for %q is not closed: %v", test.name, walk)
+		}
+		traversed := make(map[[2]int64]int)
+		for i := 1; i < len(walk); i++ {
+			u, v := walk[i-1].ID(), walk[i].ID()
+			if !g.HasEdgeBetween(u, v) {
+				t.Errorf("walk for %q traverses missing edge %d-%d", test.name, u, v)
+			}
+			traversed[[2]int64{min(u, v), max(u, v)}]++
+		}
+		for e, n := range lines {
+			if traversed[e] < n {
+				t.Errorf("walk for %q traverses %d-%d fewer times than its lines: got:%d want:%d",
+					test.name, e[0], e[1], traversed[e], n)
+			}
+		}
+	}
+}
+
+// bruteOddPairing returns the minimum total shortest path distance of a
+// perfect matching of the odd degree nodes of g by dynamic programming over
+// subsets of the odd nodes.
+func bruteOddPairing(g *simple.WeightedUndirectedGraph) float64 {
+	var odd []graph.Node
+	for _, n := range graph.NodesOf(g.Nodes()) {
+		if g.From(n.ID()).Len()%2 != 0 {
+			odd = append(odd, n)
+		}
+	}
+	paths := path.DijkstraAllPaths(g)
+	best := make([]float64, 1<<len(odd))
+	for s := 1; s < len(best); s++ {
+		best[s] = math.Inf(1)
+		if bits.OnesCount(uint(s))%2 != 0 {
+			continue
+		}
+		i := bits.TrailingZeros(uint(s))
+		for j := i + 1; j < len(odd); j++ {
+			if s&(1<<j) == 0 {
+				continue
+			}
+			d := paths.Weight(odd[i].ID(), odd[j].ID())
+			best[s] = math.Min(best[s], best[s&^(1<<i|1<<j)]+d)
+		}
+	}
+	return best[len(best)-1]
+}
+
+// checkPostmanWalk checks that walk is a closed walk in g that traverses every
+// edge of g and has the given weight.
+func checkPostmanWalk(t *testing.T, name string, g *simple.WeightedUndirectedGraph, walk []graph.Node, weight float64) {
+	t.Helper()
+	if g.Edges().Len() == 0 {
+		if walk != nil {
+			t.Errorf("%s: unexpected walk for graph without edges: %v", name, walk)
+		}
+		return
+	}
+	if walk[0].ID() != walk[len(walk)-1].ID() {
+		t.Errorf("%s: walk is not closed: %v", name, walk)
+	}
+	seen := make(map[[2]int64]bool)
+	var sum float64
+	for i := 1; i < len(walk); i++ {
+		u, v := walk[i-1].ID(), walk[i].ID()
+		w, ok := g.Weight(u, v)
+		if !ok {
+			t.Errorf("%s: walk traverses missing edge %d-%d", name, u, v)
+			return
+		}
+		sum += w
+		seen[[2]int64{min(u, v), max(u, v)}] = true
+	}
+	if len(seen) != g.Edges().Len() {
+		t.Errorf("%s: walk does not traverse every edge: got:%d want:%d", name, len(seen), g.Edges().Len())
+	}
+	if !scalar.EqualWithinAbsOrRel(sum, weight, 1e-12, 1e-12) {
+		t.Errorf("%s: weight mismatch: got:%v want:%v", name, weight, sum)
+	}
+}
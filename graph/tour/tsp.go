@@ -0,0 +1,348 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tour
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/matching"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// NearestNeighbor returns a travelling salesman tour of the complete weighted
+// graph g constructed by starting at the start node and repeatedly moving to
+// the nearest unvisited node, and the total weight of the tour. The tour
+// visits every node of g once and returns to start. Ties are broken by the
+// lowest node ID.
+//
+// NearestNeighbor will panic if start is not in g or g is not complete.
+func NearestNeighbor(g graph.Weighted, start graph.Node) (tour []graph.Node, weight float64) {
+	nodes, dist := distances(g)
+	s := -1
+	for i, n := range nodes {
+		if n.ID() == start.ID() {
+			s = i
+		}
+	}
+	if s < 0 {
+		panic("tour: start node not in graph")
+	}
+
+	visited := make([]bool, len(nodes))
+	visited[s] = true
+	seq := []int{s}
+	for u := s; len(seq) < len(nodes); {
+		next := -1
+		for v, d := range dist[u] {
+			if !visited[v] && (next < 0 || d < dist[u][next]) {
+				next = v
+			}
+		}
+		visited[next] = true
+		seq = append(seq, next)
+		u = next
+	}
+	seq = append(seq, s)
+	return nodesOf(nodes, seq), tourWeight(dist, seq)
+}
+
+// Christofides returns a travelling salesman tour of the complete weighted
+// undirected graph g using the Christofides algorithm, and the total weight
+// of the tour. The tour visits every node of g once and starts and ends at
+// the lowest ID node. If the weights of g satisfy the triangle inequality,
+// the weight of the tour is at most 3/2 times the weight of an optimal tour.
+//
+// A minimum spanning tree of g is found using Prim's algorithm and the odd
+// degree nodes of the tree are paired by a minimum weight perfect matching. The
+// Eulerian circuit of the union of the tree and the matching is shortcut to
+// visit each node once.
+//
+// Christofides will panic if g is not complete.
+//
+// See Christofides doi:10.1007/s43069-021-00101-z for details of the
+// algorithm.
+func Christofides(g graph.WeightedUndirected) (tour []graph.Node, weight float64) {
+	nodes, dist := distances(g)
+	switch len(nodes) {
+	case 0:
+		return nil, 0
+	case 1:
+		return []graph.Node{nodes[0], nodes[0]}, 0
+	}
+
+	// Find a minimum spanning tree with Prim's algorithm
+	// over the node indices, breaking ties by the lowest
+	// index so that the tour does not depend on the
+	// iteration order of g.
+	m := newEmptyMultigraph(nodes, false)
+	inTree := make([]bool, len(nodes))
+	parent := make([]int, len(nodes))
+	key := make([]float64, len(nodes))
+	for i := range key {
+		key[i] = math.Inf(1)
+	}
+	key[0] = 0
+	for range nodes {
+		u := -1
+		for v, k := range key {
+			if !inTree[v] && (u < 0 || k < key[u]) {
+				u = v
+			}
+		}
+		inTree[u] = true
+		if u != 0 {
+			m.addEdge(parent[u], u)
+		}
+		for v, d := range dist[u] {
+			if !inTree[v] && d < key[v] {
+				key[v] = d
+				parent[v] = u
+			}
+		}
+	}
+
+	// Pair the odd degree nodes of the tree with a maximum weight
+	// perfect matching of the negated weights between them.
+	pairs := simple.NewWeightedUndirectedGraph(0, 0)
+	var odd []int
+	for u, d := range m.outDegree {
+		if d%2 == 0 {
+			continue
+		}
+		pairs.AddNode(nodes[u])
+		for _, v := range odd {
+			pairs.SetWeightedEdge(simple.WeightedEdge{F: nodes[v], T: nodes[u], W: -dist[u][v]})
+		}
+		odd = append(odd, u)
+	}
+	pairing, _ := matching.MaxWeight(pairs, true)
+	for _, e := range pairing {
+		m.addEdge(m.index[e.From().ID()], m.index[e.To().ID()])
+	}
+
+	circuit, _ := m.hierholzer(0)
+	visited := make([]bool, len(nodes))
+	seq := make([]int, 0, len(nodes)+1)
+	for _, u := range circuit {
+		if !visited[u] {
+			visited[u] = true
+			seq = append(seq, u)
+		}
+	}
+	seq = append(seq, 0)
+	return nodesOf(nodes, seq), tourWeight(dist, seq)
+}
+
+// TwoOpt returns the travelling salesman tour obtained by improving the given
+// tour of the complete weighted graph g using 2-opt local search, and the total
+// weight of the returned tour. A 2-opt move replaces two edges of the tour by
+// reversing the path between them. Moves are applied until no move reduces the
+// weight of the tour. If g is directed, the change in weight of the reversed
+// path is taken into account.
+//
+// The tour must start and end at the same node and visit every other node of g
+// once, as returned by NearestNeighbor or Christofides. The first node of the
+// tour is retained. The input tour is not modified.
+//
+// TwoOpt will panic if tour is not a tour of g or g is not complete.
+//
+// See Croes doi:10.1287/opre.6.6.791 for details of the algorithm.
+func TwoOpt(g graph.Weighted, tour []graph.Node) ([]graph.Node, float64) {
+	nodes, dist := distances(g)
+	if len(nodes) == 0 && len(tour) == 0 {
+		return nil, 0
+	}
+	t := tourIndices(nodes, tour)
+	n := len(t) - 1
+
+	// forward[i] and backward[i] hold the weights of the path
+	// from t[0] to t[i] traversed forward and backward.
+	forward := make([]float64, n+1)
+	backward := make([]float64, n+1)
+	prefix := func() {
+		for i := 1; i <= n; i++ {
+			forward[i] = forward[i-1] + dist[t[i-1]][t[i]]
+			backward[i] = backward[i-1] + dist[t[i]][t[i-1]]
+		}
+	}
+	prefix()
+	for improved := true; improved; {
+		improved = false
+		tol := tolerance(forward[n])
+		for i := 0; i < n-2 && !improved; i++ {
+			for j := i + 2; j < n; j++ {
+				// Replace the edges t[i]→t[i+1] and t[j]→t[j+1]
+				// with t[i]→t[j] and t[i+1]→t[j+1], reversing
+				// the path from t[i+1] to t[j].
+				a, b, c, d := t[i], t[i+1], t[j], t[j+1]
+				delta := dist[a][c] + dist[b][d] - dist[a][b] - dist[c][d] +
+					(backward[j] - backward[i+1]) - (forward[j] - forward[i+1])
+				if delta < -tol {
+					slices.Reverse(t[i+1 : j+1])
+					prefix()
+					improved = true
+					break
+				}
+			}
+		}
+	}
+	return nodesOf(nodes, t), tourWeight(dist, t)
+}
+
+// OrOpt returns the travelling salesman tour obtained by improving the given
+// tour of the complete weighted graph g using Or-opt local search, and the
+// total weight of the returned tour. An Or-opt move moves a path of up to three
+// consecutive nodes of the tour to another position in the tour, optionally
+// reversing it. Moves are applied until no move reduces the weight of the tour.
+//
+// The tour must be given as described for TwoOpt. The first node of the tour
+// is retained. The input tour is not modified.
+//
+// OrOpt will panic if tour is not a tour of g or g is not complete.
+//
+// See Or, Traveling salesman-type combinatorial problems and their relation to
+// the logistics of regional blood banking, PhD thesis, Northwestern University,
+// 1976 for details of the algorithm.
+func OrOpt(g graph.Weighted, tour []graph.Node) ([]graph.Node, float64) {
+	nodes, dist := distances(g)
+	if len(nodes) == 0 && len(tour) == 0 {
+		return nil, 0
+	}
+	t := tourIndices(nodes, tour)
+	n := len(t) - 1
+
+	for improved := true; improved; {
+		improved = false
+		tol := tolerance(tourWeight(dist, t))
+	search:
+		for l := 1; l <= 3; l++ {
+			for i := 1; i+l <= n; i++ {
+				// The path t[i:i+l] is between p and q.
+				first, last := t[i], t[i+l-1]
+				p, q := t[i-1], t[i+l]
+				var inner, innerReversed float64
+				for k := i; k < i+l-1; k++ {
+					inner += dist[t[k]][t[k+1]]
+					innerReversed += dist[t[k+1]][t[k]]
+				}
+				removed := dist[p][first] + dist[last][q] - dist[p][q]
+				for j := 0; j < n; j++ {
+					if i-1 <= j && j < i+l {
+						continue
+					}
+					// Insert the path between t[j] and t[j+1].
+					a, b := t[j], t[j+1]
+					added := dist[a][first] + dist[last][b] - dist[a][b]
+					reversed := dist[a][last] + dist[first][b] - dist[a][b] + innerReversed - inner
+					switch {
+					case added-removed < -tol:
+						t = move(t, i, l, j, false)
+					case reversed-removed < -tol:
+						t = move(t, i, l, j, true)
+					default:
+						continue
+					}
+					improved = true
+					break search
+				}
+			}
+		}
+	}
+	return nodesOf(nodes, t), tourWeight(dist, t)
+}
+
+// move returns the tour t with the path of l nodes starting at index i moved
+// to between the nodes at index j and j+1, reversing the path if reversed is
+// true.
+func move(t []int, i, l, j int, reversed bool) []int {
+	seg := append([]int(nil), t[i:i+l]...)
+	if reversed {
+		slices.Reverse(seg)
+	}
+	dst := make([]int, 0, len(t))
+	for k, u := range t {
+		if i <= k && k < i+l {
+			continue
+		}
+		dst = append(dst, u)
+		if k == j {
+			dst = append(dst, seg...)
+		}
+	}
+	return dst
+}
+
+// distances returns the nodes of g ordered by ID and the matrix of weights
+// between them. It panics if g is not complete.
+func distances(g graph.Weighted) (nodes []graph.Node, dist [][]float64) {
+	nodes = graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	dist = make([][]float64, len(nodes))
+	for i, u := range nodes {
+		dist[i] = make([]float64, len(nodes))
+		for j, v := range nodes {
+			if i == j {
+				continue
+			}
+			w, ok := g.Weight(u.ID(), v.ID())
+			if !ok {
+				panic("tour: graph is not complete")
+			}
+			dist[i][j] = w
+		}
+	}
+	return nodes, dist
+}
+
+// tourIndices returns the node indices of a tour. It panics if tour is not a
+// closed tour visiting every node once.
+func tourIndices(nodes []graph.Node, tour []graph.Node) []int {
+	if len(tour) != len(nodes)+1 || (len(nodes) != 0 && tour[0].ID() != tour[len(tour)-1].ID()) {
+		panic("tour: invalid tour")
+	}
+	index := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		index[n.ID()] = i
+	}
+	t := make([]int, len(tour))
+	seen := make([]bool, len(nodes))
+	for i, n := range tour {
+		u, ok := index[n.ID()]
+		if !ok || (i < len(nodes) && seen[u]) {
+			panic("tour: invalid tour")
+		}
+		seen[u] = true
+		t[i] = u
+	}
+	return t
+}
+
+// tourWeight returns the weight of the walk through the nodes with the given
+// indices.
+func tourWeight(dist [][]float64, walk []int) float64 {
+	var w float64
+	for i := 1; i < len(walk); i++ {
+		w += dist[walk[i-1]][walk[i]]
+	}
+	return w
+}
+
+// tolerance returns the minimum weight reduction for a local search move to
+// be applied to a tour with the given weight.
+func tolerance(weight float64) float64 {
+	return 1e-12 * math.Max(1, math.Abs(weight))
+}
+
+// nodesOf returns the nodes with the given indices.
+func nodesOf(nodes []graph.Node, indices []int) []graph.Node {
+	dst := make([]graph.Node, len(indices))
+	for i, u := range indices {
+		dst[i] = nodes[u]
+	}
+	return dst
+}
@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tour
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestTSPHeuristics(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		n := 1 + rnd.IntN(8)
+		g := euclidean(n, rnd)
+		name := fmt.Sprintf("trial %d n=%d", trial, n)
+		opt := bruteTour(g)
+
+		nn, nnWeight := NearestNeighbor(g, simple.Node(rnd.IntN(n)))
+		checkTour(t, name+" nearest neighbor", g, nn, nnWeight)
+
+		ch, chWeight := Christofides(g)
+		checkTour(t, name+" Christofides", g, ch, chWeight)
+		if chWeight > 1.5*opt+1e-9 {
+			t.Errorf("%s: Christofides tour exceeds bound: got:%v optimum:%v", name, chWeight, opt)
+		}
+
+		for _, start := range []struct {
+			name   string
+			tour   []graph.Node
+			weight float64
+		}{
+			{name: "nearest neighbor", tour: nn, weight: nnWeight},
+			{name: "Christofides", tour: ch, weight: chWeight},
+		} {
+			orig := append([]graph.Node(nil), start.tour...)
+			for _, improve := range []struct {
+				name string
+				fn   func(graph.Weighted, []graph.Node) ([]graph.Node, float64)
+			}{
+				{name: "2-opt", fn: TwoOpt},
+				{name: "Or-opt", fn: OrOpt},
+			} {
+				tour, weight := improve.fn(g, start.tour)
+				label := fmt.Sprintf("%s %s %s", name, improve.name, start.name)
+				checkTour(t, label, g, tour, weight)
+				if weight > start.weight+1e-9 {
+					t.Errorf("%s: tour weight increased: got:%v want at most:%v", label, weight, start.weight)
+				}
+				if weight < opt-1e-9 {
+					t.Errorf("%s: tour weight below optimum: got:%v optimum:%v", label, weight, opt)
+				}
+				if tour[0].ID() != start.tour[0].ID() {
+					t.Errorf("%s: start node not retained", label)
+				}
+				for i := range orig {
+					if orig[i].ID() != start.tour[i].ID() {
+						t.Errorf("%s: input tour modified", label)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestLocalSearchDirected(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		n := 2 + rnd.IntN(7)
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i != j {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(1 + rnd.IntN(20))})
+				}
+			}
+		}
+		name := fmt.Sprintf("trial %d n=%d", trial, n)
+		opt := bruteTour(g)
+
+		nn, nnWeight := NearestNeighbor(g, simple.Node(0))
+		checkTour(t, name+" nearest neighbor", g, nn, nnWeight)
+		two, twoWeight := TwoOpt(g, nn)
+		checkTour(t, name+" 2-opt", g, two, twoWeight)
+		or, orWeight := OrOpt(g, two)
+		checkTour(t, name+" Or-opt", g, or, orWeight)
+		if twoWeight > nnWeight || orWeight > twoWeight || orWeight < opt {
+			t.Errorf("%s: unexpected tour weights: nearest neighbor:%v 2-opt:%v Or-opt:%v optimum:%v",
+				name, nnWeight, twoWeight, orWeight, opt)
+		}
+	}
+}
+
+// euclidean returns a complete undirected graph of n random points in the unit
+// square weighted by the distances between the points.
+func euclidean(n int, rnd *rand.Rand) *simple.WeightedUndirectedGraph {
+	x := make([]float64, n)
+	y := make([]float64, n)
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for i := range x {
+		x[i], y[i] = rnd.Float64(), rnd.Float64()
+		g.AddNode(simple.Node(i))
+		for j := 0; j < i; j++ {
+			d := math.Hypot(x[i]-x[j], y[i]-y[j])
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(j), T: simple.Node(i), W: d})
+		}
+	}
+	return g
+}
+
+// bruteTour returns the weight of an optimal tour of the complete graph g
+// with nodes numbered from zero by enumerating all tours.
+func bruteTour(g graph.Weighted) float64 {
+	n := g.Nodes().Len()
+	if n < 2 {
+		return 0
+	}
+	best := math.Inf(1)
+	used := make([]bool, n)
+	used[0] = true
+	var search func(u int64, k int, w float64)
+	search = func(u int64, k int, w float64) {
+		if k == n {
+			d, _ := g.Weight(u, 0)
+			best = math.Min(best, w+d)
+			return
+		}
+		for v := 1; v < n; v++ {
+			if !used[v] {
+				used[v] = true
+				d, _ := g.Weight(u, int64(v))
+				search(int64(v), k+1, w+d)
+				used[v] = false
+			}
+		}
+	}
+	search(0, 1, 0)
+	return best
+}
+
+func TestChristofidesDeterministic(t *testing.T) {
+	t.Parallel()
+	// The points of a lattice have many equal distances,
+	// so the spanning tree and the matching have many ties.
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	const side = 4
+	for i := 0; i < side*side; i++ {
+		for j := 0; j < i; j++ {
+			d := math.Hypot(float64(i/side-j/side), float64(i%side-j%side))
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(j), T: simple.Node(i), W: d})
+		}
+	}
+
+	want, wantWeight := Christofides(g)
+	checkTour(t, "lattice", g, want, wantWeight)
+	for i := 0; i < 20; i++ {
+		got, gotWeight := Christofides(g)
+		if !reflect.DeepEqual(got, want) || gotWeight != wantWeight {
+			t.Fatalf("unexpected tour on repeat %d: got:%v %v want:%v %v", i, got, gotWeight, want, wantWeight)
+		}
+	}
+}
+
+// checkTour checks that tour visits every node of g once, returns to its
+// start and has the given weight.
+func checkTour(t *testing.T, name string, g graph.Weighted, tour []graph.Node, weight float64) {
+	t.Helper()
+	n := g.Nodes().Len()
+	if len(tour) != n+1 {
+		t.Errorf("%s: unexpected tour length: got:%d want:%d", name, len(tour), n+1)
+		return
+	}
+	if tour[0].ID() != tour[n].ID() {
+		t.Errorf("%s: tour is not closed: %v", name, tour)
+	}
+	seen := make(map[int64]bool)
+	var sum float64
+	for i, u := range tour[:n] {
+		if seen[u.ID()] {
+			t.Errorf("%s: node %d visited twice: %v", name, u.ID(), tour)
+		}
+		seen[u.ID()] = true
+		if u.ID() != tour[i+1].ID() {
+			w, _ := g.Weight(u.ID(), tour[i+1].ID())
+			sum += w
+		}
+	}
+	if !scalar.EqualWithinAbsOrRel(sum, weight, 1e-12, 1e-12) {
+		t.Errorf("%s: weight mismatch: got:%v want:%v", name, weight, sum)
+	}
+}
+
+func ExampleChristofides() {
+	// The corners and centre of a square.
+	points := [][2]float64{{0, 0}, {0, 2}, {2, 2}, {2, 0}, {1, 1}}
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for i, p := range points {
+		for j, q := range points[:i] {
+			d := math.Hypot(p[0]-q[0], p[1]-q[1])
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(j), T: simple.Node(i), W: d})
+		}
+	}
+
+	tour, weight := Christofides(g)
+	fmt.Printf("Christofides: %v %.3f\n", tour, weight)
+
+	// Improve the tour, which crosses itself.
+	tour, weight = TwoOpt(g, tour)
+	fmt.Printf("2-opt:        %v %.3f\n", tour, weight)
+
+	// Output:
+	// Christofides: [0 4 2 3 1 0] 9.657
+	// 2-opt:        [0 3 2 4 1 0] 8.828
+}
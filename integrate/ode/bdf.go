@@ -0,0 +1,396 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	bdfMaxOrder      = 5
+	bdfNewtonMaxIter = 4
+)
+
+var _ Method = (*BDF)(nil)
+
+// BDF is an implicit variable order (1 to 5), variable step method based on
+// the backward differentiation formulas in the quasi-constant step size
+// Nordsieck-like difference form of Shampine and Reichelt. The numerical
+// differentiation formula modification of Klopfenstein is used to improve
+// stability. BDF is suitable for stiff problems.
+//
+// The nonlinear system at each step is solved by a simplified Newton
+// iteration using an LU decomposition of I - c⋅J where J is the Jacobian of
+// the system. The Jacobian is evaluated by Problem.Jac if it is provided, and
+// otherwise approximated by finite differences. The Jacobian is only
+// re-evaluated when the Newton iteration fails to converge.
+//
+// Dense output is provided by the interpolating polynomial of the formula.
+//
+// References:
+//   - Shampine, L. F., & Reichelt, M. W. (1997). The MATLAB ODE suite. SIAM
+//     Journal on Scientific Computing, 18(1), 1-22.
+//     https://doi.org/10.1137/S1064827594276424
+//   - Klopfenstein, R. W. (1971). Numerical differentiation formulas for
+//     stiff systems of ordinary differential equations. RCA Review, 32,
+//     447-462.
+type BDF struct {
+	sys *System
+
+	dir  float64
+	hAbs float64
+
+	t     float64
+	order int
+
+	// d holds the backward differences of the
+	// solution scaled by the step size.
+	d [][]float64
+
+	jac       *mat.Dense
+	iter      *mat.Dense
+	lu        mat.LU
+	luValid   bool
+	jacIsNew  bool
+	newtonTol float64
+
+	nEqualSteps int
+
+	gamma, alpha, errConst [bdfMaxOrder + 2]float64
+
+	yPredict, psi, yNew, delta, f []float64
+	dy, rhs                       *mat.VecDense
+	work                          []float64
+}
+
+// Init initializes the method. See the Method interface for more details.
+func (b *BDF) Init(sys *System, t0 float64, y0 []float64, h0 float64) {
+	n := len(y0)
+	if n != sys.Dim() {
+		panic("ode: mismatched dimension")
+	}
+	b.sys = sys
+	b.t = t0
+	b.hAbs = h0
+	b.dir = 0
+	b.order = 1
+	b.nEqualSteps = 0
+	b.luValid = false
+
+	relTol, _ := sys.Tolerances()
+	b.newtonTol = math.Max(10*dlamchE/relTol, math.Min(0.03, math.Sqrt(relTol)))
+
+	kappa := [bdfMaxOrder + 2]float64{0, -0.1850, -1.0 / 9, -0.0823, -0.0415, 0}
+	for k := 1; k < len(b.gamma); k++ {
+		b.gamma[k] = b.gamma[k-1] + 1/float64(k)
+	}
+	for k := range b.alpha {
+		b.alpha[k] = (1 - kappa[k]) * b.gamma[k]
+		b.errConst[k] = kappa[k]*b.gamma[k] + 1/float64(k+1)
+	}
+
+	if len(b.d) != bdfMaxOrder+3 {
+		b.d = make([][]float64, bdfMaxOrder+3)
+	}
+	for i := range b.d {
+		b.d[i] = resize(b.d[i], n)
+		for j := range b.d[i] {
+			b.d[i][j] = 0
+		}
+	}
+	copy(b.d[0], y0)
+	b.f = resize(b.f, n)
+	sys.Func(b.f, t0, y0)
+
+	b.yPredict = resize(b.yPredict, n)
+	b.psi = resize(b.psi, n)
+	b.yNew = resize(b.yNew, n)
+	b.delta = resize(b.delta, n)
+	b.work = resize(b.work, n)
+	b.dy = mat.NewVecDense(n, nil)
+	b.rhs = mat.NewVecDense(n, nil)
+	b.jac = mat.NewDense(n, n, nil)
+	b.iter = mat.NewDense(n, n, nil)
+	sys.Jac(b.jac, t0, y0)
+	b.jacIsNew = true
+}
+
+// Step takes a single step. See the Method interface for more details.
+func (b *BDF) Step(y []float64, tEnd float64) (float64, error) {
+	sys := b.sys
+	if b.dir == 0 {
+		// Complete the initialization now that the
+		// direction of integration is known.
+		b.dir = math.Copysign(1, tEnd-b.t)
+		if b.hAbs == 0 {
+			b.hAbs = sys.InitialStep(b.t, b.d[0], b.f, 1, b.dir)
+		}
+		if maxStep := sys.MaxStep(); maxStep > 0 {
+			b.hAbs = math.Min(b.hAbs, maxStep)
+		}
+		floats.ScaleTo(b.d[1], b.dir*b.hAbs, b.f)
+	}
+
+	b.jacIsNew = false
+	t := b.t
+	minStep := 10 * math.Abs(math.Nextafter(t, b.dir*math.Inf(1))-t)
+	hAbs := b.hAbs
+	if maxStep := sys.MaxStep(); maxStep > 0 && hAbs > maxStep {
+		b.changeD(maxStep / hAbs)
+		hAbs = maxStep
+		b.nEqualSteps = 0
+	} else if hAbs < minStep {
+		b.changeD(minStep / hAbs)
+		hAbs = minStep
+		b.nEqualSteps = 0
+	}
+
+	order := b.order
+	var (
+		tNew    float64
+		nIter   int
+		errNorm float64
+	)
+	for {
+		if hAbs < minStep {
+			return t, ErrStepSizeTooSmall
+		}
+		h := b.dir * hAbs
+		tNew = t + h
+		if b.dir*(tNew-tEnd) > 0 {
+			tNew = tEnd
+			b.changeD(math.Abs(tNew-t) / hAbs)
+			b.nEqualSteps = 0
+			b.luValid = false
+		}
+		h = tNew - t
+		hAbs = math.Abs(h)
+
+		// Predict the solution and compute the
+		// constant part of the corrector equation.
+		for i := range b.yPredict {
+			b.yPredict[i] = 0
+			b.psi[i] = 0
+		}
+		for k := 0; k <= order; k++ {
+			floats.Add(b.yPredict, b.d[k])
+		}
+		for k := 1; k <= order; k++ {
+			floats.AddScaled(b.psi, b.gamma[k]/b.alpha[order], b.d[k])
+		}
+
+		c := h / b.alpha[order]
+		var converged bool
+		for {
+			if !b.luValid {
+				b.factorize(c)
+			}
+			converged, nIter = b.solveNewton(tNew, c)
+			if converged || b.jacIsNew {
+				break
+			}
+			sys.Jac(b.jac, tNew, b.yPredict)
+			b.jacIsNew = true
+			b.luValid = false
+		}
+		if !converged {
+			const factor = 0.5
+			hAbs *= factor
+			b.changeD(factor)
+			b.nEqualSteps = 0
+			b.luValid = false
+			sys.stats.RejectedSteps++
+			continue
+		}
+
+		sf := safety * float64(2*bdfNewtonMaxIter+1) / float64(2*bdfNewtonMaxIter+nIter)
+		floats.ScaleTo(b.work, b.errConst[order], b.delta)
+		errNorm = sys.ErrorNorm(b.work, b.yNew, b.yNew)
+		if errNorm > 1 {
+			factor := math.Max(minFactor, sf*math.Pow(errNorm, -1/float64(order+1)))
+			hAbs *= factor
+			b.changeD(factor)
+			b.nEqualSteps = 0
+			sys.stats.RejectedSteps++
+			continue
+		}
+
+		b.nEqualSteps++
+		b.t = tNew
+		copy(y, b.yNew)
+		b.hAbs = hAbs
+
+		// Update the differences. The principal relation is
+		// ∇^{j+1} y_n = ∇^j y_n - ∇^j y_{n-1}, and delta holds
+		// ∇^{k+1} y_n.
+		floats.SubTo(b.d[order+2], b.delta, b.d[order+1])
+		copy(b.d[order+1], b.delta)
+		for k := order; k >= 0; k-- {
+			floats.Add(b.d[k], b.d[k+1])
+		}
+
+		if b.nEqualSteps < order+1 {
+			return tNew, nil
+		}
+
+		// Consider changing the order.
+		errMNorm := math.Inf(1)
+		if order > 1 {
+			floats.ScaleTo(b.work, b.errConst[order-1], b.d[order])
+			errMNorm = sys.ErrorNorm(b.work, b.yNew, b.yNew)
+		}
+		errPNorm := math.Inf(1)
+		if order < bdfMaxOrder {
+			floats.ScaleTo(b.work, b.errConst[order+1], b.d[order+2])
+			errPNorm = sys.ErrorNorm(b.work, b.yNew, b.yNew)
+		}
+		best := 0
+		var bestFactor float64
+		for i, e := range [3]float64{errMNorm, errNorm, errPNorm} {
+			f := math.Pow(e, -1/float64(order+i))
+			if i == 0 || f > bestFactor {
+				best = i
+				bestFactor = f
+			}
+		}
+		b.order = order + best - 1
+		factor := math.Min(maxFactor, sf*bestFactor)
+		b.hAbs *= factor
+		b.changeD(factor)
+		b.nEqualSteps = 0
+		b.luValid = false
+		return tNew, nil
+	}
+}
+
+// factorize computes the LU decomposition of I - c⋅J.
+func (b *BDF) factorize(c float64) {
+	b.iter.Scale(-c, b.jac)
+	n, _ := b.iter.Dims()
+	for i := 0; i < n; i++ {
+		b.iter.Set(i, i, 1+b.iter.At(i, i))
+	}
+	b.lu.Factorize(b.iter)
+	b.luValid = true
+	b.sys.stats.LUDecompositions++
+}
+
+// solveNewton solves the corrector equation using a simplified Newton
+// iteration starting from the predicted solution. On return yNew holds the
+// solution and delta holds its difference from the prediction.
+func (b *BDF) solveNewton(t, c float64) (converged bool, iter int) {
+	copy(b.yNew, b.yPredict)
+	for i := range b.delta {
+		b.delta[i] = 0
+	}
+	var dyNormOld, rate float64
+	for k := 0; k < bdfNewtonMaxIter; k++ {
+		iter = k + 1
+		b.sys.Func(b.f, t, b.yNew)
+		for _, v := range b.f {
+			if math.IsInf(v, 0) || math.IsNaN(v) {
+				return false, iter
+			}
+		}
+		rhs := b.rhs.RawVector().Data
+		for i := range rhs {
+			rhs[i] = c*b.f[i] - b.psi[i] - b.delta[i]
+		}
+		err := b.lu.SolveVecTo(b.dy, false, b.rhs)
+		if err != nil {
+			return false, iter
+		}
+		dy := b.dy.RawVector().Data
+		dyNorm := b.sys.ErrorNorm(dy, b.yPredict, b.yPredict)
+		if k > 0 {
+			rate = dyNorm / dyNormOld
+			if rate >= 1 || math.Pow(rate, float64(bdfNewtonMaxIter-k))/(1-rate)*dyNorm > b.newtonTol {
+				return false, iter
+			}
+		}
+		floats.Add(b.yNew, dy)
+		floats.Add(b.delta, dy)
+		if dyNorm == 0 || k > 0 && rate/(1-rate)*dyNorm < b.newtonTol {
+			return true, iter
+		}
+		dyNormOld = dyNorm
+	}
+	return false, iter
+}
+
+// changeD changes the step size of the differences in d by the given factor.
+func (b *BDF) changeD(factor float64) {
+	order := b.order
+	r := bdfR(order, factor)
+	u := bdfR(order, 1)
+	var ru mat.Dense
+	ru.Mul(r, u)
+	n := len(b.d[0])
+	tmp := make([][]float64, order+1)
+	for i := range tmp {
+		tmp[i] = make([]float64, n)
+		for k := 0; k <= order; k++ {
+			if v := ru.At(k, i); v != 0 {
+				floats.AddScaled(tmp[i], v, b.d[k])
+			}
+		}
+	}
+	for i := range tmp {
+		copy(b.d[i], tmp[i])
+	}
+}
+
+// bdfR returns the matrix for changing the step size of the differences of
+// the given order by the given factor.
+func bdfR(order int, factor float64) *mat.Dense {
+	r := mat.NewDense(order+1, order+1, nil)
+	for j := 0; j <= order; j++ {
+		r.Set(0, j, 1)
+	}
+	for i := 1; i <= order; i++ {
+		for j := 0; j <= order; j++ {
+			var m float64
+			if j > 0 {
+				m = (float64(i) - 1 - factor*float64(j)) / float64(i)
+			}
+			r.Set(i, j, r.At(i-1, j)*m)
+		}
+	}
+	return r
+}
+
+// DenseOutput returns an interpolant over the last step. See the Method
+// interface for more details.
+func (b *BDF) DenseOutput() Interpolant {
+	d := make([][]float64, b.order+1)
+	for i := range d {
+		d[i] = append([]float64(nil), b.d[i]...)
+	}
+	return &bdfDense{
+		t:     b.t,
+		h:     b.dir * b.hAbs,
+		order: b.order,
+		d:     d,
+	}
+}
+
+// bdfDense is the interpolating polynomial of a BDF step.
+type bdfDense struct {
+	t, h  float64
+	order int
+	d     [][]float64
+}
+
+func (d *bdfDense) Interpolate(dst []float64, t float64) {
+	copy(dst, d.d[0])
+	p := 1.0
+	for i := 0; i < d.order; i++ {
+		p *= (t - (d.t - d.h*float64(i))) / (d.h * float64(i+1))
+		floats.AddScaled(dst, p, d.d[i+1])
+	}
+}
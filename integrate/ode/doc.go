@@ -0,0 +1,14 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ode provides methods for solving initial value problems for
+// systems of ordinary differential equations
+//
+//	dy/dt = f(t, y),  y(t0) = y0.
+//
+// The explicit Runge-Kutta methods DormandPrince5, BogackiShampine3 and
+// Tsitouras5 use embedded error estimates for adaptive step size control and
+// are suitable for non-stiff problems. The BDF method is an implicit variable
+// order, variable step method for stiff problems.
+package ode // import "gonum.org/v1/gonum/integrate/ode"
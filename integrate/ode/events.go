@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"sort"
+)
+
+// eventDetector locates the zero crossings of event functions.
+type eventDetector struct {
+	events []Event
+	g      []float64
+	work   []float64
+}
+
+func newEventDetector(events []Event, t0 float64, y0 []float64) *eventDetector {
+	if len(events) == 0 {
+		return &eventDetector{}
+	}
+	d := &eventDetector{
+		events: events,
+		g:      make([]float64, len(events)),
+		work:   make([]float64, len(y0)),
+	}
+	for i, ev := range events {
+		d.g[i] = ev.Func(t0, y0)
+	}
+	return d
+}
+
+// check evaluates the event functions at the end of the step from tOld to t
+// and returns the events that occurred during the step in order of
+// occurrence. If a terminal event occurred, it is the last of the returned
+// events and terminal is true. The interpolant over the step is obtained by
+// calling dense.
+func (d *eventDetector) check(tOld, t float64, y []float64, dense func() Interpolant) (occurred []EventOccurrence, terminal bool) {
+	if len(d.events) == 0 {
+		return nil, false
+	}
+	dir := math.Copysign(1, t-tOld)
+	for i, ev := range d.events {
+		gOld := d.g[i]
+		gNew := ev.Func(t, y)
+		d.g[i] = gNew
+		up := gOld < 0 && gNew >= 0
+		down := gOld > 0 && gNew <= 0
+		if dir < 0 {
+			// Increasing and decreasing are defined
+			// with respect to forward time.
+			up, down = down, up
+		}
+		if !(up && ev.Direction >= 0 || down && ev.Direction <= 0) {
+			continue
+		}
+		interp := dense()
+		te := d.locate(ev.Func, interp, tOld, t, gOld, gNew)
+		ye := make([]float64, len(y))
+		if te == t {
+			copy(ye, y)
+		} else {
+			interp.Interpolate(ye, te)
+		}
+		occurred = append(occurred, EventOccurrence{Index: i, T: te, Y: ye})
+	}
+	if len(occurred) == 0 {
+		return nil, false
+	}
+	sort.SliceStable(occurred, func(i, j int) bool {
+		return dir*(occurred[i].T-occurred[j].T) < 0
+	})
+	for k, e := range occurred {
+		if d.events[e.Index].Terminal {
+			return occurred[:k+1], true
+		}
+	}
+	return occurred, false
+}
+
+// locate returns the root of the event function g in the interval [a, b]
+// using the Illinois variant of the regula falsi method on the interpolant
+// of the solution. The values ga and gb must have opposite signs or gb must
+// be zero.
+func (d *eventDetector) locate(g func(t float64, y []float64) float64, interp Interpolant, a, b, ga, gb float64) float64 {
+	const maxIter = 100
+	if gb == 0 {
+		return b
+	}
+	side := 0
+	for iter := 0; iter < maxIter; iter++ {
+		if math.Abs(b-a) <= 4*dlamchE*math.Max(math.Abs(a), math.Abs(b)) {
+			break
+		}
+		c := (a*gb - b*ga) / (gb - ga)
+		if c == a || c == b {
+			c = a + (b-a)/2
+		}
+		interp.Interpolate(d.work, c)
+		gc := g(c, d.work)
+		switch {
+		case gc == 0:
+			return c
+		case math.Signbit(gc) == math.Signbit(gb):
+			b, gb = c, gc
+			if side == -1 {
+				ga /= 2
+			}
+			side = -1
+		default:
+			a, ga = c, gc
+			if side == 1 {
+				gb /= 2
+			}
+			side = 1
+		}
+	}
+	// Return the end of the bracket on the side of the crossing
+	// so that the event is reported once.
+	return b
+}
+
+// dlamchE is the machine epsilon. For IEEE this is 2^{-53}.
+const dlamchE = 1.0 / (1 << 53)
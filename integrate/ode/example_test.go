@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/integrate/ode"
+)
+
+func ExampleSolve() {
+	// Integrate the undamped pendulum equation
+	//  θ'' = -sin(θ)
+	// written as a first order system and find the times
+	// at which the pendulum passes through the vertical.
+	prob := ode.Problem{
+		Func: func(dydt []float64, t float64, y []float64) {
+			dydt[0] = y[1]
+			dydt[1] = -math.Sin(y[0])
+		},
+		Events: []ode.Event{{
+			Func: func(t float64, y []float64) float64 { return y[0] },
+		}},
+	}
+	settings := &ode.Settings{RelTol: 1e-8, AbsTol: 1e-10, DenseOutput: true}
+	res, err := ode.Solve(prob, []float64{1, 0}, 0, 10, settings, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(res.Status)
+	for _, ev := range res.Events {
+		fmt.Printf("θ = 0 at t = %.4f\n", ev.T)
+	}
+	y, err := res.Interpolate(nil, 5)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("θ(5) = %.4f\n", y[0])
+
+	// Output:
+	// Success
+	// θ = 0 at t = 1.6750
+	// θ = 0 at t = 5.0250
+	// θ = 0 at t = 8.3750
+	// θ(5) = -0.0240
+
+}
+
+func ExampleBDF() {
+	// Integrate the stiff Robertson chemical kinetics problem.
+	prob := ode.Problem{
+		Func: func(dydt []float64, t float64, y []float64) {
+			dydt[0] = -0.04*y[0] + 1e4*y[1]*y[2]
+			dydt[2] = 3e7 * y[1] * y[1]
+			dydt[1] = -dydt[0] - dydt[2]
+		},
+	}
+	settings := &ode.Settings{RelTol: 1e-6, AbsTol: 1e-10}
+	res, err := ode.Solve(prob, []float64{1, 0, 0}, 0, 40, settings, &ode.BDF{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	y := res.Y[len(res.Y)-1]
+	fmt.Printf("y(40) = [%.4f %.4e %.4f]\n", y[0], y[1], y[2])
+
+	// Output:
+	// y(40) = [0.7158 9.1856e-06 0.2842]
+
+}
@@ -0,0 +1,442 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultRelTol = 1e-6
+	defaultAbsTol = 1e-9
+)
+
+var (
+	// ErrStepSizeTooSmall signifies that a Method could not take a step
+	// satisfying the error tolerances because the step size became too
+	// small relative to the current time.
+	ErrStepSizeTooSmall = errors.New("ode: step size too small")
+
+	// ErrNoDenseOutput signifies that dense output was requested from a
+	// Result of an integration that did not record it.
+	ErrNoDenseOutput = errors.New("ode: dense output not recorded")
+)
+
+// Problem describes an initial value problem for a system of ordinary
+// differential equations dy/dt = f(t, y).
+type Problem struct {
+	// Func evaluates the right-hand side f(t, y) of the system and
+	// stores the result in dydt which will be the same length as y.
+	// Func must not modify y.
+	Func func(dydt []float64, t float64, y []float64)
+
+	// Jac evaluates the Jacobian ∂f/∂y of the right-hand side at (t, y)
+	// and stores the result in-place in jac which will be a square
+	// matrix with dimensions matching the length of y. Jac must not
+	// modify y. Jac is only used by implicit methods. If it is nil, the
+	// Jacobian is approximated by finite differences using fd.Jacobian.
+	Jac func(jac *mat.Dense, t float64, y []float64)
+
+	// Events holds the events to detect during the integration.
+	Events []Event
+}
+
+// Event describes an event that is located by finding the zero crossings of
+// an event function g(t, y) during the integration.
+type Event struct {
+	// Func evaluates the event function g(t, y). Func must not modify y.
+	Func func(t float64, y []float64) float64
+
+	// Direction specifies the direction of the zero crossings that
+	// trigger the event. If Direction is positive, only crossings where
+	// g increases trigger the event, if it is negative, only crossings
+	// where g decreases trigger the event, and if it is zero, all
+	// crossings trigger the event.
+	Direction int
+
+	// Terminal specifies whether the integration stops at the event.
+	Terminal bool
+}
+
+// EventOccurrence records the location of a triggered event.
+type EventOccurrence struct {
+	// Index is the index of the event in Problem.Events.
+	Index int
+
+	// T and Y are the time and the state at which the event occurred.
+	T float64
+	Y []float64
+}
+
+// Settings represents settings of the integration. See the field comments
+// for default values.
+type Settings struct {
+	// RelTol and AbsTol are the relative and absolute tolerances used
+	// for the local error control. A step is accepted if the root mean
+	// square of the local error estimate e weighted by
+	//  AbsTol + RelTol*max(|y_old|, |y_new|)
+	// is less than one. If RelTol is zero, a default value of 1e-6
+	// will be used. If AbsTol is zero, a default value of 1e-9 will be
+	// used.
+	RelTol, AbsTol float64
+
+	// InitStep is the magnitude of the initial step size. If it is
+	// zero, the initial step size is selected automatically.
+	InitStep float64
+
+	// MaxStep is the maximum allowed magnitude of the step size. If it
+	// is zero, the step size is not limited.
+	MaxStep float64
+
+	// MaxSteps is the maximum number of accepted steps. StepLimit status
+	// is returned if the number of steps equals or exceeds this value.
+	// If it equals zero, this setting has no effect.
+	MaxSteps int
+
+	// Runtime is the maximum runtime allowed. RuntimeLimit status is
+	// returned if the duration of the run is longer than this value.
+	// If it equals zero, this setting has no effect.
+	Runtime time.Duration
+
+	// DenseOutput specifies whether the interpolants of all steps are
+	// recorded so that the solution can be evaluated at any time within
+	// the integration interval by Result.Interpolate.
+	DenseOutput bool
+}
+
+// Result represents the solution of an initial value problem.
+type Result struct {
+	// T and Y hold the times and states at the accepted steps of the
+	// integration, starting with the initial time and state.
+	T []float64
+	Y [][]float64
+
+	// Events holds the triggered events in the order of their
+	// occurrence.
+	Events []EventOccurrence
+
+	Stats
+	Status Status
+
+	// dense holds the interpolants of each accepted step
+	// if dense output was requested.
+	dense []Interpolant
+}
+
+// Interpolate stores the solution at time t into dst and returns it. If dst is
+// nil, a new slice is allocated. Interpolate returns ErrNoDenseOutput if the
+// integration was run without Settings.DenseOutput. Interpolate will panic if
+// t is outside the integration interval.
+func (r *Result) Interpolate(dst []float64, t float64) ([]float64, error) {
+	if r.dense == nil {
+		return nil, ErrNoDenseOutput
+	}
+	n := len(r.Y[0])
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n {
+		panic("ode: mismatched slice length")
+	}
+	t0, t1 := r.T[0], r.T[len(r.T)-1]
+	dir := math.Copysign(1, t1-t0)
+	if dir*(t-t0) < 0 || dir*(t-t1) > 0 {
+		panic("ode: time outside integration interval")
+	}
+	if len(r.dense) == 0 {
+		copy(dst, r.Y[0])
+		return dst, nil
+	}
+	// Find the first step ending at or after t.
+	i := sort.Search(len(r.dense), func(i int) bool { return dir*(r.T[i+1]-t) >= 0 })
+	r.dense[min(i, len(r.dense)-1)].Interpolate(dst, t)
+	return dst, nil
+}
+
+// Stats contains the statistics of the integration.
+type Stats struct {
+	Steps            int           // Number of accepted steps
+	RejectedSteps    int           // Number of rejected steps
+	FuncEvaluations  int           // Number of evaluations of Func
+	JacEvaluations   int           // Number of evaluations of the Jacobian
+	LUDecompositions int           // Number of LU decompositions
+	Runtime          time.Duration // Total runtime of the integration
+}
+
+// Method is a method for the step-wise integration of a system of ordinary
+// differential equations.
+type Method interface {
+	// Init initializes the method to integrate sys starting from the
+	// initial time t0 and state y0. If h0 is not zero, it is the
+	// magnitude of the initial step size, otherwise the method selects
+	// the initial step size. Init must not retain y0.
+	Init(sys *System, t0 float64, y0 []float64, h0 float64)
+
+	// Step takes a single accepted step from the current time in the
+	// direction of tEnd without stepping past tEnd. The state at the new
+	// time is stored into y and the new time is returned. If the step
+	// cannot be taken, Step returns a non-nil error.
+	Step(y []float64, tEnd float64) (t float64, err error)
+
+	// DenseOutput returns an interpolant of the solution over the most
+	// recently accepted step. The returned Interpolant must not be
+	// affected by subsequent calls to Step.
+	DenseOutput() Interpolant
+}
+
+// Interpolant evaluates a continuous approximation of the solution.
+type Interpolant interface {
+	// Interpolate stores the approximate solution at time t into dst.
+	Interpolate(dst []float64, t float64)
+}
+
+// System is the system of ordinary differential equations integrated by a
+// Method. It evaluates the Problem functions, keeping count of the
+// evaluations, and provides the error control parameters from Settings.
+type System struct {
+	prob    Problem
+	dim     int
+	relTol  float64
+	absTol  float64
+	maxStep float64
+	stats   *Stats
+
+	f0 []float64
+}
+
+// Dim returns the dimension of the system.
+func (s *System) Dim() int {
+	return s.dim
+}
+
+// MaxStep returns the maximum allowed magnitude of the step size.
+func (s *System) MaxStep() float64 {
+	return s.maxStep
+}
+
+// Tolerances returns the relative and absolute tolerances of the local error
+// control.
+func (s *System) Tolerances() (relTol, absTol float64) {
+	return s.relTol, s.absTol
+}
+
+// Func evaluates the right-hand side f(t, y) of the system and stores the
+// result in dydt.
+func (s *System) Func(dydt []float64, t float64, y []float64) {
+	s.stats.FuncEvaluations++
+	s.prob.Func(dydt, t, y)
+}
+
+// Jac evaluates the Jacobian ∂f/∂y of the right-hand side at (t, y) and
+// stores the result in jac. If the Problem does not provide a Jacobian, it is
+// approximated using forward differences.
+func (s *System) Jac(jac *mat.Dense, t float64, y []float64) {
+	s.stats.JacEvaluations++
+	if s.prob.Jac != nil {
+		s.prob.Jac(jac, t, y)
+		return
+	}
+	if s.f0 == nil {
+		s.f0 = make([]float64, s.dim)
+	}
+	s.Func(s.f0, t, y)
+	fd.Jacobian(jac, func(dydt, y []float64) {
+		s.Func(dydt, t, y)
+	}, y, &fd.JacobianSettings{OriginValue: s.f0})
+}
+
+// ErrorNorm returns the root mean square norm of the local error estimate e
+// weighted by AbsTol + RelTol*max(|yOld|, |yNew|).
+func (s *System) ErrorNorm(e, yOld, yNew []float64) float64 {
+	var sum float64
+	for i, v := range e {
+		sc := s.absTol + s.relTol*math.Max(math.Abs(yOld[i]), math.Abs(yNew[i]))
+		v /= sc
+		sum += v * v
+	}
+	return math.Sqrt(sum / float64(len(e)))
+}
+
+// InitialStep returns an initial step size magnitude for a method of the
+// given order starting at (t0, y0) with derivative f0 and integrating in the
+// direction of the given sign.
+//
+// The algorithm is described in
+//   - Hairer, E., Nørsett, S. P., & Wanner, G. (1993). Section II.4 Automatic
+//     Step Size Control. In Solving Ordinary Differential Equations I: Nonstiff
+//     Problems (2nd ed.) (pp. 169). Berlin: Springer.
+func (s *System) InitialStep(t0 float64, y0, f0 []float64, order int, dir float64) float64 {
+	n := len(y0)
+	var d0, d1 float64
+	for i := range y0 {
+		sc := s.absTol + s.relTol*math.Abs(y0[i])
+		d0 += (y0[i] / sc) * (y0[i] / sc)
+		d1 += (f0[i] / sc) * (f0[i] / sc)
+	}
+	d0 = math.Sqrt(d0 / float64(n))
+	d1 = math.Sqrt(d1 / float64(n))
+	var h0 float64
+	if d0 < 1e-5 || d1 < 1e-5 {
+		h0 = 1e-6
+	} else {
+		h0 = 0.01 * d0 / d1
+	}
+	if s.maxStep > 0 {
+		h0 = math.Min(h0, s.maxStep)
+	}
+
+	y1 := make([]float64, n)
+	for i := range y1 {
+		y1[i] = y0[i] + dir*h0*f0[i]
+	}
+	f1 := make([]float64, n)
+	s.Func(f1, t0+dir*h0, y1)
+	var d2 float64
+	for i := range y0 {
+		sc := s.absTol + s.relTol*math.Abs(y0[i])
+		v := (f1[i] - f0[i]) / sc
+		d2 += v * v
+	}
+	d2 = math.Sqrt(d2/float64(n)) / h0
+
+	var h1 float64
+	if d1 <= 1e-15 && d2 <= 1e-15 {
+		h1 = math.Max(1e-6, h0*1e-3)
+	} else {
+		h1 = math.Pow(0.01/math.Max(d1, d2), 1/float64(order+1))
+	}
+	h := math.Min(100*h0, h1)
+	if s.maxStep > 0 {
+		h = math.Min(h, s.maxStep)
+	}
+	return h
+}
+
+// Solve integrates the initial value problem given by p and the initial
+// state y0 at time t0 up to the time tEnd using the given method. If tEnd is
+// less than t0, the integration proceeds backwards in time.
+//
+// If method is nil, DormandPrince5 is used. If settings is nil, default
+// settings are used.
+//
+// Solve returns a non-nil error if the Method fails or if the integration
+// ends early due to the limits in settings. In the latter case the error is
+// the Err of the returned Status. Solve will panic if p.Func is nil, if y0
+// is empty or if tEnd equals t0.
+func Solve(p Problem, y0 []float64, t0, tEnd float64, settings *Settings, method Method) (*Result, error) {
+	start := time.Now()
+	if p.Func == nil {
+		panic("ode: problem has no Func")
+	}
+	n := len(y0)
+	if n == 0 {
+		panic("ode: zero dimensional input")
+	}
+	if t0 == tEnd {
+		panic("ode: empty integration interval")
+	}
+	for _, ev := range p.Events {
+		if ev.Func == nil {
+			panic("ode: event has no Func")
+		}
+	}
+
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	if s.RelTol == 0 {
+		s.RelTol = defaultRelTol
+	}
+	if s.AbsTol == 0 {
+		s.AbsTol = defaultAbsTol
+	}
+	if s.RelTol < 0 || s.AbsTol < 0 || s.InitStep < 0 || s.MaxStep < 0 {
+		panic("ode: negative setting")
+	}
+	if method == nil {
+		method = &DormandPrince5{}
+	}
+
+	res := &Result{
+		T: []float64{t0},
+		Y: [][]float64{append([]float64(nil), y0...)},
+	}
+	if s.DenseOutput {
+		res.dense = []Interpolant{}
+	}
+	sys := &System{
+		prob:    p,
+		dim:     n,
+		relTol:  s.RelTol,
+		absTol:  s.AbsTol,
+		maxStep: s.MaxStep,
+		stats:   &res.Stats,
+	}
+
+	events := newEventDetector(p.Events, t0, y0)
+
+	var err error
+	method.Init(sys, t0, y0, s.InitStep)
+	dir := math.Copysign(1, tEnd-t0)
+	t := t0
+	y := make([]float64, n)
+	for res.Status == NotTerminated {
+		tOld := t
+		t, err = method.Step(y, tEnd)
+		if err != nil {
+			res.Status = Failure
+			break
+		}
+		res.Steps++
+
+		var dense Interpolant
+		if s.DenseOutput {
+			dense = method.DenseOutput()
+		}
+		occurred, terminal := events.check(tOld, t, y, func() Interpolant {
+			if dense == nil {
+				dense = method.DenseOutput()
+			}
+			return dense
+		})
+		res.Events = append(res.Events, occurred...)
+		if terminal {
+			last := occurred[len(occurred)-1]
+			t = last.T
+			copy(y, last.Y)
+			res.Status = EventTermination
+		}
+
+		res.T = append(res.T, t)
+		res.Y = append(res.Y, append([]float64(nil), y...))
+		if s.DenseOutput {
+			res.dense = append(res.dense, dense)
+		}
+
+		if res.Status != NotTerminated {
+			break
+		}
+		switch {
+		case dir*(t-tEnd) >= 0:
+			res.Status = Success
+		case s.MaxSteps > 0 && res.Steps >= s.MaxSteps:
+			res.Status = StepLimit
+		case s.Runtime > 0 && time.Since(start) > s.Runtime:
+			res.Status = RuntimeLimit
+		}
+	}
+	res.Runtime = time.Since(start)
+	if err == nil {
+		err = res.Status.Err()
+	}
+	return res, err
+}
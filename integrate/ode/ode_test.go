@@ -0,0 +1,332 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+type methodCase struct {
+	name  string
+	new   func() Method
+	stiff bool
+}
+
+var methods = []methodCase{
+	{name: "DormandPrince5", new: func() Method { return &DormandPrince5{} }},
+	{name: "BogackiShampine3", new: func() Method { return &BogackiShampine3{} }},
+	{name: "Tsitouras5", new: func() Method { return &Tsitouras5{} }},
+	{name: "BDF", new: func() Method { return &BDF{} }, stiff: true},
+}
+
+type exactCase struct {
+	name   string
+	prob   Problem
+	y0     []float64
+	t0, t1 float64
+	exact  func(t float64) []float64
+}
+
+var exactCases = []exactCase{
+	{
+		name: "exponential decay",
+		prob: Problem{
+			Func: func(dydt []float64, t float64, y []float64) {
+				dydt[0] = -0.5 * y[0]
+			},
+			Jac: func(jac *mat.Dense, t float64, y []float64) {
+				jac.Set(0, 0, -0.5)
+			},
+		},
+		y0: []float64{2},
+		t0: 0, t1: 10,
+		exact: func(t float64) []float64 { return []float64{2 * math.Exp(-0.5*t)} },
+	},
+	{
+		name: "harmonic oscillator",
+		prob: Problem{
+			Func: func(dydt []float64, t float64, y []float64) {
+				dydt[0] = y[1]
+				dydt[1] = -y[0]
+			},
+		},
+		y0: []float64{1, 0},
+		t0: 0, t1: 2 * math.Pi,
+		exact: func(t float64) []float64 { return []float64{math.Cos(t), -math.Sin(t)} },
+	},
+	{
+		name: "backward harmonic oscillator",
+		prob: Problem{
+			Func: func(dydt []float64, t float64, y []float64) {
+				dydt[0] = y[1]
+				dydt[1] = -y[0]
+			},
+		},
+		y0: []float64{1, 0},
+		t0: 0, t1: -3,
+		exact: func(t float64) []float64 { return []float64{math.Cos(t), -math.Sin(t)} },
+	},
+	{
+		name: "non-autonomous",
+		prob: Problem{
+			Func: func(dydt []float64, t float64, y []float64) {
+				dydt[0] = -2 * t * y[0]
+			},
+		},
+		y0: []float64{1},
+		t0: 0, t1: 2,
+		exact: func(t float64) []float64 { return []float64{math.Exp(-t * t)} },
+	},
+}
+
+func TestSolveExact(t *testing.T) {
+	t.Parallel()
+	for _, m := range methods {
+		for _, test := range exactCases {
+			for _, relTol := range []float64{1e-4, 1e-8} {
+				settings := &Settings{RelTol: relTol, AbsTol: relTol * 1e-2, DenseOutput: true}
+				res, err := Solve(test.prob, test.y0, test.t0, test.t1, settings, m.new())
+				if err != nil {
+					t.Errorf("%s %s tol=%g: unexpected error: %v", m.name, test.name, relTol, err)
+					continue
+				}
+				if res.Status != Success {
+					t.Errorf("%s %s tol=%g: unexpected status: got %v want %v", m.name, test.name, relTol, res.Status, Success)
+				}
+				if res.T[len(res.T)-1] != test.t1 {
+					t.Errorf("%s %s tol=%g: integration did not end at %v, got %v", m.name, test.name, relTol, test.t1, res.T[len(res.T)-1])
+				}
+				if len(res.T) != res.Steps+1 || len(res.Y) != len(res.T) {
+					t.Errorf("%s %s tol=%g: mismatched result lengths", m.name, test.name, relTol)
+				}
+				// The global error is allowed to be somewhat
+				// larger than the local tolerance.
+				tol := 200 * relTol
+				for i, ti := range res.T {
+					want := test.exact(ti)
+					for j, v := range res.Y[i] {
+						if !scalar.EqualWithinAbsOrRel(v, want[j], tol, tol) {
+							t.Errorf("%s %s tol=%g: unexpected solution at t=%v: got %v want %v", m.name, test.name, relTol, ti, res.Y[i], want)
+							break
+						}
+					}
+				}
+				dst := make([]float64, len(test.y0))
+				for k := 0; k <= 50; k++ {
+					ti := test.t0 + (test.t1-test.t0)*float64(k)/50
+					_, err := res.Interpolate(dst, ti)
+					if err != nil {
+						t.Fatalf("%s %s tol=%g: unexpected interpolation error: %v", m.name, test.name, relTol, err)
+					}
+					want := test.exact(ti)
+					for j, v := range dst {
+						if !scalar.EqualWithinAbsOrRel(v, want[j], 2*tol, 2*tol) {
+							t.Errorf("%s %s tol=%g: unexpected dense output at t=%v: got %v want %v", m.name, test.name, relTol, ti, dst, want)
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSolveEvents(t *testing.T) {
+	t.Parallel()
+	// A ball falling from a height of 10 under unit gravity. The
+	// analytic solution is y(t) = 10 - t²/2.
+	ball := func(dydt []float64, t float64, y []float64) {
+		dydt[0] = y[1]
+		dydt[1] = -1
+	}
+	tGround := math.Sqrt(20)
+	for _, m := range methods {
+		// Non-terminal events in both directions for the oscillator
+		// x(t) = cos(t) crossing zero at π/2 (decreasing) and 3π/2
+		// (increasing).
+		osc := Problem{
+			Func: func(dydt []float64, t float64, y []float64) {
+				dydt[0] = y[1]
+				dydt[1] = -y[0]
+			},
+			Events: []Event{
+				{Func: func(t float64, y []float64) float64 { return y[0] }, Direction: -1},
+				{Func: func(t float64, y []float64) float64 { return y[0] }, Direction: 1},
+				{Func: func(t float64, y []float64) float64 { return y[1] }},
+			},
+		}
+		res, err := Solve(osc, []float64{1, 0}, 0, 2*math.Pi-0.1, &Settings{RelTol: 1e-8, AbsTol: 1e-10}, m.new())
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", m.name, err)
+			continue
+		}
+		want := []struct {
+			index int
+			t     float64
+		}{
+			{0, math.Pi / 2},
+			{2, math.Pi},
+			{1, 3 * math.Pi / 2},
+		}
+		if len(res.Events) != len(want) {
+			t.Errorf("%s: unexpected number of events: got %d want %d", m.name, len(res.Events), len(want))
+		} else {
+			for i, ev := range res.Events {
+				if ev.Index != want[i].index || !scalar.EqualWithinAbs(ev.T, want[i].t, 1e-6) {
+					t.Errorf("%s: unexpected event %d: got index %d at %v, want index %d at %v", m.name, i, ev.Index, ev.T, want[i].index, want[i].t)
+				}
+			}
+		}
+		if res.Status != Success {
+			t.Errorf("%s: unexpected status: got %v want %v", m.name, res.Status, Success)
+		}
+
+		// A terminal event stops the integration.
+		prob := Problem{
+			Func: ball,
+			Events: []Event{{
+				Func:      func(t float64, y []float64) float64 { return y[0] },
+				Direction: -1,
+				Terminal:  true,
+			}},
+		}
+		res, err = Solve(prob, []float64{10, 0}, 0, 100, &Settings{RelTol: 1e-8, AbsTol: 1e-10}, m.new())
+		if err != nil {
+			t.Errorf("%s: unexpected error for terminal event: %v", m.name, err)
+			continue
+		}
+		if res.Status != EventTermination {
+			t.Errorf("%s: unexpected status: got %v want %v", m.name, res.Status, EventTermination)
+		}
+		if len(res.Events) != 1 {
+			t.Errorf("%s: unexpected number of terminal events: got %d want 1", m.name, len(res.Events))
+			continue
+		}
+		tEnd := res.T[len(res.T)-1]
+		if !scalar.EqualWithinAbs(res.Events[0].T, tGround, 1e-6) || tEnd != res.Events[0].T {
+			t.Errorf("%s: unexpected terminal event time: got %v (end %v) want %v", m.name, res.Events[0].T, tEnd, tGround)
+		}
+		if !scalar.EqualWithinAbs(res.Y[len(res.Y)-1][0], 0, 1e-6) {
+			t.Errorf("%s: unexpected state at terminal event: got %v", m.name, res.Y[len(res.Y)-1])
+		}
+	}
+}
+
+func TestSolveStiff(t *testing.T) {
+	t.Parallel()
+	// Robertson's chemical kinetics problem.
+	robertson := Problem{
+		Func: func(dydt []float64, t float64, y []float64) {
+			dydt[0] = -0.04*y[0] + 1e4*y[1]*y[2]
+			dydt[2] = 3e7 * y[1] * y[1]
+			dydt[1] = -dydt[0] - dydt[2]
+		},
+	}
+	robertsonJac := func(jac *mat.Dense, t float64, y []float64) {
+		jac.Set(0, 0, -0.04)
+		jac.Set(0, 1, 1e4*y[2])
+		jac.Set(0, 2, 1e4*y[1])
+		jac.Set(2, 0, 0)
+		jac.Set(2, 1, 6e7*y[1])
+		jac.Set(2, 2, 0)
+		for j := 0; j < 3; j++ {
+			jac.Set(1, j, -jac.At(0, j)-jac.At(2, j))
+		}
+	}
+	// Reference solution at t=40 computed with a high accuracy
+	// stiff solver.
+	want := []float64{0.7158270687193267, 9.185512144662135e-6, 0.2841637457685283}
+
+	for _, withJac := range []bool{false, true} {
+		prob := robertson
+		if withJac {
+			prob.Jac = robertsonJac
+		}
+		res, err := Solve(prob, []float64{1, 0, 0}, 0, 40, &Settings{RelTol: 1e-6, AbsTol: 1e-10}, &BDF{})
+		if err != nil {
+			t.Fatalf("Robertson withJac=%t: unexpected error: %v", withJac, err)
+		}
+		got := res.Y[len(res.Y)-1]
+		for i := range got {
+			if !scalar.EqualWithinAbsOrRel(got[i], want[i], 1e-9, 1e-3) {
+				t.Errorf("Robertson withJac=%t: unexpected solution: got %v want %v", withJac, got, want)
+				break
+			}
+		}
+		// An explicit method would need many thousands of steps.
+		if res.Steps > 500 {
+			t.Errorf("Robertson withJac=%t: too many steps for stiff solver: %d", withJac, res.Steps)
+		}
+		if res.LUDecompositions == 0 || res.JacEvaluations == 0 {
+			t.Errorf("Robertson withJac=%t: missing statistics: %+v", withJac, res.Stats)
+		}
+	}
+
+	// Van der Pol oscillator with μ = 1000.
+	const mu = 1000
+	vdp := Problem{
+		Func: func(dydt []float64, t float64, y []float64) {
+			dydt[0] = y[1]
+			dydt[1] = mu*(1-y[0]*y[0])*y[1] - y[0]
+		},
+		Jac: func(jac *mat.Dense, t float64, y []float64) {
+			jac.Set(0, 0, 0)
+			jac.Set(0, 1, 1)
+			jac.Set(1, 0, -2*mu*y[0]*y[1]-1)
+			jac.Set(1, 1, mu*(1-y[0]*y[0]))
+		},
+	}
+	res, err := Solve(vdp, []float64{2, 0}, 0, 3000, nil, &BDF{})
+	if err != nil {
+		t.Fatalf("van der Pol: unexpected error: %v", err)
+	}
+	// The solution is a relaxation oscillation
+	// with a period of approximately 1614.
+	got := res.Y[len(res.Y)-1][0]
+	if got > -1 || got < -2.1 {
+		t.Errorf("van der Pol: unexpected solution at t=3000: got %v", got)
+	}
+	if res.Steps > 2000 {
+		t.Errorf("van der Pol: too many steps for stiff solver: %d", res.Steps)
+	}
+}
+
+func TestSolveLimits(t *testing.T) {
+	t.Parallel()
+	prob := Problem{
+		Func: func(dydt []float64, t float64, y []float64) {
+			dydt[0] = y[1]
+			dydt[1] = -y[0]
+		},
+	}
+	for _, m := range methods {
+		res, err := Solve(prob, []float64{1, 0}, 0, 100, &Settings{MaxSteps: 5}, m.new())
+		if err != StepLimit.Err() {
+			t.Errorf("%s: unexpected error: got %v want %v", m.name, err, StepLimit.Err())
+		}
+		if res.Status != StepLimit || res.Steps != 5 {
+			t.Errorf("%s: unexpected result: status %v after %d steps", m.name, res.Status, res.Steps)
+		}
+
+		res, err = Solve(prob, []float64{1, 0}, 0, 10, &Settings{MaxStep: 0.1}, m.new())
+		if err != nil {
+			t.Errorf("%s: unexpected error with MaxStep: %v", m.name, err)
+			continue
+		}
+		for i := 1; i < len(res.T); i++ {
+			if res.T[i]-res.T[i-1] > 0.1*(1+1e-12) {
+				t.Errorf("%s: step exceeds MaxStep: %v", m.name, res.T[i]-res.T[i-1])
+				break
+			}
+		}
+		if _, err := res.Interpolate(nil, 1); err != ErrNoDenseOutput {
+			t.Errorf("%s: unexpected Interpolate error: got %v want %v", m.name, err, ErrNoDenseOutput)
+		}
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// Step size control parameters.
+const (
+	safety    = 0.9
+	minFactor = 0.2
+	maxFactor = 10
+)
+
+var (
+	_ Method = (*DormandPrince5)(nil)
+	_ Method = (*BogackiShampine3)(nil)
+	_ Method = (*Tsitouras5)(nil)
+)
+
+// DormandPrince5 is the explicit Runge-Kutta method of order 5(4) of Dormand
+// and Prince. The step size is controlled assuming the accuracy of the fourth
+// order method, but steps are taken using the fifth order accurate formula.
+// Dense output is provided by a fourth order accurate interpolant.
+//
+// References:
+//   - Dormand, J. R., & Prince, P. J. (1980). A family of embedded
+//     Runge-Kutta formulae. Journal of Computational and Applied
+//     Mathematics, 6(1), 19-26. https://doi.org/10.1016/0771-050X(80)90013-3
+//   - Shampine, L. F. (1986). Some practical Runge-Kutta formulas.
+//     Mathematics of Computation, 46(173), 135-150.
+//     https://doi.org/10.2307/2008219
+type DormandPrince5 struct {
+	rk explicitRK
+}
+
+// Init initializes the method. See the Method interface for more details.
+func (m *DormandPrince5) Init(sys *System, t0 float64, y0 []float64, h0 float64) {
+	m.rk.init(&dormandPrince5, sys, t0, y0, h0)
+}
+
+// Step takes a single step. See the Method interface for more details.
+func (m *DormandPrince5) Step(y []float64, tEnd float64) (float64, error) {
+	return m.rk.step(y, tEnd)
+}
+
+// DenseOutput returns an interpolant over the last step. See the Method
+// interface for more details.
+func (m *DormandPrince5) DenseOutput() Interpolant {
+	return m.rk.denseOutput()
+}
+
+// BogackiShampine3 is the explicit Runge-Kutta method of order 3(2) of
+// Bogacki and Shampine. The step size is controlled assuming the accuracy of
+// the second order method, but steps are taken using the third order accurate
+// formula. Dense output is provided by cubic Hermite interpolation.
+//
+// References:
+//   - Bogacki, P., & Shampine, L. F. (1989). A 3(2) pair of Runge-Kutta
+//     formulas. Applied Mathematics Letters, 2(4), 321-325.
+//     https://doi.org/10.1016/0893-9659(89)90079-7
+type BogackiShampine3 struct {
+	rk explicitRK
+}
+
+// Init initializes the method. See the Method interface for more details.
+func (m *BogackiShampine3) Init(sys *System, t0 float64, y0 []float64, h0 float64) {
+	m.rk.init(&bogackiShampine3, sys, t0, y0, h0)
+}
+
+// Step takes a single step. See the Method interface for more details.
+func (m *BogackiShampine3) Step(y []float64, tEnd float64) (float64, error) {
+	return m.rk.step(y, tEnd)
+}
+
+// DenseOutput returns an interpolant over the last step. See the Method
+// interface for more details.
+func (m *BogackiShampine3) DenseOutput() Interpolant {
+	return m.rk.denseOutput()
+}
+
+// Tsitouras5 is the explicit Runge-Kutta method of order 5(4) of Tsitouras.
+// The step size is controlled assuming the accuracy of the fourth order
+// method, but steps are taken using the fifth order accurate formula. Dense
+// output is provided by cubic Hermite interpolation.
+//
+// References:
+//   - Tsitouras, Ch. (2011). Runge-Kutta pairs of order 5(4) satisfying only
+//     the first column simplifying assumption. Computers & Mathematics with
+//     Applications, 62(2), 770-775. https://doi.org/10.1016/j.camwa.2011.06.002
+type Tsitouras5 struct {
+	rk explicitRK
+}
+
+// Init initializes the method. See the Method interface for more details.
+func (m *Tsitouras5) Init(sys *System, t0 float64, y0 []float64, h0 float64) {
+	m.rk.init(&tsitouras5, sys, t0, y0, h0)
+}
+
+// Step takes a single step. See the Method interface for more details.
+func (m *Tsitouras5) Step(y []float64, tEnd float64) (float64, error) {
+	return m.rk.step(y, tEnd)
+}
+
+// DenseOutput returns an interpolant over the last step. See the Method
+// interface for more details.
+func (m *Tsitouras5) DenseOutput() Interpolant {
+	return m.rk.denseOutput()
+}
+
+// tableau holds the coefficients of an embedded explicit Runge-Kutta pair
+// with the first same as last property.
+type tableau struct {
+	// c, a and b are the coefficients of the stages
+	// before the final function evaluation.
+	c []float64
+	a [][]float64
+	b []float64
+
+	// e holds the coefficients of the error estimate
+	// for all stages including the final evaluation.
+	e []float64
+
+	// order is the order of the error estimator.
+	order int
+
+	// p holds the coefficients of the dense output
+	// polynomials for each stage. If p is nil, cubic
+	// Hermite interpolation is used.
+	p [][]float64
+}
+
+var dormandPrince5 = tableau{
+	c: []float64{0, 1.0 / 5, 3.0 / 10, 4.0 / 5, 8.0 / 9, 1},
+	a: [][]float64{
+		{},
+		{1.0 / 5},
+		{3.0 / 40, 9.0 / 40},
+		{44.0 / 45, -56.0 / 15, 32.0 / 9},
+		{19372.0 / 6561, -25360.0 / 2187, 64448.0 / 6561, -212.0 / 729},
+		{9017.0 / 3168, -355.0 / 33, 46732.0 / 5247, 49.0 / 176, -5103.0 / 18656},
+	},
+	b: []float64{35.0 / 384, 0, 500.0 / 1113, 125.0 / 192, -2187.0 / 6784, 11.0 / 84},
+	e: []float64{
+		35.0/384 - 5179.0/57600,
+		0,
+		500.0/1113 - 7571.0/16695,
+		125.0/192 - 393.0/640,
+		-2187.0/6784 + 92097.0/339200,
+		11.0/84 - 187.0/2100,
+		-1.0 / 40,
+	},
+	order: 4,
+	p: [][]float64{
+		{1, -8048581381.0 / 2820520608, 8663915743.0 / 2820520608, -12715105075.0 / 11282082432},
+		{0, 0, 0, 0},
+		{0, 131558114200.0 / 32700410799, -68118460800.0 / 10900136933, 87487479700.0 / 32700410799},
+		{0, -1754552775.0 / 470086768, 14199869525.0 / 1410260304, -10690763975.0 / 1880347072},
+		{0, 127303824393.0 / 49829197408, -318862633887.0 / 49829197408, 701980252875.0 / 199316789632},
+		{0, -282668133.0 / 205662961, 2019193451.0 / 616988883, -1453857185.0 / 822651844},
+		{0, 40617522.0 / 29380423, -110615467.0 / 29380423, 69997945.0 / 29380423},
+	},
+}
+
+var bogackiShampine3 = tableau{
+	c: []float64{0, 1.0 / 2, 3.0 / 4},
+	a: [][]float64{
+		{},
+		{1.0 / 2},
+		{0, 3.0 / 4},
+	},
+	b: []float64{2.0 / 9, 1.0 / 3, 4.0 / 9},
+	e: []float64{
+		2.0/9 - 7.0/24,
+		1.0/3 - 1.0/4,
+		4.0/9 - 1.0/3,
+		-1.0 / 8,
+	},
+	order: 2,
+}
+
+var tsitouras5 = tableau{
+	c: []float64{0, 0.161, 0.327, 0.9, 0.9800255409045097, 1},
+	a: [][]float64{
+		{},
+		{0.161},
+		{-0.008480655492356989, 0.335480655492357},
+		{2.897153057105493, -6.359448489975075, 4.3622954328695815},
+		{5.325864828439257, -11.748883564062828, 7.4955393428898365, -0.09249506636175525},
+		{5.86145544294642, -12.92096931784711, 8.159367898576159, -0.071584973281401, -0.028269050394068383},
+	},
+	b: []float64{0.09646076681806523, 0.01, 0.4798896504144996, 1.379008574103742, -3.290069515436081, 2.324710524099774},
+	e: []float64{
+		-0.00178001105222577714,
+		-0.0008164344596567469,
+		0.007880878010261995,
+		-0.1447110071732629,
+		0.5823571654525552,
+		-0.45808210592918697,
+		1.0 / 66,
+	},
+	order: 4,
+}
+
+// explicitRK implements an embedded explicit Runge-Kutta method with the
+// first same as last property and adaptive step size control.
+type explicitRK struct {
+	tab *tableau
+	sys *System
+
+	dir  float64
+	hAbs float64
+
+	t, tOld float64
+	y, yOld []float64
+	f, fOld []float64
+
+	k    [][]float64
+	work []float64
+	err  []float64
+}
+
+func (rk *explicitRK) init(tab *tableau, sys *System, t0 float64, y0 []float64, h0 float64) {
+	n := len(y0)
+	if n != sys.Dim() {
+		panic("ode: mismatched dimension")
+	}
+	rk.tab = tab
+	rk.sys = sys
+	rk.t = t0
+	rk.y = resize(rk.y, n)
+	copy(rk.y, y0)
+	rk.yOld = resize(rk.yOld, n)
+	rk.f = resize(rk.f, n)
+	rk.fOld = resize(rk.fOld, n)
+	rk.work = resize(rk.work, n)
+	rk.err = resize(rk.err, n)
+	stages := len(tab.b) + 1
+	if cap(rk.k) < stages {
+		rk.k = make([][]float64, stages)
+	}
+	rk.k = rk.k[:stages]
+	for i := range rk.k {
+		rk.k[i] = resize(rk.k[i], n)
+	}
+	sys.Func(rk.f, t0, rk.y)
+	rk.dir = 0
+	rk.hAbs = h0
+}
+
+func (rk *explicitRK) step(y []float64, tEnd float64) (float64, error) {
+	sys := rk.sys
+	if rk.dir == 0 {
+		rk.dir = math.Copysign(1, tEnd-rk.t)
+		if rk.hAbs == 0 {
+			rk.hAbs = sys.InitialStep(rk.t, rk.y, rk.f, rk.tab.order, rk.dir)
+		}
+	}
+	if maxStep := sys.MaxStep(); maxStep > 0 {
+		rk.hAbs = math.Min(rk.hAbs, maxStep)
+	}
+	minStep := 10 * math.Abs(math.Nextafter(rk.t, rk.dir*math.Inf(1))-rk.t)
+	rk.hAbs = math.Max(rk.hAbs, minStep)
+
+	tab := rk.tab
+	exp := -1 / float64(tab.order+1)
+	s := len(tab.b)
+	rejected := false
+	for {
+		if rk.hAbs < minStep {
+			return rk.t, ErrStepSizeTooSmall
+		}
+		tNew := rk.t + rk.dir*rk.hAbs
+		if rk.dir*(tNew-tEnd) > 0 {
+			tNew = tEnd
+		}
+		h := tNew - rk.t
+		hAbs := math.Abs(h)
+
+		// Compute the stages.
+		copy(rk.k[0], rk.f)
+		for i := 1; i < s; i++ {
+			copy(rk.work, rk.y)
+			for j, aij := range tab.a[i] {
+				if aij != 0 {
+					floats.AddScaled(rk.work, h*aij, rk.k[j])
+				}
+			}
+			sys.Func(rk.k[i], rk.t+tab.c[i]*h, rk.work)
+		}
+		copy(y, rk.y)
+		for j, bj := range tab.b {
+			if bj != 0 {
+				floats.AddScaled(y, h*bj, rk.k[j])
+			}
+		}
+		sys.Func(rk.k[s], tNew, y)
+
+		// Estimate the local error.
+		for i := range rk.err {
+			rk.err[i] = 0
+		}
+		for j, ej := range tab.e {
+			if ej != 0 {
+				floats.AddScaled(rk.err, h*ej, rk.k[j])
+			}
+		}
+		errNorm := sys.ErrorNorm(rk.err, rk.y, y)
+
+		if errNorm < 1 {
+			factor := float64(maxFactor)
+			if errNorm != 0 {
+				factor = math.Min(maxFactor, safety*math.Pow(errNorm, exp))
+			}
+			if rejected {
+				factor = math.Min(1, factor)
+			}
+			rk.hAbs = hAbs * factor
+
+			rk.tOld = rk.t
+			copy(rk.yOld, rk.y)
+			copy(rk.fOld, rk.f)
+			rk.t = tNew
+			copy(rk.y, y)
+			copy(rk.f, rk.k[s])
+			return tNew, nil
+		}
+		rk.hAbs = hAbs * math.Max(minFactor, safety*math.Pow(errNorm, exp))
+		rejected = true
+		sys.stats.RejectedSteps++
+	}
+}
+
+func (rk *explicitRK) denseOutput() Interpolant {
+	h := rk.t - rk.tOld
+	if rk.tab.p == nil {
+		return &hermite{
+			t0: rk.tOld,
+			h:  h,
+			y0: append([]float64(nil), rk.yOld...),
+			y1: append([]float64(nil), rk.y...),
+			f0: append([]float64(nil), rk.fOld...),
+			f1: append([]float64(nil), rk.f...),
+		}
+	}
+	// Compute the coefficients of the interpolating
+	// polynomial in θ = (t-t_old)/h.
+	n := len(rk.y)
+	q := make([][]float64, len(rk.tab.p[0]))
+	for i := range q {
+		q[i] = make([]float64, n)
+		for j, k := range rk.k {
+			if pji := rk.tab.p[j][i]; pji != 0 {
+				floats.AddScaled(q[i], h*pji, k)
+			}
+		}
+	}
+	return &rkDense{
+		t0: rk.tOld,
+		h:  h,
+		y0: append([]float64(nil), rk.yOld...),
+		q:  q,
+	}
+}
+
+// rkDense is the continuous extension of a Runge-Kutta step
+//
+//	y(t0 + θh) = y0 + Σ_i q_i θ^{i+1}.
+type rkDense struct {
+	t0, h float64
+	y0    []float64
+	q     [][]float64
+}
+
+func (d *rkDense) Interpolate(dst []float64, t float64) {
+	theta := (t - d.t0) / d.h
+	copy(dst, d.y0)
+	p := theta
+	for _, qi := range d.q {
+		floats.AddScaled(dst, p, qi)
+		p *= theta
+	}
+}
+
+// hermite is the cubic Hermite interpolant of a step given the values and
+// derivatives at both ends.
+type hermite struct {
+	t0, h  float64
+	y0, y1 []float64
+	f0, f1 []float64
+}
+
+func (d *hermite) Interpolate(dst []float64, t float64) {
+	theta := (t - d.t0) / d.h
+	theta2 := theta * theta
+	theta3 := theta2 * theta
+	h00 := 2*theta3 - 3*theta2 + 1
+	h10 := theta3 - 2*theta2 + theta
+	h01 := -2*theta3 + 3*theta2
+	h11 := theta3 - theta2
+	for i := range dst {
+		dst[i] = h00*d.y0[i] + h10*d.h*d.f0[i] + h01*d.y1[i] + h11*d.h*d.f1[i]
+	}
+}
+
+// resize takes x and returns a slice of length dim. It returns a resliced x
+// if cap(x) >= dim, and a new slice otherwise.
+func resize(x []float64, dim int) []float64 {
+	if dim > cap(x) {
+		return make([]float64, dim)
+	}
+	return x[:dim]
+}
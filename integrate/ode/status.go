@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import "errors"
+
+// Status represents the status of the integration. Programs should not rely
+// on the underlying numeric value of the Status being constant.
+type Status int
+
+const (
+	NotTerminated Status = iota
+	Success
+	EventTermination
+	Failure
+	StepLimit
+	RuntimeLimit
+)
+
+func (s Status) String() string {
+	return statuses[s].name
+}
+
+// Early returns true if the status indicates the integration ended before
+// the end of the integration interval was reached for a reason other than a
+// terminal event.
+func (s Status) Early() bool {
+	return statuses[s].early
+}
+
+// Err returns the error associated with an early ending to the integration.
+// If Early returns false, Err will return nil.
+func (s Status) Err() error {
+	return statuses[s].err
+}
+
+var statuses = []struct {
+	name  string
+	early bool
+	err   error
+}{
+	{
+		name: "NotTerminated",
+	},
+	{
+		name: "Success",
+	},
+	{
+		name: "EventTermination",
+	},
+	{
+		name:  "Failure",
+		early: true,
+		err:   errors.New("ode: termination ended in failure"),
+	},
+	{
+		name:  "StepLimit",
+		early: true,
+		err:   errors.New("ode: maximum number of steps reached"),
+	},
+	{
+		name:  "RuntimeLimit",
+		early: true,
+		err:   errors.New("ode: maximum runtime reached"),
+	},
+}
@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import "gonum.org/v1/gonum/mat"
+
+// BiCGStab implements the BiConjugate Gradient Stabilized iterative method
+// with right preconditioning for solving systems of linear equations
+//
+//	A * x = b,
+//
+// where A is a non-symmetric matrix. BiCGStab does not use the transpose of
+// A.
+//
+// References:
+//   - Barrett, R. et al. (1994). Section 2.3.8 BiConjugate Gradient Stabilized (Bi-CGSTAB).
+//     In Templates for the Solution of Linear Systems: Building Blocks
+//     for Iterative Methods (2nd ed.) (pp. 24-25). Philadelphia, PA: SIAM.
+//     Retrieved from http://www.netlib.org/templates/templates.pdf
+type BiCGStab struct {
+	x, r, rt, p, v, s, ph, sh *mat.VecDense
+
+	rho, rhoPrev, alpha, omega float64
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (b *BiCGStab) Init(x, residual *mat.VecDense) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("bicgstab: vector length mismatch")
+	}
+
+	b.x = reuse(b.x, dim)
+	b.x.CopyVec(x)
+	b.r = reuse(b.r, dim)
+	b.r.CopyVec(residual)
+	b.rt = reuse(b.rt, dim)
+	b.rt.CopyVec(residual)
+	b.p = reuse(b.p, dim)
+	b.p.Zero()
+	b.v = reuse(b.v, dim)
+	b.v.Zero()
+	b.s = reuse(b.s, dim)
+	b.ph = reuse(b.ph, dim)
+	b.sh = reuse(b.sh, dim)
+
+	b.rhoPrev = 1
+	b.alpha = 1
+	b.omega = 1
+	b.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// BiCGStab will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	CheckResidualNorm
+//	MajorIteration
+func (b *BiCGStab) Iterate(ctx *Context) (Operation, error) {
+	switch b.resume {
+	case 1:
+		b.rho = mat.Dot(b.rt, b.r)
+		if b.rho == 0 {
+			b.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		// p_i = r_{i-1} + beta*(p_{i-1} - omega * v_{i-1}).
+		beta := (b.rho / b.rhoPrev) * (b.alpha / b.omega)
+		b.p.AddScaledVec(b.p, -b.omega, b.v)
+		b.p.AddScaledVec(b.r, beta, b.p)
+		// Solve M p^_i = p_i.
+		ctx.Src.CopyVec(b.p)
+		b.resume = 2
+		return PreconSolve, nil
+	case 2:
+		b.ph.CopyVec(ctx.Dst)
+		// Compute v_i = A p^_i.
+		ctx.Src.CopyVec(b.ph)
+		b.resume = 3
+		return MulVec, nil
+	case 3:
+		b.v.CopyVec(ctx.Dst)
+		rtv := mat.Dot(b.rt, b.v)
+		if rtv == 0 {
+			b.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		b.alpha = b.rho / rtv
+		// s = r_{i-1} - alpha*v_i.
+		b.s.AddScaledVec(b.r, -b.alpha, b.v)
+		ctx.ResidualNorm = mat.Norm(b.s, 2)
+		b.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		if ctx.Converged {
+			b.x.AddScaledVec(b.x, b.alpha, b.ph)
+			ctx.X.CopyVec(b.x)
+			b.resume = 0
+			return MajorIteration, nil
+		}
+		// Solve M s^ = s.
+		ctx.Src.CopyVec(b.s)
+		b.resume = 5
+		return PreconSolve, nil
+	case 5:
+		b.sh.CopyVec(ctx.Dst)
+		// Compute t = A s^.
+		ctx.Src.CopyVec(b.sh)
+		b.resume = 6
+		return MulVec, nil
+	case 6:
+		// t is in ctx.Dst.
+		tt := mat.Dot(ctx.Dst, ctx.Dst)
+		if tt == 0 {
+			b.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		b.omega = mat.Dot(ctx.Dst, b.s) / tt
+		// x_i = x_{i-1} + alpha*p^_i + omega*s^.
+		b.x.AddScaledVec(b.x, b.alpha, b.ph)
+		b.x.AddScaledVec(b.x, b.omega, b.sh)
+		// r_i = s - omega*t.
+		b.r.AddScaledVec(b.s, -b.omega, ctx.Dst)
+		ctx.ResidualNorm = mat.Norm(b.r, 2)
+		b.resume = 7
+		return CheckResidualNorm, nil
+	case 7:
+		ctx.X.CopyVec(b.x)
+		if b.omega == 0 && !ctx.Converged {
+			b.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		b.rhoPrev = b.rho
+		b.resume = 1
+		return MajorIteration, nil
+
+	default:
+		panic("bicgstab: Init not called")
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import "gonum.org/v1/gonum/mat"
+
+// CG implements the Conjugate Gradient iterative method with
+// preconditioning for solving systems of linear equations
+//
+//	A * x = b,
+//
+// where A is a symmetric positive definite matrix. The preconditioner must
+// also be symmetric positive definite.
+//
+// References:
+//   - Barrett, R. et al. (1994). Section 2.3.1 Conjugate Gradient Method (CG).
+//     In Templates for the Solution of Linear Systems: Building Blocks
+//     for Iterative Methods (2nd ed.) (pp. 12-15). Philadelphia, PA: SIAM.
+//     Retrieved from http://www.netlib.org/templates/templates.pdf
+type CG struct {
+	x, r, p, ap *mat.VecDense
+
+	rho, rhoPrev float64
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (cg *CG) Init(x, residual *mat.VecDense) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("cg: vector length mismatch")
+	}
+
+	cg.x = reuse(cg.x, dim)
+	cg.x.CopyVec(x)
+	cg.r = reuse(cg.r, dim)
+	cg.r.CopyVec(residual)
+	cg.p = reuse(cg.p, dim)
+	cg.p.Zero()
+	cg.ap = reuse(cg.ap, dim)
+
+	cg.rhoPrev = 1
+	cg.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// CG will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	CheckResidualNorm
+//	MajorIteration
+func (cg *CG) Iterate(ctx *Context) (Operation, error) {
+	switch cg.resume {
+	case 1:
+		// Solve M z = r_{i-1}.
+		ctx.Src.CopyVec(cg.r)
+		cg.resume = 2
+		return PreconSolve, nil
+	case 2:
+		// z_{i-1} is in ctx.Dst.
+		cg.rho = mat.Dot(cg.r, ctx.Dst)
+		if cg.rho == 0 {
+			cg.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		// p_i = z_{i-1} + beta p_{i-1}.
+		beta := cg.rho / cg.rhoPrev
+		cg.p.AddScaledVec(ctx.Dst, beta, cg.p)
+		// Compute A p_i.
+		ctx.Src.CopyVec(cg.p)
+		cg.resume = 3
+		return MulVec, nil
+	case 3:
+		cg.ap.CopyVec(ctx.Dst)
+		pap := mat.Dot(cg.p, cg.ap)
+		if pap <= 0 {
+			cg.resume = 0
+			if pap == 0 {
+				return NoOperation, ErrBreakdown
+			}
+			return NoOperation, ErrNotPositiveDefinite
+		}
+		alpha := cg.rho / pap
+		cg.x.AddScaledVec(cg.x, alpha, cg.p)
+		cg.r.AddScaledVec(cg.r, -alpha, cg.ap)
+		ctx.ResidualNorm = mat.Norm(cg.r, 2)
+		cg.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		ctx.X.CopyVec(cg.x)
+		cg.rhoPrev = cg.rho
+		cg.resume = 1
+		return MajorIteration, nil
+
+	default:
+		panic("cg: Init not called")
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package linsolve provides iterative methods for solving linear systems.
+//
+// # Background
+//
+// A system of linear equations can be written as
+//
+//	A⋅x = b,
+//
+// where A is a given n×n non-singular matrix, b is a given n-vector (the
+// right-hand side), and x is an unknown n-vector.
+//
+// Direct methods such as the LU or QR decomposition compute (in the absence
+// of roundoff errors) the exact solution after a finite number of steps. For
+// a general matrix A they require O(n²) storage and O(n³) arithmetic
+// operations, which may be prohibitive when A is large.
+//
+// Iterative methods, on the other hand, compute approximations to the
+// solution x by improving an initial guess until the approximation is
+// considered accurate enough. They access the matrix A only through
+// matrix-vector products and so A may be stored in a sparse format such as
+// mat.CSR, in a banded format such as mat.BandDense, or may not be stored at
+// all.
+//
+// # Methods
+//
+// The following methods are provided:
+//   - CG, the conjugate gradient method for symmetric positive definite matrices,
+//   - MINRES, the minimum residual method for symmetric matrices,
+//   - GMRES, the restarted generalized minimum residual method for general
+//     matrices,
+//   - BiCGStab, the biconjugate gradient stabilized method for general
+//     matrices.
+//
+// # Preconditioning
+//
+// The convergence of iterative methods depends on the spectral properties of
+// A and can be accelerated by preconditioning. A preconditioner M is an
+// approximation to A for which linear systems M⋅z = r can be solved cheaply.
+// This package provides the Jacobi, ILU0 and IC0 preconditioners, and any
+// type implementing the Preconditioner interface can be used.
+//
+// # References
+//
+//   - Barrett, R. et al. (1994). Templates for the Solution of Linear Systems:
+//     Building Blocks for Iterative Methods (2nd ed.). Philadelphia, PA: SIAM.
+//     Retrieved from http://www.netlib.org/templates/templates.pdf
+//   - Saad, Y. (2003). Iterative methods for sparse linear systems (2nd ed.).
+//     Philadelphia, PA: SIAM. Retrieved from
+//     http://www-users.cs.umn.edu/~saad/IterMethBook_2ndEd.pdf
+package linsolve // import "gonum.org/v1/gonum/linsolve"
@@ -0,0 +1,206 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const defaultRestart = 30
+
+// GMRES implements the Generalized Minimum Residual method with restarts and
+// right preconditioning for solving systems of linear equations
+//
+//	A * x = b,
+//
+// where A is a general non-singular matrix. GMRES does not use the transpose
+// of A.
+//
+// GMRES stores the preconditioned Krylov basis vectors, so the preconditioner
+// may vary between iterations as in the flexible variant of the method. The
+// memory requirements are proportional to 2*Restart vectors of length n.
+//
+// References:
+//   - Barrett, R. et al. (1994). Section 2.3.4 Generalized Minimal Residual (GMRES).
+//     In Templates for the Solution of Linear Systems: Building Blocks
+//     for Iterative Methods (2nd ed.) (pp. 17-19). Philadelphia, PA: SIAM.
+//     Retrieved from http://www.netlib.org/templates/templates.pdf
+//   - Saad, Y. (1993). A flexible inner-outer preconditioned GMRES algorithm.
+//     SIAM Journal on Scientific Computing, 14(2), 461-469.
+//     https://doi.org/10.1137/0914028
+type GMRES struct {
+	// Restart is the number of iterations between restarts. If it is
+	// zero, the minimum of the dimension of the system and 30 will be
+	// used. Restart must not be negative.
+	Restart int
+
+	m int
+
+	x0, x *mat.VecDense
+	v, z  []*mat.VecDense
+
+	// h holds the upper Hessenberg matrix transformed to upper
+	// triangular form by Givens rotations, stored in row-major order
+	// with stride m.
+	h      []float64
+	cs, sn []float64
+	g, y   []float64
+
+	k      int
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (g *GMRES) Init(x, residual *mat.VecDense) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("gmres: vector length mismatch")
+	}
+	if g.Restart < 0 {
+		panic("gmres: negative restart")
+	}
+
+	g.m = g.Restart
+	if g.m == 0 {
+		g.m = min(dim, defaultRestart)
+	}
+	g.m = min(g.m, dim)
+
+	g.x0 = reuse(g.x0, dim)
+	g.x0.CopyVec(x)
+	g.x = reuse(g.x, dim)
+	g.x.CopyVec(x)
+	g.v = reuseVecs(g.v, g.m+1, dim)
+	g.z = reuseVecs(g.z, g.m, dim)
+	g.h = reuseFloats(g.h, (g.m+1)*g.m)
+	g.cs = reuseFloats(g.cs, g.m)
+	g.sn = reuseFloats(g.sn, g.m)
+	g.g = reuseFloats(g.g, g.m+1)
+	g.y = reuseFloats(g.y, g.m)
+
+	g.startCycle(residual)
+}
+
+// startCycle initializes a restart cycle with the residual r of the current
+// approximation.
+func (g *GMRES) startCycle(r *mat.VecDense) {
+	beta := mat.Norm(r, 2)
+	g.v[0].ScaleVec(1/beta, r)
+	for i := range g.g {
+		g.g[i] = 0
+	}
+	g.g[0] = beta
+	g.k = 0
+	g.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// GMRES will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	CheckResidualNorm
+//	MajorIteration
+//	ComputeResidual
+func (g *GMRES) Iterate(ctx *Context) (Operation, error) {
+	switch g.resume {
+	case 1:
+		// Solve M z_k = v_k.
+		ctx.Src.CopyVec(g.v[g.k])
+		g.resume = 2
+		return PreconSolve, nil
+	case 2:
+		// Compute A z_k.
+		g.z[g.k].CopyVec(ctx.Dst)
+		ctx.Src.CopyVec(g.z[g.k])
+		g.resume = 3
+		return MulVec, nil
+	case 3:
+		k := g.k
+		m := g.m
+		w := ctx.Dst
+
+		// Orthogonalize A z_k against the Krylov basis using
+		// the modified Gram-Schmidt process.
+		for i := 0; i <= k; i++ {
+			hik := mat.Dot(w, g.v[i])
+			g.h[i*m+k] = hik
+			w.AddScaledVec(w, -hik, g.v[i])
+		}
+		hk1 := mat.Norm(w, 2)
+		if hk1 != 0 {
+			g.v[k+1].ScaleVec(1/hk1, w)
+		}
+
+		// Apply the previous Givens rotations to the new column
+		// of the Hessenberg matrix.
+		for i := 0; i < k; i++ {
+			hi, hi1 := g.h[i*m+k], g.h[(i+1)*m+k]
+			g.h[i*m+k] = g.cs[i]*hi + g.sn[i]*hi1
+			g.h[(i+1)*m+k] = -g.sn[i]*hi + g.cs[i]*hi1
+		}
+		// Compute and apply the rotation that eliminates the
+		// subdiagonal element.
+		hkk := g.h[k*m+k]
+		r := math.Hypot(hkk, hk1)
+		if r == 0 {
+			g.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		g.cs[k] = hkk / r
+		g.sn[k] = hk1 / r
+		g.h[k*m+k] = r
+		g.g[k+1] = -g.sn[k] * g.g[k]
+		g.g[k] *= g.cs[k]
+
+		// Update the approximate solution.
+		g.solveUpper(k + 1)
+		g.x.CopyVec(g.x0)
+		for i, yi := range g.y[:k+1] {
+			g.x.AddScaledVec(g.x, yi, g.z[i])
+		}
+
+		ctx.ResidualNorm = math.Abs(g.g[k+1])
+		g.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		ctx.X.CopyVec(g.x)
+		g.k++
+		if g.k == g.m && !ctx.Converged {
+			g.resume = 5
+		} else {
+			g.resume = 1
+		}
+		return MajorIteration, nil
+	case 5:
+		g.resume = 6
+		return ComputeResidual, nil
+	case 6:
+		g.x0.CopyVec(ctx.X)
+		g.startCycle(ctx.Residual)
+		return g.Iterate(ctx)
+
+	default:
+		panic("gmres: Init not called")
+	}
+}
+
+// solveUpper solves the n×n upper triangular system R y = g where R is held
+// in the leading rows and columns of h.
+func (g *GMRES) solveUpper(n int) {
+	m := g.m
+	for i := n - 1; i >= 0; i-- {
+		s := g.g[i]
+		for j := i + 1; j < n; j++ {
+			s -= g.h[i*m+j] * g.y[j]
+		}
+		g.y[i] = s / g.h[i*m+i]
+	}
+}
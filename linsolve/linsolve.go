@@ -0,0 +1,356 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const defaultTolerance = 1e-8
+
+var (
+	// ErrBreakdown signifies that a Method encountered a division by zero
+	// in its recurrences and cannot continue.
+	ErrBreakdown = errors.New("linsolve: method breakdown")
+
+	// ErrNotPositiveDefinite signifies that a Method detected that the
+	// matrix or the preconditioner is not positive definite.
+	ErrNotPositiveDefinite = errors.New("linsolve: matrix or preconditioner not positive definite")
+
+	// ErrZeroPivot signifies that a preconditioner could not be formed
+	// due to a zero or missing diagonal element.
+	ErrZeroPivot = errors.New("linsolve: zero pivot")
+)
+
+// MulVecToer represents a square matrix A by means of a matrix-vector
+// multiplication. The mat.BandDense, mat.SymBandDense, mat.Tridiag, mat.CSR
+// and mat.CSC types satisfy MulVecToer.
+type MulVecToer interface {
+	// MulVecTo computes A⋅x or Aᵀ⋅x and stores the result into dst.
+	MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector)
+}
+
+// MulVecToFunc is a function that implements the MulVecToer interface. It
+// can be used to represent a matrix-free operator.
+type MulVecToFunc func(dst *mat.VecDense, trans bool, x mat.Vector)
+
+// MulVecTo calls f(dst, trans, x).
+func (f MulVecToFunc) MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	f(dst, trans, x)
+}
+
+// Preconditioner represents a preconditioner M, an approximation to the
+// system matrix A.
+type Preconditioner interface {
+	// PreconSolve solves M⋅z = r or Mᵀ⋅z = r and stores the result into
+	// dst. PreconSolve must return a non-nil error if the system could
+	// not be solved.
+	PreconSolve(dst *mat.VecDense, trans bool, r mat.Vector) error
+}
+
+// identity is the identity preconditioner.
+type identity struct{}
+
+func (identity) PreconSolve(dst *mat.VecDense, _ bool, r mat.Vector) error {
+	dst.CopyVec(r)
+	return nil
+}
+
+// Operation specifies the type of operation commanded by Method.
+type Operation uint64
+
+// Operations commanded by Method.Iterate.
+const (
+	NoOperation Operation = 0
+
+	// Compute A*x where x is stored in Context.Src. The
+	// result must be placed in Context.Dst.
+	MulVec Operation = 1 << (iota - 1)
+
+	// Perform a preconditioner solve M z = r where r is
+	// stored in Context.Src. The solution z must be placed
+	// in Context.Dst.
+	PreconSolve
+
+	// Trans indicates that MulVec or PreconSolve
+	// operation must be performed with the transpose,
+	// that is, compute Aᵀ*x or solve Mᵀ z = r. Method
+	// must not return Trans alone, only in combination
+	// with MulVec or PreconSolve.
+	Trans
+
+	// Compute b-A*x where x is stored in Context.X,
+	// and store the result in Context.Residual.
+	ComputeResidual
+
+	// Check convergence using the residual norm stored
+	// in Context.ResidualNorm. Context.Converged must be
+	// set to the result of the check.
+	CheckResidualNorm
+
+	// MajorIteration indicates that Method has finished
+	// what it considers to be one iteration. Method must
+	// make sure that Context.X is updated.
+	MajorIteration
+)
+
+func (op Operation) String() string {
+	switch op {
+	case NoOperation:
+		return "NoOperation"
+	case MulVec:
+		return "MulVec"
+	case MulVec | Trans:
+		return "MulVec|Trans"
+	case PreconSolve:
+		return "PreconSolve"
+	case PreconSolve | Trans:
+		return "PreconSolve|Trans"
+	case ComputeResidual:
+		return "ComputeResidual"
+	case CheckResidualNorm:
+		return "CheckResidualNorm"
+	case MajorIteration:
+		return "MajorIteration"
+	}
+	return fmt.Sprintf("Operation(%d)", uint64(op))
+}
+
+// Context mediates the communication between the Method and the caller. It
+// must not be modified or accessed apart from the commanded Operations.
+type Context struct {
+	// X is the current approximate solution. Method must
+	// update X when it returns MajorIteration and before
+	// it commands ComputeResidual.
+	X *mat.VecDense
+
+	// Residual is the residual b-A*X computed by the
+	// caller when ComputeResidual is commanded.
+	Residual *mat.VecDense
+
+	// ResidualNorm is set by Method to an estimate of the
+	// norm of the current residual and is used by the
+	// caller when CheckResidualNorm is commanded.
+	ResidualNorm float64
+
+	// Converged is set by the caller to the result of
+	// the convergence check commanded by CheckResidualNorm.
+	Converged bool
+
+	// Src and Dst are the source and destination vectors
+	// for the MulVec and PreconSolve operations.
+	Src, Dst *mat.VecDense
+}
+
+// Method is an iterative method that produces a sequence of vectors
+// converging to the solution of the system A*x = b.
+type Method interface {
+	// Init initializes the method for solving an n×n linear system with
+	// the initial estimate x and the corresponding residual b-A*x. Both
+	// x and residual have length n. Init must not retain or modify them.
+	Init(x, residual *mat.VecDense)
+
+	// Iterate performs a step of the method and returns the Operation
+	// that must be performed by the caller before Iterate is called
+	// again. Iterate communicates with the caller through ctx.
+	//
+	// If Iterate returns a non-nil error, the solve is terminated with
+	// a Failure status.
+	Iterate(ctx *Context) (Operation, error)
+}
+
+// Settings holds settings for solving a linear system.
+type Settings struct {
+	// InitX holds the initial guess. If it is nil, the zero vector
+	// will be used, otherwise its length must be equal to the
+	// length of the right-hand side.
+	InitX mat.Vector
+
+	// Tolerance specifies the relative tolerance for the residual.
+	// The iteration stops when
+	//  |r|/|b| < Tolerance,
+	// where r is the residual b-A*x at the current iteration.
+	// If Tolerance is zero, a default value of 1e-8 will be used,
+	// otherwise it must be positive and less than 1.
+	Tolerance float64
+
+	// MaxIterations is the limit on the number of iterations. If it
+	// is zero, a default value of twice the dimension of the system
+	// will be used.
+	MaxIterations int
+
+	// Runtime is the maximum runtime allowed. RuntimeLimit status is
+	// returned if the duration of the run is longer than this value.
+	// If it equals zero, this setting has no effect.
+	Runtime time.Duration
+
+	// Preconditioner is the preconditioner used by the Method. If it
+	// is nil, no preconditioning is performed.
+	Preconditioner Preconditioner
+}
+
+// Result holds the result of an iterative solve.
+type Result struct {
+	// X is the approximate solution.
+	X *mat.VecDense
+
+	// ResidualNorm is an approximation to the norm of the final
+	// residual.
+	ResidualNorm float64
+
+	// History holds the residual norms checked during the solve.
+	// The first element is the norm of the initial residual.
+	History []float64
+
+	Stats
+	Status Status
+}
+
+// Stats holds statistics about an iterative solve.
+type Stats struct {
+	Iterations  int           // Number of iterations.
+	MulVec      int           // Number of MulVec operations, including those needed to compute residuals.
+	PreconSolve int           // Number of PreconSolve operations.
+	Runtime     time.Duration // Total runtime of the solve.
+}
+
+// Iterative finds an approximate solution of the system of n linear equations
+//
+//	A*x = b,
+//
+// where A is a non-singular n×n matrix represented by a and b is a given
+// n-vector, using the given iterative method. If method is nil, GMRES with
+// the default restart will be used. If settings is nil, default settings will
+// be used.
+//
+// Note that the default choice of GMRES may not be optimal for the given
+// matrix A and it is recommended to choose a Method suitable for the
+// properties of A.
+//
+// Iterative returns a non-nil error if the Method fails or if the solve ends
+// early due to the limits in settings. In the latter case the error is the
+// Err of the returned Status.
+func Iterative(a MulVecToer, b mat.Vector, method Method, settings *Settings) (*Result, error) {
+	start := time.Now()
+
+	n := b.Len()
+	if n == 0 {
+		panic("linsolve: dimension is zero")
+	}
+
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	if s.InitX != nil && s.InitX.Len() != n {
+		panic("linsolve: mismatched length of initial guess")
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = defaultTolerance
+	}
+	if s.Tolerance <= 0 || 1 <= s.Tolerance {
+		panic("linsolve: invalid tolerance")
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 2 * n
+	}
+	if s.MaxIterations < 0 {
+		panic("linsolve: negative iteration limit")
+	}
+	if s.Preconditioner == nil {
+		s.Preconditioner = identity{}
+	}
+	if method == nil {
+		method = &GMRES{}
+	}
+
+	res := &Result{X: mat.NewVecDense(n, nil)}
+	if s.InitX != nil {
+		res.X.CopyVec(s.InitX)
+	}
+
+	bNorm := mat.Norm(b, 2)
+	if bNorm == 0 {
+		// The solution of a homogeneous system is zero.
+		res.X.Zero()
+		res.History = []float64{0}
+		res.Status = Success
+		res.Runtime = time.Since(start)
+		return res, nil
+	}
+
+	ctx := Context{
+		X:        res.X,
+		Residual: mat.NewVecDense(n, nil),
+		Src:      mat.NewVecDense(n, nil),
+		Dst:      mat.NewVecDense(n, nil),
+	}
+	computeResidual(ctx.Residual, a, b, ctx.X, &res.Stats)
+	res.ResidualNorm = mat.Norm(ctx.Residual, 2)
+	res.History = append(res.History, res.ResidualNorm)
+	if res.ResidualNorm < s.Tolerance*bNorm {
+		res.Status = Success
+		res.Runtime = time.Since(start)
+		return res, nil
+	}
+
+	var err error
+	method.Init(ctx.X, ctx.Residual)
+	for res.Status == NotTerminated {
+		var op Operation
+		op, err = method.Iterate(&ctx)
+		if err != nil {
+			res.Status = Failure
+			break
+		}
+		switch op {
+		case NoOperation:
+		case MulVec, MulVec | Trans:
+			a.MulVecTo(ctx.Dst, op&Trans != 0, ctx.Src)
+			res.MulVec++
+		case PreconSolve, PreconSolve | Trans:
+			err = s.Preconditioner.PreconSolve(ctx.Dst, op&Trans != 0, ctx.Src)
+			res.PreconSolve++
+			if err != nil {
+				res.Status = Failure
+			}
+		case ComputeResidual:
+			computeResidual(ctx.Residual, a, b, ctx.X, &res.Stats)
+		case CheckResidualNorm:
+			res.ResidualNorm = ctx.ResidualNorm
+			res.History = append(res.History, ctx.ResidualNorm)
+			ctx.Converged = ctx.ResidualNorm < s.Tolerance*bNorm
+		case MajorIteration:
+			res.Iterations++
+			switch {
+			case ctx.Converged:
+				res.Status = Success
+			case res.Iterations >= s.MaxIterations:
+				res.Status = IterationLimit
+			case s.Runtime > 0 && time.Since(start) > s.Runtime:
+				res.Status = RuntimeLimit
+			}
+		default:
+			panic(fmt.Sprintf("linsolve: invalid operation %v", op))
+		}
+	}
+	res.X = ctx.X
+	res.Runtime = time.Since(start)
+	if err == nil {
+		err = res.Status.Err()
+	}
+	return res, err
+}
+
+// computeResidual computes the residual b-A*x and stores it into dst.
+func computeResidual(dst *mat.VecDense, a MulVecToer, b mat.Vector, x *mat.VecDense, stats *Stats) {
+	a.MulVecTo(dst, false, x)
+	dst.SubVec(b, dst)
+	stats.MulVec++
+}
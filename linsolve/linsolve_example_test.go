@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/linsolve"
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleIterative() {
+	// Solve the one-dimensional Poisson equation -u'' = 1 on (0, 1)
+	// with u(0) = u(1) = 0 using a second order finite difference
+	// discretization on a grid of n interior points.
+	const n = 9
+	h := 1.0 / (n + 1)
+	a := mat.NewCOO(n, n, nil, nil, nil)
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		a.Append(i, i, 2/(h*h))
+		if i > 0 {
+			a.Append(i, i-1, -1/(h*h))
+		}
+		if i < n-1 {
+			a.Append(i, i+1, -1/(h*h))
+		}
+		b.SetVec(i, 1)
+	}
+	csr := a.ToCSR()
+
+	precon, err := linsolve.NewIC0(csr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	res, err := linsolve.Iterative(csr, b, &linsolve.CG{}, &linsolve.Settings{
+		Tolerance:      1e-10,
+		Preconditioner: precon,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("status: %v\n", res.Status)
+	// The exact solution is u(x) = x(1-x)/2.
+	fmt.Printf("u(0.5) = %.4f\n", res.X.AtVec(n/2))
+
+	// Output:
+	// status: Success
+	// u(0.5) = 0.1250
+}
@@ -0,0 +1,363 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// poisson returns the matrix of the 5-point finite difference discretization
+// of the negative Laplacian on an nx×ny grid, shifted by -shift*I.
+func poisson(nx, ny int, shift float64) *mat.CSR {
+	n := nx * ny
+	a := mat.NewCOO(n, n, nil, nil, nil)
+	for j := 0; j < ny; j++ {
+		for i := 0; i < nx; i++ {
+			k := j*nx + i
+			a.Append(k, k, 4-shift)
+			if i > 0 {
+				a.Append(k, k-1, -1)
+			}
+			if i < nx-1 {
+				a.Append(k, k+1, -1)
+			}
+			if j > 0 {
+				a.Append(k, k-nx, -1)
+			}
+			if j < ny-1 {
+				a.Append(k, k+nx, -1)
+			}
+		}
+	}
+	return a.ToCSR()
+}
+
+// convectionDiffusion returns the matrix of the upwind finite difference
+// discretization of the convection-diffusion operator on an nx×ny grid.
+func convectionDiffusion(nx, ny int, px, py float64) *mat.CSR {
+	n := nx * ny
+	a := mat.NewCOO(n, n, nil, nil, nil)
+	for j := 0; j < ny; j++ {
+		for i := 0; i < nx; i++ {
+			k := j*nx + i
+			a.Append(k, k, 4+px+py)
+			if i > 0 {
+				a.Append(k, k-1, -1-px)
+			}
+			if i < nx-1 {
+				a.Append(k, k+1, -1)
+			}
+			if j > 0 {
+				a.Append(k, k-nx, -1-py)
+			}
+			if j < ny-1 {
+				a.Append(k, k+nx, -1)
+			}
+		}
+	}
+	return a.ToCSR()
+}
+
+type testCase struct {
+	name      string
+	a         *mat.CSR
+	symmetric bool
+	spd       bool
+}
+
+func testCases() []testCase {
+	return []testCase{
+		{name: "Poisson 1×1", a: poisson(1, 1, 0), symmetric: true, spd: true},
+		{name: "Poisson 10×1", a: poisson(10, 1, 0), symmetric: true, spd: true},
+		{name: "Poisson 12×10", a: poisson(12, 10, 0), symmetric: true, spd: true},
+		{name: "Shifted Poisson 8×8", a: poisson(8, 8, 1.3), symmetric: true},
+		{name: "ConvectionDiffusion 10×10", a: convectionDiffusion(10, 10, 1, 0.5)},
+		{name: "ConvectionDiffusion 20×5", a: convectionDiffusion(20, 5, 4, 0)},
+	}
+}
+
+type methodCase struct {
+	name       string
+	newMethod  func() Method
+	symmetric  bool
+	spd        bool
+	precondSPD bool
+
+	// restarted indicates that the method may
+	// stagnate on ill-conditioned or indefinite
+	// matrices.
+	restarted bool
+}
+
+func methodCases() []methodCase {
+	return []methodCase{
+		{name: "CG", newMethod: func() Method { return &CG{} }, spd: true, precondSPD: true},
+		{name: "MINRES", newMethod: func() Method { return &MINRES{} }, symmetric: true, precondSPD: true},
+		{name: "GMRES", newMethod: func() Method { return &GMRES{} }},
+		{name: "GMRES(5)", newMethod: func() Method { return &GMRES{Restart: 5} }, restarted: true},
+		{name: "BiCGStab", newMethod: func() Method { return &BiCGStab{} }},
+	}
+}
+
+func TestIterative(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range testCases() {
+		n, _ := test.a.Dims()
+		want := mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			want.SetVec(i, rnd.NormFloat64())
+		}
+		var b mat.VecDense
+		test.a.MulVecTo(&b, false, want)
+
+		jacobi, err := NewJacobi(test.a)
+		if err != nil {
+			t.Fatalf("%s: unexpected error from NewJacobi: %v", test.name, err)
+		}
+		ilu, err := NewILU0(test.a)
+		if err != nil {
+			t.Fatalf("%s: unexpected error from NewILU0: %v", test.name, err)
+		}
+		precons := []struct {
+			name string
+			p    Preconditioner
+			spd  bool
+		}{
+			{name: "none", spd: true},
+			{name: "Jacobi", p: jacobi, spd: test.spd},
+			{name: "ILU0", p: ilu, spd: false},
+		}
+		if test.spd {
+			ic, err := NewIC0(test.a)
+			if err != nil {
+				t.Fatalf("%s: unexpected error from NewIC0: %v", test.name, err)
+			}
+			precons = append(precons, struct {
+				name string
+				p    Preconditioner
+				spd  bool
+			}{name: "IC0", p: ic, spd: true})
+		}
+
+		for _, method := range methodCases() {
+			if method.spd && !test.spd {
+				continue
+			}
+			if method.symmetric && !test.symmetric {
+				continue
+			}
+			if method.restarted && test.symmetric && !test.spd {
+				continue
+			}
+			for _, precon := range precons {
+				if method.precondSPD && !precon.spd {
+					continue
+				}
+				const tol = 1e-10
+				name := fmt.Sprintf("%s %s precon=%s", test.name, method.name, precon.name)
+				settings := &Settings{
+					Tolerance:      tol,
+					MaxIterations:  10 * n,
+					Preconditioner: precon.p,
+				}
+				res, err := Iterative(test.a, &b, method.newMethod(), settings)
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if res.Status != Success {
+					t.Errorf("%s: unexpected status: got:%v want:%v", name, res.Status, Success)
+				}
+				if len(res.History) == 0 || res.History[len(res.History)-1] != res.ResidualNorm {
+					t.Errorf("%s: residual history does not end with the final residual norm", name)
+				}
+				var r mat.VecDense
+				test.a.MulVecTo(&r, false, res.X)
+				r.SubVec(&b, &r)
+				if rnorm := mat.Norm(&r, 2) / mat.Norm(&b, 2); rnorm > 100*tol {
+					t.Errorf("%s: relative residual too large: got:%v want<%v", name, rnorm, 100*tol)
+				}
+			}
+		}
+	}
+}
+
+func TestIterativeInitX(t *testing.T) {
+	t.Parallel()
+	a := convectionDiffusion(6, 6, 1, 1)
+	n, _ := a.Dims()
+	want := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		want.SetVec(i, float64(i))
+	}
+	var b mat.VecDense
+	a.MulVecTo(&b, false, want)
+
+	for _, method := range methodCases() {
+		if method.symmetric || method.spd {
+			continue
+		}
+		// Starting at the solution must terminate immediately.
+		res, err := Iterative(a, &b, method.newMethod(), &Settings{InitX: want})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", method.name, err)
+			continue
+		}
+		if res.Iterations != 0 {
+			t.Errorf("%s: unexpected number of iterations: got:%d want:0", method.name, res.Iterations)
+		}
+		if !floats.EqualApprox(res.X.RawVector().Data, want.RawVector().Data, 1e-14) {
+			t.Errorf("%s: unexpected solution", method.name)
+		}
+	}
+}
+
+func TestIterativeIterationLimit(t *testing.T) {
+	t.Parallel()
+	a := poisson(10, 10, 0)
+	n, _ := a.Dims()
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		b.SetVec(i, 1)
+	}
+	for _, method := range methodCases() {
+		res, err := Iterative(a, b, method.newMethod(), &Settings{MaxIterations: 3})
+		if err != IterationLimit.Err() {
+			t.Errorf("%s: unexpected error: got:%v want:%v", method.name, err, IterationLimit.Err())
+		}
+		if res.Status != IterationLimit {
+			t.Errorf("%s: unexpected status: got:%v want:%v", method.name, res.Status, IterationLimit)
+		}
+		if res.Iterations != 3 {
+			t.Errorf("%s: unexpected number of iterations: got:%d want:3", method.name, res.Iterations)
+		}
+	}
+}
+
+func TestIterativeOperators(t *testing.T) {
+	t.Parallel()
+	const n = 50
+	dl := make([]float64, n-1)
+	d := make([]float64, n)
+	du := make([]float64, n-1)
+	for i := range d {
+		d[i] = 2
+	}
+	for i := range dl {
+		dl[i] = -1
+		du[i] = -1
+	}
+	tri := mat.NewTridiag(n, dl, d, du)
+	band := mat.NewSymBandDense(n, 1, nil)
+	for i := 0; i < n; i++ {
+		band.SetSymBand(i, i, 2)
+		if i < n-1 {
+			band.SetSymBand(i, i+1, -1)
+		}
+	}
+	fn := MulVecToFunc(func(dst *mat.VecDense, _ bool, x mat.Vector) {
+		for i := 0; i < n; i++ {
+			v := 2 * x.AtVec(i)
+			if i > 0 {
+				v -= x.AtVec(i - 1)
+			}
+			if i < n-1 {
+				v -= x.AtVec(i + 1)
+			}
+			dst.SetVec(i, v)
+		}
+	})
+
+	b := mat.NewVecDense(n, nil)
+	b.SetVec(0, 1)
+	b.SetVec(n-1, 1)
+	// The solution of the system is the vector of ones.
+	for _, op := range []struct {
+		name string
+		a    MulVecToer
+	}{
+		{name: "Tridiag", a: tri},
+		{name: "SymBandDense", a: band},
+		{name: "MulVecToFunc", a: fn},
+	} {
+		for _, method := range methodCases() {
+			if method.restarted {
+				continue
+			}
+			res, err := Iterative(op.a, b, method.newMethod(), &Settings{Tolerance: 1e-12})
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", op.name, method.name, err)
+				continue
+			}
+			for i := 0; i < n; i++ {
+				if v := res.X.AtVec(i); v < 1-1e-8 || 1+1e-8 < v {
+					t.Errorf("%s %s: unexpected solution element %d: got:%v want:1", op.name, method.name, i, v)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestPreconditionerTrans(t *testing.T) {
+	t.Parallel()
+	a := convectionDiffusion(5, 4, 2, 1)
+	n, _ := a.Dims()
+	ilu, err := NewILU0(a)
+	if err != nil {
+		t.Fatalf("unexpected error from NewILU0: %v", err)
+	}
+	rnd := rand.New(rand.NewPCG(1, 1))
+	r := mat.NewVecDense(n, nil)
+	s := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		r.SetVec(i, rnd.NormFloat64())
+		s.SetVec(i, rnd.NormFloat64())
+	}
+	// Check that sᵀ M⁻¹ r = (M⁻ᵀ s)ᵀ r.
+	var z, zt mat.VecDense
+	ilu.PreconSolve(&z, false, r)
+	ilu.PreconSolve(&zt, true, s)
+	got, want := mat.Dot(s, &z), mat.Dot(&zt, r)
+	if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+		t.Errorf("ILU0 transpose solve mismatch: got:%v want:%v", got, want)
+	}
+
+	// ILU0 of a tridiagonal matrix is its exact LU factorization.
+	tri := poisson(7, 1, 0)
+	ilu, err = NewILU0(tri)
+	if err != nil {
+		t.Fatalf("unexpected error from NewILU0: %v", err)
+	}
+	ic, err := NewIC0(tri)
+	if err != nil {
+		t.Fatalf("unexpected error from NewIC0: %v", err)
+	}
+	rhs := mat.NewVecDense(7, []float64{1, 2, 3, 4, 5, 6, 7})
+	var lu, chol, check mat.VecDense
+	ilu.PreconSolve(&lu, false, rhs)
+	ic.PreconSolve(&chol, false, rhs)
+	tri.MulVecTo(&check, false, &lu)
+	if !mat.EqualApprox(&check, rhs, 1e-12) {
+		t.Errorf("ILU0 is not exact for a tridiagonal matrix")
+	}
+	if !mat.EqualApprox(&lu, &chol, 1e-12) {
+		t.Errorf("IC0 is not exact for a tridiagonal matrix")
+	}
+
+	if _, err := NewIC0(poisson(3, 3, 10)); err != ErrNotPositiveDefinite {
+		t.Errorf("unexpected error for indefinite matrix: got:%v want:%v", err, ErrNotPositiveDefinite)
+	}
+	if _, err := NewJacobi(poisson(1, 1, 4)); err != ErrZeroPivot {
+		t.Errorf("unexpected error for zero diagonal: got:%v want:%v", err, ErrZeroPivot)
+	}
+}
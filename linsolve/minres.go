@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// MINRES implements the Minimum Residual iterative method with
+// preconditioning for solving systems of linear equations
+//
+//	A * x = b,
+//
+// where A is a symmetric, possibly indefinite, matrix. The preconditioner
+// must be symmetric positive definite.
+//
+// When a preconditioner is used, the residual norm checked for convergence
+// is an estimate of the norm of the preconditioned residual, |r|_{M⁻¹}.
+//
+// References:
+//   - Paige, C. C., & Saunders, M. A. (1975). Solution of sparse indefinite
+//     systems of linear equations. SIAM Journal on Numerical Analysis,
+//     12(4), 617-629. https://doi.org/10.1137/0712047
+type MINRES struct {
+	x, v, y, r1, r2, w, w1 *mat.VecDense
+
+	alfa, beta, oldb, dbar, epsln, phibar, cs, sn float64
+
+	first  bool
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (m *MINRES) Init(x, residual *mat.VecDense) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("minres: vector length mismatch")
+	}
+
+	m.x = reuse(m.x, dim)
+	m.x.CopyVec(x)
+	m.r1 = reuse(m.r1, dim)
+	m.r1.CopyVec(residual)
+	m.r2 = reuse(m.r2, dim)
+	m.r2.CopyVec(residual)
+	m.v = reuse(m.v, dim)
+	m.y = reuse(m.y, dim)
+	m.w = reuse(m.w, dim)
+	m.w.Zero()
+	m.w1 = reuse(m.w1, dim)
+	m.w1.Zero()
+
+	m.oldb = 0
+	m.dbar = 0
+	m.epsln = 0
+	m.cs = -1
+	m.sn = 0
+	m.first = true
+	m.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// MINRES will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	CheckResidualNorm
+//	MajorIteration
+func (m *MINRES) Iterate(ctx *Context) (Operation, error) {
+	switch m.resume {
+	case 1:
+		// Solve M y = r_1 for the initial Lanczos vector.
+		ctx.Src.CopyVec(m.r1)
+		m.resume = 2
+		return PreconSolve, nil
+	case 2:
+		beta, err := m.preconNorm(ctx.Dst)
+		if err != nil {
+			return NoOperation, err
+		}
+		if beta == 0 {
+			m.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		m.beta = beta
+		m.phibar = beta
+		fallthrough
+	case 3:
+		// Compute A v_k where v_k = y/beta.
+		m.v.ScaleVec(1/m.beta, m.y)
+		ctx.Src.CopyVec(m.v)
+		m.resume = 4
+		return MulVec, nil
+	case 4:
+		// Lanczos step.
+		m.y.CopyVec(ctx.Dst)
+		if !m.first {
+			m.y.AddScaledVec(m.y, -m.beta/m.oldb, m.r1)
+		}
+		m.first = false
+		m.alfa = mat.Dot(m.v, m.y)
+		m.y.AddScaledVec(m.y, -m.alfa/m.beta, m.r2)
+		m.r1.CopyVec(m.r2)
+		m.r2.CopyVec(m.y)
+		// Solve M y = r_2.
+		ctx.Src.CopyVec(m.r2)
+		m.resume = 5
+		return PreconSolve, nil
+	case 5:
+		m.oldb = m.beta
+		beta, err := m.preconNorm(ctx.Dst)
+		if err != nil {
+			return NoOperation, err
+		}
+		m.beta = beta
+
+		// Apply the previous rotation.
+		oldeps := m.epsln
+		delta := m.cs*m.dbar + m.sn*m.alfa
+		gbar := m.sn*m.dbar - m.cs*m.alfa
+		m.epsln = m.sn * m.beta
+		m.dbar = -m.cs * m.beta
+
+		// Compute the next plane rotation.
+		gamma := math.Max(math.Hypot(gbar, m.beta), dlamchE)
+		m.cs = gbar / gamma
+		m.sn = m.beta / gamma
+		phi := m.cs * m.phibar
+		m.phibar *= m.sn
+
+		// Update x.
+		// w_k = (v_k - oldeps*w_{k-2} - delta*w_{k-1}) / gamma.
+		m.w1.AddScaledVec(m.v, -oldeps, m.w1)
+		m.w1.AddScaledVec(m.w1, -delta, m.w)
+		m.w1.ScaleVec(1/gamma, m.w1)
+		m.w, m.w1 = m.w1, m.w
+		m.x.AddScaledVec(m.x, phi, m.w)
+
+		ctx.ResidualNorm = math.Abs(m.phibar)
+		m.resume = 6
+		return CheckResidualNorm, nil
+	case 6:
+		ctx.X.CopyVec(m.x)
+		if m.beta == 0 && !ctx.Converged {
+			// The Krylov subspace is exhausted.
+			m.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		m.resume = 3
+		return MajorIteration, nil
+
+	default:
+		panic("minres: Init not called")
+	}
+}
+
+// preconNorm stores the preconditioned vector y into the receiver and
+// returns its M-norm, sqrt(r_2ᵀ y).
+func (m *MINRES) preconNorm(y *mat.VecDense) (float64, error) {
+	m.y.CopyVec(y)
+	ry := mat.Dot(m.r2, m.y)
+	if ry < 0 {
+		m.resume = 0
+		return 0, ErrNotPositiveDefinite
+	}
+	return math.Sqrt(ry), nil
+}
@@ -0,0 +1,275 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Preconditioner = (*Jacobi)(nil)
+	_ Preconditioner = (*ILU0)(nil)
+	_ Preconditioner = (*IC0)(nil)
+)
+
+// Jacobi is the Jacobi or diagonal preconditioner, M = diag(A).
+type Jacobi struct {
+	diag []float64
+}
+
+// NewJacobi returns a Jacobi preconditioner for the square matrix a. If a
+// has a zero diagonal element, NewJacobi returns a nil preconditioner and
+// ErrZeroPivot.
+func NewJacobi(a mat.Matrix) (*Jacobi, error) {
+	r, c := a.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	diag := make([]float64, r)
+	for i := range diag {
+		diag[i] = a.At(i, i)
+		if diag[i] == 0 {
+			return nil, ErrZeroPivot
+		}
+	}
+	return &Jacobi{diag: diag}, nil
+}
+
+// PreconSolve solves M z = r where M is the diagonal of the matrix and stores
+// the result into dst.
+func (p *Jacobi) PreconSolve(dst *mat.VecDense, _ bool, r mat.Vector) error {
+	if r.Len() != len(p.diag) {
+		panic(mat.ErrShape)
+	}
+	reuseAsVec(dst, r.Len())
+	for i, d := range p.diag {
+		dst.SetVec(i, r.AtVec(i)/d)
+	}
+	return nil
+}
+
+// ILU0 is the incomplete LU factorization preconditioner with zero fill-in.
+// The factors L and U have the same sparsity pattern as the strictly lower
+// and upper triangles of A respectively, and L has a unit diagonal.
+//
+// References:
+//   - Saad, Y. (2003). Section 10.3.2 Zero Fill-in ILU (ILU(0)). In Iterative
+//     methods for sparse linear systems (2nd ed.) (pp. 307-312).
+//     Philadelphia, PA: SIAM.
+type ILU0 struct {
+	n      int
+	indptr []int
+	ind    []int
+	lu     []float64
+	diag   []int
+}
+
+// NewILU0 returns the ILU(0) preconditioner for the square matrix a. If a
+// diagonal element of a is not stored or a zero pivot is encountered during
+// the factorization, NewILU0 returns a nil preconditioner and ErrZeroPivot.
+func NewILU0(a *mat.CSR) (*ILU0, error) {
+	n, c := a.Dims()
+	if n != c {
+		panic(mat.ErrSquare)
+	}
+	indptr, ind, data := a.RawCSR()
+	p := &ILU0{
+		n:      n,
+		indptr: indptr,
+		ind:    ind,
+		lu:     make([]float64, len(ind)),
+		diag:   make([]int, n),
+	}
+	copy(p.lu, data)
+
+	pos := make([]int, n)
+	for i := range pos {
+		pos[i] = -1
+	}
+	for i := 0; i < n; i++ {
+		p.diag[i] = -1
+		for q := indptr[i]; q < indptr[i+1]; q++ {
+			pos[ind[q]] = q
+			if ind[q] == i {
+				p.diag[i] = q
+			}
+		}
+		if p.diag[i] < 0 {
+			return nil, ErrZeroPivot
+		}
+		for q := indptr[i]; q < p.diag[i]; q++ {
+			k := ind[q]
+			p.lu[q] /= p.lu[p.diag[k]]
+			lik := p.lu[q]
+			for r := p.diag[k] + 1; r < indptr[k+1]; r++ {
+				if s := pos[ind[r]]; s >= 0 {
+					p.lu[s] -= lik * p.lu[r]
+				}
+			}
+		}
+		if p.lu[p.diag[i]] == 0 {
+			return nil, ErrZeroPivot
+		}
+		for q := indptr[i]; q < indptr[i+1]; q++ {
+			pos[ind[q]] = -1
+		}
+	}
+	return p, nil
+}
+
+// PreconSolve solves L U z = r or (L U)ᵀ z = r and stores the result into dst.
+func (p *ILU0) PreconSolve(dst *mat.VecDense, trans bool, r mat.Vector) error {
+	if r.Len() != p.n {
+		panic(mat.ErrShape)
+	}
+	reuseAsVec(dst, p.n)
+	z := dst.RawVector()
+	for i := 0; i < p.n; i++ {
+		z.Data[i*z.Inc] = r.AtVec(i)
+	}
+	if !trans {
+		// Solve L y = r.
+		for i := 0; i < p.n; i++ {
+			s := z.Data[i*z.Inc]
+			for q := p.indptr[i]; q < p.diag[i]; q++ {
+				s -= p.lu[q] * z.Data[p.ind[q]*z.Inc]
+			}
+			z.Data[i*z.Inc] = s
+		}
+		// Solve U z = y.
+		for i := p.n - 1; i >= 0; i-- {
+			s := z.Data[i*z.Inc]
+			for q := p.diag[i] + 1; q < p.indptr[i+1]; q++ {
+				s -= p.lu[q] * z.Data[p.ind[q]*z.Inc]
+			}
+			z.Data[i*z.Inc] = s / p.lu[p.diag[i]]
+		}
+		return nil
+	}
+	// Solve Uᵀ y = r.
+	for i := 0; i < p.n; i++ {
+		yi := z.Data[i*z.Inc] / p.lu[p.diag[i]]
+		z.Data[i*z.Inc] = yi
+		for q := p.diag[i] + 1; q < p.indptr[i+1]; q++ {
+			z.Data[p.ind[q]*z.Inc] -= p.lu[q] * yi
+		}
+	}
+	// Solve Lᵀ z = y.
+	for i := p.n - 1; i >= 0; i-- {
+		zi := z.Data[i*z.Inc]
+		for q := p.indptr[i]; q < p.diag[i]; q++ {
+			z.Data[p.ind[q]*z.Inc] -= p.lu[q] * zi
+		}
+	}
+	return nil
+}
+
+// IC0 is the incomplete Cholesky factorization preconditioner with zero
+// fill-in for symmetric positive definite matrices. The factor L has the same
+// sparsity pattern as the lower triangle of A.
+//
+// References:
+//   - Saad, Y. (2003). Section 10.3.2 Zero Fill-in ILU (ILU(0)). In Iterative
+//     methods for sparse linear systems (2nd ed.) (pp. 307-312).
+//     Philadelphia, PA: SIAM.
+type IC0 struct {
+	n      int
+	indptr []int
+	ind    []int
+	l      []float64
+}
+
+// NewIC0 returns the IC(0) preconditioner for the symmetric matrix a. Only
+// the lower triangle of a is referenced. If a non-positive pivot is
+// encountered during the factorization, NewIC0 returns a nil preconditioner
+// and ErrNotPositiveDefinite.
+func NewIC0(a *mat.CSR) (*IC0, error) {
+	n, c := a.Dims()
+	if n != c {
+		panic(mat.ErrSquare)
+	}
+	aptr, aind, adata := a.RawCSR()
+
+	// Extract the lower triangle of a.
+	p := &IC0{n: n, indptr: make([]int, n+1)}
+	for i := 0; i < n; i++ {
+		for q := aptr[i]; q < aptr[i+1] && aind[q] <= i; q++ {
+			p.ind = append(p.ind, aind[q])
+			p.l = append(p.l, adata[q])
+		}
+		p.indptr[i+1] = len(p.ind)
+	}
+
+	for i := 0; i < n; i++ {
+		lo, hi := p.indptr[i], p.indptr[i+1]
+		if lo == hi || p.ind[hi-1] != i {
+			return nil, ErrNotPositiveDefinite
+		}
+		for q := lo; q < hi-1; q++ {
+			k := p.ind[q]
+			// l_ik = (a_ik - sum_{j<k} l_ij l_kj) / l_kk.
+			s := p.l[q] - p.sparseDot(lo, q, p.indptr[k], p.indptr[k+1]-1)
+			p.l[q] = s / p.l[p.indptr[k+1]-1]
+		}
+		d := p.l[hi-1] - p.sparseDot(lo, hi-1, lo, hi-1)
+		if d <= 0 {
+			return nil, ErrNotPositiveDefinite
+		}
+		p.l[hi-1] = math.Sqrt(d)
+	}
+	return p, nil
+}
+
+// sparseDot returns the dot product of the sparse vectors held in
+// l[lo1:hi1] and l[lo2:hi2].
+func (p *IC0) sparseDot(lo1, hi1, lo2, hi2 int) float64 {
+	var s float64
+	for lo1 < hi1 && lo2 < hi2 {
+		switch {
+		case p.ind[lo1] < p.ind[lo2]:
+			lo1++
+		case p.ind[lo2] < p.ind[lo1]:
+			lo2++
+		default:
+			s += p.l[lo1] * p.l[lo2]
+			lo1++
+			lo2++
+		}
+	}
+	return s
+}
+
+// PreconSolve solves L Lᵀ z = r and stores the result into dst.
+func (p *IC0) PreconSolve(dst *mat.VecDense, _ bool, r mat.Vector) error {
+	if r.Len() != p.n {
+		panic(mat.ErrShape)
+	}
+	reuseAsVec(dst, p.n)
+	z := dst.RawVector()
+	for i := 0; i < p.n; i++ {
+		z.Data[i*z.Inc] = r.AtVec(i)
+	}
+	// Solve L y = r.
+	for i := 0; i < p.n; i++ {
+		d := p.indptr[i+1] - 1
+		s := z.Data[i*z.Inc]
+		for q := p.indptr[i]; q < d; q++ {
+			s -= p.l[q] * z.Data[p.ind[q]*z.Inc]
+		}
+		z.Data[i*z.Inc] = s / p.l[d]
+	}
+	// Solve Lᵀ z = y.
+	for i := p.n - 1; i >= 0; i-- {
+		d := p.indptr[i+1] - 1
+		zi := z.Data[i*z.Inc] / p.l[d]
+		z.Data[i*z.Inc] = zi
+		for q := p.indptr[i]; q < d; q++ {
+			z.Data[p.ind[q]*z.Inc] -= p.l[q] * zi
+		}
+	}
+	return nil
+}
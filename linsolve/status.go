@@ -0,0 +1,63 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import "errors"
+
+// Status represents the status of an iterative solve. Programs should not
+// rely on the underlying numeric value of the Status being constant.
+type Status int
+
+const (
+	NotTerminated Status = iota
+	Success
+	Failure
+	IterationLimit
+	RuntimeLimit
+)
+
+func (s Status) String() string {
+	return statuses[s].name
+}
+
+// Early returns true if the status indicates the solve ended before a
+// solution of the requested accuracy was found.
+func (s Status) Early() bool {
+	return statuses[s].early
+}
+
+// Err returns the error associated with an early ending to the solve. If
+// Early returns false, Err will return nil.
+func (s Status) Err() error {
+	return statuses[s].err
+}
+
+var statuses = []struct {
+	name  string
+	early bool
+	err   error
+}{
+	{
+		name: "NotTerminated",
+	},
+	{
+		name: "Success",
+	},
+	{
+		name:  "Failure",
+		early: true,
+		err:   errors.New("linsolve: termination ended in failure"),
+	},
+	{
+		name:  "IterationLimit",
+		early: true,
+		err:   errors.New("linsolve: maximum number of iterations reached"),
+	},
+	{
+		name:  "RuntimeLimit",
+		early: true,
+		err:   errors.New("linsolve: maximum runtime reached"),
+	},
+}
@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import "gonum.org/v1/gonum/mat"
+
+// reuse returns v if it has length n, and a new zeroed vector of length n
+// otherwise.
+func reuse(v *mat.VecDense, n int) *mat.VecDense {
+	if v == nil || v.Len() != n {
+		return mat.NewVecDense(n, nil)
+	}
+	return v
+}
+
+// dlamchE is the machine epsilon. For IEEE this is 2^{-53}.
+const dlamchE = 1.0 / (1 << 53)
+
+// reuseVecs returns a slice of k vectors of length n reusing the vectors in
+// v where possible.
+func reuseVecs(v []*mat.VecDense, k, n int) []*mat.VecDense {
+	if cap(v) < k {
+		v = append(v[:cap(v)], make([]*mat.VecDense, k-cap(v))...)
+	}
+	v = v[:k]
+	for i := range v {
+		v[i] = reuse(v[i], n)
+	}
+	return v
+}
+
+// reuseFloats returns a zeroed slice of length n reusing the backing data of
+// s where possible.
+func reuseFloats(s []float64, n int) []float64 {
+	if cap(s) < n {
+		return make([]float64, n)
+	}
+	s = s[:n]
+	for i := range s {
+		s[i] = 0
+	}
+	return s
+}
+
+// reuseAsVec resizes an empty dst to length n, and panics if dst is not
+// empty and does not have length n.
+func reuseAsVec(dst *mat.VecDense, n int) {
+	if dst.IsEmpty() {
+		dst.ReuseAsVec(n)
+		return
+	}
+	if dst.Len() != n {
+		panic(mat.ErrShape)
+	}
+}
@@ -439,6 +439,14 @@ func (m *Dense) CloneFrom(a Matrix) {
 		mat.Data = make([]float64, aU.mat.N)
 		blas64.Copy(blas64.Vector{N: aU.mat.N, Inc: amat.Inc, Data: amat.Data},
 			blas64.Vector{N: aU.mat.N, Inc: 1, Data: mat.Data})
+	case *COO, *CSR, *CSC:
+		mat.Data = make([]float64, r*c)
+		aU.(NonZeroDoer).DoNonZero(func(i, j int, v float64) {
+			if trans {
+				i, j = j, i
+			}
+			mat.Data[i*c+j] += v
+		})
 	default:
 		mat.Data = make([]float64, r*c)
 		w := *m
@@ -525,6 +533,18 @@ func (m *Dense) Copy(a Matrix) (r, c int) {
 		default:
 			// Nothing to do.
 		}
+	case *COO, *CSR, *CSC:
+		for i := 0; i < r; i++ {
+			zero(m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c])
+		}
+		aU.(NonZeroDoer).DoNonZero(func(i, j int, v float64) {
+			if trans {
+				i, j = j, i
+			}
+			if i < r && j < c {
+				m.mat.Data[i*m.mat.Stride+j] += v
+			}
+		})
 	default:
 		m.checkOverlapMatrix(aU)
 		for i := 0; i < r; i++ {
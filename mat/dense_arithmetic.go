@@ -439,6 +439,22 @@ func (m *Dense) Mul(a, b Matrix) {
 		}
 	}
 
+	// The sparse products zero the receiver before
+	// reading the other operand, so it must not
+	// overlap the receiver.
+	switch aU.(type) {
+	case *COO, *CSR, *CSC:
+		m.checkOverlapMatrix(bU)
+		m.mulSparseLeft(aU.(NonZeroDoer), aTrans, b)
+		return
+	}
+	switch bU.(type) {
+	case *COO, *CSR, *CSC:
+		m.checkOverlapMatrix(aU)
+		m.mulSparseRight(a, bU.(NonZeroDoer), bTrans)
+		return
+	}
+
 	m.checkOverlapMatrix(aU)
 	m.checkOverlapMatrix(bU)
 	row := getFloat64s(ac, false)
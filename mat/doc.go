@@ -13,6 +13,7 @@
 // mat provides:
 //   - Interfaces for Matrix classes (Matrix, Symmetric, Triangular)
 //   - Concrete implementations (Dense, SymDense, TriDense, VecDense)
+//   - Sparse matrix storage formats (COO, CSR, CSC) and sparse factorizations
 //   - Methods and functions for using matrix data (Add, Trace, SymRankOne)
 //   - Types for constructing and using matrix factorizations (QR, LU, etc.)
 //   - The complementary types for complex matrices, CMatrix, CSymDense, etc.
@@ -118,7 +119,10 @@
 // factorized type, for example *LU.UTo. The factorization types can also be used
 // directly, as in *Cholesky.SolveTo. Some factorizations can be updated directly,
 // without needing to update the original matrix and refactorize, for example with
-// *LU.RankOne.
+// *LU.RankOne. The sparse factorizations, SparseCholesky and SparseLU, separate
+// the symbolic analysis of the sparsity pattern, computed by Analyze, from the
+// numerical factorization so that matrices with the same pattern can be
+// factorized repeatedly at reduced cost.
 //
 // # BLAS and LAPACK
 //
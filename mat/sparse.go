@@ -0,0 +1,870 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+var (
+	coo *COO
+	_   Matrix      = coo
+	_   allMatrix   = coo
+	_   NonZeroDoer = coo
+
+	csr *CSR
+	_   Matrix         = csr
+	_   allMatrix      = csr
+	_   NonZeroDoer    = csr
+	_   RowNonZeroDoer = csr
+	_   ColNonZeroDoer = csr
+
+	csc *CSC
+	_   Matrix         = csc
+	_   allMatrix      = csc
+	_   NonZeroDoer    = csc
+	_   RowNonZeroDoer = csc
+	_   ColNonZeroDoer = csc
+)
+
+// ErrSparseFormat is the panic value used when the index structure of a
+// sparse matrix is malformed.
+var ErrSparseFormat = Error{"mat: malformed sparse matrix structure"}
+
+// COO represents a sparse matrix in coordinate (triplet) format. Each stored
+// element is held as a row index, a column index and a value. Duplicate
+// entries are permitted and are summed when the matrix is read or converted
+// to a compressed format.
+//
+// The COO format is intended for the incremental construction of sparse
+// matrices which are then converted to CSR or CSC for computation.
+type COO struct {
+	r, c int
+	rows []int
+	cols []int
+	data []float64
+}
+
+// NewCOO creates a new r×c sparse matrix in coordinate format holding the
+// value data[k] at row rows[k] and column cols[k]. The rows, cols and data
+// slices are used as the backing storage of the matrix. If all of rows, cols
+// and data are nil, the matrix has no stored elements.
+//
+// NewCOO will panic if r or c is not positive, if the lengths of rows, cols
+// and data differ, or if any index is outside the bounds of the matrix.
+func NewCOO(r, c int, rows, cols []int, data []float64) *COO {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if len(rows) != len(data) || len(cols) != len(data) {
+		panic(ErrSliceLengthMismatch)
+	}
+	for k, i := range rows {
+		if uint(i) >= uint(r) {
+			panic(ErrRowAccess)
+		}
+		if uint(cols[k]) >= uint(c) {
+			panic(ErrColAccess)
+		}
+	}
+	return &COO{r: r, c: c, rows: rows, cols: cols, data: data}
+}
+
+// Dims returns the dimensions of the matrix.
+func (m *COO) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element at row i, column j. At sums all stored entries at
+// (i, j) and so takes time proportional to the number of stored entries.
+func (m *COO) At(i, j int) float64 {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	var v float64
+	for k, ik := range m.rows {
+		if ik == i && m.cols[k] == j {
+			v += m.data[k]
+		}
+	}
+	return v
+}
+
+// T performs an implicit transpose by returning the receiver inside a Transpose.
+func (m *COO) T() Matrix {
+	return Transpose{m}
+}
+
+// NNZ returns the number of stored entries in the matrix, including
+// duplicates and explicitly stored zeros.
+func (m *COO) NNZ() int {
+	return len(m.data)
+}
+
+// Append adds v to the element at row i, column j by appending a new entry
+// to the receiver's storage.
+func (m *COO) Append(i, j int, v float64) {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	m.rows = append(m.rows, i)
+	m.cols = append(m.cols, j)
+	m.data = append(m.data, v)
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (m *COO) IsEmpty() bool {
+	return m.r == 0
+}
+
+// Reset empties the matrix so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data. See the Reseter
+// interface for more information.
+func (m *COO) Reset() {
+	m.r, m.c = 0, 0
+	m.Zero()
+}
+
+// Zero sets all of the matrix elements to zero by removing all stored entries.
+func (m *COO) Zero() {
+	m.rows = m.rows[:0]
+	m.cols = m.cols[:0]
+	m.data = m.data[:0]
+}
+
+// DoNonZero calls the function fn for each of the stored entries of m. The
+// function fn takes a row/column index and the entry value of m at (i, j).
+// Duplicate entries are passed to fn individually.
+func (m *COO) DoNonZero(fn func(i, j int, v float64)) {
+	for k, v := range m.data {
+		if v != 0 {
+			fn(m.rows[k], m.cols[k], v)
+		}
+	}
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst. Duplicate
+// entries contribute their sum.
+func (m *COO) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	r, c := m.r, m.c
+	if trans {
+		r, c = c, r
+	}
+	if x.Len() != c {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(r)
+
+	xVec, ok := x.(*VecDense)
+	if !ok || xVec == dst {
+		xVec = getVecDenseWorkspace(c, false)
+		xVec.CloneFromVec(x)
+		defer putVecDenseWorkspace(xVec)
+	} else {
+		dst.checkOverlap(xVec.mat)
+	}
+	xd, xinc := xVec.mat.Data, xVec.mat.Inc
+	yd, yinc := dst.mat.Data, dst.mat.Inc
+
+	for i := 0; i < r; i++ {
+		yd[i*yinc] = 0
+	}
+	for k, v := range m.data {
+		i, j := m.rows[k], m.cols[k]
+		if trans {
+			i, j = j, i
+		}
+		yd[i*yinc] += v * xd[j*xinc]
+	}
+}
+
+// ToCSR returns a CSR matrix holding the elements of the receiver with
+// duplicate entries summed.
+func (m *COO) ToCSR() *CSR {
+	return &CSR{s: *compress(m.r, m.c, m.rows, m.cols, m.data)}
+}
+
+// ToCSC returns a CSC matrix holding the elements of the receiver with
+// duplicate entries summed.
+func (m *COO) ToCSC() *CSC {
+	return &CSC{s: *compress(m.c, m.r, m.cols, m.rows, m.data)}
+}
+
+// CSR represents a sparse matrix in compressed sparse row format. The column
+// indices and values of the stored elements of row i are held in
+// ind[indptr[i]:indptr[i+1]] and data[indptr[i]:indptr[i+1]], with the column
+// indices of each row in strictly increasing order.
+type CSR struct {
+	s compressed
+}
+
+// NewCSR creates a new r×c sparse matrix in compressed sparse row format. The
+// indptr, ind and data slices are used as the backing storage of the matrix;
+// indptr must have length r+1, and ind and data must have length indptr[r].
+// If all of indptr, ind and data are nil, the matrix has no stored elements.
+//
+// NewCSR will panic if r or c is not positive, with ErrSliceLengthMismatch if
+// the slice lengths are inconsistent and with ErrSparseFormat if indptr is not
+// non-decreasing or if the column indices of a row are not strictly
+// increasing and within the bounds of the matrix.
+func NewCSR(r, c int, indptr, ind []int, data []float64) *CSR {
+	return &CSR{s: *newCompressed(r, c, indptr, ind, data)}
+}
+
+// Dims returns the dimensions of the matrix.
+func (m *CSR) Dims() (r, c int) {
+	return m.s.major, m.s.minor
+}
+
+// At returns the element at row i, column j.
+func (m *CSR) At(i, j int) float64 {
+	if uint(i) >= uint(m.s.major) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.s.minor) {
+		panic(ErrColAccess)
+	}
+	return m.s.at(i, j)
+}
+
+// T returns the transpose of the receiver as a CSC matrix sharing the
+// receiver's backing data. Changes to the elements of the receiver will be
+// reflected in the returned matrix.
+func (m *CSR) T() Matrix {
+	return &CSC{s: m.s}
+}
+
+// NNZ returns the number of stored elements in the matrix.
+func (m *CSR) NNZ() int {
+	return m.s.nnz()
+}
+
+// RawCSR returns the backing slices of the receiver. Changes to the values
+// in data will be reflected in the receiver. The index slices must not be
+// modified.
+func (m *CSR) RawCSR() (indptr, ind []int, data []float64) {
+	return m.s.indptr, m.s.ind, m.s.data
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (m *CSR) IsEmpty() bool {
+	return m.s.major == 0
+}
+
+// Reset empties the matrix so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data. See the Reseter
+// interface for more information.
+func (m *CSR) Reset() {
+	m.s.reset()
+}
+
+// Zero sets all of the matrix elements to zero by removing all stored
+// elements.
+func (m *CSR) Zero() {
+	m.s.zero()
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of m. The
+// function fn takes a row/column index and the element value of m at (i, j).
+func (m *CSR) DoNonZero(fn func(i, j int, v float64)) {
+	m.s.doNonZero(fn)
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of row
+// i of m. The function fn takes a row/column index and the element value of m
+// at (i, j).
+func (m *CSR) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if uint(i) >= uint(m.s.major) {
+		panic(ErrRowAccess)
+	}
+	m.s.doMajorNonZero(i, fn)
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of m. The function fn takes a row/column index and the element
+// value of m at (i, j). DoColNonZero takes time proportional to the number of
+// rows of m times the logarithm of the number of stored elements in each row.
+func (m *CSR) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(m.s.minor) {
+		panic(ErrColAccess)
+	}
+	m.s.doMinorNonZero(j, fn)
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (m *CSR) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	m.s.mulVecTo(dst, trans, x)
+}
+
+// ToCOO returns a COO matrix holding the elements of the receiver.
+func (m *CSR) ToCOO() *COO {
+	rows, cols, data := m.s.triplets()
+	return &COO{r: m.s.major, c: m.s.minor, rows: rows, cols: cols, data: data}
+}
+
+// ToCSC returns a CSC matrix holding the elements of the receiver.
+func (m *CSR) ToCSC() *CSC {
+	return &CSC{s: *m.s.transpose()}
+}
+
+// Add adds a and b element-wise, placing the result in the receiver. Add will
+// panic if the two matrices do not have the same shape or if the receiver is
+// not empty and does not have the same shape as a and b. The receiver may be a
+// or b, but must not otherwise share backing data with them.
+func (m *CSR) Add(a, b *CSR) {
+	m.s.add(1, &a.s, 1, &b.s)
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. Sub
+// will panic if the two matrices do not have the same shape or if the receiver
+// is not empty and does not have the same shape as a and b. The receiver may
+// be a or b, but must not otherwise share backing data with them.
+func (m *CSR) Sub(a, b *CSR) {
+	m.s.add(1, &a.s, -1, &b.s)
+}
+
+// Scale multiplies the elements of a by f, placing the result in the receiver.
+// Scale will panic if the receiver is not empty and does not have the same
+// shape as a. The receiver may be a.
+func (m *CSR) Scale(f float64, a *CSR) {
+	m.s.scale(f, &a.s)
+}
+
+// CSC represents a sparse matrix in compressed sparse column format. The row
+// indices and values of the stored elements of column j are held in
+// ind[indptr[j]:indptr[j+1]] and data[indptr[j]:indptr[j+1]], with the row
+// indices of each column in strictly increasing order.
+type CSC struct {
+	s compressed
+}
+
+// NewCSC creates a new r×c sparse matrix in compressed sparse column format.
+// The indptr, ind and data slices are used as the backing storage of the
+// matrix; indptr must have length c+1, and ind and data must have length
+// indptr[c]. If all of indptr, ind and data are nil, the matrix has no stored
+// elements.
+//
+// NewCSC will panic if r or c is not positive, with ErrSliceLengthMismatch if
+// the slice lengths are inconsistent and with ErrSparseFormat if indptr is not
+// non-decreasing or if the row indices of a column are not strictly
+// increasing and within the bounds of the matrix.
+func NewCSC(r, c int, indptr, ind []int, data []float64) *CSC {
+	return &CSC{s: *newCompressed(c, r, indptr, ind, data)}
+}
+
+// Dims returns the dimensions of the matrix.
+func (m *CSC) Dims() (r, c int) {
+	return m.s.minor, m.s.major
+}
+
+// At returns the element at row i, column j.
+func (m *CSC) At(i, j int) float64 {
+	if uint(i) >= uint(m.s.minor) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.s.major) {
+		panic(ErrColAccess)
+	}
+	return m.s.at(j, i)
+}
+
+// T returns the transpose of the receiver as a CSR matrix sharing the
+// receiver's backing data. Changes to the elements of the receiver will be
+// reflected in the returned matrix.
+func (m *CSC) T() Matrix {
+	return &CSR{s: m.s}
+}
+
+// NNZ returns the number of stored elements in the matrix.
+func (m *CSC) NNZ() int {
+	return m.s.nnz()
+}
+
+// RawCSC returns the backing slices of the receiver. Changes to the values
+// in data will be reflected in the receiver. The index slices must not be
+// modified.
+func (m *CSC) RawCSC() (indptr, ind []int, data []float64) {
+	return m.s.indptr, m.s.ind, m.s.data
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (m *CSC) IsEmpty() bool {
+	return m.s.major == 0
+}
+
+// Reset empties the matrix so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data. See the Reseter
+// interface for more information.
+func (m *CSC) Reset() {
+	m.s.reset()
+}
+
+// Zero sets all of the matrix elements to zero by removing all stored
+// elements.
+func (m *CSC) Zero() {
+	m.s.zero()
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of m. The
+// function fn takes a row/column index and the element value of m at (i, j).
+func (m *CSC) DoNonZero(fn func(i, j int, v float64)) {
+	m.s.doNonZero(func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of row
+// i of m. The function fn takes a row/column index and the element value of m
+// at (i, j). DoRowNonZero takes time proportional to the number of columns of
+// m times the logarithm of the number of stored elements in each column.
+func (m *CSC) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if uint(i) >= uint(m.s.minor) {
+		panic(ErrRowAccess)
+	}
+	m.s.doMinorNonZero(i, func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of m. The function fn takes a row/column index and the element
+// value of m at (i, j).
+func (m *CSC) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(m.s.major) {
+		panic(ErrColAccess)
+	}
+	m.s.doMajorNonZero(j, func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (m *CSC) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	m.s.mulVecTo(dst, !trans, x)
+}
+
+// ToCOO returns a COO matrix holding the elements of the receiver.
+func (m *CSC) ToCOO() *COO {
+	cols, rows, data := m.s.triplets()
+	return &COO{r: m.s.minor, c: m.s.major, rows: rows, cols: cols, data: data}
+}
+
+// ToCSR returns a CSR matrix holding the elements of the receiver.
+func (m *CSC) ToCSR() *CSR {
+	return &CSR{s: *m.s.transpose()}
+}
+
+// Add adds a and b element-wise, placing the result in the receiver. Add will
+// panic if the two matrices do not have the same shape or if the receiver is
+// not empty and does not have the same shape as a and b. The receiver may be a
+// or b, but must not otherwise share backing data with them.
+func (m *CSC) Add(a, b *CSC) {
+	m.s.add(1, &a.s, 1, &b.s)
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. Sub
+// will panic if the two matrices do not have the same shape or if the receiver
+// is not empty and does not have the same shape as a and b. The receiver may
+// be a or b, but must not otherwise share backing data with them.
+func (m *CSC) Sub(a, b *CSC) {
+	m.s.add(1, &a.s, -1, &b.s)
+}
+
+// Scale multiplies the elements of a by f, placing the result in the receiver.
+// Scale will panic if the receiver is not empty and does not have the same
+// shape as a. The receiver may be a.
+func (m *CSC) Scale(f float64, a *CSC) {
+	m.s.scale(f, &a.s)
+}
+
+// compressed is the storage shared by the CSR and CSC formats. The matrix is
+// held as a sequence of major vectors, rows for CSR and columns for CSC. The
+// minor indices and values of the stored elements of major vector k are held
+// in ind[indptr[k]:indptr[k+1]] and data[indptr[k]:indptr[k+1]] with the
+// minor indices in strictly increasing order.
+type compressed struct {
+	major, minor int
+	indptr       []int
+	ind          []int
+	data         []float64
+}
+
+func newCompressed(major, minor int, indptr, ind []int, data []float64) *compressed {
+	if major <= 0 || minor <= 0 {
+		if major == 0 || minor == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if indptr == nil && ind == nil && data == nil {
+		indptr = make([]int, major+1)
+	}
+	if len(indptr) != major+1 || len(ind) != len(data) || indptr[major] != len(data) {
+		panic(ErrSliceLengthMismatch)
+	}
+	if indptr[0] != 0 {
+		panic(ErrSparseFormat)
+	}
+	for k := 0; k < major; k++ {
+		lo, hi := indptr[k], indptr[k+1]
+		if hi < lo {
+			panic(ErrSparseFormat)
+		}
+		last := -1
+		for _, l := range ind[lo:hi] {
+			if l <= last || l >= minor {
+				panic(ErrSparseFormat)
+			}
+			last = l
+		}
+	}
+	return &compressed{major: major, minor: minor, indptr: indptr, ind: ind, data: data}
+}
+
+// compress returns the compressed representation of the triplets (maj[k],
+// mnr[k], data[k]) with duplicate entries summed.
+func compress(major, minor int, maj, mnr []int, data []float64) *compressed {
+	// Sort the entries by minor index and then stably by major
+	// index using two counting sorts, so that the entries of each
+	// major vector are ordered by minor index.
+	n := len(data)
+	byMinor := make([]int, n)
+	count := make([]int, max(major, minor)+1)
+	for _, l := range mnr {
+		count[l+1]++
+	}
+	for l := 0; l < minor; l++ {
+		count[l+1] += count[l]
+	}
+	for k, l := range mnr {
+		byMinor[count[l]] = k
+		count[l]++
+	}
+
+	indptr := make([]int, major+1)
+	for _, k := range maj {
+		indptr[k+1]++
+	}
+	for k := 0; k < major; k++ {
+		indptr[k+1] += indptr[k]
+	}
+	next := count[:major]
+	copy(next, indptr)
+	order := make([]int, n)
+	for _, e := range byMinor {
+		k := maj[e]
+		order[next[k]] = e
+		next[k]++
+	}
+
+	// Merge duplicates.
+	ind := make([]int, 0, n)
+	val := make([]float64, 0, n)
+	lo := 0
+	for k := 0; k < major; k++ {
+		hi := indptr[k+1]
+		indptr[k] = len(ind)
+		for _, e := range order[lo:hi] {
+			if len(ind) > indptr[k] && ind[len(ind)-1] == mnr[e] {
+				val[len(val)-1] += data[e]
+				continue
+			}
+			ind = append(ind, mnr[e])
+			val = append(val, data[e])
+		}
+		lo = hi
+	}
+	indptr[major] = len(ind)
+	return &compressed{major: major, minor: minor, indptr: indptr, ind: ind, data: val}
+}
+
+func (s *compressed) nnz() int {
+	if s.major == 0 {
+		return 0
+	}
+	return s.indptr[s.major]
+}
+
+func (s *compressed) at(k, l int) float64 {
+	lo, hi := s.indptr[k], s.indptr[k+1]
+	p := lo + sort.SearchInts(s.ind[lo:hi], l)
+	if p < hi && s.ind[p] == l {
+		return s.data[p]
+	}
+	return 0
+}
+
+func (s *compressed) reset() {
+	s.major, s.minor = 0, 0
+	s.indptr = s.indptr[:0]
+	s.ind = s.ind[:0]
+	s.data = s.data[:0]
+}
+
+func (s *compressed) zero() {
+	zeroInts(s.indptr)
+	s.ind = s.ind[:0]
+	s.data = s.data[:0]
+}
+
+// reuseAs resizes an empty receiver to hold a major×minor matrix with room
+// for nnz stored elements, or panics with ErrShape if the receiver is not
+// empty and does not match the requested shape.
+func (s *compressed) reuseAs(major, minor, nnz int) {
+	if s.major != 0 && (s.major != major || s.minor != minor) {
+		panic(ErrShape)
+	}
+	s.major, s.minor = major, minor
+	s.indptr = useInt(s.indptr, major+1)
+	if cap(s.ind) < nnz {
+		s.ind = make([]int, 0, nnz)
+	}
+	if cap(s.data) < nnz {
+		s.data = make([]float64, 0, nnz)
+	}
+	s.ind = s.ind[:0]
+	s.data = s.data[:0]
+}
+
+func (s *compressed) doNonZero(fn func(k, l int, v float64)) {
+	for k := 0; k < s.major; k++ {
+		s.doMajorNonZero(k, fn)
+	}
+}
+
+func (s *compressed) doMajorNonZero(k int, fn func(k, l int, v float64)) {
+	lo, hi := s.indptr[k], s.indptr[k+1]
+	for p, v := range s.data[lo:hi] {
+		if v != 0 {
+			fn(k, s.ind[lo+p], v)
+		}
+	}
+}
+
+func (s *compressed) doMinorNonZero(l int, fn func(k, l int, v float64)) {
+	for k := 0; k < s.major; k++ {
+		v := s.at(k, l)
+		if v != 0 {
+			fn(k, l, v)
+		}
+	}
+}
+
+// triplets returns the major and minor indices and values of the stored
+// elements of s.
+func (s *compressed) triplets() (maj, mnr []int, data []float64) {
+	n := s.nnz()
+	maj = make([]int, n)
+	for k := 0; k < s.major; k++ {
+		for p := s.indptr[k]; p < s.indptr[k+1]; p++ {
+			maj[p] = k
+		}
+	}
+	mnr = make([]int, n)
+	copy(mnr, s.ind)
+	data = make([]float64, n)
+	copy(data, s.data[:n])
+	return maj, mnr, data
+}
+
+// transpose returns the compressed representation of s with the roles of
+// the major and minor indices exchanged.
+func (s *compressed) transpose() *compressed {
+	n := s.nnz()
+	t := &compressed{
+		major:  s.minor,
+		minor:  s.major,
+		indptr: make([]int, s.minor+1),
+		ind:    make([]int, n),
+		data:   make([]float64, n),
+	}
+	for _, l := range s.ind[:n] {
+		t.indptr[l+1]++
+	}
+	for l := 0; l < s.minor; l++ {
+		t.indptr[l+1] += t.indptr[l]
+	}
+	next := make([]int, s.minor)
+	copy(next, t.indptr)
+	for k := 0; k < s.major; k++ {
+		for p := s.indptr[k]; p < s.indptr[k+1]; p++ {
+			l := s.ind[p]
+			q := next[l]
+			t.ind[q] = k
+			t.data[q] = s.data[p]
+			next[l]++
+		}
+	}
+	return t
+}
+
+// add places alpha*a + beta*b into the receiver.
+func (s *compressed) add(alpha float64, a *compressed, beta float64, b *compressed) {
+	if a.major != b.major || a.minor != b.minor {
+		panic(ErrShape)
+	}
+	if s == a || s == b {
+		// The operands' storage would be truncated
+		// by reuseAs, so work in a temporary.
+		var t compressed
+		t.add(alpha, a, beta, b)
+		*s = t
+		return
+	}
+	s.reuseAs(a.major, a.minor, a.nnz()+b.nnz())
+	for k := 0; k < a.major; k++ {
+		s.indptr[k] = len(s.ind)
+		p, pEnd := a.indptr[k], a.indptr[k+1]
+		q, qEnd := b.indptr[k], b.indptr[k+1]
+		for p < pEnd || q < qEnd {
+			switch {
+			case q == qEnd || (p < pEnd && a.ind[p] < b.ind[q]):
+				s.ind = append(s.ind, a.ind[p])
+				s.data = append(s.data, alpha*a.data[p])
+				p++
+			case p == pEnd || b.ind[q] < a.ind[p]:
+				s.ind = append(s.ind, b.ind[q])
+				s.data = append(s.data, beta*b.data[q])
+				q++
+			default:
+				s.ind = append(s.ind, a.ind[p])
+				s.data = append(s.data, alpha*a.data[p]+beta*b.data[q])
+				p++
+				q++
+			}
+		}
+	}
+	s.indptr[a.major] = len(s.ind)
+}
+
+// scale places f*a into the receiver.
+func (s *compressed) scale(f float64, a *compressed) {
+	if s == a {
+		for p := range s.data {
+			s.data[p] *= f
+		}
+		return
+	}
+	n := a.nnz()
+	s.reuseAs(a.major, a.minor, n)
+	copy(s.indptr, a.indptr)
+	s.ind = append(s.ind, a.ind[:n]...)
+	for _, v := range a.data[:n] {
+		s.data = append(s.data, f*v)
+	}
+}
+
+// mulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst where A is the
+// matrix with rows given by the major vectors of s.
+func (s *compressed) mulVecTo(dst *VecDense, trans bool, x Vector) {
+	m, n := s.major, s.minor
+	if trans {
+		m, n = n, m
+	}
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(m)
+
+	xVec, ok := x.(*VecDense)
+	if !ok || xVec == dst {
+		xVec = getVecDenseWorkspace(n, false)
+		xVec.CloneFromVec(x)
+		defer putVecDenseWorkspace(xVec)
+	} else {
+		dst.checkOverlap(xVec.mat)
+	}
+	xd, xinc := xVec.mat.Data, xVec.mat.Inc
+	yd, yinc := dst.mat.Data, dst.mat.Inc
+
+	if !trans {
+		for k := 0; k < s.major; k++ {
+			var sum float64
+			for p := s.indptr[k]; p < s.indptr[k+1]; p++ {
+				sum += s.data[p] * xd[s.ind[p]*xinc]
+			}
+			yd[k*yinc] = sum
+		}
+		return
+	}
+	for i := 0; i < m; i++ {
+		yd[i*yinc] = 0
+	}
+	for k := 0; k < s.major; k++ {
+		xk := xd[k*xinc]
+		if xk == 0 {
+			continue
+		}
+		for p := s.indptr[k]; p < s.indptr[k+1]; p++ {
+			yd[s.ind[p]*yinc] += s.data[p] * xk
+		}
+	}
+}
+
+// zeroInts zeros the given slice's elements.
+func zeroInts(a []int) {
+	for i := range a {
+		a[i] = 0
+	}
+}
+
+// mulSparseLeft computes A⋅B or Aᵀ⋅B placing the result into the receiver
+// where A is a sparse matrix. The receiver must have the correct shape and
+// must not alias b.
+func (m *Dense) mulSparseLeft(a NonZeroDoer, aTrans bool, b Matrix) {
+	bd, ok := b.(*Dense)
+	if !ok {
+		br, bc := b.Dims()
+		bd = getDenseWorkspace(br, bc, false)
+		defer putDenseWorkspace(bd)
+		bd.Copy(b)
+	}
+	m.Zero()
+	c := bd.mat.Cols
+	a.DoNonZero(func(i, j int, v float64) {
+		if aTrans {
+			i, j = j, i
+		}
+		f64.AxpyUnitary(v, bd.mat.Data[j*bd.mat.Stride:j*bd.mat.Stride+c], m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+c])
+	})
+}
+
+// mulSparseRight computes A⋅B or A⋅Bᵀ placing the result into the receiver
+// where B is a sparse matrix. The receiver must have the correct shape and
+// must not alias a.
+func (m *Dense) mulSparseRight(a Matrix, b NonZeroDoer, bTrans bool) {
+	ad, ok := a.(*Dense)
+	if !ok {
+		ar, ac := a.Dims()
+		ad = getDenseWorkspace(ar, ac, false)
+		defer putDenseWorkspace(ad)
+		ad.Copy(a)
+	}
+	m.Zero()
+	r := uintptr(ad.mat.Rows)
+	b.DoNonZero(func(j, k int, v float64) {
+		if bTrans {
+			j, k = k, j
+		}
+		f64.AxpyInc(v, ad.mat.Data, m.mat.Data, r, uintptr(ad.mat.Stride), uintptr(m.mat.Stride), uintptr(j), uintptr(k))
+	})
+}
@@ -0,0 +1,448 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+const badSparseCholesky = "mat: invalid sparse Cholesky factorization"
+
+// ErrSparsePattern is the panic value used when a sparse factorization is
+// computed for a matrix with elements outside the analyzed sparsity pattern.
+var ErrSparsePattern = Error{"mat: matrix elements outside analyzed sparsity pattern"}
+
+// SparseCholesky is a symmetric positive definite matrix represented by its
+// sparse Cholesky decomposition
+//
+//	P * A * Pᵀ = L * Lᵀ
+//
+// where P is a fill-reducing permutation matrix and L is a sparse lower
+// triangular matrix.
+//
+// The decomposition is computed in two phases. Analyze computes the
+// permutation and the sparsity pattern of L from the sparsity pattern of A.
+// Factorize computes the values of L by left-looking column elimination.
+// Once a pattern has been analyzed, Factorize may be called repeatedly for
+// matrices with the same or a smaller sparsity pattern, avoiding the cost of
+// the symbolic analysis.
+//
+// Only the elements on and above the diagonal of the matrix are used.
+type SparseCholesky struct {
+	n int
+
+	// perm[k] is the row and column of A that is the k-th row and column
+	// of P * A * Pᵀ, and iperm is its inverse.
+	perm, iperm []int
+
+	// The elimination tree of P * A * Pᵀ.
+	parent []int
+
+	// The pattern of the strictly lower triangular part of row k of L is
+	// rowInd[rowPtr[k]:rowPtr[k+1]].
+	rowPtr, rowInd []int
+
+	// L in compressed sparse column format. The diagonal element is the
+	// first element of each column and the row indices are increasing.
+	colPtr, colInd []int
+	data           []float64
+
+	factorized bool
+	cond       float64
+}
+
+// Analyze computes the fill-reducing permutation and the symbolic
+// factorization of the square matrix a using the given ordering, discarding
+// any previous factorization held by the receiver. Only the sparsity pattern of
+// a is used. If a is a COO, CSR or CSC, explicitly stored zero elements are
+// included in the pattern.
+//
+// Analyze panics if a is not square.
+func (c *SparseCholesky) Analyze(a Matrix, ordering SparseOrdering) {
+	r, cols := a.Dims()
+	if r != cols {
+		panic(ErrSquare)
+	}
+	n := r
+	rows, colIdx, _ := upperEntries(a)
+	c.n = n
+	c.perm = newSparseGraph(n, rows, colIdx).order(ordering)
+	c.iperm = make([]int, n)
+	for k, i := range c.perm {
+		c.iperm[i] = k
+	}
+	c.factorized = false
+	c.cond = math.Inf(1)
+
+	// Row k of the lower triangle of P * A * Pᵀ.
+	lower := c.permuteLower(rows, colIdx, nil).transpose()
+
+	// Compute the elimination tree using path compression.
+	c.parent = make([]int, n)
+	ancestor := make([]int, n)
+	for k := 0; k < n; k++ {
+		c.parent[k] = -1
+		ancestor[k] = -1
+		for _, i := range lower.ind[lower.indptr[k]:lower.indptr[k+1]] {
+			for i != -1 && i < k {
+				next := ancestor[i]
+				ancestor[i] = k
+				if next == -1 {
+					c.parent[i] = k
+				}
+				i = next
+			}
+		}
+	}
+
+	// The pattern of row k of L is the set of nodes reached by walking up
+	// the elimination tree from each column index of row k of the lower
+	// triangle of P * A * Pᵀ.
+	c.rowPtr = make([]int, n+1)
+	c.rowInd = c.rowInd[:0]
+	colCount := make([]int, n)
+	mark := make([]int, n)
+	for i := range mark {
+		mark[i] = -1
+	}
+	for k := 0; k < n; k++ {
+		mark[k] = k
+		for _, i := range lower.ind[lower.indptr[k]:lower.indptr[k+1]] {
+			for ; i < k && mark[i] != k; i = c.parent[i] {
+				mark[i] = k
+				c.rowInd = append(c.rowInd, i)
+				colCount[i]++
+			}
+		}
+		c.rowPtr[k+1] = len(c.rowInd)
+	}
+
+	c.colPtr = make([]int, n+1)
+	for j := 0; j < n; j++ {
+		c.colPtr[j+1] = c.colPtr[j] + colCount[j] + 1
+	}
+	c.colInd = make([]int, c.colPtr[n])
+	next := colCount
+	for j := 0; j < n; j++ {
+		c.colInd[c.colPtr[j]] = j
+		next[j] = c.colPtr[j] + 1
+	}
+	for k := 0; k < n; k++ {
+		for _, j := range c.rowInd[c.rowPtr[k]:c.rowPtr[k+1]] {
+			c.colInd[next[j]] = k
+			next[j]++
+		}
+	}
+	c.data = make([]float64, c.colPtr[n])
+}
+
+// permuteLower returns the lower triangle of P * A * Pᵀ in compressed sparse
+// column format from the upper triangular elements of A.
+func (c *SparseCholesky) permuteLower(rows, cols []int, data []float64) *compressed {
+	maj := make([]int, len(rows))
+	mnr := make([]int, len(rows))
+	for k := range rows {
+		i, j := c.iperm[rows[k]], c.iperm[cols[k]]
+		maj[k] = min(i, j)
+		mnr[k] = max(i, j)
+	}
+	if data == nil {
+		data = make([]float64, len(rows))
+	}
+	return compress(c.n, c.n, maj, mnr, data)
+}
+
+// Factorize computes the numerical Cholesky factorization of the symmetric
+// matrix a, using the elements on and above the diagonal of a. If the
+// receiver does not hold an analysis of a matrix with the same size as a,
+// Factorize first calls Analyze with AMDOrdering.
+//
+// Factorize returns whether the matrix is positive definite. If Factorize
+// returns false, the factorization must not be used, but the analysis is
+// retained. Factorize panics with ErrSparsePattern if a has elements
+// outside the analyzed sparsity pattern.
+func (c *SparseCholesky) Factorize(a Matrix) (ok bool) {
+	r, cols := a.Dims()
+	if r != cols {
+		panic(ErrSquare)
+	}
+	if c.perm == nil || c.n != r {
+		c.Analyze(a, AMDOrdering)
+	}
+	c.factorized = false
+	c.cond = math.Inf(1)
+	n := c.n
+	rows, colIdx, vals := upperEntries(a)
+	lower := c.permuteLower(rows, colIdx, vals)
+
+	x := getFloat64s(n, true)
+	defer putFloat64s(x)
+	inPattern := make([]int, n)
+	for i := range inPattern {
+		inPattern[i] = -1
+	}
+	next := make([]int, n)
+	lmax := 0.0
+	lmin := math.Inf(1)
+	for j := 0; j < n; j++ {
+		lo, hi := c.colPtr[j], c.colPtr[j+1]
+		for _, i := range c.colInd[lo:hi] {
+			inPattern[i] = j
+		}
+		for p := lower.indptr[j]; p < lower.indptr[j+1]; p++ {
+			i := lower.ind[p]
+			if inPattern[i] != j {
+				panic(ErrSparsePattern)
+			}
+			x[i] = lower.data[p]
+		}
+
+		// Subtract the contributions of the columns k of L with
+		// L[j,k] != 0 from column j.
+		for _, k := range c.rowInd[c.rowPtr[j]:c.rowPtr[j+1]] {
+			p := next[k]
+			ljk := c.data[p]
+			for q := p; q < c.colPtr[k+1]; q++ {
+				x[c.colInd[q]] -= c.data[q] * ljk
+			}
+			next[k] = p + 1
+		}
+
+		d := x[j]
+		if !(d > 0) {
+			for _, i := range c.colInd[lo:hi] {
+				x[i] = 0
+			}
+			return false
+		}
+		ljj := math.Sqrt(d)
+		lmax = math.Max(lmax, ljj)
+		lmin = math.Min(lmin, ljj)
+		c.data[lo] = ljj
+		x[j] = 0
+		for q := lo + 1; q < hi; q++ {
+			i := c.colInd[q]
+			c.data[q] = x[i] / ljj
+			x[i] = 0
+		}
+		next[j] = lo + 1
+	}
+	c.factorized = true
+	// The square of the ratio of the extreme diagonal elements of L is a
+	// lower bound on the condition number of A.
+	c.cond = (lmax / lmin) * (lmax / lmin)
+	return true
+}
+
+// upperEntries returns the indices and values of the elements of a on and
+// above the diagonal. Explicitly stored elements of COO, CSR and CSC matrices
+// are always returned.
+func upperEntries(a Matrix) (rows, cols []int, data []float64) {
+	add := func(i, j int, v float64) {
+		if i <= j {
+			rows = append(rows, i)
+			cols = append(cols, j)
+			data = append(data, v)
+		}
+	}
+	doStoredNonZero(a, add)
+	return rows, cols, data
+}
+
+// doStoredNonZero calls fn for each element of a that is stored in a sparse
+// representation, or for each non-zero element when a is not sparse.
+func doStoredNonZero(a Matrix, fn func(i, j int, v float64)) {
+	switch a := a.(type) {
+	case *COO:
+		for k, v := range a.data {
+			fn(a.rows[k], a.cols[k], v)
+		}
+	case *CSR:
+		a.s.doNonZero(fn)
+	case *CSC:
+		a.s.doNonZero(func(j, i int, v float64) { fn(i, j, v) })
+	case NonZeroDoer:
+		a.DoNonZero(fn)
+	default:
+		r, c := a.Dims()
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				if v := a.At(i, j); v != 0 {
+					fn(i, j, v)
+				}
+			}
+		}
+	}
+}
+
+// SymmetricDim returns the number of rows and columns of the factorized
+// matrix.
+func (c *SparseCholesky) SymmetricDim() int {
+	return c.n
+}
+
+// NNZ returns the number of stored elements of L including the diagonal. It
+// returns zero if the receiver does not hold an analysis.
+func (c *SparseCholesky) NNZ() int {
+	if c.colPtr == nil {
+		return 0
+	}
+	return c.colPtr[c.n]
+}
+
+// Cond returns an estimate of the condition number of the factorized matrix.
+func (c *SparseCholesky) Cond() float64 {
+	if !c.factorized {
+		panic(badSparseCholesky)
+	}
+	return c.cond
+}
+
+// Permutation returns the fill-reducing permutation P of the decomposition
+//
+//	P * A * Pᵀ = L * Lᵀ
+//
+// where row k of P * A * Pᵀ is row dst[k] of A. If dst is nil, a new slice is
+// allocated and returned. If dst is not nil and the length of dst does not
+// equal the size of the analyzed matrix, Permutation will panic. Permutation
+// will panic if the receiver does not hold an analysis.
+func (c *SparseCholesky) Permutation(dst []int) []int {
+	if c.perm == nil {
+		panic(badSparseCholesky)
+	}
+	if dst == nil {
+		dst = make([]int, c.n)
+	}
+	if len(dst) != c.n {
+		panic(badSliceLength)
+	}
+	copy(dst, c.perm)
+	return dst
+}
+
+// LTo stores into dst the n×n sparse lower triangular matrix L of the
+// decomposition. LTo will panic if the receiver does not contain a successful
+// factorization.
+func (c *SparseCholesky) LTo(dst *CSC) {
+	if !c.factorized {
+		panic(badSparseCholesky)
+	}
+	dst.s = compressed{
+		major:  c.n,
+		minor:  c.n,
+		indptr: append([]int(nil), c.colPtr...),
+		ind:    append([]int(nil), c.colInd...),
+		data:   append([]float64(nil), c.data...),
+	}
+}
+
+// Reset resets the factorization and the analysis so that the receiver can
+// be reused.
+func (c *SparseCholesky) Reset() {
+	*c = SparseCholesky{
+		rowInd: c.rowInd[:0],
+		cond:   math.Inf(1),
+	}
+}
+
+// IsEmpty returns whether the receiver is empty.
+func (c *SparseCholesky) IsEmpty() bool {
+	return c.perm == nil
+}
+
+// Det returns the determinant of the matrix that has been factorized.
+func (c *SparseCholesky) Det() float64 {
+	return math.Exp(c.LogDet())
+}
+
+// LogDet returns the log of the determinant of the matrix that has been
+// factorized.
+func (c *SparseCholesky) LogDet() float64 {
+	if !c.factorized {
+		panic(badSparseCholesky)
+	}
+	var det float64
+	for j := 0; j < c.n; j++ {
+		det += 2 * math.Log(c.data[c.colPtr[j]])
+	}
+	return det
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the sparse Cholesky decomposition. The result is stored in-place into
+// dst. If the decomposition is near-singular a Condition error is returned.
+// See the documentation for Condition for more information.
+func (c *SparseCholesky) SolveTo(dst *Dense, b Matrix) error {
+	if !c.factorized {
+		panic(badSparseCholesky)
+	}
+	n := c.n
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(bm, bn)
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+	for j := 0; j < bn; j++ {
+		for k, i := range c.perm {
+			work[k] = b.At(i, j)
+		}
+		c.solve(work)
+		for k, i := range c.perm {
+			dst.set(i, j, work[k])
+		}
+	}
+	if c.cond > ConditionTolerance {
+		return Condition(c.cond)
+	}
+	return nil
+}
+
+// SolveVecTo finds the vector x that solves A * x = b where A is represented
+// by the sparse Cholesky decomposition. The result is stored in-place into
+// dst. If the decomposition is near-singular a Condition error is returned.
+// See the documentation for Condition for more information.
+func (c *SparseCholesky) SolveVecTo(dst *VecDense, b Vector) error {
+	if !c.factorized {
+		panic(badSparseCholesky)
+	}
+	n := c.n
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+	for k, i := range c.perm {
+		work[k] = b.AtVec(i)
+	}
+	c.solve(work)
+	dst.reuseAsNonZeroed(n)
+	for k, i := range c.perm {
+		dst.setVec(i, work[k])
+	}
+	if c.cond > ConditionTolerance {
+		return Condition(c.cond)
+	}
+	return nil
+}
+
+// solve solves L * Lᵀ * x = b in place.
+func (c *SparseCholesky) solve(x []float64) {
+	for j := 0; j < c.n; j++ {
+		lo, hi := c.colPtr[j], c.colPtr[j+1]
+		x[j] /= c.data[lo]
+		xj := x[j]
+		for q := lo + 1; q < hi; q++ {
+			x[c.colInd[q]] -= c.data[q] * xj
+		}
+	}
+	for j := c.n - 1; j >= 0; j-- {
+		lo, hi := c.colPtr[j], c.colPtr[j+1]
+		s := x[j]
+		for q := lo + 1; q < hi; q++ {
+			s -= c.data[q] * x[c.colInd[q]]
+		}
+		x[j] = s / c.data[lo]
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleSparseCholesky() {
+	// Construct the upper triangle of the shifted tridiagonal matrix of the
+	// one dimensional Poisson equation.
+	const n = 5
+	a := mat.NewCOO(n, n, nil, nil, nil)
+	poisson := func(shift float64) {
+		a.Zero()
+		for i := 0; i < n; i++ {
+			a.Append(i, i, 2+shift)
+			if i < n-1 {
+				a.Append(i, i+1, -1)
+			}
+		}
+	}
+	poisson(0)
+
+	// Compute the fill-reducing ordering and the sparsity pattern of the
+	// factor once.
+	var chol mat.SparseCholesky
+	chol.Analyze(a, mat.AMDOrdering)
+
+	b := mat.NewVecDense(n, []float64{1, 1, 1, 1, 1})
+	for _, shift := range []float64{0.1, 1} {
+		// Factorize matrices with the same sparsity pattern reusing
+		// the analysis.
+		poisson(shift)
+		if ok := chol.Factorize(a); !ok {
+			fmt.Println("a matrix is not positive definite.")
+			return
+		}
+
+		var x mat.VecDense
+		if err := chol.SolveVecTo(&x, b); err != nil {
+			fmt.Println("Matrix is near singular: ", err)
+		}
+		fmt.Printf("shift = %v\n", shift)
+		fmt.Printf("det(a) = %.4f\n", chol.Det())
+		fmt.Printf("x = %.4f\n", mat.Formatted(x.T()))
+	}
+
+	// Output:
+	// shift = 0.1
+	// det(a) = 10.0970
+	// x = [1.8609  2.9078  3.2455  2.9078  1.8609]
+	// shift = 1
+	// det(a) = 144.0000
+	// x = [0.6111  0.8333  0.8889  0.8333  0.6111]
+}
@@ -0,0 +1,229 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+var sparseOrderings = []SparseOrdering{NaturalOrdering, AMDOrdering, NestedDissectionOrdering}
+
+// randSparseSPD returns a random n×n symmetric positive definite matrix in
+// CSR format with approximately density*n*n off-diagonal elements and the
+// equivalent SymDense.
+func randSparseSPD(n int, density float64, rnd *rand.Rand) (*CSR, *SymDense) {
+	coo := NewCOO(n, n, nil, nil, nil)
+	sym := NewSymDense(n, nil)
+	diag := make([]float64, n)
+	for k := 0; k < int(density*float64(n*n)/2); k++ {
+		i := rnd.IntN(n)
+		j := rnd.IntN(n)
+		if i == j {
+			continue
+		}
+		v := rnd.NormFloat64()
+		coo.Append(i, j, v)
+		coo.Append(j, i, v)
+		sym.SetSym(i, j, sym.At(i, j)+v)
+		diag[i] += math.Abs(v)
+		diag[j] += math.Abs(v)
+	}
+	// Make the matrix diagonally dominant.
+	for i, d := range diag {
+		d += 1 + rnd.Float64()
+		coo.Append(i, i, d)
+		sym.SetSym(i, i, d)
+	}
+	return coo.ToCSR(), sym
+}
+
+// gridLaplacian returns the CSR graph Laplacian of an nx×ny grid plus the
+// identity, with its vertices randomly permuted if rnd is not nil.
+func gridLaplacian(nx, ny int, rnd *rand.Rand) *CSR {
+	n := nx * ny
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	if rnd != nil {
+		rnd.Shuffle(n, func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+	}
+	coo := NewCOO(n, n, nil, nil, nil)
+	for x := 0; x < nx; x++ {
+		for y := 0; y < ny; y++ {
+			v := perm[x*ny+y]
+			coo.Append(v, v, 1)
+			for _, d := range [][2]int{{1, 0}, {0, 1}} {
+				if x+d[0] >= nx || y+d[1] >= ny {
+					continue
+				}
+				u := perm[(x+d[0])*ny+y+d[1]]
+				coo.Append(u, v, -1)
+				coo.Append(v, u, -1)
+				coo.Append(u, u, 1)
+				coo.Append(v, v, 1)
+			}
+		}
+	}
+	return coo.ToCSR()
+}
+
+func TestSparseCholesky(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 50, 150} {
+		for _, density := range []float64{0, 0.05, 0.2, 1} {
+			for _, ordering := range sparseOrderings {
+				name := fmt.Sprintf("n=%d density=%v ordering=%d", n, density, ordering)
+				a, sym := randSparseSPD(n, density, rnd)
+
+				var chol SparseCholesky
+				chol.Analyze(a, ordering)
+				if !chol.Factorize(a) {
+					t.Errorf("%s: unexpected factorization failure", name)
+					continue
+				}
+				checkSparseCholesky(t, name, &chol, sym, rnd)
+
+				// Refactorize a matrix with the same pattern.
+				b := a.ToCOO()
+				for k := range b.data {
+					if b.rows[k] == b.cols[k] {
+						b.data[k] *= 2
+					}
+				}
+				sym2 := NewSymDense(n, nil)
+				sym2.CopySym(sym)
+				for i := 0; i < n; i++ {
+					sym2.SetSym(i, i, 2*sym.At(i, i))
+				}
+				if !chol.Factorize(b) {
+					t.Errorf("%s: unexpected refactorization failure", name)
+					continue
+				}
+				checkSparseCholesky(t, name+" refactorized", &chol, sym2, rnd)
+			}
+		}
+	}
+}
+
+// checkSparseCholesky checks the factorization, solution and determinant of
+// chol against the dense matrix sym.
+func checkSparseCholesky(t *testing.T, name string, chol *SparseCholesky, sym *SymDense, rnd *rand.Rand) {
+	t.Helper()
+	n := sym.SymmetricDim()
+	perm := chol.Permutation(nil)
+	var l CSC
+	chol.LTo(&l)
+	var llt Dense
+	llt.Mul(&l, l.T())
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if got, want := llt.At(i, j), sym.At(perm[i], perm[j]); !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+				t.Errorf("%s: L*Lᵀ mismatch at (%d,%d): got %v, want %v", name, i, j, got, want)
+				return
+			}
+		}
+	}
+
+	var dense Cholesky
+	if !dense.Factorize(sym) {
+		t.Fatalf("%s: dense factorization failed", name)
+	}
+	if got, want := chol.LogDet(), dense.LogDet(); !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+		t.Errorf("%s: LogDet mismatch: got %v, want %v", name, got, want)
+	}
+
+	b := NewDense(n, 3, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < 3; j++ {
+			b.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var x, want Dense
+	if err := chol.SolveTo(&x, b); err != nil {
+		t.Errorf("%s: unexpected error from SolveTo: %v", name, err)
+	}
+	if err := dense.SolveTo(&want, b); err != nil {
+		t.Fatalf("%s: unexpected error from dense SolveTo: %v", name, err)
+	}
+	if !EqualApprox(&x, &want, 1e-10) {
+		t.Errorf("%s: SolveTo mismatch", name)
+	}
+
+	var xv, wantv VecDense
+	bv := b.ColView(0)
+	if err := chol.SolveVecTo(&xv, bv); err != nil {
+		t.Errorf("%s: unexpected error from SolveVecTo: %v", name, err)
+	}
+	_ = dense.SolveVecTo(&wantv, bv)
+	if !EqualApprox(&xv, &wantv, 1e-10) {
+		t.Errorf("%s: SolveVecTo mismatch", name)
+	}
+	// Solve in place.
+	xv.CloneFromVec(bv)
+	_ = chol.SolveVecTo(&xv, &xv)
+	if !EqualApprox(&xv, &wantv, 1e-10) {
+		t.Errorf("%s: in-place SolveVecTo mismatch", name)
+	}
+}
+
+func TestSparseCholeskyFailure(t *testing.T) {
+	t.Parallel()
+	a := NewCOO(3, 3, []int{0, 0, 1, 2}, []int{0, 1, 1, 2}, []float64{1, 2, 1, 1})
+	var chol SparseCholesky
+	if chol.Factorize(a) {
+		t.Error("unexpected success factorizing indefinite matrix")
+	}
+	if p, _ := panics(func() { chol.LogDet() }); !p {
+		t.Error("expected panic from LogDet after failed factorization")
+	}
+
+	chol.Analyze(a, AMDOrdering)
+	b := NewCOO(3, 3, []int{0, 1, 2, 0}, []int{0, 1, 2, 2}, []float64{2, 2, 2, 1})
+	if p, _ := panics(func() { chol.Factorize(b) }); !p {
+		t.Error("expected panic factorizing matrix outside analyzed pattern")
+	}
+}
+
+func TestSparseOrderingFill(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const nx, ny = 30, 30
+	a := gridLaplacian(nx, ny, rnd)
+	nnz := make(map[SparseOrdering]int)
+	for _, ordering := range sparseOrderings {
+		var chol SparseCholesky
+		chol.Analyze(a, ordering)
+		perm := chol.Permutation(nil)
+		seen := make([]bool, len(perm))
+		for _, v := range perm {
+			if seen[v] {
+				t.Fatalf("ordering %d: not a permutation", ordering)
+			}
+			seen[v] = true
+		}
+		if !chol.Factorize(a) {
+			t.Fatalf("ordering %d: unexpected factorization failure", ordering)
+		}
+		nnz[ordering] = chol.NNZ()
+	}
+	// The natural ordering of a randomly permuted grid fills in almost
+	// completely, while the fill-reducing orderings are close to the
+	// O(n log n) optimum.
+	for _, ordering := range []SparseOrdering{AMDOrdering, NestedDissectionOrdering} {
+		if nnz[ordering] > nnz[NaturalOrdering]/5 {
+			t.Errorf("ordering %d: too much fill: got %d, natural %d", ordering, nnz[ordering], nnz[NaturalOrdering])
+		}
+		if limit := 8 * nx * ny * int(math.Log2(nx*ny)); nnz[ordering] > limit {
+			t.Errorf("ordering %d: too much fill: got %d, limit %d", ordering, nnz[ordering], limit)
+		}
+	}
+}
@@ -0,0 +1,531 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+const badSparseLU = "mat: invalid sparse LU factorization"
+
+// SparseLU is a square matrix represented by its sparse LU decomposition
+//
+//	P * A * Q = L * U
+//
+// where P is the row permutation matrix given by partial pivoting, Q is a
+// fill-reducing column permutation matrix, L is a sparse unit lower
+// triangular matrix and U is a sparse upper triangular matrix.
+//
+// The decomposition is computed in two phases. Analyze computes the column
+// permutation from the sparsity pattern of Aᵀ * A, which bounds the fill of L
+// and U for any choice of row pivots. Factorize computes L and U by
+// left-looking column elimination with partial pivoting, as described in
+//
+//	Gilbert, J. R., and Peierls, T. "Sparse partial pivoting in time
+//	proportional to arithmetic operations." SIAM Journal on Scientific and
+//	Statistical Computing 9.5 (1988): 862-874.
+//
+// Once a matrix has been analyzed, Factorize may be called repeatedly for
+// matrices with the same sparsity pattern, avoiding the cost of the symbolic
+// analysis.
+type SparseLU struct {
+	n int
+
+	// colPerm[k] is the column of A that is the k-th column of P * A * Q.
+	colPerm []int
+
+	// rowPerm[i] is the row of P * A * Q that is row i of A.
+	rowPerm []int
+
+	// L and U in compressed sparse column format. The unit diagonal
+	// element of L is stored first in each column and the diagonal
+	// element of U is stored last.
+	lPtr, lInd []int
+	lData      []float64
+	uPtr, uInd []int
+	uData      []float64
+
+	factorized bool
+	ok         bool // Whether the factorized matrix is non-singular.
+	cond       float64
+}
+
+// Analyze computes the fill-reducing column permutation of the square matrix
+// a using the given ordering, discarding any previous factorization held by
+// the receiver. Only the sparsity pattern of a is used.
+//
+// Analyze panics if a is not square.
+func (lu *SparseLU) Analyze(a Matrix, ordering SparseOrdering) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	n := r
+	lu.n = n
+	lu.factorized = false
+	lu.ok = false
+	lu.cond = math.Inf(1)
+
+	// Two columns of A are adjacent in the graph of Aᵀ * A when they share
+	// a non-zero row.
+	var rows, cols []int
+	doStoredNonZero(a, func(i, j int, _ float64) {
+		rows = append(rows, i)
+		cols = append(cols, j)
+	})
+	byRow := compress(n, n, rows, cols, make([]float64, len(rows)))
+	var u, v []int
+	for i := 0; i < n; i++ {
+		row := byRow.ind[byRow.indptr[i]:byRow.indptr[i+1]]
+		for k, j := range row {
+			for _, l := range row[k+1:] {
+				u = append(u, j)
+				v = append(v, l)
+			}
+		}
+	}
+	lu.colPerm = newSparseGraph(n, u, v).order(ordering)
+}
+
+// Factorize computes the numerical LU factorization of the square matrix a
+// with partial pivoting. If the receiver does not hold an analysis of a matrix
+// with the same size as a, Factorize first calls Analyze with AMDOrdering.
+//
+// If a is singular, the factorization is stopped at the first zero pivot and
+// subsequent calls to SolveTo and SolveVecTo return a Condition error.
+func (lu *SparseLU) Factorize(a Matrix) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	if lu.colPerm == nil || lu.n != r {
+		lu.Analyze(a, AMDOrdering)
+	}
+	n := lu.n
+	var rows, cols []int
+	var vals []float64
+	doStoredNonZero(a, func(i, j int, v float64) {
+		rows = append(rows, i)
+		cols = append(cols, j)
+		vals = append(vals, v)
+	})
+	byCol := compress(n, n, cols, rows, vals)
+
+	lu.factorized = true
+	lu.ok = false
+	lu.cond = math.Inf(1)
+	lu.rowPerm = useInt(lu.rowPerm, n)
+	for i := range lu.rowPerm {
+		lu.rowPerm[i] = -1
+	}
+	lu.lPtr = append(lu.lPtr[:0], 0)
+	lu.lInd = lu.lInd[:0]
+	lu.lData = lu.lData[:0]
+	lu.uPtr = append(lu.uPtr[:0], 0)
+	lu.uInd = lu.uInd[:0]
+	lu.uData = lu.uData[:0]
+
+	x := getFloat64s(n, true)
+	defer putFloat64s(x)
+	reach := make([]int, n)
+	stack := make([]int, n)
+	pos := make([]int, n)
+	mark := make([]int, n)
+	for i := range mark {
+		mark[i] = -1
+	}
+	umax := 0.0
+	umin := math.Inf(1)
+	for k := 0; k < n; k++ {
+		col := lu.colPerm[k]
+		lo, hi := byCol.indptr[col], byCol.indptr[col+1]
+
+		// Solve L * x = A[:,col] for the rows of x reachable from the
+		// non-zero rows of A[:,col] in the graph of L.
+		top := lu.reach(byCol.ind[lo:hi], k, reach, stack, pos, mark)
+		for p := lo; p < hi; p++ {
+			x[byCol.ind[p]] = byCol.data[p]
+		}
+		for _, i := range reach[top:] {
+			j := lu.rowPerm[i]
+			if j < 0 {
+				continue
+			}
+			xi := x[i]
+			for q := lu.lPtr[j] + 1; q < lu.lPtr[j+1]; q++ {
+				x[lu.lInd[q]] -= lu.lData[q] * xi
+			}
+		}
+
+		// Choose the pivot as the largest element in the rows that have
+		// not yet been pivotal, and store the column of U.
+		pivot := -1
+		amax := -1.0
+		for _, i := range reach[top:] {
+			if lu.rowPerm[i] < 0 {
+				if v := math.Abs(x[i]); v > amax {
+					amax = v
+					pivot = i
+				}
+			} else {
+				lu.uInd = append(lu.uInd, lu.rowPerm[i])
+				lu.uData = append(lu.uData, x[i])
+			}
+		}
+		if pivot == -1 || amax == 0 {
+			for _, i := range reach[top:] {
+				x[i] = 0
+			}
+			return
+		}
+		d := x[pivot]
+		umax = math.Max(umax, amax)
+		umin = math.Min(umin, amax)
+		lu.uInd = append(lu.uInd, k)
+		lu.uData = append(lu.uData, d)
+		lu.uPtr = append(lu.uPtr, len(lu.uInd))
+
+		// Store the column of L with row indices of A. These are
+		// converted to rows of P * A * Q once the factorization is
+		// complete.
+		lu.rowPerm[pivot] = k
+		lu.lInd = append(lu.lInd, pivot)
+		lu.lData = append(lu.lData, 1)
+		for _, i := range reach[top:] {
+			if lu.rowPerm[i] < 0 {
+				lu.lInd = append(lu.lInd, i)
+				lu.lData = append(lu.lData, x[i]/d)
+			}
+			x[i] = 0
+		}
+		lu.lPtr = append(lu.lPtr, len(lu.lInd))
+	}
+	for q, i := range lu.lInd {
+		lu.lInd[q] = lu.rowPerm[i]
+	}
+	lu.ok = true
+	lu.cond = umax / umin
+}
+
+// reach computes the set of rows of A reachable from the given rows in the
+// graph of the partially computed L, where row i has an edge to each row of
+// the column of L in which i was pivotal. The rows are stored in topological
+// order in dst[top:], and top is returned. The stack and pos slices are
+// workspace and mark is set to k for each visited row.
+func (lu *SparseLU) reach(rows []int, k int, dst, stack, pos, mark []int) (top int) {
+	top = lu.n
+	for _, r := range rows {
+		if mark[r] == k {
+			continue
+		}
+		// Iterative depth-first search from r.
+		head := 0
+		stack[0] = r
+		for head >= 0 {
+			i := stack[head]
+			j := lu.rowPerm[i]
+			if mark[i] != k {
+				mark[i] = k
+				if j < 0 {
+					pos[head] = 0
+				} else {
+					pos[head] = lu.lPtr[j] + 1
+				}
+			}
+			done := true
+			if j >= 0 {
+				for q := pos[head]; q < lu.lPtr[j+1]; q++ {
+					child := lu.lInd[q]
+					if mark[child] == k {
+						continue
+					}
+					pos[head] = q + 1
+					head++
+					stack[head] = child
+					done = false
+					break
+				}
+			}
+			if done {
+				head--
+				top--
+				dst[top] = i
+			}
+		}
+	}
+	return top
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (lu *SparseLU) isValid() bool {
+	return lu.factorized
+}
+
+// Cond returns an estimate of the condition number of the factorized matrix.
+// The estimate is the ratio of the largest and smallest magnitudes of the
+// diagonal elements of U, which is infinite if the matrix is singular.
+func (lu *SparseLU) Cond() float64 {
+	if !lu.isValid() {
+		panic(badSparseLU)
+	}
+	return lu.cond
+}
+
+// NNZ returns the number of stored elements of L and U including their
+// diagonals. It returns zero if the receiver does not hold a non-singular
+// factorization.
+func (lu *SparseLU) NNZ() int {
+	if !lu.ok {
+		return 0
+	}
+	return len(lu.lInd) + len(lu.uInd)
+}
+
+// Reset resets the factorization and the analysis so that the receiver can
+// be reused.
+func (lu *SparseLU) Reset() {
+	lu.n = 0
+	lu.colPerm = nil
+	lu.factorized = false
+	lu.ok = false
+	lu.cond = math.Inf(1)
+}
+
+// IsEmpty returns whether the receiver is empty.
+func (lu *SparseLU) IsEmpty() bool {
+	return lu.colPerm == nil
+}
+
+// RowPivots returns the row permutation P of the decomposition
+//
+//	P * A * Q = L * U
+//
+// where row k of P * A * Q is row dst[k] of A. If dst is nil, a new slice is
+// allocated and returned. If dst is not nil and the length of dst does not
+// equal the size of the factorized matrix, RowPivots will panic. RowPivots
+// will panic if the receiver does not contain a non-singular factorization.
+func (lu *SparseLU) RowPivots(dst []int) []int {
+	if !lu.ok {
+		panic(badSparseLU)
+	}
+	if dst == nil {
+		dst = make([]int, lu.n)
+	}
+	if len(dst) != lu.n {
+		panic(badSliceLength)
+	}
+	for i, k := range lu.rowPerm {
+		dst[k] = i
+	}
+	return dst
+}
+
+// ColPivots returns the column permutation Q of the decomposition
+//
+//	P * A * Q = L * U
+//
+// where column k of P * A * Q is column dst[k] of A. If dst is nil, a new
+// slice is allocated and returned. If dst is not nil and the length of dst
+// does not equal the size of the analyzed matrix, ColPivots will panic.
+// ColPivots will panic if the receiver does not hold an analysis.
+func (lu *SparseLU) ColPivots(dst []int) []int {
+	if lu.colPerm == nil {
+		panic(badSparseLU)
+	}
+	if dst == nil {
+		dst = make([]int, lu.n)
+	}
+	if len(dst) != lu.n {
+		panic(badSliceLength)
+	}
+	copy(dst, lu.colPerm)
+	return dst
+}
+
+// Det returns the determinant of the matrix that has been factorized.
+func (lu *SparseLU) Det() float64 {
+	if !lu.isValid() {
+		panic(badSparseLU)
+	}
+	if !lu.ok {
+		return 0
+	}
+	det, sign := lu.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. If the matrix is singular, LogDet
+// returns -∞ and a sign of 1. LogDet will panic if the receiver does not
+// contain a factorization.
+func (lu *SparseLU) LogDet() (det float64, sign float64) {
+	if !lu.isValid() {
+		panic(badSparseLU)
+	}
+	if !lu.ok {
+		return math.Inf(-1), 1
+	}
+	sign = float64(permutationSign(lu.rowPerm) * permutationSign(lu.colPerm))
+	for k := 0; k < lu.n; k++ {
+		v := lu.uData[lu.uPtr[k+1]-1]
+		if v < 0 {
+			sign *= -1
+		}
+		det += math.Log(math.Abs(v))
+	}
+	return det, sign
+}
+
+// permutationSign returns the sign of the permutation perm.
+func permutationSign(perm []int) int {
+	visited := make([]bool, len(perm))
+	sign := 1
+	for i := range perm {
+		if visited[i] {
+			continue
+		}
+		// A cycle of length l is the product of l-1 transpositions.
+		for j := i; !visited[j]; j = perm[j] {
+			visited[j] = true
+			sign = -sign
+		}
+		sign = -sign
+	}
+	return sign
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᵀ * X = B  if trans == true
+//
+// using the sparse LU decomposition of A stored in the receiver. The result is
+// stored in-place into dst. If A is singular or near-singular a Condition
+// error is returned. See the documentation for Condition for more
+// information. SolveTo will panic if the receiver does not contain a
+// factorization.
+func (lu *SparseLU) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !lu.isValid() {
+		panic(badSparseLU)
+	}
+	n := lu.n
+	bm, bn := b.Dims()
+	if bm != n {
+		panic(ErrShape)
+	}
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+	dst.reuseAsNonZeroed(bm, bn)
+	in := getFloat64s(n, false)
+	defer putFloat64s(in)
+	out := getFloat64s(n, false)
+	defer putFloat64s(out)
+	for j := 0; j < bn; j++ {
+		for i := range in {
+			in[i] = b.At(i, j)
+		}
+		lu.solve(out, trans, in)
+		for i, v := range out {
+			dst.set(i, j, v)
+		}
+	}
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	A * x = b   if trans == false
+//	Aᵀ * x = b  if trans == true
+//
+// using the sparse LU decomposition of A stored in the receiver. The result is
+// stored in-place into dst. If A is singular or near-singular a Condition
+// error is returned. See the documentation for Condition for more
+// information. SolveVecTo will panic if the receiver does not contain a
+// factorization.
+func (lu *SparseLU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !lu.isValid() {
+		panic(badSparseLU)
+	}
+	n := lu.n
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+	in := getFloat64s(n, false)
+	defer putFloat64s(in)
+	out := getFloat64s(n, false)
+	defer putFloat64s(out)
+	for i := range in {
+		in[i] = b.AtVec(i)
+	}
+	lu.solve(out, trans, in)
+	dst.reuseAsNonZeroed(n)
+	for i, v := range out {
+		dst.setVec(i, v)
+	}
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// solve stores into x the solution of A * x = b, or Aᵀ * x = b if trans is
+// true. The contents of b are overwritten.
+func (lu *SparseLU) solve(x []float64, trans bool, b []float64) {
+	n := lu.n
+	if !trans {
+		// L * U * Qᵀ * x = P * b.
+		for i, k := range lu.rowPerm {
+			x[k] = b[i]
+		}
+		for j := 0; j < n; j++ {
+			xj := x[j]
+			for q := lu.lPtr[j] + 1; q < lu.lPtr[j+1]; q++ {
+				x[lu.lInd[q]] -= lu.lData[q] * xj
+			}
+		}
+		for j := n - 1; j >= 0; j-- {
+			last := lu.uPtr[j+1] - 1
+			x[j] /= lu.uData[last]
+			xj := x[j]
+			for q := lu.uPtr[j]; q < last; q++ {
+				x[lu.uInd[q]] -= lu.uData[q] * xj
+			}
+		}
+		for k, j := range lu.colPerm {
+			b[j] = x[k]
+		}
+		copy(x, b)
+		return
+	}
+
+	// Uᵀ * Lᵀ * P * x = Qᵀ * b.
+	for k, j := range lu.colPerm {
+		x[k] = b[j]
+	}
+	for j := 0; j < n; j++ {
+		last := lu.uPtr[j+1] - 1
+		s := x[j]
+		for q := lu.uPtr[j]; q < last; q++ {
+			s -= lu.uData[q] * x[lu.uInd[q]]
+		}
+		x[j] = s / lu.uData[last]
+	}
+	for j := n - 1; j >= 0; j-- {
+		s := x[j]
+		for q := lu.lPtr[j] + 1; q < lu.lPtr[j+1]; q++ {
+			s -= lu.lData[q] * x[lu.lInd[q]]
+		}
+		x[j] = s
+	}
+	for i, k := range lu.rowPerm {
+		b[i] = x[k]
+	}
+	copy(x, b)
+}
@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestSparseLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 50, 150} {
+		for _, density := range []float64{0.05, 0.2, 1} {
+			for _, ordering := range sparseOrderings {
+				name := fmt.Sprintf("n=%d density=%v ordering=%d", n, density, ordering)
+				// Add a random permutation matrix so that the matrix is
+				// structurally non-singular but pivoting is required.
+				coo, dense := randCOO(n, n, density, rnd)
+				for i, j := range rnd.Perm(n) {
+					coo.Append(i, j, 1)
+					dense.Set(i, j, dense.At(i, j)+1)
+				}
+				a := coo.ToCSC()
+
+				var lu SparseLU
+				lu.Analyze(a, ordering)
+				lu.Factorize(a)
+				var want LU
+				want.Factorize(dense)
+				if want.Det() == 0 {
+					continue
+				}
+				checkSparseLU(t, name, &lu, dense, &want, rnd)
+
+				// Refactorize a matrix with the same pattern.
+				b := a.ToCOO()
+				for k := range b.data {
+					b.data[k] = rnd.NormFloat64()
+				}
+				dense = DenseCopyOf(b)
+				lu.Factorize(b)
+				want.Factorize(dense)
+				checkSparseLU(t, name+" refactorized", &lu, dense, &want, rnd)
+			}
+		}
+	}
+}
+
+// checkSparseLU checks the factorization, solutions and determinant of lu
+// against the dense matrix a and its dense factorization want.
+func checkSparseLU(t *testing.T, name string, lu *SparseLU, a *Dense, want *LU, rnd *rand.Rand) {
+	t.Helper()
+	n, _ := a.Dims()
+	if lu.Cond() > 1e10 {
+		// Skip ill-conditioned random matrices.
+		return
+	}
+
+	// Reconstruct P * A * Q from L and U.
+	rowPerm := lu.RowPivots(nil)
+	colPerm := lu.ColPivots(nil)
+	l := NewDense(n, n, nil)
+	u := NewDense(n, n, nil)
+	for j := 0; j < n; j++ {
+		for q := lu.lPtr[j]; q < lu.lPtr[j+1]; q++ {
+			l.Set(lu.lInd[q], j, lu.lData[q])
+		}
+		for q := lu.uPtr[j]; q < lu.uPtr[j+1]; q++ {
+			u.Set(lu.uInd[q], j, lu.uData[q])
+		}
+	}
+	var prod Dense
+	prod.Mul(l, u)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if got, want := prod.At(i, j), a.At(rowPerm[i], colPerm[j]); !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+				t.Errorf("%s: L*U mismatch at (%d,%d): got %v, want %v", name, i, j, got, want)
+				return
+			}
+		}
+	}
+
+	gotDet, gotSign := lu.LogDet()
+	wantDet, wantSign := want.LogDet()
+	if gotSign != wantSign || !scalar.EqualWithinAbsOrRel(gotDet, wantDet, 1e-8, 1e-8) {
+		t.Errorf("%s: LogDet mismatch: got (%v, %v), want (%v, %v)", name, gotDet, gotSign, wantDet, wantSign)
+	}
+
+	b := NewDense(n, 2, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < 2; j++ {
+			b.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	for _, trans := range []bool{false, true} {
+		var x, wantX Dense
+		_ = lu.SolveTo(&x, trans, b)
+		_ = want.SolveTo(&wantX, trans, b)
+		if !EqualApprox(&x, &wantX, 1e-8) {
+			t.Errorf("%s: SolveTo mismatch with trans=%t", name, trans)
+		}
+
+		var xv, wantv VecDense
+		bv := b.ColView(1)
+		_ = lu.SolveVecTo(&xv, trans, bv)
+		_ = want.SolveVecTo(&wantv, trans, bv)
+		if !EqualApprox(&xv, &wantv, 1e-8) {
+			t.Errorf("%s: SolveVecTo mismatch with trans=%t", name, trans)
+		}
+	}
+}
+
+func TestSparseLUSingular(t *testing.T) {
+	t.Parallel()
+	a := NewCOO(3, 3, []int{0, 0, 1, 1, 2}, []int{0, 1, 0, 1, 2}, []float64{1, 2, 2, 4, 1})
+	var lu SparseLU
+	lu.Factorize(a)
+	if det := lu.Det(); det != 0 {
+		t.Errorf("unexpected determinant: got %v, want 0", det)
+	}
+	if det, _ := lu.LogDet(); !math.IsInf(det, -1) {
+		t.Errorf("unexpected log determinant: got %v, want -Inf", det)
+	}
+	var x VecDense
+	err := lu.SolveVecTo(&x, false, NewVecDense(3, []float64{1, 2, 3}))
+	if c, ok := err.(Condition); !ok || !math.IsInf(float64(c), 1) {
+		t.Errorf("unexpected error solving singular system: %v", err)
+	}
+}
@@ -0,0 +1,395 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "container/heap"
+
+// SparseOrdering specifies the fill-reducing ordering used by the sparse
+// factorizations.
+type SparseOrdering int
+
+const (
+	// NaturalOrdering uses the rows and columns in their original order.
+	NaturalOrdering SparseOrdering = iota
+	// AMDOrdering uses an approximate minimum degree ordering. It is
+	// effective for most sparse matrices and is cheap to compute.
+	AMDOrdering
+	// NestedDissectionOrdering recursively orders vertex separators of the
+	// graph of the matrix last. It is effective for matrices arising from
+	// two and three dimensional meshes.
+	NestedDissectionOrdering
+)
+
+// ndLeafSize is the size of the subgraphs below which nested dissection
+// orders the vertices by approximate minimum degree.
+const ndLeafSize = 64
+
+// sparseGraph is an undirected graph without self loops held in compressed
+// adjacency form. The neighbors of vertex v are adj[ptr[v]:ptr[v+1]].
+type sparseGraph struct {
+	n   int
+	ptr []int
+	adj []int
+}
+
+// newSparseGraph returns the graph on n vertices with an edge between
+// u[k] and v[k] for each k. Duplicate edges and self loops are removed.
+func newSparseGraph(n int, u, v []int) *sparseGraph {
+	maj := make([]int, 0, 2*len(u))
+	mnr := make([]int, 0, 2*len(u))
+	for k := range u {
+		if u[k] == v[k] {
+			continue
+		}
+		maj = append(maj, u[k], v[k])
+		mnr = append(mnr, v[k], u[k])
+	}
+	s := compress(n, n, maj, mnr, make([]float64, len(maj)))
+	return &sparseGraph{n: n, ptr: s.indptr, adj: s.ind}
+}
+
+// order returns the permutation of the vertices of g given by the ordering.
+// The k-th vertex in the ordering is perm[k].
+func (g *sparseGraph) order(ordering SparseOrdering) []int {
+	switch ordering {
+	case NaturalOrdering:
+		perm := make([]int, g.n)
+		for i := range perm {
+			perm[i] = i
+		}
+		return perm
+	case AMDOrdering:
+		vertices := make([]int, g.n)
+		for i := range vertices {
+			vertices[i] = i
+		}
+		return g.amd(vertices)
+	case NestedDissectionOrdering:
+		return g.nestedDissection()
+	default:
+		panic("mat: unknown sparse ordering")
+	}
+}
+
+// amd returns an approximate minimum degree ordering of the subgraph of g
+// induced by the given vertices.
+//
+// The elimination is simulated on the quotient graph, in which each
+// eliminated vertex is represented by an element holding the set of
+// uneliminated vertices that form a clique in the filled graph. The degree of
+// a vertex is approximated from above by the number of adjacent vertices plus
+// the sizes of the adjacent elements excluding the most recently created one,
+// as described in
+//
+//	Amestoy, P. R., Davis, T. A., and Duff, I. S. "An approximate minimum
+//	degree ordering algorithm." SIAM Journal on Matrix Analysis and
+//	Applications 17.4 (1996): 886-905.
+func (g *sparseGraph) amd(vertices []int) []int {
+	const (
+		variable = iota
+		element
+		absorbed
+		outside
+	)
+	n := g.n
+	state := make([]int8, n)
+	for i := range state {
+		state[i] = outside
+	}
+	for _, v := range vertices {
+		state[v] = variable
+	}
+
+	vars := make([][]int, n)    // Adjacent variables of each variable.
+	elems := make([][]int, n)   // Adjacent elements of each variable.
+	pattern := make([][]int, n) // Variables of each element.
+	degree := make([]int, n)
+	queue := &degreeQueue{}
+	for _, v := range vertices {
+		for _, u := range g.adj[g.ptr[v]:g.ptr[v+1]] {
+			if state[u] == variable {
+				vars[v] = append(vars[v], u)
+			}
+		}
+		degree[v] = len(vars[v])
+		heap.Push(queue, degreeEntry{degree: degree[v], v: v})
+	}
+
+	mark := make([]int, n)
+	seen := make([]int, n)
+	w := make([]int, n)
+	var stamp int
+	alive := len(vertices)
+	order := make([]int, 0, len(vertices))
+	for len(order) < len(vertices) {
+		e := heap.Pop(queue).(degreeEntry)
+		p := e.v
+		if state[p] != variable || degree[p] != e.degree {
+			// Stale entry.
+			continue
+		}
+		order = append(order, p)
+		state[p] = element
+		alive--
+
+		// Form the pattern of the new element p from the adjacent
+		// variables and the patterns of the adjacent elements, which
+		// are absorbed into p.
+		stamp++
+		mark[p] = stamp
+		var lp []int
+		for _, v := range vars[p] {
+			if state[v] == variable && mark[v] != stamp {
+				mark[v] = stamp
+				lp = append(lp, v)
+			}
+		}
+		for _, e := range elems[p] {
+			if state[e] != element {
+				continue
+			}
+			for _, v := range pattern[e] {
+				if state[v] == variable && mark[v] != stamp {
+					mark[v] = stamp
+					lp = append(lp, v)
+				}
+			}
+			state[e] = absorbed
+			pattern[e] = nil
+		}
+		pattern[p] = lp
+		vars[p] = nil
+		elems[p] = nil
+
+		// Compute w[e] = |L_e \ L_p| for each element e adjacent to
+		// a variable in L_p.
+		for _, i := range lp {
+			for _, e := range elems[i] {
+				if state[e] != element {
+					continue
+				}
+				if seen[e] != stamp {
+					seen[e] = stamp
+					live := pattern[e][:0]
+					for _, v := range pattern[e] {
+						if state[v] == variable {
+							live = append(live, v)
+						}
+					}
+					pattern[e] = live
+					w[e] = len(live)
+				}
+				w[e]--
+			}
+		}
+
+		// Update the adjacency and the approximate degree of each
+		// variable in L_p.
+		for _, i := range lp {
+			deg := len(lp) - 1
+			es := elems[i][:0]
+			for _, e := range elems[i] {
+				if state[e] != element {
+					continue
+				}
+				if w[e] == 0 {
+					// L_e is a subset of L_p so e can be absorbed.
+					state[e] = absorbed
+					pattern[e] = nil
+					continue
+				}
+				es = append(es, e)
+				deg += w[e]
+			}
+			elems[i] = append(es, p)
+			vs := vars[i][:0]
+			for _, v := range vars[i] {
+				// Variables in L_p are now reached through p.
+				if state[v] == variable && mark[v] != stamp {
+					vs = append(vs, v)
+				}
+			}
+			vars[i] = vs
+			deg += len(vs)
+			degree[i] = min(deg, alive-1, degree[i]+len(lp))
+			heap.Push(queue, degreeEntry{degree: degree[i], v: i})
+		}
+	}
+	return order
+}
+
+// degreeEntry is an entry of a degreeQueue.
+type degreeEntry struct {
+	degree int
+	v      int
+}
+
+// degreeQueue is a priority queue of vertices ordered by degree and then by
+// vertex index.
+type degreeQueue []degreeEntry
+
+func (q degreeQueue) Len() int { return len(q) }
+func (q degreeQueue) Less(i, j int) bool {
+	if q[i].degree != q[j].degree {
+		return q[i].degree < q[j].degree
+	}
+	return q[i].v < q[j].v
+}
+func (q degreeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *degreeQueue) Push(x interface{}) { *q = append(*q, x.(degreeEntry)) }
+func (q *degreeQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	*q = old[:n-1]
+	return e
+}
+
+// nestedDissection returns a nested dissection ordering of g. Each connected
+// subgraph is split into two parts by a vertex separator taken from a level
+// set of a breadth-first search from a pseudo-peripheral vertex. The parts are
+// ordered recursively and the separator is ordered last. Subgraphs with fewer
+// than ndLeafSize vertices are ordered by approximate minimum degree.
+func (g *sparseGraph) nestedDissection() []int {
+	order := make([]int, 0, g.n)
+	label := make([]int, g.n) // Label of the subgraph holding each vertex.
+	level := make([]int, g.n)
+	var nextLabel int
+
+	vertices := make([]int, g.n)
+	for i := range vertices {
+		vertices[i] = i
+	}
+	// Subgraphs are processed from a stack. An entry with a nil subgraph
+	// and a separator appends the separator to the ordering once both
+	// parts have been ordered.
+	type task struct {
+		vertices  []int
+		separator []int
+	}
+	stack := []task{{vertices: vertices}}
+	for len(stack) > 0 {
+		t := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if t.vertices == nil {
+			order = append(order, t.separator...)
+			continue
+		}
+		vs := t.vertices
+		nextLabel++
+		for _, v := range vs {
+			label[v] = nextLabel
+		}
+		if len(vs) < ndLeafSize {
+			order = append(order, g.amd(vs)...)
+			continue
+		}
+
+		// Split disconnected subgraphs into their components.
+		comp := g.bfs(vs[0], label, level)
+		if len(comp) < len(vs) {
+			nextLabel++
+			for _, v := range comp {
+				label[v] = nextLabel
+			}
+			rest := make([]int, 0, len(vs)-len(comp))
+			for _, v := range vs {
+				if label[v] != nextLabel {
+					rest = append(rest, v)
+				}
+			}
+			stack = append(stack, task{vertices: rest}, task{vertices: comp})
+			continue
+		}
+
+		// Find a pseudo-peripheral vertex to root the level structure.
+		root := vs[0]
+		height := -1
+		for {
+			comp = g.bfs(root, label, level)
+			last := comp[len(comp)-1]
+			if level[last] <= height {
+				break
+			}
+			height = level[last]
+			root = last
+		}
+		comp = g.bfs(root, label, level)
+		if height < 2 {
+			// There is no level separator.
+			order = append(order, g.amd(vs)...)
+			continue
+		}
+
+		// Use the level that best balances the two parts as the
+		// separator, but not the first or last level.
+		counts := make([]int, height+1)
+		for _, v := range comp {
+			counts[level[v]]++
+		}
+		sep := 1
+		var below int
+		for k := 1; k < height; k++ {
+			below += counts[k-1]
+			sep = k
+			if 2*(below+counts[k]) >= len(vs) {
+				break
+			}
+		}
+		var a, b, s []int
+		for _, v := range comp {
+			switch {
+			case level[v] < sep:
+				a = append(a, v)
+			case level[v] > sep:
+				b = append(b, v)
+			default:
+				s = append(s, v)
+			}
+		}
+		// Move separator vertices without neighbors in b into a.
+		separator := s[:0]
+		for _, v := range s {
+			adjB := false
+			for _, u := range g.adj[g.ptr[v]:g.ptr[v+1]] {
+				if label[u] == label[v] && level[u] == sep+1 {
+					adjB = true
+					break
+				}
+			}
+			if adjB {
+				separator = append(separator, v)
+			} else {
+				a = append(a, v)
+			}
+		}
+		stack = append(stack, task{separator: separator}, task{vertices: b}, task{vertices: a})
+	}
+	return order
+}
+
+// bfs performs a breadth-first search of the subgraph of vertices with the
+// same label as root, storing the distance from root into level. It returns
+// the vertices reached in order of increasing distance.
+func (g *sparseGraph) bfs(root int, label, level []int) []int {
+	lbl := label[root]
+	for v := range level {
+		if label[v] == lbl {
+			level[v] = -1
+		}
+	}
+	level[root] = 0
+	queue := []int{root}
+	for head := 0; head < len(queue); head++ {
+		v := queue[head]
+		for _, u := range g.adj[g.ptr[v]:g.ptr[v+1]] {
+			if label[u] == lbl && level[u] < 0 {
+				level[u] = level[v] + 1
+				queue = append(queue, u)
+			}
+		}
+	}
+	return queue
+}
@@ -0,0 +1,365 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// randCOO returns a random r×c COO matrix with approximately density*r*c
+// entries, including duplicates, and the equivalent Dense matrix.
+func randCOO(r, c int, density float64, rnd *rand.Rand) (*COO, *Dense) {
+	m := NewCOO(r, c, nil, nil, nil)
+	d := NewDense(r, c, nil)
+	n := int(density * float64(r*c))
+	for k := 0; k < n; k++ {
+		i := rnd.IntN(r)
+		j := rnd.IntN(c)
+		v := rnd.NormFloat64()
+		m.Append(i, j, v)
+		d.Set(i, j, d.At(i, j)+v)
+	}
+	return m, d
+}
+
+func TestSparseConversion(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range []struct{ r, c int }{{1, 1}, {3, 5}, {5, 3}, {10, 10}, {20, 7}} {
+		for _, density := range []float64{0, 0.1, 0.5, 2} {
+			coo, want := randCOO(dims.r, dims.c, density, rnd)
+			csr := coo.ToCSR()
+			csc := coo.ToCSC()
+			for _, test := range []struct {
+				name string
+				m    Matrix
+			}{
+				{name: "COO", m: coo},
+				{name: "CSR", m: csr},
+				{name: "CSC", m: csc},
+				{name: "CSR.ToCSC", m: csr.ToCSC()},
+				{name: "CSC.ToCSR", m: csc.ToCSR()},
+				{name: "CSR.ToCOO", m: csr.ToCOO()},
+				{name: "CSC.ToCOO", m: csc.ToCOO()},
+				{name: "CSR.ToCOO.ToCSC", m: csr.ToCOO().ToCSC()},
+			} {
+				prefix := fmt.Sprintf("%s %d×%d density=%v", test.name, dims.r, dims.c, density)
+				if !EqualApprox(test.m, want, 1e-14) {
+					t.Errorf("%s: unexpected element values", prefix)
+				}
+				if !EqualApprox(DenseCopyOf(test.m), want, 1e-14) {
+					t.Errorf("%s: unexpected result from DenseCopyOf", prefix)
+				}
+				if !EqualApprox(DenseCopyOf(test.m.T()), want.T(), 1e-14) {
+					t.Errorf("%s: unexpected result from DenseCopyOf of transpose", prefix)
+				}
+				if !EqualApprox(test.m.T(), want.T(), 1e-14) {
+					t.Errorf("%s: unexpected transpose element values", prefix)
+				}
+
+				got := NewDense(dims.r, dims.c, nil)
+				test.m.(NonZeroDoer).DoNonZero(func(i, j int, v float64) {
+					got.Set(i, j, got.At(i, j)+v)
+				})
+				if !EqualApprox(got, want, 1e-14) {
+					t.Errorf("%s: unexpected DoNonZero result", prefix)
+				}
+			}
+
+			for _, m := range []interface {
+				Matrix
+				RowNonZeroDoer
+				ColNonZeroDoer
+			}{csr, csc} {
+				rows := NewDense(dims.r, dims.c, nil)
+				for i := 0; i < dims.r; i++ {
+					m.DoRowNonZero(i, func(i, j int, v float64) {
+						rows.Set(i, j, v)
+					})
+				}
+				if !Equal(rows, want) {
+					t.Errorf("%T %d×%d density=%v: unexpected DoRowNonZero result", m, dims.r, dims.c, density)
+				}
+				cols := NewDense(dims.r, dims.c, nil)
+				for j := 0; j < dims.c; j++ {
+					m.DoColNonZero(j, func(i, j int, v float64) {
+						cols.Set(i, j, v)
+					})
+				}
+				if !Equal(cols, want) {
+					t.Errorf("%T %d×%d density=%v: unexpected DoColNonZero result", m, dims.r, dims.c, density)
+				}
+			}
+		}
+	}
+}
+
+func TestNewCSRPanics(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		r, c   int
+		indptr []int
+		ind    []int
+		data   []float64
+	}{
+		{r: 0, c: 2},
+		{r: 2, c: -1},
+		{r: 2, c: 2, indptr: []int{0, 1}, ind: []int{0}, data: []float64{1}},
+		{r: 2, c: 2, indptr: []int{0, 1, 2}, ind: []int{0}, data: []float64{1}},
+		{r: 2, c: 2, indptr: []int{0, 2, 1}, ind: []int{0}, data: []float64{1}},
+		{r: 2, c: 2, indptr: []int{1, 1, 1}, ind: []int{0}, data: []float64{1}},
+		{r: 2, c: 2, indptr: []int{0, 2, 2}, ind: []int{1, 0}, data: []float64{1, 2}},
+		{r: 2, c: 2, indptr: []int{0, 2, 2}, ind: []int{1, 1}, data: []float64{1, 2}},
+		{r: 2, c: 2, indptr: []int{0, 1, 1}, ind: []int{2}, data: []float64{1}},
+	} {
+		panicked, _ := panics(func() { NewCSR(test.r, test.c, test.indptr, test.ind, test.data) })
+		if !panicked {
+			t.Errorf("case %d: expected panic", i)
+		}
+	}
+
+	m := NewCSR(3, 4, []int{0, 2, 2, 3}, []int{0, 3, 1}, []float64{1, 2, 3})
+	want := NewDense(3, 4, []float64{
+		1, 0, 0, 2,
+		0, 0, 0, 0,
+		0, 3, 0, 0,
+	})
+	if !Equal(m, want) {
+		t.Errorf("unexpected CSR matrix:\ngot:\n%v\nwant:\n%v", Formatted(m), Formatted(want))
+	}
+	if m.NNZ() != 3 {
+		t.Errorf("unexpected number of stored elements: got:%d want:3", m.NNZ())
+	}
+	c := NewCSC(4, 3, []int{0, 2, 2, 3}, []int{0, 3, 1}, []float64{1, 2, 3})
+	if !Equal(c, want.T()) {
+		t.Errorf("unexpected CSC matrix:\ngot:\n%v\nwant:\n%v", Formatted(c), Formatted(want.T()))
+	}
+}
+
+func TestSparseMulVecTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range []struct{ r, c int }{{1, 1}, {3, 5}, {5, 3}, {10, 10}} {
+		coo, d := randCOO(dims.r, dims.c, 0.3, rnd)
+		for _, m := range []interface {
+			Matrix
+			MulVecTo(*VecDense, bool, Vector)
+		}{coo, coo.ToCSR(), coo.ToCSC()} {
+			for _, trans := range []bool{false, true} {
+				r, c := dims.r, dims.c
+				var a Matrix = d
+				if trans {
+					r, c = c, r
+					a = d.T()
+				}
+				x := NewVecDense(c, nil)
+				for i := 0; i < c; i++ {
+					x.SetVec(i, rnd.NormFloat64())
+				}
+				var want VecDense
+				want.MulVec(a, x)
+
+				var got VecDense
+				m.MulVecTo(&got, trans, x)
+				if !EqualApprox(&got, &want, 1e-14) {
+					t.Errorf("%T %d×%d trans=%t: unexpected MulVecTo result", m, dims.r, dims.c, trans)
+				}
+
+				var viaMul VecDense
+				if trans {
+					viaMul.MulVec(m.T(), x)
+				} else {
+					viaMul.MulVec(m, x)
+				}
+				if !EqualApprox(&viaMul, &want, 1e-14) {
+					t.Errorf("%T %d×%d trans=%t: unexpected MulVec result", m, dims.r, dims.c, trans)
+				}
+
+				if r == c {
+					// Check aliased input and output.
+					alias := VecDenseCopyOf(x)
+					m.MulVecTo(alias, trans, alias)
+					if !EqualApprox(alias, &want, 1e-14) {
+						t.Errorf("%T %d×%d trans=%t: unexpected aliased MulVecTo result", m, dims.r, dims.c, trans)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSparseMul(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range []struct{ r, k, c int }{{1, 1, 1}, {3, 4, 5}, {6, 2, 3}, {8, 8, 8}} {
+		coo, sd := randCOO(dims.r, dims.k, 0.4, rnd)
+		b := NewDense(dims.k, dims.c, nil)
+		for i := 0; i < dims.k; i++ {
+			for j := 0; j < dims.c; j++ {
+				b.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		var want Dense
+		want.Mul(DenseCopyOf(sd), b)
+		var wantT Dense
+		wantT.Mul(DenseCopyOf(b.T()), DenseCopyOf(sd.T()))
+
+		for _, s := range []Matrix{coo, coo.ToCSR(), coo.ToCSC()} {
+			var got Dense
+			got.Mul(s, b)
+			if !EqualApprox(&got, &want, 1e-13) {
+				t.Errorf("%T %v: unexpected A⋅B result", s, dims)
+			}
+			got.Reset()
+			got.Mul(s, DenseCopyOf(b.T()).T())
+			if !EqualApprox(&got, &want, 1e-13) {
+				t.Errorf("%T %v: unexpected A⋅Bᵀᵀ result", s, dims)
+			}
+			got.Reset()
+			got.Mul(b.T(), s.T())
+			if !EqualApprox(&got, &wantT, 1e-13) {
+				t.Errorf("%T %v: unexpected Bᵀ⋅Aᵀ result", s, dims)
+			}
+			got.Reset()
+			got.Mul(DenseCopyOf(b.T()), s.T())
+			if !EqualApprox(&got, &wantT, 1e-13) {
+				t.Errorf("%T %v: unexpected dense Bᵀ⋅Aᵀ result", s, dims)
+			}
+
+			if dims.k == dims.c {
+				// Check aliased receiver and dense operand.
+				alias := DenseCopyOf(b)
+				alias.Mul(s, alias)
+				if !EqualApprox(alias, &want, 1e-13) {
+					t.Errorf("%T %v: unexpected aliased A⋅B result", s, dims)
+				}
+			}
+			if dims.r == dims.k {
+				// A vector operand must not share storage
+				// with the receiver.
+				n := dims.k
+				col := NewDense(n, 2, nil)
+				dst := col.Slice(0, n, 0, 1).(*Dense)
+				src := col.ColView(0)
+				panicked, _ := panics(func() { dst.Mul(s, src) })
+				if !panicked {
+					t.Errorf("%T %v: expected panic for overlapping A⋅x", s, dims)
+				}
+				row := NewDense(2, n, nil)
+				dst = row.Slice(0, 1, 0, n).(*Dense)
+				src = row.RowView(0)
+				panicked, _ = panics(func() { dst.Mul(src.T(), s) })
+				if !panicked {
+					t.Errorf("%T %v: expected panic for overlapping xᵀ⋅A", s, dims)
+				}
+			}
+		}
+	}
+}
+
+func TestSparseAddScale(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range []struct{ r, c int }{{1, 1}, {3, 5}, {7, 4}, {10, 10}} {
+		acoo, ad := randCOO(dims.r, dims.c, 0.3, rnd)
+		bcoo, bd := randCOO(dims.r, dims.c, 0.3, rnd)
+
+		var wantAdd, wantSub, wantScale Dense
+		wantAdd.Add(ad, bd)
+		wantSub.Sub(ad, bd)
+		wantScale.Scale(-2.5, ad)
+
+		a, b := acoo.ToCSR(), bcoo.ToCSR()
+		var sum, diff, scaled CSR
+		sum.Add(a, b)
+		diff.Sub(a, b)
+		scaled.Scale(-2.5, a)
+		if !EqualApprox(&sum, &wantAdd, 1e-14) {
+			t.Errorf("CSR %v: unexpected Add result", dims)
+		}
+		if !EqualApprox(&diff, &wantSub, 1e-14) {
+			t.Errorf("CSR %v: unexpected Sub result", dims)
+		}
+		if !EqualApprox(&scaled, &wantScale, 1e-14) {
+			t.Errorf("CSR %v: unexpected Scale result", dims)
+		}
+		a.Scale(-2.5, a)
+		if !EqualApprox(a, &wantScale, 1e-14) {
+			t.Errorf("CSR %v: unexpected in-place Scale result", dims)
+		}
+
+		ac, bc := acoo.ToCSC(), bcoo.ToCSC()
+		var csum, cdiff, cscaled CSC
+		csum.Add(ac, bc)
+		cdiff.Sub(ac, bc)
+		cscaled.Scale(-2.5, ac)
+		if !EqualApprox(&csum, &wantAdd, 1e-14) {
+			t.Errorf("CSC %v: unexpected Add result", dims)
+		}
+		if !EqualApprox(&cdiff, &wantSub, 1e-14) {
+			t.Errorf("CSC %v: unexpected Sub result", dims)
+		}
+		if !EqualApprox(&cscaled, &wantScale, 1e-14) {
+			t.Errorf("CSC %v: unexpected Scale result", dims)
+		}
+
+		// Check aliased receivers.
+		for _, test := range []struct {
+			name string
+			op   func(dst, a, b *CSR)
+			want *Dense
+		}{
+			{name: "Add", op: (*CSR).Add, want: &wantAdd},
+			{name: "Sub", op: (*CSR).Sub, want: &wantSub},
+		} {
+			alias := acoo.ToCSR()
+			test.op(alias, alias, b)
+			if !EqualApprox(alias, test.want, 1e-14) {
+				t.Errorf("CSR %v: unexpected %s result with receiver a", dims, test.name)
+			}
+			alias = bcoo.ToCSR()
+			test.op(alias, acoo.ToCSR(), alias)
+			if !EqualApprox(alias, test.want, 1e-14) {
+				t.Errorf("CSR %v: unexpected %s result with receiver b", dims, test.name)
+			}
+		}
+		for _, test := range []struct {
+			name string
+			op   func(dst, a, b *CSC)
+			want *Dense
+		}{
+			{name: "Add", op: (*CSC).Add, want: &wantAdd},
+			{name: "Sub", op: (*CSC).Sub, want: &wantSub},
+		} {
+			alias := acoo.ToCSC()
+			test.op(alias, alias, bc)
+			if !EqualApprox(alias, test.want, 1e-14) {
+				t.Errorf("CSC %v: unexpected %s result with receiver a", dims, test.name)
+			}
+			alias = bcoo.ToCSC()
+			test.op(alias, ac, alias)
+			if !EqualApprox(alias, test.want, 1e-14) {
+				t.Errorf("CSC %v: unexpected %s result with receiver b", dims, test.name)
+			}
+		}
+		var twice Dense
+		twice.Add(ad, ad)
+		self := acoo.ToCSR()
+		self.Add(self, self)
+		if !EqualApprox(self, &twice, 1e-14) {
+			t.Errorf("CSR %v: unexpected Add result with all operands aliased", dims)
+		}
+
+		panicked, _ := panics(func() {
+			var m CSR
+			m.Add(a, NewCSR(dims.r+1, dims.c, nil, nil, nil))
+		})
+		if !panicked {
+			t.Errorf("CSR %v: expected panic for mismatched Add", dims)
+		}
+	}
+}
@@ -649,6 +649,15 @@ func (v *VecDense) MulVec(a Matrix, b Vector) {
 			blas64.Sbmv(1, aU.mat, bmat, 0, v.mat)
 			return
 		}
+	case *COO:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *CSR:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *CSC:
+		aU.MulVecTo(v, trans, b)
+		return
 	case *SymDense:
 		if fast {
 			aU.checkOverlap(v.asGeneral())
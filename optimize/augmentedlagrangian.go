@@ -0,0 +1,210 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultPenaltyIncrease = 10
+
+	// maxPenalty is the largest penalty parameter before the constraints
+	// are considered infeasible.
+	maxPenalty = 1e20
+
+	// maxMultiplier safeguards the Lagrange multiplier estimates.
+	maxMultiplier = 1e20
+)
+
+var _ ConstrainedMethod = (*AugmentedLagrangian)(nil)
+
+// AugmentedLagrangian is an augmented Lagrangian method for nonlinearly
+// constrained optimization. At each outer iteration the augmented Lagrangian
+//
+//	L_A(x) = f(x) + λᵀc_E(x) + ρ/2 |c_E(x)|² + 1/(2ρ) Σ_i (max(0, μ_i + ρ c_I,i(x))² - μ_i²)
+//
+// is minimized subject to the bounds using Method. The multiplier estimates
+// are then updated by
+//
+//	λ ← λ + ρ c_E(x),
+//	μ ← max(0, μ + ρ c_I(x)),
+//
+// and the penalty parameter ρ is increased if the constraint violation did not
+// decrease sufficiently. The tolerance of the inner minimization is tightened
+// as the iterations progress.
+//
+// References:
+//   - Birgin, E. G., & Martínez, J. M. (2014). Practical Augmented Lagrangian
+//     Methods for Constrained Optimization. SIAM.
+//   - Nocedal, J., & Wright, S. J. (2006). Numerical Optimization (2nd ed.),
+//     chapter 17. Springer.
+type AugmentedLagrangian struct {
+	// Method minimizes the augmented Lagrangian at each outer iteration.
+	// It must be a gradient-based Method, and it must support bounds if
+	// the problem has bounds. If Method is nil, LBFGSB is used.
+	//
+	// Errors returned by Method, for example line search failures close
+	// to the minimum, do not terminate the optimization. The outer
+	// iteration continues from the best location found by Method.
+	Method Method
+
+	// InitPenalty is the initial penalty parameter ρ. If it is zero, it
+	// is chosen to balance the objective function and the constraint
+	// violation at the initial location.
+	InitPenalty float64
+
+	// PenaltyIncrease is the factor by which the penalty parameter is
+	// increased. It must be greater than one. If it is zero, a default
+	// value of 10 is used.
+	PenaltyIncrease float64
+}
+
+func (al *AugmentedLagrangian) solve(c *constrained) (Status, error) {
+	// tau is the required decrease of the constraint
+	// violation between outer iterations.
+	const tau = 0.5
+
+	method := al.Method
+	if method == nil {
+		method = &LBFGSB{}
+	}
+	gamma := al.PenaltyIncrease
+	if gamma == 0 {
+		gamma = defaultPenaltyIncrease
+	}
+	if gamma <= 1 {
+		panic("augmentedlagrangian: penalty increase not greater than one")
+	}
+	rho := al.InitPenalty
+	if rho == 0 {
+		var viol float64
+		for _, v := range c.ce {
+			viol += v * v
+		}
+		for _, v := range c.ci {
+			v = math.Max(v, 0)
+			viol += v * v
+		}
+		rho = 10 * math.Max(1, math.Abs(c.f)) / math.Max(1, 0.5*viol)
+		rho = math.Max(1e-8, math.Min(rho, 1e8))
+	}
+	if rho < 0 {
+		panic("augmentedlagrangian: negative penalty")
+	}
+
+	s := c.settings
+	p := Problem{
+		Func:  func(x []float64) float64 { return augmentedLagrangianValue(c, x, rho) },
+		Grad:  func(grad, x []float64) { augmentedLagrangianGrad(c, grad, x, rho) },
+		Lower: c.p.Lower,
+		Upper: c.p.Upper,
+	}
+	omega := math.Max(0.1, s.OptimalityTolerance)
+	prevViol := math.Inf(1)
+	for {
+		if status := c.limit(); status != NotTerminated {
+			return status, nil
+		}
+
+		inner := &Settings{GradientThreshold: omega}
+		if s.FuncEvaluations > 0 {
+			inner.FuncEvaluations = s.FuncEvaluations - c.stats.FuncEvaluations
+		}
+		if s.Runtime > 0 {
+			inner.Runtime = max(s.Runtime-time.Since(c.start), 1)
+		}
+		result, err := Minimize(p, c.x, inner, method)
+		if result == nil {
+			return Failure, err
+		}
+		copy(c.x, result.X)
+		c.f = c.evalFunc(c.x)
+		c.evalConstraints(c.ce, c.ci, c.x)
+		if result.Status == FunctionNegativeInfinity {
+			return FunctionNegativeInfinity, nil
+		}
+
+		// Measure the violation of the constraints and the
+		// complementarity with the multipliers used in the
+		// inner minimization.
+		viol := feasibility(c.ce, nil)
+		for i, v := range c.ci {
+			viol = math.Max(viol, math.Abs(math.Max(v, -c.mu[i]/rho)))
+		}
+
+		for i, v := range c.ce {
+			c.lambda[i] = math.Max(-maxMultiplier, math.Min(c.lambda[i]+rho*v, maxMultiplier))
+		}
+		for i, v := range c.ci {
+			c.mu[i] = math.Max(0, math.Min(c.mu[i]+rho*v, maxMultiplier))
+		}
+		c.stats.MajorIterations++
+		c.updateKKT()
+		if c.converged() {
+			return Success, nil
+		}
+
+		if viol > tau*prevViol {
+			rho *= gamma
+			if rho > maxPenalty {
+				if c.kkt.Feasibility > s.FeasibilityTolerance {
+					return Failure, ErrInfeasible
+				}
+				rho = maxPenalty
+			}
+		}
+		prevViol = viol
+		omega = math.Max(0.1*omega, 0.1*s.OptimalityTolerance)
+	}
+}
+
+// augmentedLagrangianValue returns the augmented Lagrangian at x.
+func augmentedLagrangianValue(c *constrained, x []float64, rho float64) float64 {
+	ce := make([]float64, c.ne)
+	ci := make([]float64, c.ni)
+	f := c.evalFunc(x)
+	c.evalConstraints(ce, ci, x)
+	for i, v := range ce {
+		f += c.lambda[i]*v + 0.5*rho*v*v
+	}
+	for i, v := range ci {
+		m := math.Max(0, c.mu[i]+rho*v)
+		f += (m*m - c.mu[i]*c.mu[i]) / (2 * rho)
+	}
+	return f
+}
+
+// augmentedLagrangianGrad stores the gradient of the augmented Lagrangian at x into dst.
+func augmentedLagrangianGrad(c *constrained, dst, x []float64, rho float64) {
+	ce := make([]float64, c.ne)
+	ci := make([]float64, c.ni)
+	var je, ji *mat.Dense
+	if c.ne > 0 {
+		je = mat.NewDense(c.ne, c.n, nil)
+	}
+	if c.ni > 0 {
+		ji = mat.NewDense(c.ni, c.n, nil)
+	}
+	g := make([]float64, c.n)
+	c.evalGrad(g, x, math.NaN())
+	c.evalConstraints(ce, ci, x)
+	c.evalJacobians(je, ji, x, ce, ci)
+
+	// The gradient of the augmented Lagrangian is the gradient
+	// of the Lagrangian with the updated multipliers.
+	lambda := make([]float64, c.ne)
+	for i, v := range ce {
+		lambda[i] = c.lambda[i] + rho*v
+	}
+	mu := make([]float64, c.ni)
+	for i, v := range ci {
+		mu[i] = math.Max(0, c.mu[i]+rho*v)
+	}
+	c.lagrangianGrad(dst, g, je, ji, lambda, mu)
+}
@@ -0,0 +1,433 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultFeasibilityTol = 1e-8
+	defaultOptimalityTol  = 1e-6
+	defaultOuterIters     = 100
+)
+
+// ErrInfeasible signifies that a constrained optimization could not find a
+// point satisfying the constraints.
+var ErrInfeasible = errors.New("optimize: constraints appear to be infeasible")
+
+// ConstrainedProblem describes a nonlinearly constrained optimization problem
+//
+//	minimize f(x)
+//	subject to c_E(x) = 0,
+//	           c_I(x) ≤ 0,
+//	           Lower ≤ x ≤ Upper,
+//
+// where c_E and c_I are vector-valued equality and inequality constraint
+// functions.
+type ConstrainedProblem struct {
+	// Func evaluates the objective function at the given location. Func
+	// must not modify x.
+	Func func(x []float64) float64
+
+	// Grad evaluates the gradient of the objective at x and stores the
+	// result in-place in grad. Grad must not modify x. If Grad is nil,
+	// the gradient is approximated by finite differences using
+	// fd.Gradient.
+	Grad func(grad, x []float64)
+
+	// NumEquality is the number of equality constraints.
+	NumEquality int
+
+	// Equality evaluates the equality constraints at x and stores the
+	// result in dst which will have length NumEquality. Equality must
+	// not modify x. Equality must not be nil if NumEquality is positive.
+	Equality func(dst, x []float64)
+
+	// EqualityJac evaluates the Jacobian of the equality constraints at x
+	// and stores the result in-place in jac which will be a
+	// NumEquality×len(x) matrix. EqualityJac must not modify x. If
+	// EqualityJac is nil, the Jacobian is approximated by forward
+	// differences using fd.Jacobian.
+	EqualityJac func(jac *mat.Dense, x []float64)
+
+	// NumInequality is the number of inequality constraints.
+	NumInequality int
+
+	// Inequality evaluates the inequality constraints at x and stores the
+	// result in dst which will have length NumInequality. Inequality must
+	// not modify x. Inequality must not be nil if NumInequality is
+	// positive.
+	Inequality func(dst, x []float64)
+
+	// InequalityJac evaluates the Jacobian of the inequality constraints at
+	// x and stores the result in-place in jac which will be a
+	// NumInequality×len(x) matrix. InequalityJac must not modify x. If
+	// InequalityJac is nil, the Jacobian is approximated by forward
+	// differences using fd.Jacobian.
+	InequalityJac func(jac *mat.Dense, x []float64)
+
+	// Lower and Upper hold the bounds on the variables with the same
+	// meaning as in Problem.
+	Lower, Upper []float64
+}
+
+// ConstrainedSettings represents settings of a constrained optimization run.
+// The zero value of each field uses the default described in the field
+// comment.
+type ConstrainedSettings struct {
+	// FeasibilityTolerance is the tolerance on the infinity norm of the
+	// constraint violation. If it is zero, a default value of 1e-8 is used.
+	FeasibilityTolerance float64
+
+	// OptimalityTolerance is the tolerance on the stationarity and
+	// complementarity residuals of the KKT conditions. If it is zero, a
+	// default value of 1e-6 is used.
+	OptimalityTolerance float64
+
+	// MajorIterations is the maximum number of outer iterations, each of
+	// which updates the Lagrange multipliers. IterationLimit status is
+	// returned if the number of outer iterations equals or exceeds this
+	// value. If it is zero, a default value of 100 is used.
+	MajorIterations int
+
+	// FuncEvaluations is the maximum allowed number of objective function
+	// evaluations. FunctionEvaluationLimit status is returned if the total
+	// number of evaluations equals or exceeds this number. If it equals
+	// zero, this setting has no effect.
+	FuncEvaluations int
+
+	// Runtime is the maximum runtime allowed. RuntimeLimit status is
+	// returned if the duration of the run is longer than this value. If
+	// it equals zero, this setting has no effect.
+	Runtime time.Duration
+}
+
+// KKTResidual holds the residuals of the Karush-Kuhn-Tucker optimality
+// conditions of a constrained problem at a location x with Lagrange
+// multipliers λ and μ.
+type KKTResidual struct {
+	// Stationarity is the infinity norm of the projected gradient of the
+	// Lagrangian
+	//  P(x - ∇L(x)) - x,
+	// where ∇L(x) = ∇f(x) + J_E(x)ᵀλ + J_I(x)ᵀμ and P is the projection
+	// onto the bounds.
+	Stationarity float64
+
+	// Feasibility is the infinity norm of the constraint violation
+	// max(|c_E(x)|, max(c_I(x), 0)).
+	Feasibility float64
+
+	// Complementarity is the infinity norm of min(μ, -c_I(x)).
+	Complementarity float64
+}
+
+// ConstrainedResult represents the answer of a constrained optimization run.
+type ConstrainedResult struct {
+	// X holds the optimal location.
+	X []float64
+
+	// F is the objective function value at X.
+	F float64
+
+	// Gradient holds the gradient of the objective at X.
+	Gradient []float64
+
+	// Equality and Inequality hold the values of the constraints at X.
+	Equality   []float64
+	Inequality []float64
+
+	// EqualityMultipliers and InequalityMultipliers hold the estimates of
+	// the Lagrange multipliers λ and μ of the equality and inequality
+	// constraints. The inequality multipliers are non-negative.
+	EqualityMultipliers   []float64
+	InequalityMultipliers []float64
+
+	// KKT holds the residuals of the optimality conditions at X.
+	KKT KKTResidual
+
+	// Stats holds the statistics of the run. MajorIterations is the
+	// number of outer iterations.
+	Stats
+	Status Status
+}
+
+// ConstrainedMethod is a method for solving nonlinearly constrained
+// optimization problems. The AugmentedLagrangian type satisfies
+// ConstrainedMethod.
+//
+// ConstrainedMethod is sealed. Its method operates on the unexported state of
+// a MinimizeConstrained run, so ConstrainedMethod cannot be implemented
+// outside this package.
+type ConstrainedMethod interface {
+	// solve runs the optimization starting from the current state of c
+	// and returns the final status.
+	solve(c *constrained) (Status, error)
+}
+
+// MinimizeConstrained finds a local minimum of the constrained problem p
+// starting from initX. If method is nil, AugmentedLagrangian is used. If
+// settings is nil, default settings are used. If p specifies bounds and initX
+// lies outside of them, the optimization starts from the projection of initX
+// onto the bounds.
+//
+// MinimizeConstrained returns Success status when the KKT residuals are within
+// the tolerances given by settings. A non-nil error is returned if the
+// optimization ends early. MinimizeConstrained panics if p.Func is nil, if
+// initX is empty, if a constraint function is missing or if the bounds are
+// invalid.
+func MinimizeConstrained(p ConstrainedProblem, initX []float64, settings *ConstrainedSettings, method ConstrainedMethod) (*ConstrainedResult, error) {
+	start := time.Now()
+	if p.Func == nil {
+		panic(badProblem)
+	}
+	n := len(initX)
+	if n == 0 {
+		panic(nonpositiveDimension)
+	}
+	if p.NumEquality < 0 || p.NumInequality < 0 {
+		panic("optimize: negative number of constraints")
+	}
+	if p.NumEquality > 0 && p.Equality == nil {
+		panic("optimize: missing equality constraint function")
+	}
+	if p.NumInequality > 0 && p.Inequality == nil {
+		panic("optimize: missing inequality constraint function")
+	}
+	err := checkOptimization(Problem{Func: p.Func, Lower: p.Lower, Upper: p.Upper}, n, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s ConstrainedSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.FeasibilityTolerance == 0 {
+		s.FeasibilityTolerance = defaultFeasibilityTol
+	}
+	if s.OptimalityTolerance == 0 {
+		s.OptimalityTolerance = defaultOptimalityTol
+	}
+	if s.MajorIterations == 0 {
+		s.MajorIterations = defaultOuterIters
+	}
+	if method == nil {
+		method = &AugmentedLagrangian{}
+	}
+
+	c := newConstrained(&p, initX, &s, start)
+	var status Status
+	c.f = c.evalFunc(c.x)
+	if math.IsInf(c.f, 1) || math.IsNaN(c.f) {
+		status = Failure
+		err = ErrFunc(c.f)
+	} else {
+		c.evalConstraints(c.ce, c.ci, c.x)
+		status, err = method.solve(c)
+	}
+	if err == nil {
+		err = status.Err()
+	}
+	c.stats.Runtime = time.Since(start)
+	return &ConstrainedResult{
+		X:                     c.x,
+		F:                     c.f,
+		Gradient:              c.g,
+		Equality:              c.ce,
+		Inequality:            c.ci,
+		EqualityMultipliers:   c.lambda,
+		InequalityMultipliers: c.mu,
+		KKT:                   c.kkt,
+		Stats:                 c.stats,
+		Status:                status,
+	}, err
+}
+
+// constrained holds the state of a constrained optimization shared by the
+// methods.
+type constrained struct {
+	p        *ConstrainedProblem
+	settings *ConstrainedSettings
+	start    time.Time
+	stats    Stats
+
+	n, ne, ni    int
+	lower, upper []float64
+
+	x      []float64
+	f      float64
+	g      []float64  // Gradient of the objective at x.
+	ce, ci []float64  // Constraint values at x.
+	je, ji *mat.Dense // Constraint Jacobians at x.
+
+	lambda, mu []float64 // Lagrange multiplier estimates.
+	kkt        KKTResidual
+}
+
+func newConstrained(p *ConstrainedProblem, initX []float64, settings *ConstrainedSettings, start time.Time) *constrained {
+	n, ne, ni := len(initX), p.NumEquality, p.NumInequality
+	lower, upper := problemBounds(&Problem{Lower: p.Lower, Upper: p.Upper}, n)
+	c := &constrained{
+		p:        p,
+		settings: settings,
+		start:    start,
+		n:        n,
+		ne:       ne,
+		ni:       ni,
+		lower:    lower,
+		upper:    upper,
+		x:        append([]float64(nil), initX...),
+		g:        make([]float64, n),
+		ce:       make([]float64, ne),
+		ci:       make([]float64, ni),
+		lambda:   make([]float64, ne),
+		mu:       make([]float64, ni),
+	}
+	project(c.x, lower, upper)
+	if ne > 0 {
+		c.je = mat.NewDense(ne, n, nil)
+	}
+	if ni > 0 {
+		c.ji = mat.NewDense(ni, n, nil)
+	}
+	return c
+}
+
+// evalFunc returns the objective function value at x.
+func (c *constrained) evalFunc(x []float64) float64 {
+	c.stats.FuncEvaluations++
+	return c.p.Func(x)
+}
+
+// evalGrad evaluates the gradient of the objective at x and stores it into
+// dst. The function value f at x is used for finite differences if it is not
+// NaN.
+func (c *constrained) evalGrad(dst, x []float64, f float64) {
+	c.stats.GradEvaluations++
+	if c.p.Grad != nil {
+		c.p.Grad(dst, x)
+		return
+	}
+	fd.Gradient(dst, func(x []float64) float64 {
+		c.stats.FuncEvaluations++
+		return c.p.Func(x)
+	}, x, &fd.Settings{OriginKnown: !math.IsNaN(f), OriginValue: f})
+}
+
+// evalConstraints evaluates the equality and inequality constraints at x and
+// stores them into ce and ci.
+func (c *constrained) evalConstraints(ce, ci, x []float64) {
+	if c.ne > 0 {
+		c.p.Equality(ce, x)
+	}
+	if c.ni > 0 {
+		c.p.Inequality(ci, x)
+	}
+}
+
+// evalJacobians evaluates the constraint Jacobians at x with constraint
+// values ce and ci and stores them into je and ji.
+func (c *constrained) evalJacobians(je, ji *mat.Dense, x, ce, ci []float64) {
+	if c.ne > 0 {
+		if c.p.EqualityJac != nil {
+			c.p.EqualityJac(je, x)
+		} else {
+			fd.Jacobian(je, c.p.Equality, x, &fd.JacobianSettings{OriginValue: ce})
+		}
+	}
+	if c.ni > 0 {
+		if c.p.InequalityJac != nil {
+			c.p.InequalityJac(ji, x)
+		} else {
+			fd.Jacobian(ji, c.p.Inequality, x, &fd.JacobianSettings{OriginValue: ci})
+		}
+	}
+}
+
+// feasibility returns the infinity norm of the constraint violation of the
+// constraint values ce and ci.
+func feasibility(ce, ci []float64) float64 {
+	var v float64
+	for _, e := range ce {
+		v = math.Max(v, math.Abs(e))
+	}
+	for _, i := range ci {
+		v = math.Max(v, i)
+	}
+	return v
+}
+
+// updateKKT evaluates the gradient and the constraint Jacobians at the current
+// location and updates the KKT residuals for the current multipliers.
+func (c *constrained) updateKKT() {
+	c.evalGrad(c.g, c.x, c.f)
+	c.evalJacobians(c.je, c.ji, c.x, c.ce, c.ci)
+
+	gl := make([]float64, c.n)
+	c.lagrangianGrad(gl, c.g, c.je, c.ji, c.lambda, c.mu)
+	pg := make([]float64, c.n)
+	floats.SubTo(pg, c.x, gl)
+	project(pg, c.lower, c.upper)
+	floats.Sub(pg, c.x)
+
+	var comp float64
+	for i, m := range c.mu {
+		comp = math.Max(comp, math.Abs(math.Min(m, -c.ci[i])))
+	}
+	c.kkt = KKTResidual{
+		Stationarity:    floats.Norm(pg, math.Inf(1)),
+		Feasibility:     feasibility(c.ce, c.ci),
+		Complementarity: comp,
+	}
+}
+
+// lagrangianGrad stores the gradient of the Lagrangian
+//
+//	g + J_Eᵀλ + J_Iᵀμ
+//
+// into dst.
+func (c *constrained) lagrangianGrad(dst, g []float64, je, ji *mat.Dense, lambda, mu []float64) {
+	copy(dst, g)
+	d := mat.NewVecDense(c.n, dst)
+	var tmp mat.VecDense
+	if c.ne > 0 {
+		tmp.MulVec(je.T(), mat.NewVecDense(c.ne, lambda))
+		d.AddVec(d, &tmp)
+	}
+	if c.ni > 0 {
+		tmp.MulVec(ji.T(), mat.NewVecDense(c.ni, mu))
+		d.AddVec(d, &tmp)
+	}
+}
+
+// converged returns whether the KKT residuals are within the tolerances.
+func (c *constrained) converged() bool {
+	s := c.settings
+	return c.kkt.Feasibility <= s.FeasibilityTolerance &&
+		c.kkt.Stationarity <= s.OptimalityTolerance &&
+		c.kkt.Complementarity <= s.OptimalityTolerance
+}
+
+// limit returns the status corresponding to the limits in the settings being
+// reached or NotTerminated if no limit is reached.
+func (c *constrained) limit() Status {
+	s := c.settings
+	switch {
+	case s.MajorIterations > 0 && c.stats.MajorIterations >= s.MajorIterations:
+		return IterationLimit
+	case s.FuncEvaluations > 0 && c.stats.FuncEvaluations >= s.FuncEvaluations:
+		return FunctionEvaluationLimit
+	case s.Runtime > 0 && time.Since(c.start) > s.Runtime:
+		return RuntimeLimit
+	}
+	return NotTerminated
+}
@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+func ExampleMinimizeConstrained() {
+	// Minimize the distance to the point (2, 1) subject to
+	// x₀ + x₁ ≤ 1 and x₀ - x₁ = 0.5.
+	p := optimize.ConstrainedProblem{
+		Func: func(x []float64) float64 {
+			return (x[0]-2)*(x[0]-2) + (x[1]-1)*(x[1]-1)
+		},
+		Grad: func(grad, x []float64) {
+			grad[0] = 2 * (x[0] - 2)
+			grad[1] = 2 * (x[1] - 1)
+		},
+		NumEquality: 1,
+		Equality: func(dst, x []float64) {
+			dst[0] = x[0] - x[1] - 0.5
+		},
+		EqualityJac: func(jac *mat.Dense, x []float64) {
+			jac.Set(0, 0, 1)
+			jac.Set(0, 1, -1)
+		},
+		NumInequality: 1,
+		Inequality: func(dst, x []float64) {
+			dst[0] = x[0] + x[1] - 1
+		},
+		InequalityJac: func(jac *mat.Dense, x []float64) {
+			jac.Set(0, 0, 1)
+			jac.Set(0, 1, 1)
+		},
+	}
+
+	result, err := optimize.MinimizeConstrained(p, []float64{0, 0}, nil, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Status: %v\n", result.Status)
+	fmt.Printf("X: %.4f\n", result.X)
+	fmt.Printf("F: %.4f\n", result.F)
+	fmt.Printf("λ: %.4f\n", result.EqualityMultipliers)
+	fmt.Printf("μ: %.4f\n", result.InequalityMultipliers)
+
+	// Output:
+	// Status: Success
+	// X: [0.7500 0.2500]
+	// F: 2.1250
+	// λ: [0.5000]
+	// μ: [2.0000]
+}
@@ -0,0 +1,243 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// hs071 is problem 71 of the Hock-Schittkowski test set.
+var hs071 = ConstrainedProblem{
+	Func: func(x []float64) float64 {
+		return x[0]*x[3]*(x[0]+x[1]+x[2]) + x[2]
+	},
+	Grad: func(grad, x []float64) {
+		grad[0] = x[3] * (2*x[0] + x[1] + x[2])
+		grad[1] = x[0] * x[3]
+		grad[2] = x[0]*x[3] + 1
+		grad[3] = x[0] * (x[0] + x[1] + x[2])
+	},
+	NumEquality: 1,
+	Equality: func(dst, x []float64) {
+		dst[0] = floats.Dot(x, x) - 40
+	},
+	EqualityJac: func(jac *mat.Dense, x []float64) {
+		for j, v := range x {
+			jac.Set(0, j, 2*v)
+		}
+	},
+	NumInequality: 1,
+	Inequality: func(dst, x []float64) {
+		dst[0] = 25 - x[0]*x[1]*x[2]*x[3]
+	},
+	InequalityJac: func(jac *mat.Dense, x []float64) {
+		jac.Set(0, 0, -x[1]*x[2]*x[3])
+		jac.Set(0, 1, -x[0]*x[2]*x[3])
+		jac.Set(0, 2, -x[0]*x[1]*x[3])
+		jac.Set(0, 3, -x[0]*x[1]*x[2])
+	},
+	Lower: []float64{1, 1, 1, 1},
+	Upper: []float64{5, 5, 5, 5},
+}
+
+func TestAugmentedLagrangian(t *testing.T) {
+	t.Parallel()
+	circle := ConstrainedProblem{
+		Func: func(x []float64) float64 { return x[0] + x[1] },
+		Grad: func(grad, x []float64) {
+			grad[0] = 1
+			grad[1] = 1
+		},
+		NumEquality: 1,
+		Equality: func(dst, x []float64) {
+			dst[0] = x[0]*x[0] + x[1]*x[1] - 2
+		},
+		EqualityJac: func(jac *mat.Dense, x []float64) {
+			jac.Set(0, 0, 2*x[0])
+			jac.Set(0, 1, 2*x[1])
+		},
+	}
+	circleFD := circle
+	circleFD.Grad = nil
+	circleFD.EqualityJac = nil
+
+	halfPlane := func(c float64) ConstrainedProblem {
+		return ConstrainedProblem{
+			Func: func(x []float64) float64 {
+				return (x[0]-2)*(x[0]-2) + (x[1]-1)*(x[1]-1)
+			},
+			Grad: func(grad, x []float64) {
+				grad[0] = 2 * (x[0] - 2)
+				grad[1] = 2 * (x[1] - 1)
+			},
+			NumInequality: 1,
+			Inequality: func(dst, x []float64) {
+				dst[0] = x[0] + x[1] - c
+			},
+			InequalityJac: func(jac *mat.Dense, x []float64) {
+				jac.Set(0, 0, 1)
+				jac.Set(0, 1, 1)
+			},
+		}
+	}
+
+	for _, test := range []struct {
+		name       string
+		p          ConstrainedProblem
+		x          []float64
+		want       []float64
+		wantF      float64
+		wantLambda []float64
+		wantMu     []float64
+	}{
+		{
+			name:       "circle",
+			p:          circle,
+			x:          []float64{1, 0},
+			want:       []float64{-1, -1},
+			wantF:      -2,
+			wantLambda: []float64{0.5},
+		},
+		{
+			name:       "circle finite differences",
+			p:          circleFD,
+			x:          []float64{1, 0},
+			want:       []float64{-1, -1},
+			wantF:      -2,
+			wantLambda: []float64{0.5},
+		},
+		{
+			name:   "active inequality",
+			p:      halfPlane(1),
+			x:      []float64{0, 0},
+			want:   []float64{1, 0},
+			wantF:  2,
+			wantMu: []float64{2},
+		},
+		{
+			name:   "inactive inequality",
+			p:      halfPlane(5),
+			x:      []float64{0, 0},
+			want:   []float64{2, 1},
+			wantF:  0,
+			wantMu: []float64{0},
+		},
+		{
+			name:  "HS071",
+			p:     hs071,
+			x:     []float64{1, 5, 5, 1},
+			want:  []float64{1, 4.742999644, 3.821149979, 1.379408293},
+			wantF: 17.01401727,
+		},
+	} {
+		result, err := MinimizeConstrained(test.p, test.x, nil, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.Status != Success {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, Success)
+		}
+		if !floats.EqualApprox(result.X, test.want, 1e-5) {
+			t.Errorf("%s: unexpected solution: got %v, want %v", test.name, result.X, test.want)
+		}
+		if !scalar.EqualWithinAbsOrRel(result.F, test.wantF, 1e-6, 1e-6) {
+			t.Errorf("%s: unexpected objective: got %v, want %v", test.name, result.F, test.wantF)
+		}
+		if test.wantLambda != nil && !floats.EqualApprox(result.EqualityMultipliers, test.wantLambda, 1e-5) {
+			t.Errorf("%s: unexpected equality multipliers: got %v, want %v", test.name, result.EqualityMultipliers, test.wantLambda)
+		}
+		if test.wantMu != nil && !floats.EqualApprox(result.InequalityMultipliers, test.wantMu, 1e-5) {
+			t.Errorf("%s: unexpected inequality multipliers: got %v, want %v", test.name, result.InequalityMultipliers, test.wantMu)
+		}
+		checkKKT(t, test.name, test.p, result)
+	}
+}
+
+// checkKKT checks that the KKT residuals reported in result agree with the
+// problem p and are within the default tolerances.
+func checkKKT(t *testing.T, name string, p ConstrainedProblem, result *ConstrainedResult) {
+	t.Helper()
+	n := len(result.X)
+	if result.KKT.Feasibility > defaultFeasibilityTol {
+		t.Errorf("%s: infeasible solution: %v", name, result.KKT.Feasibility)
+	}
+	if result.KKT.Stationarity > defaultOptimalityTol || result.KKT.Complementarity > defaultOptimalityTol {
+		t.Errorf("%s: KKT residuals too large: %+v", name, result.KKT)
+	}
+	for _, mu := range result.InequalityMultipliers {
+		if mu < 0 {
+			t.Errorf("%s: negative inequality multiplier: %v", name, mu)
+		}
+	}
+
+	// Recompute the stationarity residual independently.
+	if p.Grad == nil || p.NumEquality > 0 && p.EqualityJac == nil || p.NumInequality > 0 && p.InequalityJac == nil {
+		return
+	}
+	gl := make([]float64, n)
+	p.Grad(gl, result.X)
+	if p.NumEquality > 0 {
+		jac := mat.NewDense(p.NumEquality, n, nil)
+		p.EqualityJac(jac, result.X)
+		for i, l := range result.EqualityMultipliers {
+			floats.AddScaled(gl, l, jac.RawRowView(i))
+		}
+	}
+	if p.NumInequality > 0 {
+		jac := mat.NewDense(p.NumInequality, n, nil)
+		p.InequalityJac(jac, result.X)
+		for i, m := range result.InequalityMultipliers {
+			floats.AddScaled(gl, m, jac.RawRowView(i))
+		}
+	}
+	lower, upper := problemBounds(&Problem{Lower: p.Lower, Upper: p.Upper}, n)
+	pg := make([]float64, n)
+	floats.SubTo(pg, result.X, gl)
+	project(pg, lower, upper)
+	floats.Sub(pg, result.X)
+	if got := floats.Norm(pg, math.Inf(1)); math.Abs(got-result.KKT.Stationarity) > 1e-10 {
+		t.Errorf("%s: mismatched stationarity residual: got %v, want %v", name, result.KKT.Stationarity, got)
+	}
+}
+
+func TestAugmentedLagrangianInfeasible(t *testing.T) {
+	t.Parallel()
+	p := ConstrainedProblem{
+		Func:          func(x []float64) float64 { return x[0] * x[0] },
+		Grad:          func(grad, x []float64) { grad[0] = 2 * x[0] },
+		NumInequality: 1,
+		Inequality: func(dst, x []float64) {
+			dst[0] = x[0]*x[0] + 1
+		},
+	}
+	result, err := MinimizeConstrained(p, []float64{1}, nil, nil)
+	if err == nil {
+		t.Fatalf("expected error for infeasible problem")
+	}
+	if result.Status != Failure && result.Status != IterationLimit {
+		t.Errorf("unexpected status: %v", result.Status)
+	}
+}
+
+func TestAugmentedLagrangianLimits(t *testing.T) {
+	t.Parallel()
+	settings := &ConstrainedSettings{MajorIterations: 1}
+	result, err := MinimizeConstrained(hs071, []float64{1, 5, 5, 1}, settings, nil)
+	if err == nil {
+		t.Errorf("expected error with iteration limit")
+	}
+	if result.Status != IterationLimit {
+		t.Errorf("unexpected status: got %v, want %v", result.Status, IterationLimit)
+	}
+	if result.MajorIterations != 1 {
+		t.Errorf("unexpected number of iterations: got %d, want 1", result.MajorIterations)
+	}
+}
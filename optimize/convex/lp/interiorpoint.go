@@ -0,0 +1,409 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// defaultInteriorPointTol is the convergence tolerance used by
+	// InteriorPoint when tol is zero.
+	defaultInteriorPointTol = 1e-9
+	// interiorPointIterations is the maximum number of iterations of
+	// InteriorPoint.
+	interiorPointIterations = 200
+	// stepFactor is the fraction of the step to the boundary of the positive
+	// orthant taken by InteriorPoint.
+	stepFactor = 0.995
+)
+
+// ErrIterationLimit is returned by InteriorPoint when it fails to converge
+// within its maximum number of iterations.
+var ErrIterationLimit = errors.New("lp: iteration limit reached")
+
+// InteriorPoint solves a linear program in standard form using a primal-dual
+// interior-point method. The standard form of a linear program is:
+//
+//	minimize	cᵀ x
+//	s.t. 		A*x = b
+//				x >= 0 .
+//
+// This is the same form that is solved by Simplex, and the Convert function can
+// be used to transform a general LP into standard form. Unlike Simplex,
+// InteriorPoint does not require A to have full row rank, and the number of
+// iterations grows slowly with the size of the problem and is not affected by
+// degeneracy.
+//
+// The input tol sets the relative accuracy of the primal feasibility, the dual
+// feasibility and the duality gap of the returned solution. If tol is zero, a
+// default value of 1e-9 is used. ErrInfeasible or ErrUnbounded is returned if
+// the problem is detected to be primal or dual infeasible. If the method fails
+// to converge, the most recent iterate is returned together with
+// ErrIterationLimit or ErrLinSolve.
+//
+// The returned Solution contains the dual prices and reduced costs at the final
+// iterate. The solution found by an interior-point method is in general not a
+// vertex, and when the LP has multiple optimal solutions X lies in the relative
+// interior of the optimal face. The basis in the returned Solution is
+// identified from the final iterate by choosing a maximal set of linearly
+// independent columns of A in decreasing order of the ratio of the primal
+// value to the sum of the primal value and the reduced cost, so it is the
+// optimal basis when the optimal solution is unique and non-degenerate.
+//
+// The len(c) must equal the number of columns of A, and len(b) must equal the
+// number of rows of A or InteriorPoint will panic.
+//
+// InteriorPoint uses Mehrotra's predictor-corrector method applied to the
+// homogeneous self-dual embedding of the LP, as described in
+//
+//	Andersen, E. D., and Andersen, K. D. "The Mosek interior point optimizer for
+//	linear programming: an implementation of the homogeneous algorithm." High
+//	performance optimization. Springer, Boston, MA (2000): 197-232.
+func InteriorPoint(c []float64, A mat.Matrix, b []float64, tol float64) (*Solution, error) {
+	m, n := A.Dims()
+	if len(c) != n {
+		panic("lp: c vector incorrect length")
+	}
+	if len(b) != m {
+		panic("lp: b vector incorrect length")
+	}
+	if tol < 0 {
+		panic("lp: negative tolerance")
+	}
+	if tol == 0 {
+		tol = defaultInteriorPointTol
+	}
+	ip := newInteriorPoint(c, A, b)
+	return ip.solve(tol)
+}
+
+// interiorPoint holds the state of the homogeneous self-dual interior-point
+// method. The homogeneous model of the standard form LP is
+//
+//	A*x - b*τ = 0
+//	Aᵀ*y + s - c*τ = 0
+//	bᵀy - cᵀx - κ = 0
+//	x, s, τ, κ >= 0
+//
+// and a solution with τ > 0 gives the optimal solution x/τ, y/τ and s/τ of the
+// LP, while a solution with κ > 0 is a certificate of infeasibility.
+type interiorPoint struct {
+	m, n int
+	a    *mat.Dense
+	b, c []float64
+
+	x, s   []float64
+	y      []float64
+	tau    float64
+	kappa  float64
+	rp, rd []float64 // Primal and dual residuals.
+	rg     float64   // Gap residual.
+
+	theta []float64    // x/s.
+	ad    *mat.Dense   // A*Θ^½.
+	chol  mat.Cholesky // Factorization of A*Θ*Aᵀ.
+	norm  mat.SymDense // A*Θ*Aᵀ.
+	q     []float64    // (A*Θ*Aᵀ)⁻¹ (b + A*Θ*c).
+	tmpN  []float64    // Temporary of length n.
+	tmpM  []float64    // Temporary of length m.
+	rhs   []float64    // Right-hand side of the normal equations.
+	aff   interiorStep // Affine scaling direction.
+	cor   interiorStep // Combined predictor-corrector direction.
+	rxs   []float64    // Complementarity right-hand side.
+}
+
+// interiorStep is a search direction of the homogeneous model.
+type interiorStep struct {
+	x, y, s    []float64
+	tau, kappa float64
+}
+
+func newInteriorStep(m, n int) interiorStep {
+	return interiorStep{
+		x: make([]float64, n),
+		y: make([]float64, m),
+		s: make([]float64, n),
+	}
+}
+
+func newInteriorPoint(c []float64, A mat.Matrix, b []float64) *interiorPoint {
+	m, n := A.Dims()
+	ip := &interiorPoint{
+		m:     m,
+		n:     n,
+		a:     mat.DenseCopyOf(A),
+		b:     b,
+		c:     c,
+		x:     make([]float64, n),
+		s:     make([]float64, n),
+		y:     make([]float64, m),
+		tau:   1,
+		kappa: 1,
+		rp:    make([]float64, m),
+		rd:    make([]float64, n),
+		theta: make([]float64, n),
+		ad:    mat.NewDense(m, n, nil),
+		q:     make([]float64, m),
+		tmpN:  make([]float64, n),
+		tmpM:  make([]float64, m),
+		rhs:   make([]float64, m),
+		aff:   newInteriorStep(m, n),
+		cor:   newInteriorStep(m, n),
+		rxs:   make([]float64, n),
+	}
+	// Start from the center of the positive orthant.
+	for i := range ip.x {
+		ip.x[i] = 1
+		ip.s[i] = 1
+	}
+	return ip
+}
+
+func (ip *interiorPoint) solve(tol float64) (*Solution, error) {
+	normB := 1 + floats.Norm(ip.b, math.Inf(1))
+	normC := 1 + floats.Norm(ip.c, math.Inf(1))
+	mu0 := ip.mu()
+	for iter := 0; iter < interiorPointIterations; iter++ {
+		ip.residuals()
+		mu := ip.mu()
+
+		// Check for convergence of the scaled iterate to an optimal solution.
+		pobj := floats.Dot(ip.c, ip.x) / ip.tau
+		dobj := floats.Dot(ip.b, ip.y) / ip.tau
+		primal := floats.Norm(ip.rp, math.Inf(1)) / ip.tau
+		dual := floats.Norm(ip.rd, math.Inf(1)) / ip.tau
+		if primal <= tol*normB && dual <= tol*normC && math.Abs(pobj-dobj) <= tol*(1+math.Abs(dobj)) {
+			return ip.solution(), nil
+		}
+
+		// Check for a certificate of infeasibility. In this case τ tends
+		// to zero while κ remains bounded away from zero.
+		if mu <= tol*mu0 && ip.tau <= tol*math.Max(1, ip.kappa) {
+			return nil, ip.infeasibility()
+		}
+
+		if !ip.factorize() {
+			return ip.solution(), ErrLinSolve
+		}
+
+		// Predictor step. Compute the affine scaling direction that aims
+		// at a solution of the homogeneous model.
+		for i, v := range ip.x {
+			ip.rxs[i] = -v * ip.s[i]
+		}
+		ip.direction(&ip.aff, 1, -ip.tau*ip.kappa)
+		alpha := math.Min(1, ip.maxStep(&ip.aff))
+
+		// Use Mehrotra's heuristic to choose the centering parameter.
+		var muAff float64
+		for i, v := range ip.x {
+			muAff += (v + alpha*ip.aff.x[i]) * (ip.s[i] + alpha*ip.aff.s[i])
+		}
+		muAff += (ip.tau + alpha*ip.aff.tau) * (ip.kappa + alpha*ip.aff.kappa)
+		muAff /= float64(ip.n + 1)
+		sigma := math.Min(1, math.Pow(muAff/mu, 3))
+
+		// Corrector step. Include the second order term and recenter.
+		for i, v := range ip.x {
+			ip.rxs[i] = -v*ip.s[i] - ip.aff.x[i]*ip.aff.s[i] + sigma*mu
+		}
+		rtk := -ip.tau*ip.kappa - ip.aff.tau*ip.aff.kappa + sigma*mu
+		ip.direction(&ip.cor, 1-sigma, rtk)
+		alpha = math.Min(1, stepFactor*ip.maxStep(&ip.cor))
+
+		floats.AddScaled(ip.x, alpha, ip.cor.x)
+		floats.AddScaled(ip.y, alpha, ip.cor.y)
+		floats.AddScaled(ip.s, alpha, ip.cor.s)
+		ip.tau += alpha * ip.cor.tau
+		ip.kappa += alpha * ip.cor.kappa
+	}
+	return ip.solution(), ErrIterationLimit
+}
+
+// infeasibility returns the error corresponding to the infeasibility
+// certificate at the current iterate. If bᵀy > 0, then y is a certificate of
+// primal infeasibility when Aᵀy <= 0, and if cᵀx < 0, then x is a certificate
+// of dual infeasibility when A*x = 0. Both may hold at the same time, so the
+// certificate with the smaller relative residual is chosen.
+func (ip *interiorPoint) infeasibility() error {
+	primal := math.Inf(1)
+	if by := floats.Dot(ip.b, ip.y); by > 0 {
+		// rd = c*τ - Aᵀy - s, and τ is negligible.
+		primal = floats.Norm(ip.rd, math.Inf(1)) / by
+	}
+	dual := math.Inf(1)
+	if cx := floats.Dot(ip.c, ip.x); cx < 0 {
+		// rp = b*τ - A*x.
+		dual = floats.Norm(ip.rp, math.Inf(1)) / -cx
+	}
+	if dual < primal {
+		return ErrUnbounded
+	}
+	return ErrInfeasible
+}
+
+// mu returns the complementarity measure of the current iterate.
+func (ip *interiorPoint) mu() float64 {
+	return (floats.Dot(ip.x, ip.s) + ip.tau*ip.kappa) / float64(ip.n+1)
+}
+
+// residuals computes the residuals of the homogeneous model at the current
+// iterate.
+func (ip *interiorPoint) residuals() {
+	// rp = b*τ - A*x.
+	rp := mat.NewVecDense(ip.m, ip.rp)
+	rp.MulVec(ip.a, mat.NewVecDense(ip.n, ip.x))
+	floats.Scale(-1, ip.rp)
+	floats.AddScaled(ip.rp, ip.tau, ip.b)
+
+	// rd = c*τ - Aᵀ*y - s.
+	rd := mat.NewVecDense(ip.n, ip.rd)
+	rd.MulVec(ip.a.T(), mat.NewVecDense(ip.m, ip.y))
+	floats.Scale(-1, ip.rd)
+	floats.AddScaled(ip.rd, ip.tau, ip.c)
+	floats.Sub(ip.rd, ip.s)
+
+	// rg = κ + cᵀx - bᵀy.
+	ip.rg = ip.kappa + floats.Dot(ip.c, ip.x) - floats.Dot(ip.b, ip.y)
+}
+
+// factorize forms and factorizes the normal equations matrix A*Θ*Aᵀ with
+// Θ = X*S⁻¹, and computes ip.q. If the matrix is not numerically positive
+// definite, a small diagonal regularization is added. factorize returns
+// whether the factorization was successful.
+func (ip *interiorPoint) factorize() bool {
+	for j, v := range ip.x {
+		ip.theta[j] = v / ip.s[j]
+	}
+	ip.ad.Apply(func(_, j int, v float64) float64 {
+		return v * math.Sqrt(ip.theta[j])
+	}, ip.a)
+	ip.norm.SymOuterK(1, ip.ad)
+
+	var maxDiag float64
+	for i := 0; i < ip.m; i++ {
+		maxDiag = math.Max(maxDiag, ip.norm.At(i, i))
+	}
+	if maxDiag == 0 {
+		maxDiag = 1
+	}
+	ok := ip.chol.Factorize(&ip.norm)
+	for reg := 1e-14 * maxDiag; !ok && reg <= 1e-6*maxDiag; reg *= 100 {
+		for i := 0; i < ip.m; i++ {
+			ip.norm.SetSym(i, i, ip.norm.At(i, i)+reg)
+		}
+		ok = ip.chol.Factorize(&ip.norm)
+	}
+	if !ok {
+		return false
+	}
+
+	// q = (A*Θ*Aᵀ)⁻¹ (b + A*Θ*c).
+	for j, v := range ip.c {
+		ip.tmpN[j] = ip.theta[j] * v
+	}
+	ip.normalSolve(ip.q, ip.b, ip.tmpN)
+	return true
+}
+
+// normalSolve solves (A*Θ*Aᵀ) dst = u + A*v using the current factorization.
+func (ip *interiorPoint) normalSolve(dst, u, v []float64) {
+	rhs := mat.NewVecDense(ip.m, ip.rhs)
+	rhs.MulVec(ip.a, mat.NewVecDense(ip.n, v))
+	floats.Add(ip.rhs, u)
+	// An error is only returned if A*Θ*Aᵀ is close to singular, in which
+	// case the solution is still a useful search direction.
+	_ = ip.chol.SolveVecTo(mat.NewVecDense(ip.m, dst), rhs)
+}
+
+// direction computes the search direction of the homogeneous model that
+// reduces the residuals by the factor 1-eta and targets the complementarity
+// right-hand sides ip.rxs and rtk, that is
+//
+//	A*dx - b*dτ = eta*rp
+//	Aᵀ*dy + ds - c*dτ = eta*rd
+//	bᵀ*dy - cᵀ*dx - dκ = eta*rg
+//	S*dx + X*ds = rxs
+//	κ*dτ + τ*dκ = rtk
+func (ip *interiorPoint) direction(d *interiorStep, eta, rtk float64) {
+	// Eliminating ds and dκ gives dy = p + q*dτ and dx = u + v*dτ where
+	//  (A*Θ*Aᵀ) p = eta*rp + A*Θ*(eta*rd - X⁻¹*rxs)
+	//  u = Θ*(Aᵀ*p - eta*rd + X⁻¹*rxs)
+	//  v = Θ*(Aᵀ*q - c)
+	// and dτ is then found from the gap equation.
+	for j, v := range ip.x {
+		ip.tmpN[j] = ip.theta[j] * (eta*ip.rd[j] - ip.rxs[j]/v)
+	}
+	floats.ScaleTo(ip.tmpM, eta, ip.rp)
+	p := d.y
+	ip.normalSolve(p, ip.tmpM, ip.tmpN)
+
+	// u is stored in d.x and v in d.s.
+	u := mat.NewVecDense(ip.n, d.x)
+	u.MulVec(ip.a.T(), mat.NewVecDense(ip.m, p))
+	v := mat.NewVecDense(ip.n, d.s)
+	v.MulVec(ip.a.T(), mat.NewVecDense(ip.m, ip.q))
+	for j, xj := range ip.x {
+		d.x[j] = ip.theta[j] * (d.x[j] - eta*ip.rd[j] + ip.rxs[j]/xj)
+		d.s[j] = ip.theta[j] * (d.s[j] - ip.c[j])
+	}
+
+	num := eta*ip.rg + floats.Dot(ip.c, d.x) - floats.Dot(ip.b, p) + rtk/ip.tau
+	den := -floats.Dot(ip.c, d.s) + floats.Dot(ip.b, ip.q) + ip.kappa/ip.tau
+	d.tau = num / den
+
+	floats.AddScaled(d.y, d.tau, ip.q)
+	floats.AddScaled(d.x, d.tau, d.s)
+	for j, xj := range ip.x {
+		d.s[j] = (ip.rxs[j] - ip.s[j]*d.x[j]) / xj
+	}
+	d.kappa = (rtk - ip.kappa*d.tau) / ip.tau
+}
+
+// maxStep returns the largest step along d that keeps x, s, τ and κ
+// non-negative.
+func (ip *interiorPoint) maxStep(d *interiorStep) float64 {
+	alpha := math.Inf(1)
+	ratio := func(v, dv float64) {
+		if dv < 0 {
+			alpha = math.Min(alpha, -v/dv)
+		}
+	}
+	for j := range ip.x {
+		ratio(ip.x[j], d.x[j])
+		ratio(ip.s[j], d.s[j])
+	}
+	ratio(ip.tau, d.tau)
+	ratio(ip.kappa, d.kappa)
+	return alpha
+}
+
+// solution returns the Solution of the LP corresponding to the current
+// iterate of the homogeneous model.
+func (ip *interiorPoint) solution() *Solution {
+	x := make([]float64, ip.n)
+	floats.ScaleTo(x, 1/ip.tau, ip.x)
+	y := make([]float64, ip.m)
+	floats.ScaleTo(y, 1/ip.tau, ip.y)
+	r := reducedCosts(ip.c, ip.a, y)
+	basis := identifyBasis(ip.a, x, r)
+	status := make([]BasisStatus, ip.n)
+	for _, j := range basis {
+		status[j] = Basic
+	}
+	return &Solution{
+		F:           floats.Dot(ip.c, x),
+		X:           x,
+		Dual:        y,
+		ReducedCost: r,
+		Basis:       basis,
+		Status:      status,
+	}
+}
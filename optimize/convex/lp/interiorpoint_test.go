@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestInteriorPoint(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		A    mat.Matrix
+		b    []float64
+		c    []float64
+		f    float64
+		err  error
+	}{
+		{
+			name: "basic feasible LP",
+			A: mat.NewDense(2, 4, []float64{
+				-1, 2, 1, 0,
+				3, 1, 0, 1,
+			}),
+			b: []float64{4, 9},
+			c: []float64{-1, -2, 0, 0},
+			f: -8,
+		},
+		{
+			name: "assignment",
+			// Assign 3 workers to 3 jobs. The LP is highly degenerate
+			// since every vertex has only 3 of 9 non-zero variables
+			// while there are 6 equality constraints.
+			A: mat.NewDense(6, 9, []float64{
+				1, 1, 1, 0, 0, 0, 0, 0, 0,
+				0, 0, 0, 1, 1, 1, 0, 0, 0,
+				0, 0, 0, 0, 0, 0, 1, 1, 1,
+				1, 0, 0, 1, 0, 0, 1, 0, 0,
+				0, 1, 0, 0, 1, 0, 0, 1, 0,
+				0, 0, 1, 0, 0, 1, 0, 0, 1,
+			}),
+			b: []float64{1, 1, 1, 1, 1, 1},
+			c: []float64{4, 1, 3, 2, 0, 5, 3, 2, 2},
+			f: 5,
+		},
+		{
+			name: "multiple optima",
+			A:    mat.NewDense(1, 3, []float64{1, 1, 1}),
+			b:    []float64{1},
+			c:    []float64{1, 1, 2},
+			f:    1,
+		},
+		{
+			name: "infeasible",
+			A: mat.NewDense(2, 3, []float64{
+				1, 1, 0,
+				1, 1, 1,
+			}),
+			b:   []float64{2, 1},
+			c:   []float64{1, 1, 1},
+			err: ErrInfeasible,
+		},
+		{
+			name: "unbounded",
+			A:    mat.NewDense(1, 3, []float64{1, -1, 1}),
+			b:    []float64{1},
+			c:    []float64{-1, -1, 0},
+			err:  ErrUnbounded,
+		},
+	} {
+		sol, err := InteriorPoint(test.c, test.A, test.b, 0)
+		if err != test.err {
+			t.Errorf("%s: unexpected error: got %v, want %v", test.name, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(sol.F, test.f, 1e-8, 1e-8) {
+			t.Errorf("%s: unexpected objective: got %v, want %v", test.name, sol.F, test.f)
+		}
+		checkSolution(t, test.name, test.c, test.A, test.b, sol, 1e-7)
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	testRandomInteriorPoint(t, 2000, 0.2, 10, rnd)
+	testRandomInteriorPoint(t, 2000, 0, 10, rnd)
+	testRandomInteriorPoint(t, 50, 0, 100, rnd)
+}
+
+func testRandomInteriorPoint(t *testing.T, nTest int, pZero float64, maxN int, rnd *rand.Rand) {
+	for i := 0; i < nTest; i++ {
+		n := rnd.IntN(maxN) + 2
+		m := rnd.IntN(n-1) + 1
+		randValue := func() float64 {
+			if rnd.Float64() < pZero {
+				return 0
+			}
+			return rnd.NormFloat64()
+		}
+		a := mat.NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, randValue())
+			}
+		}
+		b := make([]float64, m)
+		for i := range b {
+			b[i] = randValue()
+		}
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = randValue()
+		}
+		name := fmt.Sprintf("random m=%d n=%d", m, n)
+
+		fSimplex, _, basis, errSimplex := simplex(nil, c, a, b, convergenceTol)
+		sol, err := InteriorPoint(c, a, b, 0)
+		switch errSimplex {
+		case nil:
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(sol.F, fSimplex, 1e-6, 1e-6) {
+				t.Errorf("%s: objective mismatch: interior point %v, simplex %v", name, sol.F, fSimplex)
+			}
+			checkSolution(t, name, c, a, b, sol, 1e-6)
+			if pZero == 0 && basis != nil {
+				// Random problems without zero entries have a unique
+				// non-degenerate optimal solution with probability one,
+				// so the identified basis is the optimal basis.
+				slices.Sort(basis)
+				got := append([]int(nil), sol.Basis...)
+				slices.Sort(got)
+				if !slices.Equal(got, basis) {
+					t.Errorf("%s: basis mismatch: interior point %v, simplex %v", name, got, basis)
+				}
+			}
+		case ErrInfeasible:
+			// The problem may be both primal and dual infeasible, in which
+			// case either certificate is valid.
+			if err != ErrInfeasible && err != ErrUnbounded {
+				t.Errorf("%s: simplex infeasible, interior point returned %v", name, err)
+			}
+		case ErrUnbounded:
+			// Simplex reports a zero column with a negative cost as
+			// unbounded without checking feasibility.
+			if err != ErrUnbounded && !(err == ErrInfeasible && hasZeroColumn(a)) {
+				t.Errorf("%s: simplex unbounded, interior point returned %v", name, err)
+			}
+		}
+	}
+}
+
+func hasZeroColumn(a mat.Matrix) bool {
+	m, n := a.Dims()
+	for j := 0; j < n; j++ {
+		zero := true
+		for i := 0; i < m; i++ {
+			if a.At(i, j) != 0 {
+				zero = false
+				break
+			}
+		}
+		if zero {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSimplexSolution(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 2000; i++ {
+		n := rnd.IntN(20) + 2
+		m := rnd.IntN(n-1) + 1
+		a := mat.NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		b := make([]float64, m)
+		for i := range b {
+			b[i] = rnd.NormFloat64()
+		}
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = rnd.NormFloat64()
+		}
+		name := fmt.Sprintf("random m=%d n=%d", m, n)
+
+		f, x, errSimplex := Simplex(c, a, b, convergenceTol, nil)
+		sol, err := SimplexSolution(c, a, b, convergenceTol, nil)
+		if err != errSimplex {
+			t.Errorf("%s: error mismatch: got %v, want %v", name, err, errSimplex)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if sol.F != f || !floats.Equal(sol.X, x) {
+			t.Errorf("%s: solution mismatch with Simplex", name)
+		}
+		if len(sol.Basis) != m {
+			t.Errorf("%s: unexpected basis size: got %d, want %d", name, len(sol.Basis), m)
+		}
+		for j, v := range sol.X {
+			if v != 0 && sol.Status[j] != Basic {
+				t.Errorf("%s: non-zero variable %d is %v", name, j, sol.Status[j])
+			}
+		}
+		for _, j := range sol.Basis {
+			if sol.ReducedCost[j] != 0 {
+				t.Errorf("%s: non-zero reduced cost for basic variable %d", name, j)
+			}
+		}
+		checkSolution(t, name, c, a, b, sol, 1e-8)
+	}
+}
+
+// checkSolution checks that sol is a primal and dual feasible solution of the
+// standard form LP and that it satisfies strong duality.
+func checkSolution(t *testing.T, name string, c []float64, a mat.Matrix, b []float64, sol *Solution, tol float64) {
+	t.Helper()
+	m, n := a.Dims()
+	if len(sol.X) != n || len(sol.Dual) != m || len(sol.ReducedCost) != n || len(sol.Status) != n {
+		t.Errorf("%s: unexpected solution dimensions", name)
+		return
+	}
+	scale := 1 + floats.Norm(c, math.Inf(1)) + floats.Norm(b, math.Inf(1))
+
+	var ax mat.VecDense
+	ax.MulVec(a, mat.NewVecDense(n, sol.X))
+	if !floats.EqualApprox(ax.RawVector().Data, b, tol*scale) {
+		t.Errorf("%s: primal solution not feasible", name)
+	}
+	if floats.Min(sol.X) < -tol*scale {
+		t.Errorf("%s: negative primal solution", name)
+	}
+
+	// Aᵀ*y + r = c.
+	var aty mat.VecDense
+	aty.MulVec(a.T(), mat.NewVecDense(m, sol.Dual))
+	floats.Add(aty.RawVector().Data, sol.ReducedCost)
+	if !floats.EqualApprox(aty.RawVector().Data, c, tol*scale) {
+		t.Errorf("%s: reduced costs inconsistent with dual prices", name)
+	}
+	if floats.Min(sol.ReducedCost) < -tol*scale {
+		t.Errorf("%s: dual solution not feasible", name)
+	}
+
+	// Strong duality.
+	if dualF := floats.Dot(b, sol.Dual); !scalar.EqualWithinAbsOrRel(dualF, sol.F, tol*scale, tol) {
+		t.Errorf("%s: duality gap: primal %v, dual %v", name, sol.F, dualF)
+	}
+
+	var nBasic int
+	for _, s := range sol.Status {
+		if s == Basic {
+			nBasic++
+		}
+	}
+	if nBasic != len(sol.Basis) {
+		t.Errorf("%s: status inconsistent with basis", name)
+	}
+}
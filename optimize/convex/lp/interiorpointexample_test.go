@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+func ExampleInteriorPoint() {
+	// Maximize x + 2y subject to -x + 2y <= 4 and 3x + y <= 9. The LP is
+	// converted to standard form by adding slack variables.
+	c := []float64{-1, -2, 0, 0}
+	A := mat.NewDense(2, 4, []float64{-1, 2, 1, 0, 3, 1, 0, 1})
+	b := []float64{4, 9}
+
+	sol, err := lp.InteriorPoint(c, A, b, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("opt: %.4f\n", sol.F)
+	fmt.Printf("x: %.4f\n", sol.X)
+	// The dual prices are the change in the optimal value per unit
+	// increase of the right-hand side of each constraint.
+	fmt.Printf("dual prices: %.4f\n", sol.Dual)
+	// The reduced costs of the slack variables are the amounts by which
+	// the objective would worsen per unit of unused capacity.
+	fmt.Printf("slack reduced costs: %.4f\n", sol.ReducedCost[2:])
+	fmt.Printf("status: %v\n", sol.Status)
+	// Output:
+	// opt: -8.0000
+	// x: [2.0000 3.0000 0.0000 0.0000]
+	// dual prices: [-0.7143 -0.5714]
+	// slack reduced costs: [0.7143 0.5714]
+	// status: [Basic Basic NonBasic NonBasic]
+}
@@ -0,0 +1,566 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// defaultIntegralityTol is the default distance from the nearest integer
+	// within which a variable is considered integral.
+	defaultIntegralityTol = 1e-6
+	// defaultGapTol is the default relative gap at which branch and bound
+	// terminates.
+	defaultGapTol = 1e-9
+	// maxCutsPerRound is the maximum number of cuts added in a round of
+	// cutting planes.
+	maxCutsPerRound = 10
+	// minCutFraction is the minimum distance from an integer of a basic
+	// variable for it to generate a cut.
+	minCutFraction = 0.01
+	// maxCutDynamism is the maximum ratio of the largest to the smallest
+	// non-zero coefficient of a cut.
+	maxCutDynamism = 1e6
+)
+
+var (
+	// ErrNodeLimit is returned by BranchAndBound when settings.NodeLimit
+	// LP relaxations have been solved before the search completes.
+	ErrNodeLimit = errors.New("lp: node limit reached")
+	// ErrTimeLimit is returned by BranchAndBound when settings.TimeLimit
+	// has elapsed before the search completes.
+	ErrTimeLimit = errors.New("lp: time limit reached")
+)
+
+// NodeSelection is the rule used by BranchAndBound to choose the next node
+// of the search tree to explore.
+type NodeSelection int
+
+const (
+	// BestBound selects the open node with the lowest bound. It minimizes
+	// the number of nodes needed to prove optimality.
+	BestBound NodeSelection = iota
+	// DepthFirst selects the most recently created node. It uses little
+	// memory and tends to find integer feasible solutions quickly.
+	DepthFirst
+)
+
+// MILPSettings holds the settings for BranchAndBound.
+type MILPSettings struct {
+	// Tolerance is the tolerance passed to Simplex when solving the LP
+	// relaxations.
+	Tolerance float64
+
+	// IntegralityTolerance is the maximum distance from the nearest integer
+	// for which an integer variable is considered integral. If it is zero,
+	// a default value of 1e-6 is used.
+	IntegralityTolerance float64
+
+	// GapTolerance is the relative gap between the incumbent and the bound
+	// at which the search terminates. If it is zero, a default value of
+	// 1e-9 is used.
+	GapTolerance float64
+
+	// NodeSelection is the rule for choosing the next node to explore.
+	NodeSelection NodeSelection
+
+	// CutRounds is the number of rounds of Gomory mixed-integer cuts added
+	// to the LP relaxation at the root node. If it is zero, no cuts are
+	// added.
+	CutRounds int
+
+	// NodeLimit is the maximum number of LP relaxations solved. If it is
+	// zero, the number of nodes is not limited.
+	NodeLimit int
+
+	// TimeLimit is the maximum duration of the search. If it is zero, the
+	// duration is not limited.
+	TimeLimit time.Duration
+}
+
+// MILPResult holds the result of BranchAndBound.
+type MILPResult struct {
+	// X is the incumbent, the best integer feasible solution found. X is
+	// nil if no integer feasible solution was found.
+	X []float64
+	// F is the objective function value at the incumbent, or +Inf if no
+	// integer feasible solution was found.
+	F float64
+	// Bound is a lower bound on the optimal objective function value.
+	Bound float64
+	// Gap is the relative gap (F - Bound) / max(1, |F|) between the
+	// incumbent and the bound.
+	Gap float64
+
+	// Nodes is the number of LP relaxations solved.
+	Nodes int
+	// Cuts is the number of cuts added at the root node.
+	Cuts int
+}
+
+// BranchAndBound solves a mixed-integer linear program in standard form
+//
+//	minimize	cᵀ x
+//	s.t. 		A*x = b
+//				x >= 0
+//				x_j integer if integer[j] is true
+//
+// using LP-based branch and bound. The LP relaxations are solved with the
+// Simplex method, and every node is warm-started from the optimal basis of its
+// parent. BranchAndBound branches on the most fractional integer variable by
+// adding the constraint x_j <= ⌊v⌋ to one child and x_j >= ⌈v⌉ to the other,
+// where v is the value of x_j in the relaxation. Nodes are explored in the
+// order given by settings.NodeSelection. If settings.CutRounds is positive,
+// Gomory mixed-integer cuts are added to the root relaxation before branching.
+//
+// The Convert function can be used to transform a general MILP into standard
+// form. In that case both the positive and the negative parts of an integer
+// variable must be marked as integer.
+//
+// If the search completes, the returned MILPResult holds the optimal solution
+// and err is nil. ErrInfeasible is returned if the problem has no integer
+// feasible solution and ErrUnbounded is returned if the LP relaxation is
+// unbounded. If the node or time limit is reached, the result at that point is
+// returned together with ErrNodeLimit or ErrTimeLimit, and the incumbent, if
+// any, is integer feasible with an objective value within Gap of the optimal.
+// Other errors from Simplex are returned with the result at that point.
+//
+// The restrictions on A, b and c are the same as for Simplex, and len(integer)
+// must equal len(c) or BranchAndBound will panic. If settings is nil, the
+// default settings are used.
+func BranchAndBound(c []float64, A mat.Matrix, b []float64, integer []bool, settings *MILPSettings) (*MILPResult, error) {
+	m, n := A.Dims()
+	if len(c) != n {
+		panic("lp: c vector incorrect length")
+	}
+	if len(b) != m {
+		panic("lp: b vector incorrect length")
+	}
+	if len(integer) != n {
+		panic("lp: integer mask incorrect length")
+	}
+	var s MILPSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.IntegralityTolerance == 0 {
+		s.IntegralityTolerance = defaultIntegralityTol
+	}
+	if s.GapTolerance == 0 {
+		s.GapTolerance = defaultGapTol
+	}
+
+	bb := &branchAndBound{
+		settings: s,
+		start:    time.Now(),
+		n:        n,
+		c:        append([]float64(nil), c...),
+		a:        mat.DenseCopyOf(A),
+		b:        append([]float64(nil), b...),
+		integer:  append([]bool(nil), integer...),
+		incF:     math.Inf(1),
+	}
+	return bb.solve()
+}
+
+// branchAndBound holds the state of the branch and bound search. The root
+// relaxation, including any cuts, is given by c, a and b. Every cut adds a
+// row and a surplus column to the root relaxation, and every branch adds a
+// row and a slack or surplus column to the relaxation of a node.
+type branchAndBound struct {
+	settings MILPSettings
+	start    time.Time
+
+	n       int // Number of variables of the original problem.
+	c       []float64
+	a       *mat.Dense
+	b       []float64
+	integer []bool
+
+	incumbent []float64
+	incF      float64
+
+	open  nodeQueue
+	seq   int
+	nodes int
+	cuts  int
+}
+
+// branch is a bound on a single variable, either x_j <= value or
+// x_j >= value.
+type branch struct {
+	j     int
+	upper bool
+	value float64
+}
+
+// bbNode is a node of the branch and bound tree.
+type bbNode struct {
+	branches []branch
+	// bound is a lower bound on the objective value in the subtree,
+	// the optimal value of the relaxation of the parent.
+	bound float64
+	// basis is the warm start basis for the relaxation.
+	basis []int
+	depth int
+	seq   int
+}
+
+func (bb *branchAndBound) solve() (*MILPResult, error) {
+	root := &bbNode{}
+	f, x, basis, err := bb.relax(root)
+	bb.nodes++
+	if err != nil {
+		return nil, err
+	}
+	f, x, basis = bb.addCuts(f, x, basis)
+
+	bb.open = nodeQueue{selection: bb.settings.NodeSelection}
+	bb.process(root, f, x, basis)
+	for bb.open.Len() > 0 {
+		if bb.gap() <= bb.settings.GapTolerance {
+			break
+		}
+		if bb.settings.NodeLimit > 0 && bb.nodes >= bb.settings.NodeLimit {
+			return bb.result(), ErrNodeLimit
+		}
+		if bb.settings.TimeLimit > 0 && time.Since(bb.start) >= bb.settings.TimeLimit {
+			return bb.result(), ErrTimeLimit
+		}
+		nd := heap.Pop(&bb.open).(*bbNode)
+		if bb.prune(nd.bound) {
+			continue
+		}
+		f, x, basis, err := bb.relax(nd)
+		bb.nodes++
+		if err == ErrInfeasible {
+			continue
+		}
+		if err != nil {
+			// Keep the node so that the reported bound remains valid.
+			heap.Push(&bb.open, nd)
+			return bb.result(), err
+		}
+		bb.process(nd, f, x, basis)
+	}
+	if bb.incumbent == nil {
+		return nil, ErrInfeasible
+	}
+	return bb.result(), nil
+}
+
+// relax solves the LP relaxation of the node.
+func (bb *branchAndBound) relax(nd *bbNode) (float64, []float64, []int, error) {
+	m0, n0 := bb.a.Dims()
+	nb := len(nd.branches)
+	if nb == 0 {
+		return warmSimplex(nd.basis, bb.c, bb.a, bb.b, bb.settings.Tolerance)
+	}
+
+	// Add a row and a slack or surplus column for every branch.
+	a := mat.NewDense(m0+nb, n0+nb, nil)
+	a.Slice(0, m0, 0, n0).(*mat.Dense).Copy(bb.a)
+	c := make([]float64, n0+nb)
+	copy(c, bb.c)
+	b := make([]float64, m0+nb)
+	copy(b, bb.b)
+	for k, br := range nd.branches {
+		a.Set(m0+k, br.j, 1)
+		if br.upper {
+			a.Set(m0+k, n0+k, 1)
+		} else {
+			a.Set(m0+k, n0+k, -1)
+		}
+		b[m0+k] = br.value
+	}
+	return warmSimplex(nd.basis, c, a, b, bb.settings.Tolerance)
+}
+
+// process updates the incumbent if the relaxation of the node has an integer
+// solution, and otherwise branches on the most fractional integer variable.
+func (bb *branchAndBound) process(nd *bbNode, f float64, x []float64, basis []int) {
+	if bb.prune(f) {
+		return
+	}
+	j := bb.branchVariable(x)
+	if j < 0 {
+		bb.incumbent = make([]float64, bb.n)
+		copy(bb.incumbent, x[:bb.n])
+		// Remove the rounding errors of the relaxation from the incumbent.
+		for i, v := range bb.incumbent {
+			if bb.integer[i] {
+				v = math.Round(v)
+			}
+			bb.incumbent[i] = math.Max(v, 0)
+		}
+		bb.incF = floats.Dot(bb.c[:bb.n], bb.incumbent)
+		return
+	}
+
+	_, n0 := bb.a.Dims()
+	slack := n0 + len(nd.branches)
+	v := x[j]
+	down := bb.child(nd, f, basis, slack, branch{j: j, upper: true, value: math.Floor(v)})
+	up := bb.child(nd, f, basis, slack, branch{j: j, upper: false, value: math.Ceil(v)})
+	// The most recently pushed child is explored first by depth-first
+	// search, so push the child in the direction of rounding last.
+	if v-math.Floor(v) < 0.5 {
+		heap.Push(&bb.open, up)
+		heap.Push(&bb.open, down)
+	} else {
+		heap.Push(&bb.open, down)
+		heap.Push(&bb.open, up)
+	}
+}
+
+// child returns a child of nd with the additional branch. The warm start
+// basis of the child is the optimal basis of the parent together with the
+// slack column of the new branch.
+func (bb *branchAndBound) child(nd *bbNode, f float64, basis []int, slack int, br branch) *bbNode {
+	bb.seq++
+	branches := make([]branch, len(nd.branches)+1)
+	copy(branches, nd.branches)
+	branches[len(nd.branches)] = br
+	childBasis := make([]int, len(basis)+1)
+	copy(childBasis, basis)
+	childBasis[len(basis)] = slack
+	return &bbNode{
+		branches: branches,
+		bound:    f,
+		basis:    childBasis,
+		depth:    nd.depth + 1,
+		seq:      bb.seq,
+	}
+}
+
+// branchVariable returns the index of the most fractional integer variable
+// in x, or -1 if all integer variables are integral.
+func (bb *branchAndBound) branchVariable(x []float64) int {
+	idx := -1
+	best := bb.settings.IntegralityTolerance
+	for j, isInt := range bb.integer[:bb.n] {
+		if !isInt {
+			continue
+		}
+		frac := math.Abs(x[j] - math.Round(x[j]))
+		if frac > best {
+			idx = j
+			best = frac
+		}
+	}
+	return idx
+}
+
+// prune returns whether a node with the given bound can not contain a
+// solution that improves the incumbent by more than the gap tolerance.
+func (bb *branchAndBound) prune(bound float64) bool {
+	if bb.incumbent == nil {
+		return false
+	}
+	return bound >= bb.incF-bb.settings.GapTolerance*math.Max(1, math.Abs(bb.incF))
+}
+
+// bound returns the lower bound on the optimal objective value.
+func (bb *branchAndBound) bound() float64 {
+	bound := bb.incF
+	for _, nd := range bb.open.nodes {
+		bound = math.Min(bound, nd.bound)
+	}
+	return bound
+}
+
+// gap returns the relative gap between the incumbent and the bound.
+func (bb *branchAndBound) gap() float64 {
+	if bb.incumbent == nil {
+		return math.Inf(1)
+	}
+	return (bb.incF - bb.bound()) / math.Max(1, math.Abs(bb.incF))
+}
+
+func (bb *branchAndBound) result() *MILPResult {
+	return &MILPResult{
+		X:     bb.incumbent,
+		F:     bb.incF,
+		Bound: bb.bound(),
+		Gap:   bb.gap(),
+		Nodes: bb.nodes,
+		Cuts:  bb.cuts,
+	}
+}
+
+// addCuts adds rounds of Gomory mixed-integer cuts to the root relaxation
+// and returns the solution of the strengthened relaxation. If solving a
+// strengthened relaxation fails, the cuts of that round are removed.
+func (bb *branchAndBound) addCuts(f float64, x []float64, basis []int) (float64, []float64, []int) {
+	for round := 0; round < bb.settings.CutRounds; round++ {
+		cuts := bb.gomoryCuts(x, basis)
+		if len(cuts) == 0 {
+			break
+		}
+		m0, n0 := bb.a.Dims()
+		k := len(cuts)
+
+		// Each cut αᵀz >= 1 is added as the row αᵀz - s = 1 with a new
+		// surplus variable s >= 0.
+		a := mat.NewDense(m0+k, n0+k, nil)
+		a.Slice(0, m0, 0, n0).(*mat.Dense).Copy(bb.a)
+		c := make([]float64, n0+k)
+		copy(c, bb.c)
+		b := make([]float64, m0+k)
+		copy(b, bb.b)
+		integer := make([]bool, n0+k)
+		copy(integer, bb.integer)
+		warm := make([]int, m0+k)
+		copy(warm, basis)
+		for i, cut := range cuts {
+			a.SetRow(m0+i, append(cut, make([]float64, k)...))
+			a.Set(m0+i, n0+i, -1)
+			b[m0+i] = 1
+			warm[m0+i] = n0 + i
+		}
+
+		fCut, xCut, basisCut, err := warmSimplex(warm, c, a, b, bb.settings.Tolerance)
+		bb.nodes++
+		if err != nil {
+			break
+		}
+		bb.a, bb.b, bb.c, bb.integer = a, b, c, integer
+		bb.cuts += k
+		f, x, basis = fCut, xCut, basisCut
+	}
+	return f, x, basis
+}
+
+// gomoryCuts returns Gomory mixed-integer cuts derived from the simplex
+// tableau rows of the fractional basic integer variables. Each cut is
+// returned as the coefficients α of a constraint αᵀz >= 1 on the variables of
+// the root relaxation.
+func (bb *branchAndBound) gomoryCuts(x []float64, basis []int) [][]float64 {
+	m, n := bb.a.Dims()
+
+	// Find the rows of the fractional basic integer variables, most
+	// fractional first.
+	var rows []int
+	for i, j := range basis {
+		if !bb.integer[j] {
+			continue
+		}
+		f0 := x[j] - math.Floor(x[j])
+		if f0 < minCutFraction || f0 > 1-minCutFraction {
+			continue
+		}
+		rows = append(rows, i)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	sort.SliceStable(rows, func(i, k int) bool {
+		fi := x[basis[rows[i]]]
+		fk := x[basis[rows[k]]]
+		return math.Abs(fi-math.Floor(fi)-0.5) < math.Abs(fk-math.Floor(fk)-0.5)
+	})
+	if len(rows) > maxCutsPerRound {
+		rows = rows[:maxCutsPerRound]
+	}
+
+	ab := mat.NewDense(m, m, nil)
+	extractColumns(ab, bb.a, basis)
+	var lu mat.LU
+	lu.Factorize(ab)
+	if lu.Det() == 0 {
+		return nil
+	}
+	isBasic := make([]bool, n)
+	for _, j := range basis {
+		isBasic[j] = true
+	}
+
+	var cuts [][]float64
+	e := mat.NewVecDense(m, nil)
+	var u, row mat.VecDense
+	for _, i := range rows {
+		// The tableau row is e_iᵀ * ab⁻¹ * A.
+		e.Zero()
+		e.SetVec(i, 1)
+		if err := lu.SolveVecTo(&u, true, e); err != nil {
+			continue
+		}
+		row.MulVec(bb.a.T(), &u)
+
+		xj := x[basis[i]]
+		f0 := xj - math.Floor(xj)
+		cut := make([]float64, n)
+		minCoef, maxCoef := math.Inf(1), 0.0
+		for k := 0; k < n; k++ {
+			if isBasic[k] {
+				continue
+			}
+			v := row.AtVec(k)
+			var coef float64
+			if bb.integer[k] {
+				fk := v - math.Floor(v)
+				if fk <= f0 {
+					coef = fk / f0
+				} else {
+					coef = (1 - fk) / (1 - f0)
+				}
+			} else {
+				if v >= 0 {
+					coef = v / f0
+				} else {
+					coef = -v / (1 - f0)
+				}
+			}
+			cut[k] = coef
+			if coef != 0 {
+				minCoef = math.Min(minCoef, coef)
+				maxCoef = math.Max(maxCoef, coef)
+			}
+		}
+		if maxCoef == 0 || maxCoef > maxCutDynamism*minCoef {
+			continue
+		}
+		cuts = append(cuts, cut)
+	}
+	return cuts
+}
+
+// nodeQueue is a priority queue of open nodes ordered by the node selection
+// rule.
+type nodeQueue struct {
+	selection NodeSelection
+	nodes     []*bbNode
+}
+
+func (q nodeQueue) Len() int { return len(q.nodes) }
+func (q nodeQueue) Less(i, j int) bool {
+	a, b := q.nodes[i], q.nodes[j]
+	if q.selection == BestBound && a.bound != b.bound {
+		return a.bound < b.bound
+	}
+	if a.depth != b.depth {
+		return a.depth > b.depth
+	}
+	return a.seq > b.seq
+}
+func (q nodeQueue) Swap(i, j int) { q.nodes[i], q.nodes[j] = q.nodes[j], q.nodes[i] }
+func (q *nodeQueue) Push(x interface{}) {
+	q.nodes = append(q.nodes, x.(*bbNode))
+}
+func (q *nodeQueue) Pop() interface{} {
+	n := len(q.nodes)
+	nd := q.nodes[n-1]
+	q.nodes[n-1] = nil
+	q.nodes = q.nodes[:n-1]
+	return nd
+}
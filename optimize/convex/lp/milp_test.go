@@ -0,0 +1,320 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestBranchAndBound(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name    string
+		A       mat.Matrix
+		b       []float64
+		c       []float64
+		integer []bool
+		f       float64
+		err     error
+	}{
+		{
+			// Maximize 5x + 4y subject to 6x + 4y <= 24 and x + 2y <= 6.
+			// The LP optimum is x = 3, y = 1.5.
+			name: "two variables",
+			A: mat.NewDense(2, 4, []float64{
+				6, 4, 1, 0,
+				1, 2, 0, 1,
+			}),
+			b:       []float64{24, 6},
+			c:       []float64{-5, -4, 0, 0},
+			integer: []bool{true, true, false, false},
+			f:       -20,
+		},
+		{
+			// Knapsack with capacity 10 and item weights 5, 4, 6, 3 and
+			// values 10, 40, 30, 50.
+			name: "knapsack",
+			A: mat.NewDense(5, 9, []float64{
+				5, 4, 6, 3, 1, 0, 0, 0, 0,
+				1, 0, 0, 0, 0, 1, 0, 0, 0,
+				0, 1, 0, 0, 0, 0, 1, 0, 0,
+				0, 0, 1, 0, 0, 0, 0, 1, 0,
+				0, 0, 0, 1, 0, 0, 0, 0, 1,
+			}),
+			b:       []float64{10, 1, 1, 1, 1},
+			c:       []float64{-10, -40, -30, -50, 0, 0, 0, 0, 0},
+			integer: []bool{true, true, true, true, false, false, false, false, false},
+			f:       -90,
+		},
+		{
+			name:    "continuous only",
+			A:       mat.NewDense(1, 3, []float64{2, 2, 1}),
+			b:       []float64{3},
+			c:       []float64{-1, -1, 0},
+			integer: []bool{false, false, false},
+			f:       -1.5,
+		},
+		{
+			name:    "integer infeasible",
+			A:       mat.NewDense(1, 2, []float64{2, 2}),
+			b:       []float64{3},
+			c:       []float64{1, 1},
+			integer: []bool{true, true},
+			err:     ErrInfeasible,
+		},
+		{
+			name:    "unbounded",
+			A:       mat.NewDense(1, 3, []float64{1, -1, 1}),
+			b:       []float64{1},
+			c:       []float64{-1, -1, 0},
+			integer: []bool{true, true, false},
+			err:     ErrUnbounded,
+		},
+	} {
+		for _, settings := range milpSettings() {
+			name := fmt.Sprintf("%s %+v", test.name, settings)
+			result, err := BranchAndBound(test.c, test.A, test.b, test.integer, settings)
+			if err != test.err {
+				t.Errorf("%s: unexpected error: got %v, want %v", name, err, test.err)
+				continue
+			}
+			if err != nil {
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(result.F, test.f, 1e-8, 1e-8) {
+				t.Errorf("%s: unexpected objective: got %v, want %v", name, result.F, test.f)
+			}
+			checkMILPResult(t, name, test.c, test.A, test.b, test.integer, result)
+		}
+	}
+}
+
+func milpSettings() []*MILPSettings {
+	return []*MILPSettings{
+		nil,
+		{NodeSelection: DepthFirst},
+		{CutRounds: 3},
+		{NodeSelection: DepthFirst, CutRounds: 3},
+	}
+}
+
+func TestBranchAndBoundRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const upper = 3
+	for trial := 0; trial < 100; trial++ {
+		// Generate min cᵀz s.t. G*z <= h, 0 <= z <= upper where the first
+		// nInt elements of z are integer. Every inequality is converted
+		// to an equality with a slack variable.
+		nInt := rnd.IntN(3) + 1
+		nCont := rnd.IntN(3)
+		nz := nInt + nCont
+		nIneq := rnd.IntN(3) + 1
+		mRows := nIneq + nz
+		nVars := nz + mRows
+		a := mat.NewDense(mRows, nVars, nil)
+		b := make([]float64, mRows)
+		for i := 0; i < nIneq; i++ {
+			for j := 0; j < nz; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+			a.Set(i, nz+i, 1)
+			b[i] = rnd.Float64() * 2
+		}
+		for j := 0; j < nz; j++ {
+			a.Set(nIneq+j, j, 1)
+			a.Set(nIneq+j, nz+nIneq+j, 1)
+			b[nIneq+j] = upper
+		}
+		c := make([]float64, nVars)
+		for j := 0; j < nz; j++ {
+			c[j] = rnd.NormFloat64()
+		}
+		integer := make([]bool, nVars)
+		for j := 0; j < nInt; j++ {
+			integer[j] = true
+		}
+		want := bruteForceMILP(a, b, c, nInt, nz, upper)
+
+		for _, settings := range milpSettings() {
+			name := fmt.Sprintf("trial %d %+v", trial, settings)
+			result, err := BranchAndBound(c, a, b, integer, settings)
+			if math.IsInf(want, 1) {
+				if err != ErrInfeasible {
+					t.Errorf("%s: unexpected error for infeasible problem: %v", name, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(result.F, want, 1e-7, 1e-7) {
+				t.Errorf("%s: unexpected objective: got %v, want %v", name, result.F, want)
+			}
+			checkMILPResult(t, name, c, a, b, integer, result)
+		}
+	}
+}
+
+// bruteForceMILP returns the optimal value of the MILP generated by
+// TestBranchAndBoundRandom by enumerating all values of the integer
+// variables and solving the LP in the continuous variables.
+func bruteForceMILP(a *mat.Dense, b, c []float64, nInt, nz, upper int) float64 {
+	mRows, nVars := a.Dims()
+	best := math.Inf(1)
+	xi := make([]int, nInt)
+	for {
+		// Fix the integer variables by moving them to the right-hand side
+		// and solve for the remaining variables.
+		bFixed := make([]float64, mRows)
+		copy(bFixed, b)
+		var fInt float64
+		for j, v := range xi {
+			for i := 0; i < mRows; i++ {
+				bFixed[i] -= a.At(i, j) * float64(v)
+			}
+			fInt += c[j] * float64(v)
+		}
+		// Drop the rows of the upper bounds of the integer variables.
+		rows := make([]int, 0, mRows)
+		feasible := true
+		for i := 0; i < mRows; i++ {
+			isIntBound := i >= mRows-nz && i < mRows-nz+nInt
+			if isIntBound {
+				if bFixed[i] < 0 {
+					feasible = false
+				}
+				continue
+			}
+			rows = append(rows, i)
+		}
+		if feasible {
+			cols := make([]int, 0, nVars-nInt)
+			for j := nInt; j < nVars; j++ {
+				// Skip the slacks of the integer upper bounds.
+				if j >= nz+mRows-nz && j < nz+mRows-nz+nInt {
+					continue
+				}
+				cols = append(cols, j)
+			}
+			sub := mat.NewDense(len(rows), len(cols), nil)
+			bSub := make([]float64, len(rows))
+			cSub := make([]float64, len(cols))
+			for ii, i := range rows {
+				for jj, j := range cols {
+					sub.Set(ii, jj, a.At(i, j))
+				}
+				bSub[ii] = bFixed[i]
+			}
+			for jj, j := range cols {
+				cSub[jj] = c[j]
+			}
+			f, _, err := Simplex(cSub, sub, bSub, 0, nil)
+			if err == nil {
+				best = math.Min(best, fInt+f)
+			}
+		}
+
+		// Advance to the next integer point.
+		k := 0
+		for k < nInt {
+			xi[k]++
+			if xi[k] <= upper {
+				break
+			}
+			xi[k] = 0
+			k++
+		}
+		if k == nInt {
+			return best
+		}
+	}
+}
+
+// checkMILPResult checks that the result is feasible, integral and consistent.
+func checkMILPResult(t *testing.T, name string, c []float64, a mat.Matrix, b []float64, integer []bool, result *MILPResult) {
+	t.Helper()
+	const tol = 1e-8
+	var ax mat.VecDense
+	ax.MulVec(a, mat.NewVecDense(len(result.X), result.X))
+	if !floats.EqualApprox(ax.RawVector().Data, b, tol) {
+		t.Errorf("%s: solution not feasible", name)
+	}
+	for j, v := range result.X {
+		if v < -tol {
+			t.Errorf("%s: negative variable %d: %v", name, j, v)
+		}
+		if integer[j] && v != math.Round(v) {
+			t.Errorf("%s: integer variable %d not integral: %v", name, j, v)
+		}
+	}
+	if f := floats.Dot(c, result.X); !scalar.EqualWithinAbsOrRel(f, result.F, tol, tol) {
+		t.Errorf("%s: objective mismatch: got %v, want %v", name, result.F, f)
+	}
+	if result.Bound > result.F+tol*math.Max(1, math.Abs(result.F)) {
+		t.Errorf("%s: bound %v above objective %v", name, result.Bound, result.F)
+	}
+	if result.Gap > defaultGapTol {
+		t.Errorf("%s: gap too large: %v", name, result.Gap)
+	}
+}
+
+func TestBranchAndBoundLimits(t *testing.T) {
+	t.Parallel()
+	// A knapsack problem whose LP relaxation is weak so that many nodes
+	// are needed to prove optimality. The weights are even and the
+	// capacity is odd.
+	const n = 15
+	a := mat.NewDense(1, n+1, nil)
+	c := make([]float64, n+1)
+	integer := make([]bool, n+1)
+	for j := 0; j < n; j++ {
+		a.Set(0, j, 2)
+		c[j] = -1
+		integer[j] = true
+	}
+	a.Set(0, n, 1)
+	b := []float64{n}
+
+	for _, selection := range []NodeSelection{BestBound, DepthFirst} {
+		settings := &MILPSettings{NodeSelection: selection, NodeLimit: 10}
+		result, err := BranchAndBound(c, a, b, integer, settings)
+		if err != ErrNodeLimit {
+			t.Errorf("%v: unexpected error: got %v, want %v", selection, err, ErrNodeLimit)
+			continue
+		}
+		if result.Nodes != settings.NodeLimit {
+			t.Errorf("%v: unexpected number of nodes: got %d, want %d", selection, result.Nodes, settings.NodeLimit)
+		}
+		// The relaxation bound of every node is -n/2.
+		if result.Bound != -n/2.0 {
+			t.Errorf("%v: unexpected bound: got %v, want %v", selection, result.Bound, -n/2.0)
+		}
+		if result.X != nil {
+			if result.F < -7 {
+				t.Errorf("%v: incumbent better than optimum: %v", selection, result.F)
+			}
+			if want := (result.F - result.Bound) / math.Abs(result.F); result.Gap != want {
+				t.Errorf("%v: unexpected gap: got %v, want %v", selection, result.Gap, want)
+			}
+		} else if !math.IsInf(result.Gap, 1) {
+			t.Errorf("%v: unexpected gap without incumbent: %v", selection, result.Gap)
+		}
+	}
+
+	settings := &MILPSettings{TimeLimit: 1}
+	_, err := BranchAndBound(c, a, b, integer, settings)
+	if err != ErrTimeLimit {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrTimeLimit)
+	}
+}
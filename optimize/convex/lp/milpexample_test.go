@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+func ExampleBranchAndBound() {
+	// Choose the number of large and small machines to buy to maximize
+	// daily output. A large machine produces 5 units per day, costs 6 and
+	// needs 1 operator. A small machine produces 4 units per day, costs 4
+	// and needs 2 operators. The budget is 24 and there are 6 operators.
+	//
+	//	maximize	5x + 4y
+	//	s.t.		6x + 4y <= 24
+	//				 x + 2y <= 6
+	//				x, y >= 0 and integer
+	//
+	// The inequalities are converted to equalities with slack variables.
+	c := []float64{-5, -4, 0, 0}
+	A := mat.NewDense(2, 4, []float64{
+		6, 4, 1, 0,
+		1, 2, 0, 1,
+	})
+	b := []float64{24, 6}
+	integer := []bool{true, true, false, false}
+
+	result, err := lp.BranchAndBound(c, A, b, integer, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("large machines: %v\n", result.X[0])
+	fmt.Printf("small machines: %v\n", result.X[1])
+	fmt.Printf("output: %v\n", -result.F)
+	fmt.Printf("gap: %v\n", result.Gap)
+	// Output:
+	// large machines: 4
+	// small machines: 0
+	// output: 20
+	// gap: 0
+}
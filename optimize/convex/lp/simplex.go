@@ -93,6 +93,32 @@ func Simplex(c []float64, A mat.Matrix, b []float64, tol float64, initialBasic [
 	return ans, x, err
 }
 
+// warmSimplex solves the standard form LP in the same way as simplex, but
+// starts the search from the columns of A in basicIdxs. The columns must
+// be linearly independent, but they need not form a feasible basis, in which
+// case the Phase I problem is started from them. If the warm start fails
+// for numerical reasons, the LP is solved from scratch.
+func warmSimplex(basicIdxs []int, c []float64, A mat.Matrix, b []float64, tol float64) (float64, []float64, []int, error) {
+	m, _ := A.Dims()
+	if len(basicIdxs) != m {
+		return simplex(nil, c, A, b, tol)
+	}
+	ab := mat.NewDense(m, m, nil)
+	extractColumns(ab, A, basicIdxs)
+	if mat.Cond(ab, 1) > 1e12 {
+		return simplex(nil, c, A, b, tol)
+	}
+	start, _, _, err := findFeasibleBasic(A, b, append([]int(nil), basicIdxs...))
+	switch err {
+	case nil:
+		return simplex(start, c, A, b, tol)
+	case ErrInfeasible:
+		return math.NaN(), nil, nil, ErrInfeasible
+	default:
+		return simplex(nil, c, A, b, tol)
+	}
+}
+
 func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol float64) (float64, []float64, []int, error) {
 	err := verifyInputs(initialBasic, c, A, b)
 	if err != nil {
@@ -496,11 +522,19 @@ func extractColumns(dst *mat.Dense, A mat.Matrix, cols []int) {
 // findInitialBasic finds an initial basic solution, and returns the basic
 // indices, ab, and xb.
 func findInitialBasic(A mat.Matrix, b []float64) ([]int, *mat.Dense, []float64, error) {
-	m, n := A.Dims()
+	m, _ := A.Dims()
 	basicIdxs := findLinearlyIndependent(A)
 	if len(basicIdxs) != m {
 		return nil, nil, nil, ErrSingular
 	}
+	return findFeasibleBasic(A, b, basicIdxs)
+}
+
+// findFeasibleBasic finds a basic feasible solution starting from the
+// linearly independent columns of A in basicIdxs, and returns the basic
+// indices, ab, and xb. basicIdxs may be modified.
+func findFeasibleBasic(A mat.Matrix, b []float64, basicIdxs []int) ([]int, *mat.Dense, []float64, error) {
+	m, n := A.Dims()
 
 	// It may be that this linearly independent basis is also a feasible set. If
 	// so, the Phase I problem can be avoided.
@@ -27,3 +27,25 @@ func ExampleSimplex() {
 	// opt: -8
 	// x: [2 3 0 0]
 }
+
+func ExampleSimplexSolution() {
+	c := []float64{-1, -2, 0, 0}
+	A := mat.NewDense(2, 4, []float64{-1, 2, 1, 0, 3, 1, 0, 1})
+	b := []float64{4, 9}
+
+	sol, err := lp.SimplexSolution(c, A, b, 0, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("opt: %v\n", sol.F)
+	fmt.Printf("x: %v\n", sol.X)
+	fmt.Printf("dual prices: %.4f\n", sol.Dual)
+	fmt.Printf("reduced costs: %.4f\n", sol.ReducedCost)
+	fmt.Printf("status: %v\n", sol.Status)
+	// Output:
+	// opt: -8
+	// x: [2 3 0 0]
+	// dual prices: [-0.7143 -0.5714]
+	// reduced costs: [0.0000 0.0000 0.7143 0.5714]
+	// status: [Basic Basic NonBasic NonBasic]
+}
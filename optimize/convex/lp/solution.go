@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// BasisStatus is the status of a variable of a standard form LP in a basic
+// solution.
+type BasisStatus int
+
+const (
+	// NonBasic indicates that the variable is not in the basis and is at its
+	// lower bound of zero.
+	NonBasic BasisStatus = iota
+	// Basic indicates that the variable is in the basis.
+	Basic
+)
+
+// String returns a string representation of the basis status.
+func (s BasisStatus) String() string {
+	switch s {
+	case NonBasic:
+		return "NonBasic"
+	case Basic:
+		return "Basic"
+	}
+	return "BasisStatus(" + strconv.Itoa(int(s)) + ")"
+}
+
+// Solution is the solution of a standard form LP
+//
+//	minimize	cᵀ x
+//	s.t. 		A*x = b
+//				x >= 0
+//
+// together with the dual information needed for sensitivity analysis.
+//
+// At an optimal solution the dual prices y and the reduced costs r satisfy
+//
+//	Aᵀ*y + r = c,
+//	r >= 0,
+//	r_j * x_j = 0,
+//
+// and bᵀy equals the optimal objective value. The dual price y_i is the rate
+// of change of the optimal objective value with respect to b_i, and the reduced
+// cost r_j is the rate of increase of the objective value when a non-basic x_j
+// is forced away from zero.
+type Solution struct {
+	// F is the objective function value at X.
+	F float64
+	// X is the primal solution.
+	X []float64
+	// Dual holds the dual prices y of the equality constraints.
+	Dual []float64
+	// ReducedCost holds the reduced costs c - Aᵀ*y of the variables.
+	ReducedCost []float64
+
+	// Basis holds the indices of the basic variables. Basis has
+	// length equal to the rank of A.
+	Basis []int
+	// Status holds the basis status of each variable.
+	Status []BasisStatus
+}
+
+// SimplexSolution solves a linear program in standard form using the Simplex
+// function and returns the optimal basic solution along with its dual prices,
+// reduced costs and basis status. The inputs and the returned errors are the
+// same as for Simplex. If Simplex returns an error along with a feasible
+// solution, the Solution at that point is returned together with the error.
+//
+// The dual prices are computed from the optimal basis B as the solution of
+//
+//	Bᵀ*y = c_B.
+func SimplexSolution(c []float64, A mat.Matrix, b []float64, tol float64, initialBasic []int) (*Solution, error) {
+	f, x, basis, err := simplex(initialBasic, c, A, b, tol)
+	if x == nil {
+		return nil, err
+	}
+	if basis == nil {
+		// The problem was square and solved directly, so every
+		// variable is basic.
+		basis = make([]int, len(x))
+		for i := range basis {
+			basis[i] = i
+		}
+	}
+	sol, errDual := basicSolution(c, A, x, basis)
+	sol.F = f
+	if err == nil {
+		err = errDual
+	}
+	return sol, err
+}
+
+// basicSolution returns the Solution of the standard form LP at x with the
+// given basis. The dual prices are found by solving Bᵀ*y = c_B. If the basis
+// matrix is singular, the returned Solution does not contain the dual
+// information and ErrSingular is returned.
+func basicSolution(c []float64, A mat.Matrix, x []float64, basis []int) (*Solution, error) {
+	m, n := A.Dims()
+	sol := &Solution{
+		F:      floats.Dot(c, x),
+		X:      x,
+		Basis:  make([]int, len(basis)),
+		Status: make([]BasisStatus, n),
+	}
+	copy(sol.Basis, basis)
+	for _, j := range basis {
+		sol.Status[j] = Basic
+	}
+
+	ab := mat.NewDense(m, len(basis), nil)
+	extractColumns(ab, A, basis)
+	cb := make([]float64, len(basis))
+	for i, j := range basis {
+		cb[i] = c[j]
+	}
+	y := make([]float64, m)
+	err := mat.NewVecDense(m, y).SolveVec(ab.T(), mat.NewVecDense(len(cb), cb))
+	if err != nil {
+		return sol, ErrSingular
+	}
+	sol.Dual = y
+	sol.ReducedCost = reducedCosts(c, A, y)
+	// The reduced costs of the basic variables are zero by definition.
+	for _, j := range basis {
+		sol.ReducedCost[j] = 0
+	}
+	return sol, nil
+}
+
+// reducedCosts returns c - Aᵀ*y.
+func reducedCosts(c []float64, A mat.Matrix, y []float64) []float64 {
+	_, n := A.Dims()
+	r := make([]float64, n)
+	rVec := mat.NewVecDense(n, r)
+	rVec.MulVec(A.T(), mat.NewVecDense(len(y), y))
+	floats.SubTo(r, c, r)
+	return r
+}
+
+// identifyBasis returns a set of linearly independent columns of A that
+// identifies the optimal basis of an interior-point solution. Columns are
+// considered in decreasing order of x_j/(x_j+r_j), so that variables that are
+// strictly positive at the solution enter the basis first. A column enters
+// the basis if it is not, within a relative tolerance, in the span of the
+// previously chosen columns.
+func identifyBasis(A mat.Matrix, x, r []float64) []int {
+	m, n := A.Dims()
+	order := make([]int, n)
+	score := make([]float64, n)
+	for j := range order {
+		order[j] = j
+		if d := x[j] + math.Max(r[j], 0); d > 0 {
+			score[j] = x[j] / d
+		}
+	}
+	sort.SliceStable(order, func(i, k int) bool {
+		return score[order[i]] > score[order[k]]
+	})
+
+	const indepTol = 1e-9
+	basis := make([]int, 0, m)
+	q := make([][]float64, 0, m) // Orthonormal basis of the chosen columns.
+	col := make([]float64, m)
+	for _, j := range order {
+		if len(basis) == m {
+			break
+		}
+		mat.Col(col, j, A)
+		norm := floats.Norm(col, 2)
+		if norm == 0 {
+			continue
+		}
+		// Orthogonalize twice for numerical stability.
+		for pass := 0; pass < 2; pass++ {
+			for _, v := range q {
+				floats.AddScaled(col, -floats.Dot(v, col), v)
+			}
+		}
+		rem := floats.Norm(col, 2)
+		if rem <= indepTol*norm {
+			continue
+		}
+		floats.Scale(1/rem, col)
+		q = append(q, append([]float64(nil), col...))
+		basis = append(basis, j)
+	}
+	return basis
+}
@@ -0,0 +1,578 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	ErrInfeasible     = errors.New("qp: problem is infeasible")
+	ErrUnbounded      = errors.New("qp: problem is unbounded")
+	ErrNotConvex      = errors.New("qp: Q is not positive semidefinite")
+	ErrIterationLimit = errors.New("qp: iteration limit reached")
+)
+
+const badShape = "qp: size mismatch"
+
+const (
+	defaultTol = 1e-9
+
+	// independentTol is the relative tolerance for testing whether
+	// a constraint row is linearly independent of the working set.
+	independentTol = 1e-10
+)
+
+// Problem is a convex quadratic program
+//
+//	minimize	½ xᵀ Q x + cᵀ x
+//	s.t.		A x = b
+//				G x ≤ h
+//
+// where Q is symmetric positive semidefinite.
+type Problem struct {
+	// Q is the n×n quadratic term. If Q is nil the problem is a
+	// linear program.
+	Q mat.Symmetric
+	// C is the linear term. Its length n is the number of variables.
+	C []float64
+
+	// A and B specify the equality constraints. A must have n columns
+	// and len(B) rows. If there are no equality constraints, A and B
+	// may be nil.
+	A mat.Matrix
+	B []float64
+
+	// G and H specify the inequality constraints. G must have n columns
+	// and len(H) rows. If there are no inequality constraints, G and H
+	// may be nil.
+	G mat.Matrix
+	H []float64
+}
+
+// Settings holds settings for solving a quadratic program.
+type Settings struct {
+	// Tolerance is the relative tolerance used for testing feasibility,
+	// stationarity and the sign of the dual values. If Tolerance is zero,
+	// a default value of 1e-9 is used.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of changes of the working set.
+	// If MaxIterations is zero, a default of 100*(n+len(B)+len(H)) is used.
+	MaxIterations int
+
+	// InitialX is an optional starting point. If it is feasible, the
+	// solver starts from it without a feasibility phase. Otherwise it is
+	// used as the starting point of the feasibility phase.
+	InitialX []float64
+
+	// InitialActive optionally holds the indices of the inequality
+	// constraints that are expected to be active at the solution, for
+	// example Result.Active of a related problem. Constraints that are
+	// not active at the starting point are ignored.
+	InitialActive []int
+}
+
+// Result holds the solution of a quadratic program.
+type Result struct {
+	// X is the optimal solution and F the optimal objective value.
+	X []float64
+	F float64
+
+	// EqualityDual and InequalityDual hold the dual values y and z of the
+	// equality and inequality constraints satisfying
+	//  Q x + c + Aᵀ y + Gᵀ z = 0,
+	// with z ≥ 0 and zᵢ = 0 for inactive inequality constraints.
+	EqualityDual   []float64
+	InequalityDual []float64
+
+	// Active holds the sorted indices of the inequality constraints in
+	// the final working set. It can be used as Settings.InitialActive to
+	// warm start a related problem.
+	Active []int
+
+	// Iterations is the total number of iterations including the
+	// feasibility phase.
+	Iterations int
+}
+
+// ActiveSet solves the convex quadratic program p using a primal active-set
+// method. If settings is nil, default settings are used.
+//
+// If no feasible starting point is supplied, a feasible point is first found
+// by minimizing the sum of the constraint violations with the same method.
+// ErrInfeasible is returned if the violations cannot be reduced to zero. At
+// each iteration the quadratic model is minimized over the null space of the
+// constraints in the working set. If Q is singular along this null space and
+// the objective decreases linearly along a direction that no constraint
+// blocks, ErrUnbounded is returned. ErrNotConvex is returned if Q is detected
+// to be indefinite.
+//
+// ActiveSet panics if the dimensions of the problem are inconsistent.
+//
+// A description of the primal active-set method can be found in Ch. 16 of
+//
+//	Nocedal, J., & Wright, S. J. (2006). Numerical Optimization (2nd ed.).
+//	Springer.
+func ActiveSet(p Problem, settings *Settings) (*Result, error) {
+	n := len(p.C)
+	if n == 0 {
+		panic("qp: zero dimensional problem")
+	}
+	if p.Q != nil && p.Q.SymmetricDim() != n {
+		panic(badShape)
+	}
+	a := denseConstraints(p.A, p.B, n)
+	g := denseConstraints(p.G, p.H, n)
+	me, mi := len(p.B), len(p.H)
+
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = defaultTol
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 100 * (n + me + mi)
+	}
+	if s.InitialX != nil && len(s.InitialX) != n {
+		panic(badShape)
+	}
+	for _, i := range s.InitialActive {
+		if i < 0 || mi <= i {
+			panic("qp: initial active index out of range")
+		}
+	}
+
+	x := make([]float64, n)
+	if s.InitialX != nil {
+		copy(x, s.InitialX)
+	}
+	var iterations int
+	if violation(a, p.B, g, p.H, x) > feasTol(s.Tolerance, p.B, p.H) {
+		var err error
+		x, iterations, err = phaseI(a, p.B, g, p.H, x, &s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Remove redundant equality constraints. The problem is feasible
+	// so the removed rows are consistent with the remaining ones.
+	var eqRows []int
+	var basis [][]float64
+	for i := 0; i < me; i++ {
+		if addIndependent(&basis, a.RawRowView(i)) {
+			eqRows = append(eqRows, i)
+		}
+	}
+
+	// Form the initial working set from the constraints
+	// active at the starting point.
+	candidates := s.InitialActive
+	if candidates == nil {
+		candidates = make([]int, mi)
+		for i := range candidates {
+			candidates[i] = i
+		}
+	}
+	ftol := feasTol(s.Tolerance, nil, p.H)
+	var working []int
+	for _, i := range candidates {
+		if floats.Dot(g.RawRowView(i), x)-p.H[i] >= -ftol && addIndependent(&basis, g.RawRowView(i)) {
+			working = append(working, i)
+		}
+	}
+
+	solver := &activeSet{
+		n:       n,
+		q:       p.Q,
+		c:       p.C,
+		a:       a,
+		eqRows:  eqRows,
+		g:       g,
+		h:       p.H,
+		tol:     s.Tolerance,
+		maxIter: s.MaxIterations - iterations,
+		x:       x,
+		working: working,
+	}
+	y, z, err := solver.solve()
+	iterations += solver.iter
+	if err != nil {
+		if err == ErrUnbounded {
+			return nil, err
+		}
+		return &Result{X: solver.x, F: objective(p.Q, p.C, solver.x), Iterations: iterations}, err
+	}
+
+	eqDual := make([]float64, me)
+	for k, i := range eqRows {
+		eqDual[i] = y[k]
+	}
+	active := append([]int(nil), solver.working...)
+	sort.Ints(active)
+	return &Result{
+		X:              solver.x,
+		F:              objective(p.Q, p.C, solver.x),
+		EqualityDual:   eqDual,
+		InequalityDual: z,
+		Active:         active,
+		Iterations:     iterations,
+	}, nil
+}
+
+// denseConstraints returns a dense copy of the constraint matrix m with
+// right-hand side rhs, checking the dimensions.
+func denseConstraints(m mat.Matrix, rhs []float64, n int) *mat.Dense {
+	if m == nil {
+		if len(rhs) != 0 {
+			panic(badShape)
+		}
+		return nil
+	}
+	r, c := m.Dims()
+	if r != len(rhs) || c != n {
+		panic(badShape)
+	}
+	return mat.DenseCopyOf(m)
+}
+
+// objective returns ½ xᵀ Q x + cᵀ x.
+func objective(q mat.Symmetric, c, x []float64) float64 {
+	f := floats.Dot(c, x)
+	if q != nil {
+		xv := mat.NewVecDense(len(x), x)
+		f += 0.5 * mat.Inner(xv, q, xv)
+	}
+	return f
+}
+
+// feasTol returns the absolute feasibility tolerance for the right-hand sides
+// b and h.
+func feasTol(tol float64, b, h []float64) float64 {
+	scale := 1.0
+	if len(b) > 0 {
+		scale = math.Max(scale, floats.Norm(b, math.Inf(1)))
+	}
+	if len(h) > 0 {
+		scale = math.Max(scale, floats.Norm(h, math.Inf(1)))
+	}
+	return tol * scale
+}
+
+// violation returns the infinity norm of the constraint violation at x.
+func violation(a *mat.Dense, b []float64, g *mat.Dense, h []float64, x []float64) float64 {
+	var v float64
+	for i, bi := range b {
+		v = math.Max(v, math.Abs(floats.Dot(a.RawRowView(i), x)-bi))
+	}
+	for i, hi := range h {
+		v = math.Max(v, floats.Dot(g.RawRowView(i), x)-hi)
+	}
+	return v
+}
+
+// addIndependent adds row to the orthonormal basis using modified Gram-Schmidt
+// if it is linearly independent of the rows already in the basis. It returns
+// whether row was added.
+func addIndependent(basis *[][]float64, row []float64) bool {
+	norm := floats.Norm(row, 2)
+	if norm == 0 {
+		return false
+	}
+	v := append([]float64(nil), row...)
+	for _, q := range *basis {
+		floats.AddScaled(v, -floats.Dot(q, v), q)
+	}
+	vnorm := floats.Norm(v, 2)
+	if vnorm <= independentTol*norm {
+		return false
+	}
+	floats.Scale(1/vnorm, v)
+	*basis = append(*basis, v)
+	return true
+}
+
+// phaseI finds a feasible point of the constraints
+//
+//	A x = b, G x ≤ h
+//
+// starting from x by solving the linear program
+//
+//	minimize	Σ t + Σ u + Σ v
+//	s.t.		A x - u + v = b
+//				G x - t ≤ h
+//				t, u, v ≥ 0.
+func phaseI(a *mat.Dense, b []float64, g *mat.Dense, h []float64, x []float64, s *Settings) ([]float64, int, error) {
+	n, me, mi := len(x), len(b), len(h)
+	nv := n + mi + 2*me
+
+	c := make([]float64, nv)
+	for i := n; i < nv; i++ {
+		c[i] = 1
+	}
+	w := make([]float64, nv)
+	copy(w, x)
+	var aI *mat.Dense
+	if me > 0 {
+		aI = mat.NewDense(me, nv, nil)
+		aI.Slice(0, me, 0, n).(*mat.Dense).Copy(a)
+		for i, bi := range b {
+			r := floats.Dot(a.RawRowView(i), x) - bi
+			aI.Set(i, n+mi+i, -1)
+			aI.Set(i, n+mi+me+i, 1)
+			w[n+mi+i] = math.Max(r, 0)
+			w[n+mi+me+i] = math.Max(-r, 0)
+		}
+	}
+	gI := mat.NewDense(2*mi+2*me, nv, nil)
+	hI := make([]float64, 2*mi+2*me)
+	for i, hi := range h {
+		copy(gI.RawRowView(i), g.RawRowView(i))
+		gI.Set(i, n+i, -1)
+		hI[i] = hi
+		w[n+i] = math.Max(floats.Dot(g.RawRowView(i), x)-hi, 0)
+	}
+	for j := 0; j < mi+2*me; j++ {
+		gI.Set(mi+j, n+j, -1)
+	}
+
+	var eqRows []int
+	for i := 0; i < me; i++ {
+		eqRows = append(eqRows, i)
+	}
+	solver := &activeSet{
+		n:       nv,
+		c:       c,
+		a:       aI,
+		eqRows:  eqRows,
+		g:       gI,
+		h:       hI,
+		tol:     s.Tolerance,
+		maxIter: s.MaxIterations,
+		x:       w,
+	}
+	_, _, err := solver.solve()
+	if err != nil {
+		return nil, solver.iter, err
+	}
+	x = append(x[:0], solver.x[:n]...)
+	if violation(a, b, g, h, x) > feasTol(s.Tolerance, b, h) {
+		return nil, solver.iter, ErrInfeasible
+	}
+	return x, solver.iter, nil
+}
+
+// activeSet is the state of the primal active-set method.
+type activeSet struct {
+	n int
+	q mat.Symmetric
+	c []float64
+
+	a      *mat.Dense
+	eqRows []int // Linearly independent rows of a.
+	g      *mat.Dense
+	h      []float64
+
+	tol     float64
+	maxIter int
+	iter    int
+
+	x       []float64
+	working []int // Inequality constraints in the working set.
+}
+
+// solve runs the active-set iterations from the feasible point s.x with the
+// linearly independent working set s.working. It returns the dual values of
+// the equality constraints in s.eqRows and of all inequality constraints.
+func (s *activeSet) solve() (y, z []float64, err error) {
+	n := s.n
+	grad := make([]float64, n)
+	gv := mat.NewVecDense(n, grad)
+	p := make([]float64, n)
+	pv := mat.NewVecDense(n, p)
+	inWorking := make([]bool, len(s.h))
+	for _, i := range s.working {
+		inWorking[i] = true
+	}
+	var (
+		wt  *mat.Dense
+		qr  mat.QR
+		qm  mat.Dense
+		eig mat.EigenSym
+	)
+	for {
+		if s.iter >= s.maxIter {
+			return nil, nil, ErrIterationLimit
+		}
+
+		// Gradient of the objective.
+		copy(grad, s.c)
+		if s.q != nil {
+			var qx mat.VecDense
+			qx.MulVec(s.q, mat.NewVecDense(n, s.x))
+			gv.AddVec(gv, &qx)
+		}
+		gnorm := floats.Norm(grad, math.Inf(1))
+
+		// Null space of the working set constraints.
+		k := len(s.eqRows) + len(s.working)
+		var null *mat.Dense
+		if k == 0 {
+			null = mat.NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				null.Set(i, i, 1)
+			}
+		} else {
+			wt = mat.NewDense(n, k, nil)
+			for j, i := range s.eqRows {
+				wt.SetCol(j, s.a.RawRowView(i))
+			}
+			for j, i := range s.working {
+				wt.SetCol(len(s.eqRows)+j, s.g.RawRowView(i))
+			}
+			qr.Factorize(wt)
+			qm.Reset()
+			qr.QTo(&qm)
+			if k < n {
+				null = qm.Slice(0, n, k, n).(*mat.Dense)
+			}
+		}
+
+		// Minimize the quadratic model in the null space. If the reduced
+		// Hessian is singular and the reduced gradient has a component
+		// in its null space, move along that component instead.
+		pv.Zero()
+		ray := false
+		if null != nil {
+			_, nz := null.Dims()
+			var r mat.VecDense
+			r.MulVec(null.T(), gv)
+			var u mat.VecDense
+			if s.q == nil {
+				u.ScaleVec(-1, &r)
+				ray = true
+			} else {
+				var qz, h mat.Dense
+				qz.Mul(s.q, null)
+				h.Mul(null.T(), &qz)
+				hs := mat.NewSymDense(nz, nil)
+				for i := 0; i < nz; i++ {
+					for j := i; j < nz; j++ {
+						hs.SetSym(i, j, 0.5*(h.At(i, j)+h.At(j, i)))
+					}
+				}
+				if !eig.Factorize(hs, true) {
+					return nil, nil, errors.New("qp: eigendecomposition failed")
+				}
+				vals := eig.RawValues()
+				var vecs mat.Dense
+				eig.VectorsTo(&vecs)
+				lmax := math.Max(math.Abs(vals[0]), math.Abs(vals[nz-1]))
+				eps := s.tol * math.Max(1, lmax)
+				if vals[0] < -eps {
+					return nil, nil, ErrNotConvex
+				}
+				newton := mat.NewVecDense(nz, nil)
+				flat := mat.NewVecDense(nz, nil)
+				for i, l := range vals {
+					v := vecs.ColView(i)
+					d := mat.Dot(v, &r)
+					if l > eps {
+						newton.AddScaledVec(newton, -d/l, v)
+					} else {
+						flat.AddScaledVec(flat, -d, v)
+					}
+				}
+				if mat.Norm(flat, math.Inf(1)) > s.tol*(1+gnorm) {
+					u.CloneFromVec(flat)
+					ray = true
+				} else {
+					u.CloneFromVec(newton)
+				}
+			}
+			pv.MulVec(null, &u)
+		}
+
+		if floats.Norm(p, math.Inf(1)) <= s.tol*(1+floats.Norm(s.x, math.Inf(1))) {
+			// The current point minimizes the model on the working
+			// set. Compute the multipliers from Wᵀλ = -grad.
+			lambda := mat.NewVecDense(max(k, 1), nil)
+			if k > 0 {
+				var neg mat.VecDense
+				neg.ScaleVec(-1, gv)
+				err := qr.SolveVecTo(lambda, false, &neg)
+				if err != nil {
+					if c, ok := err.(mat.Condition); !ok || math.IsInf(float64(c), 1) {
+						return nil, nil, err
+					}
+				}
+			}
+			me := len(s.eqRows)
+			drop := -1
+			minZ := -s.tol * (1 + gnorm)
+			for j := range s.working {
+				if l := lambda.AtVec(me + j); l < minZ {
+					minZ = l
+					drop = j
+				}
+			}
+			if drop < 0 {
+				y = make([]float64, me)
+				for j := range y {
+					y[j] = lambda.AtVec(j)
+				}
+				z := make([]float64, len(s.h))
+				for j, i := range s.working {
+					z[i] = math.Max(0, lambda.AtVec(me+j))
+				}
+				return y, z, nil
+			}
+			inWorking[s.working[drop]] = false
+			s.working = append(s.working[:drop], s.working[drop+1:]...)
+			s.iter++
+			continue
+		}
+
+		// Find the longest feasible step along p.
+		alpha := 1.0
+		if ray {
+			alpha = math.Inf(1)
+		}
+		block := -1
+		pnorm := floats.Norm(p, 2)
+		for i, hi := range s.h {
+			if inWorking[i] {
+				continue
+			}
+			row := s.g.RawRowView(i)
+			gp := floats.Dot(row, p)
+			if gp <= independentTol*floats.Norm(row, 2)*pnorm {
+				continue
+			}
+			step := math.Max(0, hi-floats.Dot(row, s.x)) / gp
+			if step < alpha {
+				alpha = step
+				block = i
+			}
+		}
+		if math.IsInf(alpha, 1) {
+			return nil, nil, ErrUnbounded
+		}
+		floats.AddScaled(s.x, alpha, p)
+		if block >= 0 {
+			inWorking[block] = true
+			s.working = append(s.working, block)
+		}
+		s.iter++
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/qp"
+)
+
+func ExampleActiveSet() {
+	// Find the minimum variance portfolio of three assets with
+	// an expected return of at least 0.1 and no short positions.
+	cov := mat.NewSymDense(3, []float64{
+		0.04, 0.006, 0.002,
+		0, 0.09, 0.009,
+		0, 0, 0.01,
+	})
+	ret := []float64{0.12, 0.15, 0.05}
+
+	p := qp.Problem{
+		Q: cov,
+		C: make([]float64, 3),
+		// The weights sum to one.
+		A: mat.NewDense(1, 3, []float64{1, 1, 1}),
+		B: []float64{1},
+		// -retᵀx ≤ -0.1 and -x ≤ 0.
+		G: mat.NewDense(4, 3, []float64{
+			-ret[0], -ret[1], -ret[2],
+			-1, 0, 0,
+			0, -1, 0,
+			0, 0, -1,
+		}),
+		H: []float64{-0.1, 0, 0, 0},
+	}
+	result, err := qp.ActiveSet(p, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("weights: %.4f\n", result.X)
+	fmt.Printf("variance: %.5f\n", 2*result.F)
+	fmt.Printf("return constraint dual: %.4f\n", result.InequalityDual[0])
+	// Output:
+	// weights: [0.4231 0.2038 0.3731]
+	// variance: 0.01533
+	// return constraint dual: 0.1783
+}
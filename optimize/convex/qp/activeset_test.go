@@ -0,0 +1,410 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+func TestActiveSet(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		p      Problem
+		x      []float64
+		f      float64
+		eqDual []float64
+		inDual []float64
+	}{
+		{
+			name: "unconstrained",
+			p: Problem{
+				Q: mat.NewSymDense(2, []float64{2, 1, 1, 2}),
+				C: []float64{-1, -1},
+			},
+			x: []float64{1.0 / 3, 1.0 / 3},
+			f: -1.0 / 3,
+		},
+		{
+			name: "active inequality",
+			p: Problem{
+				Q: mat.NewSymDense(2, []float64{1, 0, 0, 1}),
+				C: []float64{-1, -1},
+				G: mat.NewDense(1, 2, []float64{1, 1}),
+				H: []float64{1},
+			},
+			x:      []float64{0.5, 0.5},
+			f:      -0.75,
+			inDual: []float64{0.5},
+		},
+		{
+			name: "inactive inequality",
+			p: Problem{
+				Q: mat.NewSymDense(2, []float64{1, 0, 0, 1}),
+				C: []float64{-1, -1},
+				G: mat.NewDense(1, 2, []float64{1, 1}),
+				H: []float64{3},
+			},
+			x:      []float64{1, 1},
+			f:      -1,
+			inDual: []float64{0},
+		},
+		{
+			name: "equality",
+			p: Problem{
+				Q: mat.NewSymDense(3, []float64{2, 0, 0, 0, 2, 0, 0, 0, 2}),
+				C: []float64{0, 0, 0},
+				A: mat.NewDense(1, 3, []float64{1, 1, 1}),
+				B: []float64{3},
+			},
+			x:      []float64{1, 1, 1},
+			f:      3,
+			eqDual: []float64{-2},
+		},
+		{
+			name: "redundant equality",
+			p: Problem{
+				Q: mat.NewSymDense(3, []float64{2, 0, 0, 0, 2, 0, 0, 0, 2}),
+				C: []float64{0, 0, 0},
+				A: mat.NewDense(2, 3, []float64{1, 1, 1, 2, 2, 2}),
+				B: []float64{3, 6},
+			},
+			x: []float64{1, 1, 1},
+			f: 3,
+		},
+		{
+			// Nocedal & Wright Example 16.4.
+			name: "Nocedal Wright 16.4",
+			p: Problem{
+				Q: mat.NewSymDense(2, []float64{2, 0, 0, 2}),
+				C: []float64{-2, -5},
+				G: mat.NewDense(5, 2, []float64{
+					-1, 2,
+					1, 2,
+					1, -2,
+					-1, 0,
+					0, -1,
+				}),
+				H: []float64{2, 6, 2, 0, 0},
+			},
+			x:      []float64{1.4, 1.7},
+			f:      -6.45,
+			inDual: []float64{0.8, 0, 0, 0, 0},
+		},
+		{
+			name: "linear program",
+			p: Problem{
+				C: []float64{-1, -2},
+				G: mat.NewDense(4, 2, []float64{
+					-1, 2,
+					3, 1,
+					-1, 0,
+					0, -1,
+				}),
+				H: []float64{4, 9, 0, 0},
+			},
+			x:      []float64{2, 3},
+			f:      -8,
+			inDual: []float64{5.0 / 7, 4.0 / 7, 0, 0},
+		},
+		{
+			name: "singular Q",
+			p: Problem{
+				Q: mat.NewSymDense(2, []float64{1, 0, 0, 0}),
+				C: []float64{-1, -1},
+				G: mat.NewDense(1, 2, []float64{0, 1}),
+				H: []float64{2},
+			},
+			x:      []float64{1, 2},
+			f:      -2.5,
+			inDual: []float64{1},
+		},
+	} {
+		result, err := ActiveSet(test.p, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !floats.EqualApprox(result.X, test.x, 1e-8) {
+			t.Errorf("%s: unexpected solution: got %v, want %v", test.name, result.X, test.x)
+		}
+		if !scalar.EqualWithinAbsOrRel(result.F, test.f, 1e-10, 1e-10) {
+			t.Errorf("%s: unexpected objective: got %v, want %v", test.name, result.F, test.f)
+		}
+		if test.eqDual != nil && !floats.EqualApprox(result.EqualityDual, test.eqDual, 1e-8) {
+			t.Errorf("%s: unexpected equality dual: got %v, want %v", test.name, result.EqualityDual, test.eqDual)
+		}
+		if test.inDual != nil && !floats.EqualApprox(result.InequalityDual, test.inDual, 1e-8) {
+			t.Errorf("%s: unexpected inequality dual: got %v, want %v", test.name, result.InequalityDual, test.inDual)
+		}
+		checkKKT(t, test.name, test.p, result)
+	}
+}
+
+func TestActiveSetErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		p    Problem
+		err  error
+	}{
+		{
+			name: "infeasible inequalities",
+			p: Problem{
+				Q: mat.NewSymDense(1, []float64{1}),
+				C: []float64{0},
+				G: mat.NewDense(2, 1, []float64{1, -1}),
+				H: []float64{0, -1},
+			},
+			err: ErrInfeasible,
+		},
+		{
+			name: "inconsistent equalities",
+			p: Problem{
+				C: []float64{1, 1},
+				A: mat.NewDense(2, 2, []float64{1, 1, 1, 1}),
+				B: []float64{1, 2},
+			},
+			err: ErrInfeasible,
+		},
+		{
+			name: "unbounded linear program",
+			p: Problem{
+				C: []float64{-1, 0},
+				G: mat.NewDense(1, 2, []float64{-1, 0}),
+				H: []float64{0},
+			},
+			err: ErrUnbounded,
+		},
+		{
+			name: "unbounded singular Q",
+			p: Problem{
+				Q: mat.NewSymDense(2, []float64{1, 0, 0, 0}),
+				C: []float64{0, -1},
+			},
+			err: ErrUnbounded,
+		},
+		{
+			name: "indefinite Q",
+			p: Problem{
+				Q: mat.NewSymDense(2, []float64{1, 0, 0, -1}),
+				C: []float64{0, 0},
+			},
+			err: ErrNotConvex,
+		},
+	} {
+		_, err := ActiveSet(test.p, nil)
+		if err != test.err {
+			t.Errorf("%s: unexpected error: got %v, want %v", test.name, err, test.err)
+		}
+	}
+}
+
+func TestActiveSetRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range []struct{ n, me, mi int }{
+		{2, 0, 3},
+		{5, 1, 10},
+		{10, 3, 20},
+		{20, 5, 40},
+	} {
+		for trial := 0; trial < 5; trial++ {
+			p := randomProblem(rnd, dims.n, dims.me, dims.mi)
+			result, err := ActiveSet(p, nil)
+			if err != nil {
+				t.Errorf("n=%d trial=%d: unexpected error: %v", dims.n, trial, err)
+				continue
+			}
+			checkKKT(t, "random", p, result)
+
+			// Warm starting from the solution must not change the working set.
+			warm, err := ActiveSet(p, &Settings{InitialX: result.X, InitialActive: result.Active})
+			if err != nil {
+				t.Errorf("n=%d trial=%d: unexpected error with warm start: %v", dims.n, trial, err)
+				continue
+			}
+			if warm.Iterations != 0 {
+				t.Errorf("n=%d trial=%d: warm start used %d iterations", dims.n, trial, warm.Iterations)
+			}
+			if !floats.EqualApprox(warm.X, result.X, 1e-10) {
+				t.Errorf("n=%d trial=%d: warm start changed solution", dims.n, trial)
+			}
+		}
+	}
+}
+
+func TestActiveSetSimplex(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		// Random bounded LP: minimize cᵀx over the box [-1, 1]ⁿ
+		// intersected with random half-spaces containing the origin.
+		n, mi := 4, 6
+		g := mat.NewDense(mi+2*n, n, nil)
+		h := make([]float64, mi+2*n)
+		for i := 0; i < mi; i++ {
+			for j := 0; j < n; j++ {
+				g.Set(i, j, rnd.NormFloat64())
+			}
+			h[i] = rnd.Float64() + 0.1
+		}
+		for j := 0; j < n; j++ {
+			g.Set(mi+j, j, 1)
+			h[mi+j] = 1
+			g.Set(mi+n+j, j, -1)
+			h[mi+n+j] = 1
+		}
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = rnd.NormFloat64()
+		}
+
+		result, err := ActiveSet(Problem{C: c, G: g, H: h}, nil)
+		if err != nil {
+			t.Errorf("trial %d: unexpected error: %v", trial, err)
+			continue
+		}
+
+		// Standard form in the shifted variables u = x + 1 ≥ 0
+		// with slacks s for the half-spaces and the upper bounds.
+		cStd := make([]float64, 2*n+mi)
+		copy(cStd, c)
+		aStd := mat.NewDense(mi+n, 2*n+mi, nil)
+		bStd := make([]float64, mi+n)
+		for i := 0; i < mi; i++ {
+			row := g.RawRowView(i)
+			copy(aStd.RawRowView(i), row)
+			aStd.Set(i, n+i, 1)
+			bStd[i] = h[i] + floats.Sum(row)
+		}
+		for j := 0; j < n; j++ {
+			aStd.Set(mi+j, j, 1)
+			aStd.Set(mi+j, n+mi+j, 1)
+			bStd[mi+j] = 2
+		}
+		want, _, err := lp.Simplex(cStd, aStd, bStd, 0, nil)
+		if err != nil {
+			t.Fatalf("trial %d: unexpected simplex error: %v", trial, err)
+		}
+		want -= floats.Sum(c)
+		if !scalar.EqualWithinAbsOrRel(result.F, want, 1e-8, 1e-8) {
+			t.Errorf("trial %d: objective mismatch: got %v, want %v", trial, result.F, want)
+		}
+	}
+}
+
+// randomProblem returns a random feasible strictly convex quadratic program.
+func randomProblem(rnd *rand.Rand, n, me, mi int) Problem {
+	m := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			m.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var q mat.SymDense
+	q.SymOuterK(1/float64(n), m)
+	for i := 0; i < n; i++ {
+		q.SetSym(i, i, q.At(i, i)+0.1)
+	}
+	c := make([]float64, n)
+	for i := range c {
+		c[i] = 10 * rnd.NormFloat64()
+	}
+	x0 := make([]float64, n)
+	for i := range x0 {
+		x0[i] = rnd.NormFloat64()
+	}
+	p := Problem{Q: &q, C: c}
+	if me > 0 {
+		a := mat.NewDense(me, n, nil)
+		for i := 0; i < me; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		b := make([]float64, me)
+		mat.NewVecDense(me, b).MulVec(a, mat.NewVecDense(n, x0))
+		p.A, p.B = a, b
+	}
+	g := mat.NewDense(mi, n, nil)
+	for i := 0; i < mi; i++ {
+		for j := 0; j < n; j++ {
+			g.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	h := make([]float64, mi)
+	mat.NewVecDense(mi, h).MulVec(g, mat.NewVecDense(n, x0))
+	for i := range h {
+		h[i] += rnd.Float64()
+	}
+	p.G, p.H = g, h
+	return p
+}
+
+// checkKKT checks that result satisfies the KKT conditions of p.
+func checkKKT(t *testing.T, name string, p Problem, result *Result) {
+	t.Helper()
+	const tol = 1e-7
+	n := len(p.C)
+	x := mat.NewVecDense(n, result.X)
+
+	// Stationarity.
+	r := mat.NewVecDense(n, nil)
+	r.CopyVec(mat.NewVecDense(n, p.C))
+	if p.Q != nil {
+		var qx mat.VecDense
+		qx.MulVec(p.Q, x)
+		r.AddVec(r, &qx)
+	}
+	if p.A != nil {
+		var ay mat.VecDense
+		ay.MulVec(p.A.T(), mat.NewVecDense(len(p.B), result.EqualityDual))
+		r.AddVec(r, &ay)
+	}
+	if p.G != nil {
+		var gz mat.VecDense
+		gz.MulVec(p.G.T(), mat.NewVecDense(len(p.H), result.InequalityDual))
+		r.AddVec(r, &gz)
+	}
+	if norm := mat.Norm(r, math.Inf(1)); norm > tol*(1+floats.Norm(p.C, math.Inf(1))) {
+		t.Errorf("%s: stationarity residual too large: %v", name, norm)
+	}
+
+	// Feasibility and complementarity.
+	if p.A != nil {
+		var ax mat.VecDense
+		ax.MulVec(p.A, x)
+		for i, b := range p.B {
+			if math.Abs(ax.AtVec(i)-b) > tol*(1+math.Abs(b)) {
+				t.Errorf("%s: equality %d violated: %v != %v", name, i, ax.AtVec(i), b)
+			}
+		}
+	}
+	if p.G != nil {
+		var gx mat.VecDense
+		gx.MulVec(p.G, x)
+		for i, h := range p.H {
+			slack := h - gx.AtVec(i)
+			z := result.InequalityDual[i]
+			if slack < -tol*(1+math.Abs(h)) {
+				t.Errorf("%s: inequality %d violated by %v", name, i, -slack)
+			}
+			if z < 0 {
+				t.Errorf("%s: negative dual value %v", name, z)
+			}
+			if math.Abs(z*slack) > tol*(1+math.Abs(h)) {
+				t.Errorf("%s: complementarity violated for %d: z=%v slack=%v", name, i, z, slack)
+			}
+		}
+	}
+}
@@ -36,6 +36,10 @@ var (
 	// is not supplied by Problem.
 	ErrMissingGrad = errors.New("optimize: problem does not provide needed Grad function")
 
+	// ErrUnsupportedBounds signifies that a Method does not support the bound
+	// constraints specified by Problem.
+	ErrUnsupportedBounds = errors.New("optimize: method does not support bound constraints")
+
 	// ErrMissingHess signifies that a Method requires a Hessian function that
 	// is not supplied by Problem.
 	ErrMissingHess = errors.New("optimize: problem does not provide needed Hess function")
@@ -58,6 +58,22 @@ type needser interface {
 	}
 }
 
+// boundedMethod is a Method that supports bound constraints on the variables.
+type boundedMethod interface {
+	// setBounds sets the bounds of the problem before Init is called.
+	// The bounds have the length of the problem dimension and may hold
+	// infinite values.
+	setBounds(lower, upper []float64)
+}
+
+// projectedGradienter is a localMethod whose gradient convergence is measured
+// by a projection of the gradient.
+type projectedGradienter interface {
+	// projectGradient stores the gradient grad at x projected for the
+	// convergence check into dst.
+	projectGradient(dst, x, grad []float64)
+}
+
 // Statuser can report the status and any error. It is intended for methods as
 // an additional error reporting mechanism apart from the errors returned from
 // Init and Iterate.
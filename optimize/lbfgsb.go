@@ -0,0 +1,506 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method          = (*LBFGSB)(nil)
+	_ localMethod     = (*LBFGSB)(nil)
+	_ NextDirectioner = (*LBFGSB)(nil)
+	_ boundedMethod   = (*LBFGSB)(nil)
+)
+
+// LBFGSB implements the limited-memory BFGS method for gradient-based
+// minimization subject to bound constraints on the variables. The bounds are
+// specified by Problem.Lower and Problem.Upper. If the problem has no bounds,
+// LBFGSB behaves similarly to LBFGS.
+//
+// At each iteration the generalized Cauchy point, the first local minimizer
+// of the quadratic model along the projected steepest descent path, is
+// computed to determine the set of variables at their bounds. The quadratic
+// model is then minimized over the remaining free variables and the search
+// direction points from the current location to this subspace minimizer.
+// The Hessian approximation is stored implicitly in the compact limited-memory
+// form from the last Store iterations.
+//
+// The search direction is scaled such that steps in [0, 1] remain feasible.
+// If the Linesearcher is a *MoreThuente, a copy of it is used whose
+// MaximumStep is set at each iteration to the longest feasible step along the
+// search direction, and reaching it is accepted as a successful line search.
+// The Linesearcher itself is not modified. If another
+// Linesearcher attempts an infeasible step, the evaluation point is projected
+// onto the bounds.
+//
+// References:
+//   - Byrd, R. H., Lu, P., Nocedal, J., & Zhu, C. (1995). A limited memory
+//     algorithm for bound constrained optimization. SIAM Journal on
+//     Scientific Computing, 16(5), 1190-1208.
+//   - Morales, J. L., & Nocedal, J. (2011). Remark on "Algorithm 778:
+//     L-BFGS-B: Fortran subroutines for large-scale bound constrained
+//     optimization". ACM Transactions on Mathematical Software, 38(1), 1-4.
+type LBFGSB struct {
+	// Linesearcher selects suitable steps along the search direction.
+	// Accepted steps should satisfy the strong Wolfe conditions.
+	// If Linesearcher is nil, a MoreThuente line search will be used.
+	Linesearcher Linesearcher
+	// Store is the size of the limited-memory storage.
+	// If Store is 0, it will be defaulted to 10.
+	Store int
+	// GradStopThreshold sets the threshold for stopping if the infinity
+	// norm of the projected gradient
+	//  P(x - ∇f(x)) - x,
+	// where P is the projection onto the bounds, gets too small. If
+	// GradStopThreshold is 0 it is defaulted to 1e-12, and if it is NaN
+	// the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	ls *LinesearchMethod
+	mt MoreThuente // Copy of a MoreThuente Linesearcher.
+
+	lower, upper []float64 // Bounds set by Minimize.
+
+	dim  int       // Dimension of the problem
+	x    []float64 // Location at the last major iteration
+	grad []float64 // Gradient at the last major iteration
+
+	// History stored in the order of insertion.
+	s, y  [][]float64
+	theta float64
+
+	// Work space.
+	// The Cauchy point and the subspace minimizer are stored relative
+	// to the current location to avoid cancellation for badly scaled
+	// variables.
+	w      *mat.Dense // W = [Y θS]
+	m      *mat.Dense // Middle matrix of the compact representation.
+	zcp    []float64  // Generalized Cauchy point minus x.
+	c      []float64  // Wᵀ⋅zcp
+	free   []bool     // Free variables at the Cauchy point.
+	zbar   []float64  // Subspace minimizer minus x.
+	xbreak []breakpoint
+}
+
+// breakpoint is a breakpoint of the projected steepest descent path.
+type breakpoint struct {
+	t   float64
+	idx int
+}
+
+func (l *LBFGSB) Status() (Status, error) {
+	return l.status, l.err
+}
+
+func (*LBFGSB) Uses(has Available) (uses Available, err error) {
+	return has.boundedGradient()
+}
+
+func (l *LBFGSB) setBounds(lower, upper []float64) {
+	l.lower = lower
+	l.upper = upper
+}
+
+func (l *LBFGSB) Init(dim, tasks int) int {
+	l.status = NotTerminated
+	l.err = nil
+	if l.lower == nil {
+		l.lower = make([]float64, dim)
+		floats.AddConst(math.Inf(-1), l.lower)
+	}
+	if l.upper == nil {
+		l.upper = make([]float64, dim)
+		floats.AddConst(math.Inf(1), l.upper)
+	}
+	if len(l.lower) != dim || len(l.upper) != dim {
+		panic("lbfgsb: bounds dimension mismatch")
+	}
+	return 1
+}
+
+func (l *LBFGSB) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	l.status, l.err = localOptimizer{}.run(l, l.GradStopThreshold, operation, result, tasks)
+	close(operation)
+	// Clear the bounds so that the next run uses the bounds of its problem.
+	l.lower, l.upper = nil, nil
+}
+
+func (l *LBFGSB) initLocal(loc *Location) (Operation, error) {
+	if l.Linesearcher == nil {
+		l.Linesearcher = &MoreThuente{}
+	}
+	if l.Store == 0 {
+		l.Store = 10
+	}
+
+	if l.ls == nil {
+		l.ls = &LinesearchMethod{}
+	}
+	l.ls.Linesearcher = l.Linesearcher
+	if mt, ok := l.Linesearcher.(*MoreThuente); ok {
+		// Work on a copy so that setting the maximum
+		// step does not modify the caller's value.
+		l.mt = *mt
+		l.ls.Linesearcher = &l.mt
+	}
+	l.ls.NextDirectioner = l
+
+	return l.ls.Init(loc)
+}
+
+func (l *LBFGSB) iterateLocal(loc *Location) (Operation, error) {
+	op, err := l.ls.Iterate(loc)
+	if err == ErrLinesearcherBound {
+		// The line search reached the longest feasible step with
+		// a sufficient decrease. The location is fully evaluated,
+		// so accept it.
+		l.ls.lastOp = MajorIteration
+		return MajorIteration, nil
+	}
+	if op.isEvaluation() {
+		project(loc.X, l.lower, l.upper)
+	}
+	return op, err
+}
+
+// projectGradient stores the projected gradient P(x - grad) - x into dst.
+func (l *LBFGSB) projectGradient(dst, x, grad []float64) {
+	floats.SubTo(dst, x, grad)
+	project(dst, l.lower, l.upper)
+	floats.Sub(dst, x)
+}
+
+func (l *LBFGSB) InitDirection(loc *Location, dir []float64) (stepSize float64) {
+	dim := len(loc.X)
+	l.dim = dim
+	l.s = l.s[:0]
+	l.y = l.y[:0]
+	l.theta = 1
+
+	l.x = resize(l.x, dim)
+	copy(l.x, loc.X)
+	l.grad = resize(l.grad, dim)
+	copy(l.grad, loc.Gradient)
+
+	l.zcp = resize(l.zcp, dim)
+	l.zbar = resize(l.zbar, dim)
+	l.free = make([]bool, dim)
+
+	l.direction(dir, loc.X, loc.Gradient)
+	maxStep := l.setMaxStep(loc.X, dir)
+	return math.Min(maxStep, 1/floats.Norm(dir, 2))
+}
+
+func (l *LBFGSB) NextDirection(loc *Location, dir []float64) (stepSize float64) {
+	if len(loc.X) != l.dim || len(loc.Gradient) != l.dim || len(dir) != l.dim {
+		panic("lbfgsb: unexpected size mismatch")
+	}
+
+	s := make([]float64, l.dim)
+	floats.SubTo(s, loc.X, l.x)
+	y := make([]float64, l.dim)
+	floats.SubTo(y, loc.Gradient, l.grad)
+	sDotY := floats.Dot(s, y)
+	yDotY := floats.Dot(y, y)
+	// Only update the Hessian approximation if it
+	// remains positive definite.
+	if sDotY > dlamchE*yDotY {
+		if len(l.s) == l.Store {
+			copy(l.s, l.s[1:])
+			copy(l.y, l.y[1:])
+			l.s = l.s[:l.Store-1]
+			l.y = l.y[:l.Store-1]
+		}
+		l.s = append(l.s, s)
+		l.y = append(l.y, y)
+		l.theta = yDotY / sDotY
+	}
+
+	copy(l.x, loc.X)
+	copy(l.grad, loc.Gradient)
+
+	l.direction(dir, loc.X, loc.Gradient)
+	l.setMaxStep(loc.X, dir)
+	return 1
+}
+
+// setMaxStep computes the longest feasible step from x along dir, sets it as
+// the maximum step of the copy of a MoreThuente Linesearcher and returns it.
+func (l *LBFGSB) setMaxStep(x, dir []float64) float64 {
+	const maxStep = 1e20 // Default maximum step of MoreThuente.
+	step := maxStep
+	for i, d := range dir {
+		switch {
+		case d > 0:
+			step = math.Min(step, (l.upper[i]-x[i])/d)
+		case d < 0:
+			step = math.Min(step, (l.lower[i]-x[i])/d)
+		}
+	}
+	// The search direction is constructed so that the unit
+	// step is feasible.
+	step = math.Max(step, 1)
+	if l.ls.Linesearcher == &l.mt {
+		l.mt.MaximumStep = step
+	}
+	return step
+}
+
+// direction computes the search direction from x to the minimizer of the
+// quadratic model over the free variables at the generalized Cauchy point.
+func (l *LBFGSB) direction(dir, x, g []float64) {
+	l.formCompact()
+	l.cauchyPoint(x, g)
+	l.subspaceMinimize(x, g)
+	copy(dir, l.zbar)
+	if floats.Dot(dir, g) >= 0 {
+		// The subspace minimization failed to produce
+		// a descent direction, use the Cauchy point.
+		copy(dir, l.zcp)
+	}
+}
+
+// formCompact forms the matrices W and M of the compact representation
+//
+//	B = θI - W⋅M⋅Wᵀ
+//
+// of the limited-memory BFGS matrix.
+func (l *LBFGSB) formCompact() {
+	k := len(l.s)
+	if k == 0 {
+		l.w, l.m = nil, nil
+		return
+	}
+	l.w = mat.NewDense(l.dim, 2*k, nil)
+	for j := 0; j < k; j++ {
+		for i := 0; i < l.dim; i++ {
+			l.w.Set(i, j, l.y[j][i])
+			l.w.Set(i, k+j, l.theta*l.s[j][i])
+		}
+	}
+	// The inverse of M is
+	//  [ -D   Lᵀ  ]
+	//  [  L  θSᵀS ]
+	// where D = diag(sᵢᵀyᵢ) and L is the strictly lower
+	// triangular part of SᵀY.
+	mInv := mat.NewDense(2*k, 2*k, nil)
+	for i := 0; i < k; i++ {
+		mInv.Set(i, i, -floats.Dot(l.s[i], l.y[i]))
+		for j := 0; j < i; j++ {
+			v := floats.Dot(l.s[i], l.y[j])
+			mInv.Set(k+i, j, v)
+			mInv.Set(j, k+i, v)
+		}
+		for j := 0; j < k; j++ {
+			mInv.Set(k+i, k+j, l.theta*floats.Dot(l.s[i], l.s[j]))
+		}
+	}
+	l.m = mat.NewDense(2*k, 2*k, nil)
+	err := l.m.Inverse(mInv)
+	if c, ok := err.(mat.Condition); err != nil && (!ok || math.IsInf(float64(c), 1)) {
+		// The memory is numerically degenerate,
+		// restart with a scaled identity.
+		l.s = l.s[:0]
+		l.y = l.y[:0]
+		l.w, l.m = nil, nil
+	}
+}
+
+// cauchyPoint computes the generalized Cauchy point and stores it relative to
+// x into l.zcp along with c = Wᵀ⋅zcp and the set of free variables.
+func (l *LBFGSB) cauchyPoint(x, g []float64) {
+	n := l.dim
+	d := make([]float64, n)
+	l.xbreak = l.xbreak[:0]
+	for i, gi := range g {
+		l.zcp[i] = 0
+		t := math.Inf(1)
+		switch {
+		case gi < 0:
+			t = (x[i] - l.upper[i]) / gi
+		case gi > 0:
+			t = (x[i] - l.lower[i]) / gi
+		}
+		// Variables at a bound are free only if the steepest
+		// descent direction points into the feasible region.
+		l.free[i] = t > 0 && (gi != 0 || l.lower[i] < x[i] && x[i] < l.upper[i])
+		if t > 0 {
+			d[i] = -gi
+			if !math.IsInf(t, 1) {
+				l.xbreak = append(l.xbreak, breakpoint{t: t, idx: i})
+			}
+		}
+	}
+	sort.Slice(l.xbreak, func(i, j int) bool { return l.xbreak[i].t < l.xbreak[j].t })
+
+	k2 := 0
+	if l.w != nil {
+		_, k2 = l.w.Dims()
+	}
+	p := mat.NewVecDense(max(k2, 1), nil)
+	c := mat.NewVecDense(max(k2, 1), nil)
+	var mp, wb, mwb mat.VecDense
+	if k2 > 0 {
+		p.MulVec(l.w.T(), mat.NewVecDense(n, d))
+	}
+	fp := -floats.Dot(d, d)
+	fpp := -l.theta * fp
+	if k2 > 0 {
+		mp.MulVec(l.m, p)
+		fpp -= mat.Dot(p, &mp)
+	}
+	fpp0 := -l.theta * fp
+	dtMin := -fp / math.Max(fpp, dlamchE*fpp0)
+	tOld := 0.0
+
+	next := 0
+	for next < len(l.xbreak) {
+		bp := l.xbreak[next]
+		dt := bp.t - tOld
+		if dtMin < dt {
+			break
+		}
+		next++
+		b := bp.idx
+		if d[b] > 0 {
+			l.zcp[b] = l.upper[b] - x[b]
+		} else {
+			l.zcp[b] = l.lower[b] - x[b]
+		}
+		l.free[b] = false
+		zb := l.zcp[b]
+		gb := g[b]
+		if k2 > 0 {
+			c.AddScaledVec(c, dt, p)
+			wb.CloneFromVec(l.w.RowView(b))
+			mwb.MulVec(l.m, &wb)
+			fp += dt*fpp + gb*gb + l.theta*gb*zb - gb*mat.Dot(&mwb, c)
+			fpp += -l.theta*gb*gb - 2*gb*mat.Dot(&mwb, p) - gb*gb*mat.Dot(&mwb, &wb)
+			p.AddScaledVec(p, gb, &wb)
+		} else {
+			fp += dt*fpp + gb*gb + l.theta*gb*zb
+			fpp -= l.theta * gb * gb
+		}
+		d[b] = 0
+		fpp = math.Max(fpp, dlamchE*fpp0)
+		dtMin = -fp / fpp
+		tOld = bp.t
+	}
+	dtMin = math.Max(dtMin, 0)
+	tOld += dtMin
+	for i, di := range d {
+		// Variables that have not reached their
+		// bounds move along the descent path.
+		if di != 0 {
+			l.zcp[i] = tOld * di
+		}
+	}
+	if k2 > 0 {
+		c.AddScaledVec(c, dtMin, p)
+	}
+	l.c = append(l.c[:0], c.RawVector().Data[:k2]...)
+}
+
+// subspaceMinimize minimizes the quadratic model over the free variables
+// starting at the Cauchy point and stores the result relative to x into
+// l.zbar.
+func (l *LBFGSB) subspaceMinimize(x, g []float64) {
+	copy(l.zbar, l.zcp)
+	var free []int
+	for i, f := range l.free {
+		if f {
+			free = append(free, i)
+		}
+	}
+	nf := len(free)
+	if nf == 0 {
+		return
+	}
+	theta := l.theta
+
+	// Reduced gradient of the model at the Cauchy point
+	//  rc = Zᵀ(g + θ⋅zcp - W⋅M⋅c).
+	rc := make([]float64, nf)
+	var mc mat.VecDense
+	k2 := len(l.c)
+	if k2 > 0 {
+		mc.MulVec(l.m, mat.NewVecDense(k2, l.c))
+	}
+	for j, i := range free {
+		rc[j] = g[i] + theta*l.zcp[i]
+		if k2 > 0 {
+			rc[j] -= mat.Dot(l.w.RowView(i), &mc)
+		}
+	}
+
+	du := make([]float64, nf)
+	floats.ScaleTo(du, -1/theta, rc)
+	if k2 > 0 {
+		// Use the Sherman-Morrison-Woodbury formula for the
+		// inverse of the reduced Hessian
+		//  du = -rc/θ - 1/θ² ZᵀW (I - 1/θ M WᵀZ ZᵀW)⁻¹ M WᵀZ rc.
+		wz := mat.NewDense(nf, k2, nil)
+		for j, i := range free {
+			wz.SetRow(j, l.w.RawRowView(i))
+		}
+		var v mat.VecDense
+		v.MulVec(wz.T(), mat.NewVecDense(nf, rc))
+		v.MulVec(l.m, &v)
+		var wtw, n mat.Dense
+		wtw.Mul(wz.T(), wz)
+		n.Mul(l.m, &wtw)
+		n.Scale(-1/theta, &n)
+		for i := 0; i < k2; i++ {
+			n.Set(i, i, n.At(i, i)+1)
+		}
+		err := v.SolveVec(&n, &v)
+		if err != nil {
+			// Fall back to the Cauchy point.
+			return
+		}
+		var zwv mat.VecDense
+		zwv.MulVec(wz, &v)
+		floats.AddScaled(du, -1/(theta*theta), zwv.RawVector().Data)
+	}
+
+	// Project the subspace minimizer onto the bounds. If this does not
+	// give a descent direction, backtrack along du to stay feasible.
+	for j, i := range free {
+		l.zbar[i] = math.Min(math.Max(l.zcp[i]+du[j], l.lower[i]-x[i]), l.upper[i]-x[i])
+	}
+	if floats.Dot(l.zbar, g) < 0 {
+		return
+	}
+	alpha := 1.0
+	for j, i := range free {
+		switch {
+		case du[j] > 0:
+			alpha = math.Min(alpha, (l.upper[i]-x[i]-l.zcp[i])/du[j])
+		case du[j] < 0:
+			alpha = math.Min(alpha, (l.lower[i]-x[i]-l.zcp[i])/du[j])
+		}
+	}
+	for j, i := range free {
+		l.zbar[i] = l.zcp[i] + alpha*du[j]
+	}
+}
+
+func (*LBFGSB) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
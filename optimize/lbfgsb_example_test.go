@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func ExampleLBFGSB() {
+	// Minimize the Rosenbrock function subject to
+	// x₀ ≤ 0.5 and 0.5 ≤ x₂ ≤ 0.8.
+	inf := math.Inf(1)
+	p := optimize.Problem{
+		Func:  functions.ExtendedRosenbrock{}.Func,
+		Grad:  functions.ExtendedRosenbrock{}.Grad,
+		Lower: []float64{-inf, -inf, 0.5, -inf},
+		Upper: []float64{0.5, inf, 0.8, inf},
+	}
+
+	// The objective function is not zero at the constrained minimum
+	// so the default gradient threshold is too strict for the
+	// attainable precision.
+	settings := &optimize.Settings{GradientThreshold: 1e-6}
+
+	x := []float64{0, 0, 0, 0}
+	result, err := optimize.Minimize(p, x, settings, &optimize.LBFGSB{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = result.Status.Err(); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("result.Status: %v\n", result.Status)
+	fmt.Printf("result.X: %0.4g\n", result.X)
+	fmt.Printf("result.F: %0.4g\n", result.F)
+	// Output:
+	// result.Status: GradientThreshold
+	// result.X: [0.5 0.5033 0.5 0.25]
+	// result.F: 13.25
+}
@@ -0,0 +1,255 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestLBFGSBBounds(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	rosen := functions.ExtendedRosenbrock{}
+	for _, test := range []struct {
+		name         string
+		p            Problem
+		x            []float64
+		lower, upper []float64
+		want         []float64 // Expected solution if known.
+	}{
+		{
+			name:  "Rosenbrock upper bound",
+			p:     Problem{Func: rosen.Func, Grad: rosen.Grad},
+			x:     []float64{-1.2, 1},
+			upper: []float64{0.5, inf},
+			want:  []float64{0.5, 0.25},
+		},
+		{
+			name:  "Rosenbrock lower bound",
+			p:     Problem{Func: rosen.Func, Grad: rosen.Grad},
+			x:     []float64{3, 3},
+			lower: []float64{1.5, -inf},
+			want:  []float64{1.5, 2.25},
+		},
+		{
+			name:  "Rosenbrock inactive bounds",
+			p:     Problem{Func: rosen.Func, Grad: rosen.Grad},
+			x:     []float64{-1.2, 1},
+			lower: []float64{-2, -2},
+			upper: []float64{2, 2},
+			want:  []float64{1, 1},
+		},
+		{
+			name:  "Rosenbrock fixed variable",
+			p:     Problem{Func: rosen.Func, Grad: rosen.Grad},
+			x:     []float64{-1.2, 1},
+			lower: []float64{-inf, 2},
+			upper: []float64{inf, 2},
+		},
+		{
+			name:  "Rosenbrock infeasible start",
+			p:     Problem{Func: rosen.Func, Grad: rosen.Grad},
+			x:     []float64{-5, 10, 3, -4},
+			lower: []float64{-1, -1, -1, -1},
+			upper: []float64{0.5, 0.5, 0.5, 0.5},
+		},
+		{
+			name: "Separable quadratic",
+			p: Problem{
+				Func: func(x []float64) float64 {
+					c := []float64{-1, 0.5, 2}
+					var f float64
+					for i, v := range x {
+						f += (v - c[i]) * (v - c[i])
+					}
+					return f
+				},
+				Grad: func(grad, x []float64) {
+					c := []float64{-1, 0.5, 2}
+					for i, v := range x {
+						grad[i] = 2 * (v - c[i])
+					}
+				},
+			},
+			x:     []float64{0.5, 0.5, 0.5},
+			lower: []float64{0, 0, 0},
+			upper: []float64{1, 1, 1},
+			want:  []float64{0, 0.5, 1},
+		},
+	} {
+		p := test.p
+		p.Lower = test.lower
+		p.Upper = test.upper
+		result, err := Minimize(p, test.x, nil, &LBFGSB{})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.Status != GradientThreshold {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, GradientThreshold)
+		}
+		checkBoundedOptimum(t, test.name, p, result)
+		if test.want != nil && !floats.EqualApprox(result.X, test.want, 1e-8) {
+			t.Errorf("%s: unexpected solution: got %v, want %v", test.name, result.X, test.want)
+		}
+	}
+}
+
+func TestLBFGSBQuadratic(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{2, 5, 20, 50} {
+		for trial := 0; trial < 5; trial++ {
+			// Minimize ½ xᵀ⋅A⋅x + bᵀ⋅x over [-1, 1]ⁿ with a random
+			// positive definite A.
+			a := mat.NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			var ata mat.SymDense
+			ata.SymOuterK(1/float64(n), a)
+			for i := 0; i < n; i++ {
+				ata.SetSym(i, i, ata.At(i, i)+1)
+			}
+			b := make([]float64, n)
+			for i := range b {
+				b[i] = 5 * rnd.NormFloat64()
+			}
+			p := Problem{
+				Func: func(x []float64) float64 {
+					xv := mat.NewVecDense(n, x)
+					return 0.5*mat.Inner(xv, &ata, xv) + floats.Dot(b, x)
+				},
+				Grad: func(grad, x []float64) {
+					g := mat.NewVecDense(n, grad)
+					g.MulVec(&ata, mat.NewVecDense(n, x))
+					floats.Add(grad, b)
+				},
+				Lower: make([]float64, n),
+				Upper: make([]float64, n),
+			}
+			for i := range p.Lower {
+				p.Lower[i] = -1
+				p.Upper[i] = 1
+			}
+			x := make([]float64, n)
+			settings := &Settings{GradientThreshold: 1e-7}
+			result, err := Minimize(p, x, settings, &LBFGSB{})
+			name := fmt.Sprintf("n=%d trial=%d", n, trial)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			checkBoundedOptimum(t, name, p, result)
+		}
+	}
+}
+
+// checkBoundedOptimum checks that the result is feasible and satisfies the
+// first-order optimality conditions of the bound-constrained problem p.
+func checkBoundedOptimum(t *testing.T, name string, p Problem, result *Result) {
+	t.Helper()
+	const tol = 1e-6
+	dim := len(result.X)
+	lower, upper := problemBounds(&p, dim)
+	if !inBounds(result.X, lower, upper) {
+		t.Errorf("%s: solution %v outside bounds", name, result.X)
+	}
+	grad := make([]float64, dim)
+	p.Grad(grad, result.X)
+	pg := make([]float64, dim)
+	floats.SubTo(pg, result.X, grad)
+	project(pg, lower, upper)
+	floats.Sub(pg, result.X)
+	if norm := floats.Norm(pg, math.Inf(1)); norm > tol {
+		t.Errorf("%s: projected gradient too large: %v", name, norm)
+	}
+}
+
+func TestLBFGSBReuse(t *testing.T) {
+	t.Parallel()
+	rosen := functions.ExtendedRosenbrock{}
+	method := &LBFGSB{}
+	bounded := Problem{
+		Func:  rosen.Func,
+		Grad:  rosen.Grad,
+		Upper: []float64{0.5, math.Inf(1)},
+	}
+	result, err := Minimize(bounded, []float64{-1.2, 1}, nil, method)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(result.X, []float64{0.5, 0.25}, 1e-8) {
+		t.Errorf("unexpected bounded solution: got %v", result.X)
+	}
+	// The bounds of the previous problem must not leak into the next one.
+	unbounded := Problem{Func: rosen.Func, Grad: rosen.Grad}
+	result, err = Minimize(unbounded, []float64{-1.2, 1, -1.2}, nil, method)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(result.X, []float64{1, 1, 1}, 1e-8) {
+		t.Errorf("unexpected unbounded solution: got %v", result.X)
+	}
+}
+
+func TestLBFGSBLinesearcherUnchanged(t *testing.T) {
+	t.Parallel()
+	rosen := functions.ExtendedRosenbrock{}
+	p := Problem{
+		Func:  rosen.Func,
+		Grad:  rosen.Grad,
+		Upper: []float64{0.5, math.Inf(1)},
+	}
+	ls := &MoreThuente{MaximumStep: 1e10}
+	result, err := Minimize(p, []float64{-1.2, 1}, nil, &LBFGSB{Linesearcher: ls})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(result.X, []float64{0.5, 0.25}, 1e-8) {
+		t.Errorf("unexpected solution: got %v", result.X)
+	}
+	if ls.MaximumStep != 1e10 {
+		t.Errorf("unexpected MaximumStep after Minimize: got:%v want:1e10", ls.MaximumStep)
+	}
+}
+
+func TestBoundsUnsupported(t *testing.T) {
+	t.Parallel()
+	rosen := functions.ExtendedRosenbrock{}
+	p := Problem{
+		Func:  rosen.Func,
+		Grad:  rosen.Grad,
+		Lower: []float64{0, 0},
+	}
+	for _, method := range []Method{&BFGS{}, &LBFGS{}, &NelderMead{}, &Newton{}} {
+		panicked := func() (panicked bool) {
+			defer func() { panicked = recover() != nil }()
+			_, _ = Minimize(p, []float64{1, 2}, nil, method)
+			return false
+		}()
+		if !panicked {
+			t.Errorf("%T: expected panic for bounded problem", method)
+		}
+	}
+
+	// The default method must support bounds.
+	result, err := Minimize(p, []float64{-1, 2}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error with default method: %v", err)
+	}
+	if !floats.EqualApprox(result.X, []float64{1, 1}, 1e-8) {
+		t.Errorf("unexpected solution with default method: got %v", result.X)
+	}
+}
@@ -0,0 +1,476 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultLeastSquaresTol = 1e-8
+
+	// dlamchE is the machine epsilon.
+	dlamchE = 1.0 / (1 << 52)
+)
+
+// ErrNoCovariance signifies that a parameter covariance estimate could not be
+// computed because there are not more residuals than parameters.
+var ErrNoCovariance = errors.New("optimize: insufficient residuals for covariance estimate")
+
+// LeastSquaresProblem describes a nonlinear least squares problem
+//
+//	minimize 1/2 Σ_i ρ(r_i(x)²)
+//	subject to Lower ≤ x ≤ Upper,
+//
+// where r is a vector of residuals and ρ is a loss function.
+type LeastSquaresProblem struct {
+	// Func evaluates the residuals at x and stores the result in dst
+	// which will have length NumResiduals. Func must not modify x.
+	Func func(dst, x []float64)
+
+	// Jac evaluates the Jacobian of the residuals at x and stores the
+	// result in-place in jac which will be a NumResiduals×len(x) matrix.
+	// Jac must not modify x. If Jac is nil, the Jacobian is approximated
+	// by forward differences using fd.Jacobian.
+	Jac func(jac *mat.Dense, x []float64)
+
+	// NumResiduals is the number of residuals. It must be positive.
+	NumResiduals int
+
+	// Lower and Upper hold the bounds on the parameters. If Lower is
+	// nil, the parameters are not bounded from below, otherwise its
+	// length must equal the number of parameters. Elements of Lower
+	// may be -∞. Similarly for Upper. Each element of Lower must be
+	// less than the corresponding element of Upper.
+	Lower, Upper []float64
+
+	// Loss is the loss function ρ applied to the squared residuals.
+	// If Loss is nil, the standard least squares loss ρ(z) = z is
+	// used.
+	Loss Loss
+}
+
+// LeastSquaresSettings represents settings of a nonlinear least squares
+// optimization run. The zero value of each field uses the default described
+// in the field comment.
+type LeastSquaresSettings struct {
+	// FunctionTolerance is the relative tolerance on the change of the
+	// cost. The optimization terminates with FunctionConvergence status
+	// when a step decreases the cost by less than FunctionTolerance
+	// times the cost and the decrease agrees with the model prediction.
+	// If it is zero, a default value of 1e-8 is used.
+	FunctionTolerance float64
+
+	// StepTolerance is the relative tolerance on the step size. The
+	// optimization terminates with StepConvergence status when the norm
+	// of the step is less than StepTolerance*(StepTolerance + |x|). If
+	// it is zero, a default value of 1e-8 is used.
+	StepTolerance float64
+
+	// GradientTolerance is the tolerance on the infinity norm of the
+	// scaled gradient of the cost. The optimization terminates with
+	// GradientThreshold status when the norm is less than
+	// GradientTolerance. If it is zero, a default value of 1e-8 is used.
+	GradientTolerance float64
+
+	// MajorIterations is the maximum number of accepted steps.
+	// IterationLimit status is returned if the number of major
+	// iterations equals or exceeds this value. If it equals zero, this
+	// setting has no effect.
+	MajorIterations int
+
+	// FuncEvaluations is the maximum allowed number of residual
+	// evaluations. FunctionEvaluationLimit status is returned if the
+	// total number of evaluations equals or exceeds this number. If it
+	// equals zero, this setting has no effect.
+	FuncEvaluations int
+
+	// Runtime is the maximum runtime allowed. RuntimeLimit status is
+	// returned if the duration of the run is longer than this value. If
+	// it equals zero, this setting has no effect.
+	Runtime time.Duration
+}
+
+// LeastSquaresResult represents the answer of a nonlinear least squares
+// optimization run.
+type LeastSquaresResult struct {
+	// X holds the optimal parameters.
+	X []float64
+
+	// Residuals holds the residuals at X.
+	Residuals []float64
+
+	// Jacobian holds the Jacobian of the residuals at X.
+	Jacobian *mat.Dense
+
+	// Cost is the value of the cost 1/2 Σ_i ρ(r_i²) at X.
+	Cost float64
+
+	// Gradient holds the gradient of the cost at X.
+	Gradient []float64
+
+	// Stats holds the statistics of the run. GradEvaluations is the
+	// number of evaluations of the Jacobian, including those performed
+	// by finite differences.
+	Stats
+	Status Status
+}
+
+// Covariance computes an estimate of the covariance matrix of the parameters
+// and stores it into dst. The estimate is
+//
+//	σ² (JᵀJ)⁺,
+//
+// where J is the Jacobian at the optimum, σ² = 2*Cost/(m-n) is the estimated
+// residual variance and ⁺ denotes the pseudo-inverse. The pseudo-inverse is
+// computed using the singular value decomposition of J with singular values
+// smaller than ε*max(m,n)*s₀ treated as zero, where s₀ is the largest
+// singular value.
+//
+// If the number of residuals m is not larger than the number of parameters n,
+// Covariance returns ErrNoCovariance. If dst is empty, it is resized to n×n,
+// otherwise its dimensions must be n×n.
+func (r *LeastSquaresResult) Covariance(dst *mat.SymDense) error {
+	m, n := r.Jacobian.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(n)
+	} else if dst.SymmetricDim() != n {
+		panic(mat.ErrShape)
+	}
+	if m <= n {
+		return ErrNoCovariance
+	}
+	var svd mat.SVD
+	ok := svd.Factorize(r.Jacobian, mat.SVDThinV)
+	if !ok {
+		return errors.New("optimize: SVD factorization failed")
+	}
+	s := svd.Values(nil)
+	var v mat.Dense
+	svd.VTo(&v)
+	threshold := dlamchE * float64(max(m, n)) * s[0]
+	variance := 2 * r.Cost / float64(m-n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			var c float64
+			for k, sk := range s {
+				if sk > threshold {
+					c += v.At(i, k) * v.At(j, k) / (sk * sk)
+				}
+			}
+			dst.SetSym(i, j, variance*c)
+		}
+	}
+	return nil
+}
+
+// LeastSquaresMethod is a method for solving nonlinear least squares problems.
+// The LevenbergMarquardt and TrustRegionReflective types satisfy
+// LeastSquaresMethod.
+//
+// LeastSquaresMethod is sealed. Its method operates on the unexported state
+// of a LeastSquares run, so LeastSquaresMethod cannot be implemented outside
+// this package.
+type LeastSquaresMethod interface {
+	// solve runs the optimization starting from the current state of
+	// lsq and returns the final status.
+	solve(lsq *leastSquares) (Status, error)
+}
+
+// LeastSquares finds the parameters x that minimize the sum of the (robustly
+// weighted) squared residuals described by p, starting from initX. If method
+// is nil, TrustRegionReflective is used. If settings is nil, default settings
+// are used.
+//
+// The Jacobian of the residuals is evaluated only at accepted points. If p
+// provides bounds, initX must lie within them. Elements of initX lying on a
+// bound are moved slightly into the interior of the feasible region.
+//
+// LeastSquares returns a non-nil error if the optimization ends early or if
+// the residuals are not finite at initX. LeastSquares panics if p.Func is nil,
+// if p.NumResiduals is not positive, if initX is empty or if the bounds are
+// invalid or initX is outside of them.
+func LeastSquares(p LeastSquaresProblem, initX []float64, settings *LeastSquaresSettings, method LeastSquaresMethod) (*LeastSquaresResult, error) {
+	start := time.Now()
+	if p.Func == nil {
+		panic(badProblem)
+	}
+	n := len(initX)
+	if n == 0 {
+		panic(nonpositiveDimension)
+	}
+	if p.NumResiduals <= 0 {
+		panic("optimize: non-positive number of residuals")
+	}
+	lower, upper := p.Lower, p.Upper
+	if lower == nil {
+		lower = make([]float64, n)
+		floats.AddConst(math.Inf(-1), lower)
+	}
+	if upper == nil {
+		upper = make([]float64, n)
+		floats.AddConst(math.Inf(1), upper)
+	}
+	if len(lower) != n || len(upper) != n {
+		panic("optimize: bounds length mismatch")
+	}
+	for i, x := range initX {
+		if !(lower[i] < upper[i]) {
+			panic("optimize: lower bound not less than upper bound")
+		}
+		if x < lower[i] || upper[i] < x {
+			panic("optimize: initial location outside bounds")
+		}
+	}
+
+	var s LeastSquaresSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.FunctionTolerance == 0 {
+		s.FunctionTolerance = defaultLeastSquaresTol
+	}
+	if s.StepTolerance == 0 {
+		s.StepTolerance = defaultLeastSquaresTol
+	}
+	if s.GradientTolerance == 0 {
+		s.GradientTolerance = defaultLeastSquaresTol
+	}
+	if method == nil {
+		method = &TrustRegionReflective{}
+	}
+
+	lsq := newLeastSquares(&p, initX, lower, upper, &s, start)
+	makeStrictlyFeasible(lsq.x, lower, upper)
+	var (
+		status Status
+		err    error
+	)
+	if !lsq.evalResiduals(lsq.r, lsq.x) {
+		status = Failure
+		err = ErrFunc(math.NaN())
+	} else {
+		lsq.cost = lsq.costOf(lsq.r)
+		lsq.evalJacobian()
+		status, err = method.solve(lsq)
+	}
+	if err == nil {
+		err = status.Err()
+	}
+	lsq.stats.Runtime = time.Since(start)
+	return &LeastSquaresResult{
+		X:         lsq.x,
+		Residuals: lsq.r,
+		Jacobian:  lsq.jac,
+		Cost:      lsq.cost,
+		Gradient:  lsq.g,
+		Stats:     lsq.stats,
+		Status:    status,
+	}, err
+}
+
+// leastSquares holds the state of a least squares optimization shared by the
+// methods.
+type leastSquares struct {
+	p        *LeastSquaresProblem
+	settings *LeastSquaresSettings
+	start    time.Time
+	stats    Stats
+
+	m, n         int
+	lower, upper []float64
+
+	x    []float64
+	r    []float64  // Residuals at x.
+	jac  *mat.Dense // Jacobian of r at x.
+	cost float64
+
+	// rs and js are the residuals and the Jacobian
+	// scaled for the robust loss function so that
+	// the Gauss-Newton model of the cost is
+	//  1/2 |rs + js⋅s|².
+	rs []float64
+	js *mat.Dense
+	g  []float64 // Gradient of the cost, jsᵀ⋅rs.
+
+	xtmp []float64
+}
+
+func newLeastSquares(p *LeastSquaresProblem, initX, lower, upper []float64, settings *LeastSquaresSettings, start time.Time) *leastSquares {
+	m, n := p.NumResiduals, len(initX)
+	return &leastSquares{
+		p:        p,
+		settings: settings,
+		start:    start,
+		m:        m,
+		n:        n,
+		lower:    lower,
+		upper:    upper,
+		x:        append([]float64(nil), initX...),
+		r:        make([]float64, m),
+		jac:      mat.NewDense(m, n, nil),
+		rs:       make([]float64, m),
+		js:       mat.NewDense(m, n, nil),
+		g:        make([]float64, n),
+		xtmp:     make([]float64, n),
+	}
+}
+
+// evalResiduals evaluates the residuals at x and stores them into dst. It
+// returns whether all residuals are finite.
+func (lsq *leastSquares) evalResiduals(dst, x []float64) bool {
+	copy(lsq.xtmp, x)
+	lsq.p.Func(dst, lsq.xtmp)
+	lsq.stats.FuncEvaluations++
+	for _, v := range dst {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// costOf returns the cost corresponding to the residuals r.
+func (lsq *leastSquares) costOf(r []float64) float64 {
+	if lsq.p.Loss == nil {
+		return 0.5 * floats.Dot(r, r)
+	}
+	var cost float64
+	for _, v := range r {
+		rho, _, _ := lsq.p.Loss.Loss(v * v)
+		cost += rho
+	}
+	return 0.5 * cost
+}
+
+// evalJacobian evaluates the Jacobian at the current location and updates
+// the scaled residuals, the scaled Jacobian and the gradient.
+func (lsq *leastSquares) evalJacobian() {
+	lsq.stats.GradEvaluations++
+	copy(lsq.xtmp, lsq.x)
+	if lsq.p.Jac != nil {
+		lsq.p.Jac(lsq.jac, lsq.xtmp)
+	} else {
+		fd.Jacobian(lsq.jac, func(dst, x []float64) {
+			lsq.p.Func(dst, x)
+			lsq.stats.FuncEvaluations++
+		}, lsq.xtmp, &fd.JacobianSettings{OriginValue: lsq.r})
+	}
+
+	copy(lsq.rs, lsq.r)
+	lsq.js.Copy(lsq.jac)
+	if lsq.p.Loss != nil {
+		for i, v := range lsq.r {
+			_, rho1, rho2 := lsq.p.Loss.Loss(v * v)
+			scale := rho1 + 2*rho2*v*v
+			if scale < dlamchE {
+				scale = dlamchE
+			}
+			scale = math.Sqrt(scale)
+			lsq.rs[i] *= rho1 / scale
+			row := lsq.js.RawRowView(i)
+			floats.Scale(scale, row)
+		}
+	}
+	gv := mat.NewVecDense(lsq.n, lsq.g)
+	gv.MulVec(lsq.js.T(), mat.NewVecDense(lsq.m, lsq.rs))
+}
+
+// accept moves the current location to x with residuals r and cost and
+// re-evaluates the Jacobian.
+func (lsq *leastSquares) accept(x, r []float64, cost float64) {
+	copy(lsq.x, x)
+	copy(lsq.r, r)
+	lsq.cost = cost
+	lsq.stats.MajorIterations++
+	lsq.evalJacobian()
+}
+
+// limit returns the status corresponding to the limits in the settings being
+// reached or NotTerminated if no limit is reached.
+func (lsq *leastSquares) limit() Status {
+	s := lsq.settings
+	switch {
+	case s.MajorIterations > 0 && lsq.stats.MajorIterations >= s.MajorIterations:
+		return IterationLimit
+	case s.FuncEvaluations > 0 && lsq.stats.FuncEvaluations >= s.FuncEvaluations:
+		return FunctionEvaluationLimit
+	case s.Runtime > 0 && time.Since(lsq.start) > s.Runtime:
+		return RuntimeLimit
+	}
+	return NotTerminated
+}
+
+// converged returns the status corresponding to the convergence tests on a
+// trial step with the given actual cost reduction, step norm and the ratio of
+// the actual to the predicted reduction.
+func (lsq *leastSquares) converged(reduction, stepNorm, ratio float64) Status {
+	s := lsq.settings
+	ftol := reduction < s.FunctionTolerance*lsq.cost && ratio > 0.25
+	xtol := stepNorm < s.StepTolerance*(s.StepTolerance+floats.Norm(lsq.x, 2))
+	switch {
+	case ftol:
+		return FunctionConvergence
+	case xtol:
+		return StepConvergence
+	}
+	return NotTerminated
+}
+
+// reductionRatio returns the ratio of the actual to the predicted reduction
+// of the cost.
+func reductionRatio(actual, predicted float64) float64 {
+	switch {
+	case predicted > 0:
+		return actual / predicted
+	case predicted == actual && actual == 0:
+		return 1
+	}
+	return 0
+}
+
+// modelValue returns the value of the quadratic model
+//
+//	gᵀs + 1/2 (|J⋅s|² + Σ_i diag_i s_i²)
+//
+// where diag may be nil.
+func modelValue(jac mat.Matrix, g, diag, s []float64) float64 {
+	var js mat.VecDense
+	js.MulVec(jac, mat.NewVecDense(len(s), s))
+	q := mat.Dot(&js, &js)
+	for i, d := range diag {
+		q += d * s[i] * s[i]
+	}
+	return floats.Dot(g, s) + 0.5*q
+}
+
+// makeStrictlyFeasible moves the elements of x lying on a bound to the
+// interior of the feasible region.
+func makeStrictlyFeasible(x, lower, upper []float64) {
+	for i, v := range x {
+		switch {
+		case v <= lower[i]:
+			x[i] = math.Nextafter(lower[i], upper[i])
+		case v >= upper[i]:
+			x[i] = math.Nextafter(upper[i], lower[i])
+		}
+		if x[i] < lower[i] || upper[i] < x[i] {
+			x[i] = 0.5 * (lower[i] + upper[i])
+		}
+	}
+}
+
+// project projects x onto the feasible region.
+func project(x, lower, upper []float64) {
+	for i, v := range x {
+		x[i] = math.Min(math.Max(v, lower[i]), upper[i])
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+func ExampleLeastSquares() {
+	// Fit the model y = a*exp(-b*t) to measured data.
+	t := []float64{0, 0.5, 1, 1.5, 2, 2.5, 3, 3.5, 4}
+	y := []float64{5.03, 3.61, 2.54, 1.83, 1.33, 0.93, 0.68, 0.49, 0.35}
+
+	p := optimize.LeastSquaresProblem{
+		Func: func(dst, x []float64) {
+			for i, ti := range t {
+				dst[i] = x[0]*math.Exp(-x[1]*ti) - y[i]
+			}
+		},
+		Jac: func(jac *mat.Dense, x []float64) {
+			for i, ti := range t {
+				e := math.Exp(-x[1] * ti)
+				jac.Set(i, 0, e)
+				jac.Set(i, 1, -x[0]*ti*e)
+			}
+		},
+		NumResiduals: len(t),
+		// The decay rate must be non-negative.
+		Lower: []float64{math.Inf(-1), 0},
+	}
+	result, err := optimize.LeastSquares(p, []float64{1, 0}, nil, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var cov mat.SymDense
+	err = result.Covariance(&cov)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("result.Status: %v\n", result.Status)
+	fmt.Printf("a = %.3f ± %.3f\n", result.X[0], math.Sqrt(cov.At(0, 0)))
+	fmt.Printf("b = %.3f ± %.3f\n", result.X[1], math.Sqrt(cov.At(1, 1)))
+
+	// Output:
+	// result.Status: FunctionConvergence
+	// a = 5.029 ± 0.014
+	// b = 0.671 ± 0.003
+}
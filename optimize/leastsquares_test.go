@@ -0,0 +1,318 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var leastSquaresMethods = []struct {
+	name string
+	new  func() LeastSquaresMethod
+}{
+	{name: "LevenbergMarquardt", new: func() LeastSquaresMethod { return &LevenbergMarquardt{} }},
+	{name: "TrustRegionReflective", new: func() LeastSquaresMethod { return &TrustRegionReflective{} }},
+}
+
+// rosenbrockResiduals is the Rosenbrock function written as the sum of
+// squares of two residuals.
+var rosenbrockResiduals = LeastSquaresProblem{
+	Func: func(dst, x []float64) {
+		dst[0] = 10 * (x[1] - x[0]*x[0])
+		dst[1] = 1 - x[0]
+	},
+	Jac: func(jac *mat.Dense, x []float64) {
+		jac.Set(0, 0, -20*x[0])
+		jac.Set(0, 1, 10)
+		jac.Set(1, 0, -1)
+		jac.Set(1, 1, 0)
+	},
+	NumResiduals: 2,
+}
+
+// expDecay returns a least squares problem fitting a*exp(-b*t) + c to the
+// given data.
+func expDecay(t, y []float64, withJac bool) LeastSquaresProblem {
+	p := LeastSquaresProblem{
+		Func: func(dst, x []float64) {
+			for i, ti := range t {
+				dst[i] = x[0]*math.Exp(-x[1]*ti) + x[2] - y[i]
+			}
+		},
+		NumResiduals: len(t),
+	}
+	if withJac {
+		p.Jac = func(jac *mat.Dense, x []float64) {
+			for i, ti := range t {
+				e := math.Exp(-x[1] * ti)
+				jac.Set(i, 0, e)
+				jac.Set(i, 1, -x[0]*ti*e)
+				jac.Set(i, 2, 1)
+			}
+		}
+	}
+	return p
+}
+
+func TestLeastSquares(t *testing.T) {
+	t.Parallel()
+	want := []float64{2.5, 1.3, 0.5}
+	ts := make([]float64, 30)
+	ys := make([]float64, len(ts))
+	for i := range ts {
+		ts[i] = 4 * float64(i) / float64(len(ts)-1)
+		ys[i] = want[0]*math.Exp(-want[1]*ts[i]) + want[2]
+	}
+
+	for _, m := range leastSquaresMethods {
+		for _, test := range []struct {
+			name string
+			p    LeastSquaresProblem
+			x0   []float64
+			want []float64
+			cost float64
+		}{
+			{
+				name: "Rosenbrock",
+				p:    rosenbrockResiduals,
+				x0:   []float64{-1.2, 1},
+				want: []float64{1, 1},
+			},
+			{
+				name: "Rosenbrock without Jac",
+				p:    LeastSquaresProblem{Func: rosenbrockResiduals.Func, NumResiduals: 2},
+				x0:   []float64{-1.2, 1},
+				want: []float64{1, 1},
+			},
+			{
+				name: "Rosenbrock bounded",
+				p: LeastSquaresProblem{
+					Func:         rosenbrockResiduals.Func,
+					Jac:          rosenbrockResiduals.Jac,
+					NumResiduals: 2,
+					Upper:        []float64{0.5, math.Inf(1)},
+				},
+				x0:   []float64{-1.2, 1},
+				want: []float64{0.5, 0.25},
+				cost: 0.125,
+			},
+			{
+				name: "Rosenbrock start on bound",
+				p: LeastSquaresProblem{
+					Func:         rosenbrockResiduals.Func,
+					Jac:          rosenbrockResiduals.Jac,
+					NumResiduals: 2,
+					Lower:        []float64{-2, 1.5},
+					Upper:        []float64{2, 3},
+				},
+				x0: []float64{2, 1.5},
+				// The minimizer of the cost along
+				// the active bound x[1] = 1.5.
+				want: []float64{1.2243707487363524, 1.5},
+				cost: 0.025213093946803537,
+			},
+			{
+				name: "exponential decay",
+				p:    expDecay(ts, ys, true),
+				x0:   []float64{1, 1, 0},
+				want: want,
+			},
+			{
+				name: "exponential decay without Jac",
+				p:    expDecay(ts, ys, false),
+				x0:   []float64{1, 1, 0},
+				want: want,
+			},
+		} {
+			res, err := LeastSquares(test.p, test.x0, nil, m.new())
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", m.name, test.name, err)
+				continue
+			}
+			if res.Status.Early() {
+				t.Errorf("%s %s: unexpected early status %v", m.name, test.name, res.Status)
+			}
+			if !floats.EqualApprox(res.X, test.want, 1e-5) {
+				t.Errorf("%s %s: unexpected solution: got %v want %v", m.name, test.name, res.X, test.want)
+			}
+			if math.Abs(res.Cost-test.cost) > 1e-8 {
+				t.Errorf("%s %s: unexpected cost: got %v want %v", m.name, test.name, res.Cost, test.cost)
+			}
+			r := make([]float64, test.p.NumResiduals)
+			test.p.Func(r, res.X)
+			if !floats.EqualApprox(r, res.Residuals, 1e-14) {
+				t.Errorf("%s %s: residuals do not match X", m.name, test.name)
+			}
+			for i, v := range res.X {
+				if test.p.Lower != nil && v < test.p.Lower[i] || test.p.Upper != nil && v > test.p.Upper[i] {
+					t.Errorf("%s %s: solution violates bounds: %v", m.name, test.name, res.X)
+					break
+				}
+			}
+			if res.GradEvaluations == 0 || res.FuncEvaluations == 0 {
+				t.Errorf("%s %s: missing statistics: %+v", m.name, test.name, res.Stats)
+			}
+		}
+	}
+}
+
+func TestLeastSquaresLoss(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	// Fit a line to data with a few gross outliers.
+	const a, b = 1.5, -0.5
+	n := 50
+	ts := make([]float64, n)
+	ys := make([]float64, n)
+	for i := range ts {
+		ts[i] = float64(i) / float64(n-1)
+		ys[i] = a*ts[i] + b + 0.01*rnd.NormFloat64()
+		if i%10 == 3 {
+			ys[i] += 5
+		}
+	}
+	line := func(loss Loss) LeastSquaresProblem {
+		return LeastSquaresProblem{
+			Func: func(dst, x []float64) {
+				for i, t := range ts {
+					dst[i] = x[0]*t + x[1] - ys[i]
+				}
+			},
+			Jac: func(jac *mat.Dense, x []float64) {
+				for i, t := range ts {
+					jac.Set(i, 0, t)
+					jac.Set(i, 1, 1)
+				}
+			},
+			NumResiduals: n,
+			Loss:         loss,
+		}
+	}
+	for _, m := range leastSquaresMethods {
+		res, err := LeastSquares(line(nil), []float64{0, 0}, nil, m.new())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		linearErr := math.Hypot(res.X[0]-a, res.X[1]-b)
+		for _, loss := range []Loss{HuberLoss{Scale: 0.1}, SoftL1Loss{Scale: 0.1}, CauchyLoss{Scale: 0.1}} {
+			res, err := LeastSquares(line(loss), []float64{0, 0}, nil, m.new())
+			if err != nil {
+				t.Errorf("%s %T: unexpected error: %v", m.name, loss, err)
+				continue
+			}
+			robustErr := math.Hypot(res.X[0]-a, res.X[1]-b)
+			if robustErr > 0.1*linearErr || robustErr > 0.05 {
+				t.Errorf("%s %T: robust fit not better than linear fit: got %v want < %v", m.name, loss, res.X, []float64{a, b})
+			}
+		}
+	}
+}
+
+func TestLossDerivatives(t *testing.T) {
+	t.Parallel()
+	for _, loss := range []Loss{HuberLoss{}, HuberLoss{Scale: 2}, SoftL1Loss{}, SoftL1Loss{Scale: 0.5}, CauchyLoss{}, CauchyLoss{Scale: 3}} {
+		for _, z := range []float64{0.01, 0.5, 2, 10, 100} {
+			const h = 1e-6
+			rho, drho, d2rho := loss.Loss(z)
+			rhoP, drhoP, _ := loss.Loss(z + h)
+			rhoM, drhoM, _ := loss.Loss(z - h)
+			if math.Abs((rhoP-rhoM)/(2*h)-drho) > 1e-6*math.Max(1, math.Abs(drho)) {
+				t.Errorf("%#v z=%v: unexpected first derivative: got %v want %v", loss, z, drho, (rhoP-rhoM)/(2*h))
+			}
+			if math.Abs((drhoP-drhoM)/(2*h)-d2rho) > 1e-5*math.Max(1, math.Abs(d2rho)) {
+				t.Errorf("%#v z=%v: unexpected second derivative: got %v want %v", loss, z, d2rho, (drhoP-drhoM)/(2*h))
+			}
+			if rho > z {
+				t.Errorf("%#v z=%v: loss larger than linear loss: %v", loss, z, rho)
+			}
+		}
+	}
+}
+
+func TestLeastSquaresCovariance(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	// For a linear model the covariance estimate is
+	// exactly σ²(XᵀX)⁻¹.
+	n, k := 40, 3
+	x := mat.NewDense(n, k, nil)
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		ti := float64(i) / float64(n)
+		x.Set(i, 0, 1)
+		x.Set(i, 1, ti)
+		x.Set(i, 2, ti*ti)
+		y[i] = 1 - 2*ti + 3*ti*ti + 0.1*rnd.NormFloat64()
+	}
+	p := LeastSquaresProblem{
+		Func: func(dst, beta []float64) {
+			d := mat.NewVecDense(n, dst)
+			d.MulVec(x, mat.NewVecDense(k, beta))
+			floats.Sub(dst, y)
+		},
+		Jac: func(jac *mat.Dense, _ []float64) {
+			jac.Copy(x)
+		},
+		NumResiduals: n,
+	}
+	for _, m := range leastSquaresMethods {
+		res, err := LeastSquares(p, make([]float64, k), nil, m.new())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		var cov mat.SymDense
+		err = res.Covariance(&cov)
+		if err != nil {
+			t.Fatalf("%s: unexpected covariance error: %v", m.name, err)
+		}
+		var xtx mat.Dense
+		xtx.Mul(x.T(), x)
+		var want mat.Dense
+		err = want.Inverse(&xtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigma2 := floats.Dot(res.Residuals, res.Residuals) / float64(n-k)
+		want.Scale(sigma2, &want)
+		if !mat.EqualApprox(&cov, &want, 1e-10) {
+			t.Errorf("%s: unexpected covariance:\ngot:\n%v\nwant:\n%v", m.name, mat.Formatted(&cov), mat.Formatted(&want))
+		}
+	}
+
+	res, err := LeastSquares(rosenbrockResiduals, []float64{-1.2, 1}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cov mat.SymDense
+	if err := res.Covariance(&cov); err != ErrNoCovariance {
+		t.Errorf("unexpected error for square problem: got %v want %v", err, ErrNoCovariance)
+	}
+}
+
+func TestLeastSquaresLimits(t *testing.T) {
+	t.Parallel()
+	for _, m := range leastSquaresMethods {
+		res, err := LeastSquares(rosenbrockResiduals, []float64{-1.2, 1}, &LeastSquaresSettings{MajorIterations: 2}, m.new())
+		if err != IterationLimit.Err() {
+			t.Errorf("%s: unexpected error: got %v want %v", m.name, err, IterationLimit.Err())
+		}
+		if res.Status != IterationLimit || res.MajorIterations != 2 {
+			t.Errorf("%s: unexpected result: status %v after %d iterations", m.name, res.Status, res.MajorIterations)
+		}
+
+		res, err = LeastSquares(rosenbrockResiduals, []float64{-1.2, 1}, &LeastSquaresSettings{FuncEvaluations: 3}, m.new())
+		if err != FunctionEvaluationLimit.Err() {
+			t.Errorf("%s: unexpected error: got %v want %v", m.name, err, FunctionEvaluationLimit.Err())
+		}
+		if res.FuncEvaluations != 3 {
+			t.Errorf("%s: unexpected number of function evaluations: got %d want 3", m.name, res.FuncEvaluations)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const defaultLevenbergMarquardtDamping = 1e-3
+
+var _ LeastSquaresMethod = (*LevenbergMarquardt)(nil)
+
+// LevenbergMarquardt is the Levenberg-Marquardt method for nonlinear least
+// squares problems. At each iteration the step s is found by solving the
+// damped linear least squares problem
+//
+//	minimize |J⋅s + r|² + μ|D⋅s|²,
+//
+// where J is the Jacobian of the residuals r, μ is the damping parameter and D
+// is a diagonal scaling matrix holding the largest column norms of J seen so
+// far. The damping parameter is updated according to the agreement between
+// the actual and the predicted reduction of the cost following Nielsen.
+//
+// Bound constraints are handled by fixing the variables that lie on a bound
+// with the gradient pointing out of the feasible region and projecting the
+// trial points onto the feasible region. For problems with many active bounds
+// TrustRegionReflective is usually more efficient.
+//
+// References:
+//   - Marquardt, D. W. (1963). An algorithm for least-squares estimation of
+//     nonlinear parameters. Journal of the Society for Industrial and Applied
+//     Mathematics, 11(2), 431-441.
+//   - Nielsen, H. B. (1999). Damping parameter in Marquardt's method.
+//     Technical Report IMM-REP-1999-05, Technical University of Denmark.
+type LevenbergMarquardt struct {
+	// InitDamping is the initial damping parameter μ. Since D holds
+	// the column norms of J, μ is relative to the scale of JᵀJ. If
+	// it is zero, a default value of 1e-3 is used.
+	InitDamping float64
+}
+
+func (lm *LevenbergMarquardt) solve(lsq *leastSquares) (Status, error) {
+	m, n := lsq.m, lsq.n
+	mu := lm.InitDamping
+	if mu == 0 {
+		mu = defaultLevenbergMarquardtDamping
+	}
+
+	diag := make([]float64, n)
+	updateColumnScale(diag, lsq.js)
+	nu := 2.0
+
+	aug := mat.NewDense(m+n, n, nil)
+	rhs := mat.NewVecDense(m+n, nil)
+	var (
+		qr mat.QR
+		sv mat.VecDense
+	)
+	step := make([]float64, n)
+	xNew := make([]float64, n)
+	rNew := make([]float64, m)
+	pg := make([]float64, n)
+	active := make([]bool, n)
+	for {
+		// Check the norm of the projected gradient.
+		floats.SubTo(pg, lsq.x, lsq.g)
+		project(pg, lsq.lower, lsq.upper)
+		floats.Sub(pg, lsq.x)
+		if floats.Norm(pg, math.Inf(1)) < lsq.settings.GradientTolerance {
+			return GradientThreshold, nil
+		}
+		if status := lsq.limit(); status != NotTerminated {
+			return status, nil
+		}
+
+		aug.Zero()
+		aug.Slice(0, m, 0, n).(*mat.Dense).Copy(lsq.js)
+		for i, r := range lsq.rs {
+			rhs.SetVec(i, -r)
+		}
+		// Fix the variables on an active bound by
+		// removing them from the linear system.
+		for j, xj := range lsq.x {
+			g := lsq.g[j]
+			active[j] = xj <= lsq.lower[j] && g > 0 || xj >= lsq.upper[j] && g < 0
+			if active[j] {
+				for i := 0; i < m; i++ {
+					aug.Set(i, j, 0)
+				}
+			}
+		}
+
+		for {
+			if status := lsq.limit(); status != NotTerminated {
+				return status, nil
+			}
+			sqrtMu := math.Sqrt(mu)
+			for i, d := range diag {
+				if active[i] {
+					aug.Set(m+i, i, 1)
+				} else {
+					aug.Set(m+i, i, sqrtMu*d)
+				}
+			}
+			qr.Factorize(aug)
+			err := qr.SolveVecTo(&sv, false, rhs)
+			if err != nil {
+				// The augmented system is singular which
+				// can only happen with zero columns of J.
+				mu *= nu
+				nu *= 2
+				continue
+			}
+			floats.AddTo(xNew, lsq.x, sv.RawVector().Data)
+			project(xNew, lsq.lower, lsq.upper)
+			floats.SubTo(step, xNew, lsq.x)
+			stepNorm := floats.Norm(step, 2)
+
+			var reduction, predicted float64
+			ok := lsq.evalResiduals(rNew, xNew)
+			if ok {
+				costNew := lsq.costOf(rNew)
+				reduction = lsq.cost - costNew
+				predicted = -modelValue(lsq.js, lsq.g, nil, step)
+				ratio := reductionRatio(reduction, predicted)
+				status := lsq.converged(reduction, stepNorm, ratio)
+				if reduction > 0 && predicted > 0 {
+					lsq.accept(xNew, rNew, costNew)
+					updateColumnScale(diag, lsq.js)
+					mu *= math.Max(1.0/3, 1-math.Pow(2*ratio-1, 3))
+					nu = 2
+					if status != NotTerminated {
+						return status, nil
+					}
+					break
+				}
+				if status != NotTerminated {
+					return status, nil
+				}
+			} else if stepNorm < lsq.settings.StepTolerance*(lsq.settings.StepTolerance+floats.Norm(lsq.x, 2)) {
+				return StepConvergence, nil
+			}
+			mu *= nu
+			nu *= 2
+		}
+	}
+}
+
+// updateColumnScale updates the elements of diag to the maximum of their
+// current value and the norm of the corresponding column of a. Zero norms are
+// replaced by one.
+func updateColumnScale(diag []float64, a *mat.Dense) {
+	for j := range diag {
+		norm := mat.Norm(a.ColView(j), 2)
+		if norm == 0 {
+			norm = 1
+		}
+		diag[j] = math.Max(diag[j], norm)
+	}
+}
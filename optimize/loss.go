@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import "math"
+
+// Loss is a loss function ρ used to reduce the influence of outliers on the
+// solution of a least squares problem. The cost of the problem is
+//
+//	1/2 Σ_i ρ(r_i²),
+//
+// where r_i are the residuals.
+type Loss interface {
+	// Loss returns the value of the loss function and its first and
+	// second derivatives at z, the squared residual.
+	Loss(z float64) (rho, drho, d2rho float64)
+}
+
+// HuberLoss is the Huber loss function
+//
+//	ρ(z) = z           if z ≤ 1,
+//	ρ(z) = 2√z - 1     otherwise,
+//
+// applied to the residuals divided by Scale. HuberLoss is quadratic for small
+// residuals and linear for large residuals.
+type HuberLoss struct {
+	// Scale is the soft margin between inlier and outlier
+	// residuals. If Scale is zero, a value of 1 is used.
+	Scale float64
+}
+
+// Loss implements the Loss interface.
+func (l HuberLoss) Loss(z float64) (rho, drho, d2rho float64) {
+	return scaleLoss(z, l.Scale, func(z float64) (float64, float64, float64) {
+		if z <= 1 {
+			return z, 1, 0
+		}
+		s := math.Sqrt(z)
+		return 2*s - 1, 1 / s, -0.5 / (z * s)
+	})
+}
+
+// SoftL1Loss is the smooth approximation to the absolute value loss
+//
+//	ρ(z) = 2(√(1+z) - 1),
+//
+// applied to the residuals divided by Scale.
+type SoftL1Loss struct {
+	// Scale is the soft margin between inlier and outlier
+	// residuals. If Scale is zero, a value of 1 is used.
+	Scale float64
+}
+
+// Loss implements the Loss interface.
+func (l SoftL1Loss) Loss(z float64) (rho, drho, d2rho float64) {
+	return scaleLoss(z, l.Scale, func(z float64) (float64, float64, float64) {
+		t := 1 + z
+		s := math.Sqrt(t)
+		return 2 * (s - 1), 1 / s, -0.5 / (t * s)
+	})
+}
+
+// CauchyLoss is the Cauchy loss function
+//
+//	ρ(z) = log(1+z),
+//
+// applied to the residuals divided by Scale. CauchyLoss severely weakens the
+// influence of outliers.
+type CauchyLoss struct {
+	// Scale is the soft margin between inlier and outlier
+	// residuals. If Scale is zero, a value of 1 is used.
+	Scale float64
+}
+
+// Loss implements the Loss interface.
+func (l CauchyLoss) Loss(z float64) (rho, drho, d2rho float64) {
+	return scaleLoss(z, l.Scale, func(z float64) (float64, float64, float64) {
+		t := 1 + z
+		return math.Log1p(z), 1 / t, -1 / (t * t)
+	})
+}
+
+// scaleLoss evaluates the loss function
+//
+//	C² ρ(z/C²)
+//
+// and its derivatives where C is the scale.
+func scaleLoss(z, scale float64, rho func(z float64) (float64, float64, float64)) (float64, float64, float64) {
+	if scale == 0 {
+		scale = 1
+	}
+	c2 := scale * scale
+	r0, r1, r2 := rho(z / c2)
+	return c2 * r0, r1, r2 / c2
+}
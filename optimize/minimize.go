@@ -126,7 +126,9 @@ type Method interface {
 //
 // The second argument specifies the initial location for the optimization.
 // Some Methods do not require an initial location, but initX must still be
-// specified for the dimension of the optimization problem.
+// specified for the dimension of the optimization problem. If p specifies
+// bounds and initX lies outside of them, the optimization starts from the
+// projection of initX onto the bounds.
 //
 // The third argument contains the settings for the minimization. If settings
 // is nil, the zero value will be used, see the documentation of the Settings
@@ -168,6 +170,7 @@ func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*R
 	optLoc := newLocation(dim) // This must have an allocated X field.
 	optLoc.F = math.Inf(1)
 
+	initX = projectInitLocation(p, dim, initX, settings.InitValues)
 	initOp, initLoc := getInitLocation(dim, initX, settings.InitValues)
 
 	converger := settings.Converger
@@ -203,6 +206,9 @@ func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*R
 }
 
 func getDefaultMethod(p *Problem) Method {
+	if p.Lower != nil || p.Upper != nil {
+		return &LBFGSB{}
+	}
 	if p.Grad != nil {
 		return &LBFGS{}
 	}
@@ -222,6 +228,9 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 	if initErr != nil {
 		panic(fmt.Sprintf("optimize: specified method inconsistent with Problem: %v", initErr))
 	}
+	if bm, ok := method.(boundedMethod); ok {
+		bm.setBounds(problemBounds(prob, dim))
+	}
 	newNTasks := method.Init(dim, nTasks)
 	if newNTasks > nTasks {
 		panic("optimize: too many tasks returned by Method")
@@ -349,7 +358,7 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 		case NoOperation:
 			// Just send the task back.
 		case MajorIteration:
-			status = performMajorIteration(optLoc, task.Location, stats, converger, startTime, settings)
+			status = performMajorIteration(prob, optLoc, task.Location, stats, converger, startTime, settings)
 		case MethodDone:
 			methodDone = true
 			status = MethodConverge
@@ -449,6 +458,44 @@ func getInitLocation(dim int, initX []float64, initValues *Location) (Operation,
 	return op, loc
 }
 
+// problemBounds returns the bounds of the problem with missing bounds
+// replaced by infinities.
+func problemBounds(p *Problem, dim int) (lower, upper []float64) {
+	lower = make([]float64, dim)
+	upper = make([]float64, dim)
+	for i := range lower {
+		lower[i] = math.Inf(-1)
+		upper[i] = math.Inf(1)
+	}
+	if p.Lower != nil {
+		copy(lower, p.Lower)
+	}
+	if p.Upper != nil {
+		copy(upper, p.Upper)
+	}
+	return lower, upper
+}
+
+// projectInitLocation returns the initial location projected onto the bounds
+// of the problem. The returned slice is a copy if the projection modified any
+// element.
+func projectInitLocation(p Problem, dim int, initX []float64, initValues *Location) []float64 {
+	if initX == nil || p.Lower == nil && p.Upper == nil {
+		return initX
+	}
+	lower, upper := problemBounds(&p, dim)
+	if inBounds(initX, lower, upper) {
+		return initX
+	}
+	if initValues != nil {
+		panic("optimize: initial location outside bounds with InitValues specified")
+	}
+	x := make([]float64, dim)
+	copy(x, initX)
+	project(x, lower, upper)
+	return x
+}
+
 func checkOptimization(p Problem, dim int, recorder Recorder) error {
 	if p.Func == nil {
 		panic(badProblem)
@@ -456,6 +503,16 @@ func checkOptimization(p Problem, dim int, recorder Recorder) error {
 	if dim <= 0 {
 		panic("optimize: impossible problem dimension")
 	}
+	if p.Lower != nil && len(p.Lower) != dim || p.Upper != nil && len(p.Upper) != dim {
+		panic("optimize: bounds do not match problem dimension")
+	}
+	if p.Lower != nil && p.Upper != nil {
+		for i, l := range p.Lower {
+			if l > p.Upper[i] {
+				panic("optimize: lower bound greater than upper bound")
+			}
+		}
+	}
 	if p.Status != nil {
 		_, err := p.Status()
 		if err != nil {
@@ -525,12 +582,22 @@ func updateEvaluationStats(stats *Stats, op Operation) {
 // the convergence criteria given by settings. Otherwise a corresponding status is
 // returned.
 // Unlike checkLimits, checkConvergence is called only at MajorIterations.
-func checkLocationConvergence(loc *Location, settings *Settings, converger Converger) Status {
+func checkLocationConvergence(p *Problem, loc *Location, settings *Settings, converger Converger) Status {
 	if math.IsInf(loc.F, -1) {
 		return FunctionNegativeInfinity
 	}
 	if loc.Gradient != nil && settings.GradientThreshold > 0 {
-		norm := floats.Norm(loc.Gradient, math.Inf(1))
+		grad := loc.Gradient
+		if p.Lower != nil || p.Upper != nil {
+			// Use the projected gradient so that variables held
+			// at their bounds do not prevent convergence.
+			lower, upper := problemBounds(p, len(loc.X))
+			grad = make([]float64, len(loc.X))
+			floats.SubTo(grad, loc.X, loc.Gradient)
+			project(grad, lower, upper)
+			floats.Sub(grad, loc.X)
+		}
+		norm := floats.Norm(grad, math.Inf(1))
 		if norm < settings.GradientThreshold {
 			return GradientThreshold
 		}
@@ -574,7 +641,7 @@ func checkIterationLimits(loc *Location, stats *Stats, settings *Settings) Statu
 // performMajorIteration does all of the steps needed to perform a MajorIteration.
 // It increments the iteration count, updates the optimal location, and checks
 // the necessary convergence criteria.
-func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Converger, startTime time.Time, settings *Settings) Status {
+func performMajorIteration(p *Problem, optLoc, loc *Location, stats *Stats, converger Converger, startTime time.Time, settings *Settings) Status {
 	optLoc.F = loc.F
 	copy(optLoc.X, loc.X)
 	if loc.Gradient == nil {
@@ -587,7 +654,7 @@ func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Conver
 	}
 	stats.MajorIterations++
 	stats.Runtime = time.Since(startTime)
-	status := checkLocationConvergence(optLoc, settings, converger)
+	status := checkLocationConvergence(p, optLoc, settings, converger)
 	if status != NotTerminated {
 		return status
 	}
@@ -0,0 +1,452 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var _ LeastSquaresMethod = (*TrustRegionReflective)(nil)
+
+// TrustRegionReflective is a trust region method for bound constrained
+// nonlinear least squares problems. The bounds are handled by the affine
+// scaling of Coleman and Li that keeps the iterates strictly feasible. The
+// trust region subproblem is solved exactly in the scaled variables using the
+// singular value decomposition of the augmented Jacobian. When a step crosses
+// a bound, the best of the step truncated at the bound, the step reflected
+// from the bound and the scaled steepest descent step is taken.
+//
+// TrustRegionReflective is robust for both bounded and unbounded problems,
+// including rank-deficient ones. The cost of an iteration is dominated by the
+// singular value decomposition of an (m+n)×n matrix.
+//
+// References:
+//   - Branch, M. A., Coleman, T. F., & Li, Y. (1999). A subspace, interior,
+//     and conjugate gradient method for large-scale bound-constrained
+//     minimization problems. SIAM Journal on Scientific Computing, 21(1),
+//     1-23.
+//   - Moré, J. J. (1978). The Levenberg-Marquardt algorithm: implementation
+//     and theory. In Numerical Analysis (pp. 105-116). Springer.
+type TrustRegionReflective struct {
+	// InitRadius is the initial trust region radius. If it is zero,
+	// the radius is initialized from the norm of the scaled initial
+	// location.
+	InitRadius float64
+}
+
+func (trf *TrustRegionReflective) solve(lsq *leastSquares) (Status, error) {
+	m, n := lsq.m, lsq.n
+	lower, upper := lsq.lower, lsq.upper
+
+	v := make([]float64, n)
+	dv := make([]float64, n)
+	d := make([]float64, n)
+	diagH := make([]float64, n)
+	gh := make([]float64, n)
+	jh := mat.NewDense(m, n, nil)
+	aug := mat.NewDense(m+n, n, nil)
+	fAug := mat.NewVecDense(m+n, nil)
+	uf := make([]float64, n)
+	ph := make([]float64, n)
+	step := make([]float64, n)
+	stepH := make([]float64, n)
+	xNew := make([]float64, n)
+	rNew := make([]float64, m)
+	var (
+		svd mat.SVD
+		u   mat.Dense
+		vt  mat.Dense
+		s   []float64
+	)
+
+	colemanLi(v, dv, lsq.x, lsq.g, lower, upper)
+	delta := trf.InitRadius
+	if delta == 0 {
+		for i, xi := range lsq.x {
+			delta += xi * xi / v[i]
+		}
+		delta = math.Sqrt(delta)
+		if delta == 0 {
+			delta = 1
+		}
+	}
+
+	var alpha float64 // Levenberg-Marquardt parameter.
+	for {
+		colemanLi(v, dv, lsq.x, lsq.g, lower, upper)
+		var gNorm float64
+		for i, gi := range lsq.g {
+			gNorm = math.Max(gNorm, math.Abs(gi*v[i]))
+		}
+		if gNorm < lsq.settings.GradientTolerance {
+			return GradientThreshold, nil
+		}
+		if status := lsq.limit(); status != NotTerminated {
+			return status, nil
+		}
+
+		// Compute the quantities in the scaled variables
+		// where the trust region is a sphere.
+		for i := range d {
+			d[i] = math.Sqrt(v[i])
+			diagH[i] = lsq.g[i] * dv[i]
+			gh[i] = d[i] * lsq.g[i]
+		}
+		for i := 0; i < m; i++ {
+			row := jh.RawRowView(i)
+			floats.MulTo(row, lsq.js.RawRowView(i), d)
+		}
+		aug.Zero()
+		aug.Slice(0, m, 0, n).(*mat.Dense).Copy(jh)
+		for i, dh := range diagH {
+			aug.Set(m+i, i, math.Sqrt(dh))
+		}
+		for i, r := range lsq.rs {
+			fAug.SetVec(i, r)
+		}
+		ok := svd.Factorize(aug, mat.SVDThin)
+		if !ok {
+			return Failure, nil
+		}
+		svd.UTo(&u)
+		svd.VTo(&vt)
+		s = svd.Values(s)
+		ufv := mat.NewVecDense(n, uf)
+		ufv.MulVec(u.T(), fAug)
+
+		theta := math.Max(0.995, 1-gNorm)
+		reduction := -1.0
+		var (
+			costNew float64
+			status  Status
+		)
+		for reduction <= 0 {
+			if status = lsq.limit(); status != NotTerminated {
+				return status, nil
+			}
+			alpha = solveTrustRegionSVD(ph, m, uf, s, &vt, delta, alpha)
+			p := make([]float64, n)
+			floats.MulTo(p, d, ph)
+			predicted := selectReflectiveStep(step, stepH, lsq.x, jh, diagH, gh, p, ph, d, delta, lower, upper, theta)
+			floats.AddTo(xNew, lsq.x, step)
+			makeStrictlyFeasible(xNew, lower, upper)
+			stepHNorm := floats.Norm(stepH, 2)
+			if !lsq.evalResiduals(rNew, xNew) {
+				delta = 0.25 * stepHNorm
+				continue
+			}
+			costNew = lsq.costOf(rNew)
+			reduction = lsq.cost - costNew
+
+			deltaNew := delta
+			ratio := reductionRatio(reduction, predicted)
+			switch {
+			case ratio < 0.25:
+				deltaNew = 0.25 * stepHNorm
+			case ratio > 0.75 && stepHNorm > 0.95*delta:
+				deltaNew *= 2
+			}
+			status = lsq.converged(reduction, floats.Norm(step, 2), ratio)
+			if status != NotTerminated {
+				break
+			}
+			alpha *= delta / deltaNew
+			delta = deltaNew
+		}
+		if reduction > 0 {
+			lsq.accept(xNew, rNew, costNew)
+		}
+		if status != NotTerminated {
+			return status, nil
+		}
+	}
+}
+
+// colemanLi computes the Coleman-Li scaling vector v and its derivative dv
+// at x for the gradient g.
+func colemanLi(v, dv, x, g, lower, upper []float64) {
+	for i, gi := range g {
+		v[i] = 1
+		dv[i] = 0
+		switch {
+		case gi < 0 && !math.IsInf(upper[i], 1):
+			v[i] = upper[i] - x[i]
+			dv[i] = -1
+		case gi > 0 && !math.IsInf(lower[i], -1):
+			v[i] = x[i] - lower[i]
+			dv[i] = 1
+		}
+	}
+}
+
+// solveTrustRegionSVD solves the trust region subproblem
+//
+//	minimize |J⋅p + f|  subject to |p| ≤ delta
+//
+// given the thin singular value decomposition J = U⋅diag(s)⋅Vᵀ of the m×n
+// matrix J and uf = Uᵀ⋅f. The solution is stored into p and the
+// corresponding Levenberg-Marquardt parameter is returned. The parameter is
+// found by Newton iterations on the secular equation starting from alpha.
+func solveTrustRegionSVD(p []float64, m int, uf, s []float64, v *mat.Dense, delta, alpha float64) float64 {
+	const (
+		rtol    = 0.01
+		maxIter = 10
+	)
+	n := len(p)
+	suf := make([]float64, n)
+	floats.MulTo(suf, s, uf)
+	tmp := make([]float64, n)
+	pv := mat.NewVecDense(n, p)
+
+	phi := func(alpha float64) (phi, dphi float64) {
+		var sum, sum3 float64
+		for i, sv := range s {
+			den := sv*sv + alpha
+			sum += (suf[i] / den) * (suf[i] / den)
+			sum3 += suf[i] * suf[i] / (den * den * den)
+		}
+		norm := math.Sqrt(sum)
+		return norm - delta, -sum3 / norm
+	}
+
+	fullRank := m >= n && s[n-1] > dlamchE*float64(m)*s[0]
+	if fullRank {
+		// Try the Gauss-Newton step.
+		for i := range tmp {
+			tmp[i] = -uf[i] / s[i]
+		}
+		pv.MulVec(v, mat.NewVecDense(n, tmp))
+		if floats.Norm(p, 2) <= delta {
+			return 0
+		}
+	}
+
+	upper := floats.Norm(suf, 2) / delta
+	var lower float64
+	if fullRank {
+		f, df := phi(0)
+		lower = -f / df
+	}
+	if alpha == 0 && !fullRank {
+		alpha = math.Max(0.001*upper, math.Sqrt(lower*upper))
+	}
+	for it := 0; it < maxIter; it++ {
+		if alpha < lower || alpha > upper {
+			alpha = math.Max(0.001*upper, math.Sqrt(lower*upper))
+		}
+		f, df := phi(alpha)
+		if f < 0 {
+			upper = alpha
+		}
+		ratio := f / df
+		lower = math.Max(lower, alpha-ratio)
+		alpha -= (f + delta) * ratio / delta
+		if math.Abs(f) < rtol*delta {
+			break
+		}
+	}
+	for i, sv := range s {
+		tmp[i] = -suf[i] / (sv*sv + alpha)
+	}
+	pv.MulVec(v, mat.NewVecDense(n, tmp))
+	// Make the norm of p equal to delta to
+	// prevent it from lying outside the trust
+	// region due to the inexact solution.
+	floats.Scale(delta/floats.Norm(p, 2), p)
+	return alpha
+}
+
+// selectReflectiveStep selects the best of the trust region step p truncated
+// at a bound, the step reflected from the bound and the scaled steepest
+// descent step, and stores it into step and its scaled counterpart into
+// stepH. It returns the predicted reduction of the cost. The scaled step ph
+// and p are modified.
+func selectReflectiveStep(step, stepH, x []float64, jh mat.Matrix, diagH, gh, p, ph, d []float64, delta float64, lower, upper []float64, theta float64) float64 {
+	n := len(x)
+	xp := make([]float64, n)
+	floats.AddTo(xp, x, p)
+	if inBounds(xp, lower, upper) {
+		copy(step, p)
+		copy(stepH, ph)
+		return -modelValue(jh, gh, diagH, ph)
+	}
+
+	pStride, hits := stepToBound(x, p, lower, upper)
+
+	// Compute the reflected direction.
+	rh := make([]float64, n)
+	copy(rh, ph)
+	for i, hit := range hits {
+		if hit {
+			rh[i] = -rh[i]
+		}
+	}
+	r := make([]float64, n)
+	floats.MulTo(r, d, rh)
+
+	// Restrict the trust region step such that it hits the bound.
+	floats.Scale(pStride, p)
+	floats.Scale(pStride, ph)
+	xOnBound := make([]float64, n)
+	floats.AddTo(xOnBound, x, p)
+
+	// The reflected direction crosses either the boundary of the
+	// feasible region or the trust region first.
+	_, toTR := intersectTrustRegion(ph, rh, delta)
+	toBound, _ := stepToBound(xOnBound, r, lower, upper)
+	rStride := math.Min(toBound, toTR)
+	var rLower, rUpper float64
+	if rStride > 0 {
+		rLower = (1 - theta) * pStride / rStride
+		if rStride == toBound {
+			rUpper = theta * toBound
+		} else {
+			rUpper = toTR
+		}
+	} else {
+		rLower, rUpper = 0, -1
+	}
+	rValue := math.Inf(1)
+	if rLower <= rUpper {
+		a, b, c := quadratic1D(jh, gh, rh, ph, diagH)
+		var t float64
+		t, rValue = minimizeQuadratic1D(a, b, c, rLower, rUpper)
+		for i := range rh {
+			rh[i] = t*rh[i] + ph[i]
+			r[i] = rh[i] * d[i]
+		}
+	}
+
+	// Make the truncated step strictly interior.
+	floats.Scale(theta, p)
+	floats.Scale(theta, ph)
+	pValue := modelValue(jh, gh, diagH, ph)
+
+	// Scaled steepest descent step.
+	agh := make([]float64, n)
+	floats.ScaleTo(agh, -1, gh)
+	ag := make([]float64, n)
+	floats.MulTo(ag, d, agh)
+	toTR = delta / floats.Norm(agh, 2)
+	toBound, _ = stepToBound(x, ag, lower, upper)
+	agStride := toTR
+	if toBound < toTR {
+		agStride = theta * toBound
+	}
+	a, b, _ := quadratic1D(jh, gh, agh, nil, diagH)
+	agStride, agValue := minimizeQuadratic1D(a, b, 0, 0, agStride)
+	floats.Scale(agStride, agh)
+	floats.Scale(agStride, ag)
+
+	switch {
+	case pValue < rValue && pValue < agValue:
+		copy(step, p)
+		copy(stepH, ph)
+		return -pValue
+	case rValue < pValue && rValue < agValue:
+		copy(step, r)
+		copy(stepH, rh)
+		return -rValue
+	default:
+		copy(step, ag)
+		copy(stepH, agh)
+		return -agValue
+	}
+}
+
+// inBounds returns whether x lies within the bounds.
+func inBounds(x, lower, upper []float64) bool {
+	for i, v := range x {
+		if v < lower[i] || upper[i] < v {
+			return false
+		}
+	}
+	return true
+}
+
+// stepToBound returns the smallest step t such that x + t*s lies on a bound
+// and reports which elements of x reach the bound at that step.
+func stepToBound(x, s, lower, upper []float64) (float64, []bool) {
+	steps := make([]float64, len(x))
+	t := math.Inf(1)
+	for i, si := range s {
+		steps[i] = math.Inf(1)
+		if si != 0 {
+			steps[i] = math.Max((lower[i]-x[i])/si, (upper[i]-x[i])/si)
+		}
+		t = math.Min(t, steps[i])
+	}
+	hits := make([]bool, len(x))
+	for i, st := range steps {
+		hits[i] = st == t && s[i] != 0
+	}
+	return t, hits
+}
+
+// intersectTrustRegion returns the values t1 ≤ t2 for which |x + t*s| = delta.
+func intersectTrustRegion(x, s []float64, delta float64) (t1, t2 float64) {
+	a := floats.Dot(s, s)
+	b := floats.Dot(x, s)
+	c := floats.Dot(x, x) - delta*delta
+	d := math.Sqrt(b*b - a*c)
+	q := -(b + math.Copysign(d, b))
+	t1 = q / a
+	t2 = c / q
+	if t1 > t2 {
+		t1, t2 = t2, t1
+	}
+	return t1, t2
+}
+
+// quadratic1D returns the coefficients of the quadratic a*t² + b*t + c
+// equal to the value of the model
+//
+//	gᵀy + 1/2 (|J⋅y|² + Σ_i diag_i y_i²)
+//
+// along the line y = s0 + t*s. If s0 is nil, it is treated as zero.
+func quadratic1D(jac mat.Matrix, g, s, s0, diag []float64) (a, b, c float64) {
+	n := len(s)
+	var js mat.VecDense
+	js.MulVec(jac, mat.NewVecDense(n, s))
+	a = mat.Dot(&js, &js)
+	for i, di := range diag {
+		a += di * s[i] * s[i]
+	}
+	a *= 0.5
+	b = floats.Dot(g, s)
+	if s0 != nil {
+		var js0 mat.VecDense
+		js0.MulVec(jac, mat.NewVecDense(n, s0))
+		b += mat.Dot(&js0, &js)
+		c = 0.5*mat.Dot(&js0, &js0) + floats.Dot(g, s0)
+		for i, di := range diag {
+			b += di * s0[i] * s[i]
+			c += 0.5 * di * s0[i] * s0[i]
+		}
+	}
+	return a, b, c
+}
+
+// minimizeQuadratic1D returns the minimizer of a*t² + b*t + c on the interval
+// [lo, hi] and the minimum value.
+func minimizeQuadratic1D(a, b, c, lo, hi float64) (t, val float64) {
+	f := func(t float64) float64 { return t*(a*t+b) + c }
+	t, val = lo, f(lo)
+	if v := f(hi); v < val {
+		t, val = hi, v
+	}
+	if a != 0 {
+		ext := -0.5 * b / a
+		if lo < ext && ext < hi {
+			if v := f(ext); v < val {
+				t, val = ext, v
+			}
+		}
+	}
+	return t, val
+}
@@ -131,6 +131,14 @@ type Problem struct {
 	// will have dimensions matching the length of x. Hess must not modify x.
 	Hess func(hess *mat.SymDense, x []float64)
 
+	// Lower and Upper hold the bounds on the variables. If Lower is nil,
+	// the variables are not bounded from below, otherwise its length must
+	// match the problem dimension and its elements may be -∞. Similarly for
+	// Upper. Each element of Lower must not be greater than the
+	// corresponding element of Upper. Only Methods that support bound
+	// constraints, such as LBFGSB, can be used with a bounded Problem.
+	Lower, Upper []float64
+
 	// Status reports the status of the objective function being optimized and any
 	// error. This can be used to terminate early, for example when the function is
 	// not able to evaluate itself. The user can use one of the pre-provided Status
@@ -138,29 +146,37 @@ type Problem struct {
 	Status func() (Status, error)
 }
 
-// Available describes the functions available to call in Problem.
+// Available describes the functions available to call in Problem and whether
+// the Problem has bound constraints.
 type Available struct {
-	Grad bool
-	Hess bool
+	Grad   bool
+	Hess   bool
+	Bounds bool
 }
 
 func availFromProblem(prob Problem) Available {
-	return Available{Grad: prob.Grad != nil, Hess: prob.Hess != nil}
+	return Available{
+		Grad:   prob.Grad != nil,
+		Hess:   prob.Hess != nil,
+		Bounds: prob.Lower != nil || prob.Upper != nil,
+	}
 }
 
 // function tests if the Problem described by the receiver is suitable for an
 // unconstrained Method that only calls the function, and returns the result.
 func (has Available) function() (uses Available, err error) {
-	// TODO(btracey): This needs to be modified when optimize supports
-	// constrained optimization.
+	if has.Bounds {
+		return Available{}, ErrUnsupportedBounds
+	}
 	return Available{}, nil
 }
 
 // gradient tests if the Problem described by the receiver is suitable for an
 // unconstrained gradient-based Method, and returns the result.
 func (has Available) gradient() (uses Available, err error) {
-	// TODO(btracey): This needs to be modified when optimize supports
-	// constrained optimization.
+	if has.Bounds {
+		return Available{}, ErrUnsupportedBounds
+	}
 	if !has.Grad {
 		return Available{}, ErrMissingGrad
 	}
@@ -170,8 +186,9 @@ func (has Available) gradient() (uses Available, err error) {
 // hessian tests if the Problem described by the receiver is suitable for an
 // unconstrained Hessian-based Method, and returns the result.
 func (has Available) hessian() (uses Available, err error) {
-	// TODO(btracey): This needs to be modified when optimize supports
-	// constrained optimization.
+	if has.Bounds {
+		return Available{}, ErrUnsupportedBounds
+	}
 	if !has.Grad {
 		return Available{}, ErrMissingGrad
 	}
@@ -181,6 +198,15 @@ func (has Available) hessian() (uses Available, err error) {
 	return Available{Grad: true, Hess: true}, nil
 }
 
+// boundedGradient tests if the Problem described by the receiver is suitable
+// for a bound-constrained gradient-based Method, and returns the result.
+func (has Available) boundedGradient() (uses Available, err error) {
+	if !has.Grad {
+		return Available{}, ErrMissingGrad
+	}
+	return Available{Grad: true, Bounds: has.Bounds}, nil
+}
+
 // Settings represents settings of the optimization run. It contains initial
 // settings, convergence information, and Recorder information. Convergence
 // settings are only checked at MajorIterations, while Evaluation thresholds
@@ -194,7 +220,9 @@ type Settings struct {
 	InitValues *Location
 
 	// GradientThreshold stops optimization with GradientThreshold status if the
-	// infinity norm of the gradient is less than this value. This defaults to
+	// infinity norm of the gradient is less than this value. For problems with
+	// bounds the projected gradient P(x - ∇f(x)) - x is used instead, where P
+	// is the projection onto the bounds. This defaults to
 	// a value of 0 (and so gradient convergence is not checked), however note
 	// that many Methods (LBFGS, CG, etc.) will converge with a small value of
 	// the gradient, and so to fully disable this setting the Method may need to
@@ -1213,6 +1213,11 @@ func TestLBFGS(t *testing.T) {
 	testLocal(t, tests, &LBFGS{})
 }
 
+func TestLBFGSB(t *testing.T) {
+	t.Parallel()
+	testLocal(t, gradientDescentTests, &LBFGSB{})
+}
+
 func TestNewton(t *testing.T) {
 	t.Parallel()
 	testLocal(t, newtonTests, &Newton{})
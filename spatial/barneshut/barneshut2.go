@@ -240,6 +240,11 @@ func splitPlane(b r2.Box, dir int) r2.Box {
 
 // summarize updates node masses and centers of mass.
 func (t *tile) summarize() (center r2.Vec, mass float64) {
+	if t.particle != nil {
+		// Leaves hold the particle's own
+		// position and mass.
+		return t.center, t.mass
+	}
 	for _, d := range &t.nodes {
 		if d == nil {
 			continue
@@ -407,6 +407,29 @@ func walkPlane(t *tile, fn func(*tile)) {
 	}
 }
 
+func TestPlaneMass(t *testing.T) {
+	t.Parallel()
+	// Particles with non-unit masses are placed at their
+	// own positions in the tree, so the exact and tree
+	// force calculations agree.
+	particles := []Particle2{
+		particle2{x: 1, y: 2, m: 2},
+		particle2{x: -3, y: 1, m: 3},
+		particle2{x: 2, y: -2, m: 0.5},
+	}
+	q, err := NewPlane(particles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, p := range particles {
+		want := q.ForceOn(p, 0, Gravity2)
+		got := q.ForceOn(p, 1e-3, Gravity2)
+		if r2.Norm(r2.Sub(got, want)) > 1e-12 {
+			t.Errorf("unexpected force on particle at %v: got:%v want:%v", p.Coord2(), got, want)
+		}
+	}
+}
+
 func TestPlaneForceOn(t *testing.T) {
 	t.Parallel()
 	const (
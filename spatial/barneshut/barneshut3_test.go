@@ -404,6 +404,29 @@ func walkVolume(t *bucket, fn func(*bucket)) {
 	}
 }
 
+func TestVolumeMass(t *testing.T) {
+	t.Parallel()
+	// Particles with non-unit masses are placed at their
+	// own positions in the tree, so the exact and tree
+	// force calculations agree.
+	particles := []Particle3{
+		particle3{x: 1, y: 2, z: 0, m: 2},
+		particle3{x: -3, y: 1, z: 2, m: 3},
+		particle3{x: 2, y: -2, z: 1, m: 0.5},
+	}
+	q, err := NewVolume(particles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, p := range particles {
+		want := q.ForceOn(p, 0, Gravity3)
+		got := q.ForceOn(p, 1e-3, Gravity3)
+		if r3.Norm(r3.Sub(got, want)) > 1e-12 {
+			t.Errorf("unexpected force on particle at %v: got:%v want:%v", p.Coord3(), got, want)
+		}
+	}
+}
+
 func TestVolumeForceOn(t *testing.T) {
 	t.Parallel()
 	const (